@@ -178,8 +178,13 @@ type Config_Spec struct {
 	PrimaryStorage   *Config_Spec_PrimaryStorage   `protobuf:"bytes,1,opt,name=primaryStorage,proto3" json:"primaryStorage,omitempty"`
 	SecondaryStorage *Config_Spec_SecondaryStorage `protobuf:"bytes,2,opt,name=secondaryStorage,proto3" json:"secondaryStorage,omitempty"`
 	Network          *Config_Spec_Network          `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// SecretManager installs the secret manager component and delegates the
+	// storage of the Cluster's Secrets data to it instead of the primary
+	// storage. This can only be effective when set prior to the Cluster
+	// installation.
+	SecretManager *Config_Spec_SecretManager `protobuf:"bytes,4,opt,name=secretManager,proto3" json:"secretManager,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Config_Spec) Reset() {
@@ -233,6 +238,13 @@ func (x *Config_Spec) GetNetwork() *Config_Spec_Network {
 	return nil
 }
 
+func (x *Config_Spec) GetSecretManager() *Config_Spec_SecretManager {
+	if x != nil {
+		return x.SecretManager
+	}
+	return nil
+}
+
 type Config_Status struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -484,6 +496,91 @@ func (x *Config_Spec_Network) GetQuicv0() *Config_Spec_Network_QUICV0 {
 	return nil
 }
 
+type Config_Spec_SecretManager struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Type:
+	//
+	//	*Config_Spec_SecretManager_Vault_
+	//	*Config_Spec_SecretManager_File_
+	Type          isConfig_Spec_SecretManager_Type `protobuf_oneof:"type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Config_Spec_SecretManager) Reset() {
+	*x = Config_Spec_SecretManager{}
+	mi := &file_cbootstrapv1_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config_Spec_SecretManager) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_Spec_SecretManager) ProtoMessage() {}
+
+func (x *Config_Spec_SecretManager) ProtoReflect() protoreflect.Message {
+	mi := &file_cbootstrapv1_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config_Spec_SecretManager.ProtoReflect.Descriptor instead.
+func (*Config_Spec_SecretManager) Descriptor() ([]byte, []int) {
+	return file_cbootstrapv1_proto_rawDescGZIP(), []int{0, 0, 3}
+}
+
+func (x *Config_Spec_SecretManager) GetType() isConfig_Spec_SecretManager_Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *Config_Spec_SecretManager) GetVault() *Config_Spec_SecretManager_Vault {
+	if x != nil {
+		if x, ok := x.Type.(*Config_Spec_SecretManager_Vault_); ok {
+			return x.Vault
+		}
+	}
+	return nil
+}
+
+func (x *Config_Spec_SecretManager) GetFile() *Config_Spec_SecretManager_File {
+	if x != nil {
+		if x, ok := x.Type.(*Config_Spec_SecretManager_File_); ok {
+			return x.File
+		}
+	}
+	return nil
+}
+
+type isConfig_Spec_SecretManager_Type interface {
+	isConfig_Spec_SecretManager_Type()
+}
+
+type Config_Spec_SecretManager_Vault_ struct {
+	// Vault stores the Secrets data in a Vault KV version 2 secrets engine.
+	Vault *Config_Spec_SecretManager_Vault `protobuf:"bytes,1,opt,name=vault,proto3,oneof"`
+}
+
+type Config_Spec_SecretManager_File_ struct {
+	// File stores the Secrets data in encrypted files on a persistent
+	// volume.
+	File *Config_Spec_SecretManager_File `protobuf:"bytes,2,opt,name=file,proto3,oneof"`
+}
+
+func (*Config_Spec_SecretManager_Vault_) isConfig_Spec_SecretManager_Type() {}
+
+func (*Config_Spec_SecretManager_File_) isConfig_Spec_SecretManager_Type() {}
+
 type Config_Spec_PrimaryStorage_Postgresql struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
@@ -498,7 +595,7 @@ type Config_Spec_PrimaryStorage_Postgresql struct {
 
 func (x *Config_Spec_PrimaryStorage_Postgresql) Reset() {
 	*x = Config_Spec_PrimaryStorage_Postgresql{}
-	mi := &file_cbootstrapv1_proto_msgTypes[6]
+	mi := &file_cbootstrapv1_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -510,7 +607,7 @@ func (x *Config_Spec_PrimaryStorage_Postgresql) String() string {
 func (*Config_Spec_PrimaryStorage_Postgresql) ProtoMessage() {}
 
 func (x *Config_Spec_PrimaryStorage_Postgresql) ProtoReflect() protoreflect.Message {
-	mi := &file_cbootstrapv1_proto_msgTypes[6]
+	mi := &file_cbootstrapv1_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -582,7 +679,7 @@ type Config_Spec_SecondaryStorage_Redis struct {
 
 func (x *Config_Spec_SecondaryStorage_Redis) Reset() {
 	*x = Config_Spec_SecondaryStorage_Redis{}
-	mi := &file_cbootstrapv1_proto_msgTypes[7]
+	mi := &file_cbootstrapv1_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -594,7 +691,7 @@ func (x *Config_Spec_SecondaryStorage_Redis) String() string {
 func (*Config_Spec_SecondaryStorage_Redis) ProtoMessage() {}
 
 func (x *Config_Spec_SecondaryStorage_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_cbootstrapv1_proto_msgTypes[7]
+	mi := &file_cbootstrapv1_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -663,7 +760,7 @@ type Config_Spec_Network_V4 struct {
 
 func (x *Config_Spec_Network_V4) Reset() {
 	*x = Config_Spec_Network_V4{}
-	mi := &file_cbootstrapv1_proto_msgTypes[8]
+	mi := &file_cbootstrapv1_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -675,7 +772,7 @@ func (x *Config_Spec_Network_V4) String() string {
 func (*Config_Spec_Network_V4) ProtoMessage() {}
 
 func (x *Config_Spec_Network_V4) ProtoReflect() protoreflect.Message {
-	mi := &file_cbootstrapv1_proto_msgTypes[8]
+	mi := &file_cbootstrapv1_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -709,7 +806,7 @@ type Config_Spec_Network_V6 struct {
 
 func (x *Config_Spec_Network_V6) Reset() {
 	*x = Config_Spec_Network_V6{}
-	mi := &file_cbootstrapv1_proto_msgTypes[9]
+	mi := &file_cbootstrapv1_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -721,7 +818,7 @@ func (x *Config_Spec_Network_V6) String() string {
 func (*Config_Spec_Network_V6) ProtoMessage() {}
 
 func (x *Config_Spec_Network_V6) ProtoReflect() protoreflect.Message {
-	mi := &file_cbootstrapv1_proto_msgTypes[9]
+	mi := &file_cbootstrapv1_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -756,7 +853,7 @@ type Config_Spec_Network_Wireguard struct {
 
 func (x *Config_Spec_Network_Wireguard) Reset() {
 	*x = Config_Spec_Network_Wireguard{}
-	mi := &file_cbootstrapv1_proto_msgTypes[10]
+	mi := &file_cbootstrapv1_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -768,7 +865,7 @@ func (x *Config_Spec_Network_Wireguard) String() string {
 func (*Config_Spec_Network_Wireguard) ProtoMessage() {}
 
 func (x *Config_Spec_Network_Wireguard) ProtoReflect() protoreflect.Message {
-	mi := &file_cbootstrapv1_proto_msgTypes[10]
+	mi := &file_cbootstrapv1_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -812,7 +909,7 @@ type Config_Spec_Network_QUICV0 struct {
 
 func (x *Config_Spec_Network_QUICV0) Reset() {
 	*x = Config_Spec_Network_QUICV0{}
-	mi := &file_cbootstrapv1_proto_msgTypes[11]
+	mi := &file_cbootstrapv1_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -824,7 +921,7 @@ func (x *Config_Spec_Network_QUICV0) String() string {
 func (*Config_Spec_Network_QUICV0) ProtoMessage() {}
 
 func (x *Config_Spec_Network_QUICV0) ProtoReflect() protoreflect.Message {
-	mi := &file_cbootstrapv1_proto_msgTypes[11]
+	mi := &file_cbootstrapv1_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -861,6 +958,145 @@ func (x *Config_Spec_Network_QUICV0) GetMtu() uint32 {
 	return 0
 }
 
+type Config_Spec_SecretManager_Vault struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Address is the Vault server URL (e.g. `https://vault.example.com:8200`).
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Token is the Vault token used by the secret manager.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// Mount is the mount path of the KV v2 secrets engine. By default it
+	// is set to `secret`.
+	Mount string `protobuf:"bytes,3,opt,name=mount,proto3" json:"mount,omitempty"`
+	// PathPrefix is the path under which the Secrets data are stored. By
+	// default it is set to `octelium`.
+	PathPrefix string `protobuf:"bytes,4,opt,name=pathPrefix,proto3" json:"pathPrefix,omitempty"`
+	// Namespace is the Vault Enterprise namespace, if any.
+	Namespace     string `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Config_Spec_SecretManager_Vault) Reset() {
+	*x = Config_Spec_SecretManager_Vault{}
+	mi := &file_cbootstrapv1_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config_Spec_SecretManager_Vault) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_Spec_SecretManager_Vault) ProtoMessage() {}
+
+func (x *Config_Spec_SecretManager_Vault) ProtoReflect() protoreflect.Message {
+	mi := &file_cbootstrapv1_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config_Spec_SecretManager_Vault.ProtoReflect.Descriptor instead.
+func (*Config_Spec_SecretManager_Vault) Descriptor() ([]byte, []int) {
+	return file_cbootstrapv1_proto_rawDescGZIP(), []int{0, 0, 3, 0}
+}
+
+func (x *Config_Spec_SecretManager_Vault) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Config_Spec_SecretManager_Vault) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *Config_Spec_SecretManager_Vault) GetMount() string {
+	if x != nil {
+		return x.Mount
+	}
+	return ""
+}
+
+func (x *Config_Spec_SecretManager_Vault) GetPathPrefix() string {
+	if x != nil {
+		return x.PathPrefix
+	}
+	return ""
+}
+
+func (x *Config_Spec_SecretManager_Vault) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type Config_Spec_SecretManager_File struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// StorageClassName is the StorageClass of the persistent volume
+	// claim. By default the default StorageClass is used.
+	StorageClassName string `protobuf:"bytes,1,opt,name=storageClassName,proto3" json:"storageClassName,omitempty"`
+	// Size is the persistent volume claim size. By default it is set to
+	// `1Gi`.
+	Size          string `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Config_Spec_SecretManager_File) Reset() {
+	*x = Config_Spec_SecretManager_File{}
+	mi := &file_cbootstrapv1_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config_Spec_SecretManager_File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_Spec_SecretManager_File) ProtoMessage() {}
+
+func (x *Config_Spec_SecretManager_File) ProtoReflect() protoreflect.Message {
+	mi := &file_cbootstrapv1_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config_Spec_SecretManager_File.ProtoReflect.Descriptor instead.
+func (*Config_Spec_SecretManager_File) Descriptor() ([]byte, []int) {
+	return file_cbootstrapv1_proto_rawDescGZIP(), []int{0, 0, 3, 1}
+}
+
+func (x *Config_Spec_SecretManager_File) GetStorageClassName() string {
+	if x != nil {
+		return x.StorageClassName
+	}
+	return ""
+}
+
+func (x *Config_Spec_SecretManager_File) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
 var File_cbootstrapv1_proto protoreflect.FileDescriptor
 
 var file_cbootstrapv1_proto_rawDesc = []byte{
@@ -870,7 +1106,7 @@ var file_cbootstrapv1_proto_rawDesc = []byte{
 	0x74, 0x72, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x1a, 0x26, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x65, 0x74, 0x61,
 	0x76, 0x31, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x76, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0xe0, 0x0e, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70,
+	0xe9, 0x12, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70,
 	0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
 	0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69,
 	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x3f,
@@ -886,7 +1122,7 @@ var file_cbootstrapv1_proto_rawDesc = []byte{
 	0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61,
 	0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73,
 	0x74, 0x72, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0xc8, 0x0c,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0xd1, 0x10,
 	0x0a, 0x04, 0x53, 0x70, 0x65, 0x63, 0x12, 0x65, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72,
 	0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d,
 	0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c,
@@ -905,94 +1141,126 @@ var file_cbootstrapv1_proto_rawDesc = []byte{
 	0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74,
 	0x65, 0x72, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e,
 	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x4e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x1a, 0xa5, 0x02, 0x0a,
-	0x0e, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12,
-	0x6a, 0x0a, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x71, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x48, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61,
+	0x6f, 0x72, 0x6b, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x62, 0x0a, 0x0d,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61,
 	0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73,
 	0x74, 0x72, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53,
-	0x70, 0x65, 0x63, 0x2e, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x71, 0x6c, 0x48, 0x00, 0x52,
-	0x0a, 0x70, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x71, 0x6c, 0x1a, 0x9e, 0x01, 0x0a, 0x0a,
-	0x50, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x71, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x73, 0x54, 0x4c, 0x53, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x54, 0x4c, 0x53, 0x42, 0x06, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x1a, 0x95, 0x02, 0x0a, 0x10, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61,
-	0x72, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x5d, 0x0a, 0x05, 0x72, 0x65, 0x64,
-	0x69, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c,
-	0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e,
-	0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61,
-	0x72, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x73, 0x48,
-	0x00, 0x52, 0x05, 0x72, 0x65, 0x64, 0x69, 0x73, 0x1a, 0x99, 0x01, 0x0a, 0x05, 0x52, 0x65, 0x64,
-	0x69, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f,
-	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f,
-	0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x69, 0x73, 0x54, 0x4c, 0x53, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69,
-	0x73, 0x54, 0x4c, 0x53, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x1a, 0xd9, 0x05, 0x0a,
-	0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x4f, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75,
+	0x70, 0x65, 0x63, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x52, 0x0d, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x1a, 0xa5, 0x02, 0x0a, 0x0e, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x12, 0x6a, 0x0a, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x71,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x48, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69,
+	0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x62,
+	0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x71,
+	0x6c, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x71, 0x6c, 0x1a,
+	0x9e, 0x01, 0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x71, 0x6c, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x73,
+	0x54, 0x4c, 0x53, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x54, 0x4c, 0x53,
+	0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x1a, 0x95, 0x02, 0x0a, 0x10, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x5d, 0x0a,
+	0x05, 0x72, 0x65, 0x64, 0x69, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x6f,
+	0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65,
+	0x64, 0x69, 0x73, 0x48, 0x00, 0x52, 0x05, 0x72, 0x65, 0x64, 0x69, 0x73, 0x1a, 0x99, 0x01, 0x0a,
+	0x05, 0x52, 0x65, 0x64, 0x69, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x73, 0x54, 0x4c, 0x53, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x69, 0x73, 0x54, 0x4c, 0x53, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x1a, 0xd9, 0x05, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x4f, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x6f, 0x63, 0x74,
+	0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x49, 0x0a,
+	0x02, 0x76, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6f, 0x63, 0x74, 0x65,
+	0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2e, 0x56, 0x34, 0x52, 0x02, 0x76, 0x34, 0x12, 0x49, 0x0a, 0x02, 0x76, 0x36, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x6f, 0x6f, 0x74,
+	0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x53, 0x70, 0x65, 0x63, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x56, 0x36, 0x52,
+	0x02, 0x76, 0x36, 0x12, 0x5e, 0x0a, 0x09, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75,
 	0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x6f,
 	0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x4d,
-	0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x49, 0x0a, 0x02, 0x76, 0x34, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x6f, 0x6f,
-	0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x56, 0x34,
-	0x52, 0x02, 0x76, 0x34, 0x12, 0x49, 0x0a, 0x02, 0x76, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x39, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x70, 0x65, 0x63,
-	0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x56, 0x36, 0x52, 0x02, 0x76, 0x36, 0x12,
-	0x5e, 0x0a, 0x09, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x40, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74,
-	0x72, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x70,
-	0x65, 0x63, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x57, 0x69, 0x72, 0x65, 0x67,
-	0x75, 0x61, 0x72, 0x64, 0x52, 0x09, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x12,
-	0x55, 0x0a, 0x06, 0x71, 0x75, 0x69, 0x63, 0x76, 0x30, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x3d, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x2e,
-	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x51, 0x55, 0x49, 0x43, 0x56, 0x30, 0x52, 0x06,
-	0x71, 0x75, 0x69, 0x63, 0x76, 0x30, 0x1a, 0x2c, 0x0a, 0x02, 0x56, 0x34, 0x12, 0x26, 0x0a, 0x0e,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x1a, 0x2c, 0x0a, 0x02, 0x56, 0x36, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x1a, 0x3f, 0x0a, 0x09, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x12,
-	0x20, 0x0a, 0x0b, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x72,
-	0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
-	0x6d, 0x74, 0x75, 0x1a, 0x54, 0x0a, 0x06, 0x51, 0x55, 0x49, 0x43, 0x56, 0x30, 0x12, 0x16, 0x0a,
-	0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x50, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x67, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x22, 0x3d, 0x0a, 0x04, 0x4d, 0x6f, 0x64,
-	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x0e,
-	0x0a, 0x0a, 0x44, 0x55, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x43, 0x4b, 0x10, 0x01, 0x12, 0x0b,
-	0x0a, 0x07, 0x56, 0x36, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x56,
-	0x34, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x03, 0x1a, 0x08, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2f, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69,
-	0x75, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f,
-	0x63, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x67, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x57,
+	0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x52, 0x09, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x12, 0x55, 0x0a, 0x06, 0x71, 0x75, 0x69, 0x63, 0x76, 0x30, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73,
+	0x74, 0x72, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53,
+	0x70, 0x65, 0x63, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x51, 0x55, 0x49, 0x43,
+	0x56, 0x30, 0x52, 0x06, 0x71, 0x75, 0x69, 0x63, 0x76, 0x30, 0x1a, 0x2c, 0x0a, 0x02, 0x56, 0x34,
+	0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x1a, 0x2c, 0x0a, 0x02, 0x56, 0x36, 0x12, 0x26,
+	0x0a, 0x0e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x1a, 0x3f, 0x0a, 0x09, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f,
+	0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x1a, 0x54, 0x0a, 0x06, 0x51, 0x55, 0x49, 0x43, 0x56,
+	0x30, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x74, 0x75, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x22, 0x3d, 0x0a,
+	0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54,
+	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x55, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x43, 0x4b,
+	0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x56, 0x36, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x02, 0x12,
+	0x0b, 0x0a, 0x07, 0x56, 0x34, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x03, 0x1a, 0xa2, 0x03, 0x0a,
+	0x0d, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x5a,
+	0x0a, 0x05, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e,
+	0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x56, 0x61, 0x75, 0x6c,
+	0x74, 0x48, 0x00, 0x52, 0x05, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x57, 0x0a, 0x04, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c,
+	0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x04, 0x66,
+	0x69, 0x6c, 0x65, 0x1a, 0x8b, 0x01, 0x0a, 0x05, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x50, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x1a, 0x46, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x1a, 0x08, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x38, 0x5a, 0x36, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69,
+	0x75, 0x6d, 0x2f, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x73,
+	0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x63, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74,
+	0x72, 0x61, 0x70, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1008,7 +1276,7 @@ func file_cbootstrapv1_proto_rawDescGZIP() []byte {
 }
 
 var file_cbootstrapv1_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_cbootstrapv1_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_cbootstrapv1_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_cbootstrapv1_proto_goTypes = []any{
 	(Config_Spec_Network_Mode)(0),                 // 0: octelium.api.cluster.bootstrap.v1.Config.Spec.Network.Mode
 	(*Config)(nil),                                // 1: octelium.api.cluster.bootstrap.v1.Config
@@ -1017,33 +1285,39 @@ var file_cbootstrapv1_proto_goTypes = []any{
 	(*Config_Spec_PrimaryStorage)(nil),            // 4: octelium.api.cluster.bootstrap.v1.Config.Spec.PrimaryStorage
 	(*Config_Spec_SecondaryStorage)(nil),          // 5: octelium.api.cluster.bootstrap.v1.Config.Spec.SecondaryStorage
 	(*Config_Spec_Network)(nil),                   // 6: octelium.api.cluster.bootstrap.v1.Config.Spec.Network
-	(*Config_Spec_PrimaryStorage_Postgresql)(nil), // 7: octelium.api.cluster.bootstrap.v1.Config.Spec.PrimaryStorage.Postgresql
-	(*Config_Spec_SecondaryStorage_Redis)(nil),    // 8: octelium.api.cluster.bootstrap.v1.Config.Spec.SecondaryStorage.Redis
-	(*Config_Spec_Network_V4)(nil),                // 9: octelium.api.cluster.bootstrap.v1.Config.Spec.Network.V4
-	(*Config_Spec_Network_V6)(nil),                // 10: octelium.api.cluster.bootstrap.v1.Config.Spec.Network.V6
-	(*Config_Spec_Network_Wireguard)(nil),         // 11: octelium.api.cluster.bootstrap.v1.Config.Spec.Network.Wireguard
-	(*Config_Spec_Network_QUICV0)(nil),            // 12: octelium.api.cluster.bootstrap.v1.Config.Spec.Network.QUICV0
-	(*metav1.Metadata)(nil),                       // 13: octelium.api.main.meta.v1.Metadata
+	(*Config_Spec_SecretManager)(nil),             // 7: octelium.api.cluster.bootstrap.v1.Config.Spec.SecretManager
+	(*Config_Spec_PrimaryStorage_Postgresql)(nil), // 8: octelium.api.cluster.bootstrap.v1.Config.Spec.PrimaryStorage.Postgresql
+	(*Config_Spec_SecondaryStorage_Redis)(nil),    // 9: octelium.api.cluster.bootstrap.v1.Config.Spec.SecondaryStorage.Redis
+	(*Config_Spec_Network_V4)(nil),                // 10: octelium.api.cluster.bootstrap.v1.Config.Spec.Network.V4
+	(*Config_Spec_Network_V6)(nil),                // 11: octelium.api.cluster.bootstrap.v1.Config.Spec.Network.V6
+	(*Config_Spec_Network_Wireguard)(nil),         // 12: octelium.api.cluster.bootstrap.v1.Config.Spec.Network.Wireguard
+	(*Config_Spec_Network_QUICV0)(nil),            // 13: octelium.api.cluster.bootstrap.v1.Config.Spec.Network.QUICV0
+	(*Config_Spec_SecretManager_Vault)(nil),       // 14: octelium.api.cluster.bootstrap.v1.Config.Spec.SecretManager.Vault
+	(*Config_Spec_SecretManager_File)(nil),        // 15: octelium.api.cluster.bootstrap.v1.Config.Spec.SecretManager.File
+	(*metav1.Metadata)(nil),                       // 16: octelium.api.main.meta.v1.Metadata
 }
 var file_cbootstrapv1_proto_depIdxs = []int32{
-	13, // 0: octelium.api.cluster.bootstrap.v1.Config.metadata:type_name -> octelium.api.main.meta.v1.Metadata
+	16, // 0: octelium.api.cluster.bootstrap.v1.Config.metadata:type_name -> octelium.api.main.meta.v1.Metadata
 	2,  // 1: octelium.api.cluster.bootstrap.v1.Config.spec:type_name -> octelium.api.cluster.bootstrap.v1.Config.Spec
 	3,  // 2: octelium.api.cluster.bootstrap.v1.Config.status:type_name -> octelium.api.cluster.bootstrap.v1.Config.Status
 	4,  // 3: octelium.api.cluster.bootstrap.v1.Config.Spec.primaryStorage:type_name -> octelium.api.cluster.bootstrap.v1.Config.Spec.PrimaryStorage
 	5,  // 4: octelium.api.cluster.bootstrap.v1.Config.Spec.secondaryStorage:type_name -> octelium.api.cluster.bootstrap.v1.Config.Spec.SecondaryStorage
 	6,  // 5: octelium.api.cluster.bootstrap.v1.Config.Spec.network:type_name -> octelium.api.cluster.bootstrap.v1.Config.Spec.Network
-	7,  // 6: octelium.api.cluster.bootstrap.v1.Config.Spec.PrimaryStorage.postgresql:type_name -> octelium.api.cluster.bootstrap.v1.Config.Spec.PrimaryStorage.Postgresql
-	8,  // 7: octelium.api.cluster.bootstrap.v1.Config.Spec.SecondaryStorage.redis:type_name -> octelium.api.cluster.bootstrap.v1.Config.Spec.SecondaryStorage.Redis
-	0,  // 8: octelium.api.cluster.bootstrap.v1.Config.Spec.Network.mode:type_name -> octelium.api.cluster.bootstrap.v1.Config.Spec.Network.Mode
-	9,  // 9: octelium.api.cluster.bootstrap.v1.Config.Spec.Network.v4:type_name -> octelium.api.cluster.bootstrap.v1.Config.Spec.Network.V4
-	10, // 10: octelium.api.cluster.bootstrap.v1.Config.Spec.Network.v6:type_name -> octelium.api.cluster.bootstrap.v1.Config.Spec.Network.V6
-	11, // 11: octelium.api.cluster.bootstrap.v1.Config.Spec.Network.wireguard:type_name -> octelium.api.cluster.bootstrap.v1.Config.Spec.Network.Wireguard
-	12, // 12: octelium.api.cluster.bootstrap.v1.Config.Spec.Network.quicv0:type_name -> octelium.api.cluster.bootstrap.v1.Config.Spec.Network.QUICV0
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	7,  // 6: octelium.api.cluster.bootstrap.v1.Config.Spec.secretManager:type_name -> octelium.api.cluster.bootstrap.v1.Config.Spec.SecretManager
+	8,  // 7: octelium.api.cluster.bootstrap.v1.Config.Spec.PrimaryStorage.postgresql:type_name -> octelium.api.cluster.bootstrap.v1.Config.Spec.PrimaryStorage.Postgresql
+	9,  // 8: octelium.api.cluster.bootstrap.v1.Config.Spec.SecondaryStorage.redis:type_name -> octelium.api.cluster.bootstrap.v1.Config.Spec.SecondaryStorage.Redis
+	0,  // 9: octelium.api.cluster.bootstrap.v1.Config.Spec.Network.mode:type_name -> octelium.api.cluster.bootstrap.v1.Config.Spec.Network.Mode
+	10, // 10: octelium.api.cluster.bootstrap.v1.Config.Spec.Network.v4:type_name -> octelium.api.cluster.bootstrap.v1.Config.Spec.Network.V4
+	11, // 11: octelium.api.cluster.bootstrap.v1.Config.Spec.Network.v6:type_name -> octelium.api.cluster.bootstrap.v1.Config.Spec.Network.V6
+	12, // 12: octelium.api.cluster.bootstrap.v1.Config.Spec.Network.wireguard:type_name -> octelium.api.cluster.bootstrap.v1.Config.Spec.Network.Wireguard
+	13, // 13: octelium.api.cluster.bootstrap.v1.Config.Spec.Network.quicv0:type_name -> octelium.api.cluster.bootstrap.v1.Config.Spec.Network.QUICV0
+	14, // 14: octelium.api.cluster.bootstrap.v1.Config.Spec.SecretManager.vault:type_name -> octelium.api.cluster.bootstrap.v1.Config.Spec.SecretManager.Vault
+	15, // 15: octelium.api.cluster.bootstrap.v1.Config.Spec.SecretManager.file:type_name -> octelium.api.cluster.bootstrap.v1.Config.Spec.SecretManager.File
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_cbootstrapv1_proto_init() }
@@ -1057,13 +1331,17 @@ func file_cbootstrapv1_proto_init() {
 	file_cbootstrapv1_proto_msgTypes[4].OneofWrappers = []any{
 		(*Config_Spec_SecondaryStorage_Redis_)(nil),
 	}
+	file_cbootstrapv1_proto_msgTypes[6].OneofWrappers = []any{
+		(*Config_Spec_SecretManager_Vault_)(nil),
+		(*Config_Spec_SecretManager_File_)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cbootstrapv1_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   12,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
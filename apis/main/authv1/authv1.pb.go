@@ -137,7 +137,7 @@ func (x RegisterDeviceBeginRequest_Info_OSType) Number() protoreflect.EnumNumber
 
 // Deprecated: Use RegisterDeviceBeginRequest_Info_OSType.Descriptor instead.
 func (RegisterDeviceBeginRequest_Info_OSType) EnumDescriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{5, 0, 0}
+	return file_authv1_proto_rawDescGZIP(), []int{7, 0, 0}
 }
 
 type TokenT0_Content_Type int32
@@ -189,7 +189,7 @@ func (x TokenT0_Content_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TokenT0_Content_Type.Descriptor instead.
 func (TokenT0_Content_Type) EnumDescriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{12, 0, 0}
+	return file_authv1_proto_rawDescGZIP(), []int{14, 0, 0}
 }
 
 type Authenticator_Status_Type int32
@@ -241,7 +241,7 @@ func (x Authenticator_Status_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Authenticator_Status_Type.Descriptor instead.
 func (Authenticator_Status_Type) EnumDescriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{17, 1, 0}
+	return file_authv1_proto_rawDescGZIP(), []int{19, 1, 0}
 }
 
 type SessionToken struct {
@@ -488,6 +488,97 @@ func (*LogoutResponse) Descriptor() ([]byte, []int) {
 	return file_authv1_proto_rawDescGZIP(), []int{4}
 }
 
+type IssueClientCertificateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PublicKey is the PKIX, ASN.1 DER-encoded public key of the certificate.
+	PublicKey     []byte `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueClientCertificateRequest) Reset() {
+	*x = IssueClientCertificateRequest{}
+	mi := &file_authv1_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueClientCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueClientCertificateRequest) ProtoMessage() {}
+
+func (x *IssueClientCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authv1_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueClientCertificateRequest.ProtoReflect.Descriptor instead.
+func (*IssueClientCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_authv1_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *IssueClientCertificateRequest) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+type IssueClientCertificateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Certificate is the DER-encoded client certificate bound to the Session.
+	// It expires along with the Session's current access token.
+	Certificate   []byte `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueClientCertificateResponse) Reset() {
+	*x = IssueClientCertificateResponse{}
+	mi := &file_authv1_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueClientCertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueClientCertificateResponse) ProtoMessage() {}
+
+func (x *IssueClientCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authv1_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueClientCertificateResponse.ProtoReflect.Descriptor instead.
+func (*IssueClientCertificateResponse) Descriptor() ([]byte, []int) {
+	return file_authv1_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *IssueClientCertificateResponse) GetCertificate() []byte {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
 type RegisterDeviceBeginRequest struct {
 	state         protoimpl.MessageState           `protogen:"open.v1"`
 	Info          *RegisterDeviceBeginRequest_Info `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
@@ -497,7 +588,7 @@ type RegisterDeviceBeginRequest struct {
 
 func (x *RegisterDeviceBeginRequest) Reset() {
 	*x = RegisterDeviceBeginRequest{}
-	mi := &file_authv1_proto_msgTypes[5]
+	mi := &file_authv1_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -509,7 +600,7 @@ func (x *RegisterDeviceBeginRequest) String() string {
 func (*RegisterDeviceBeginRequest) ProtoMessage() {}
 
 func (x *RegisterDeviceBeginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[5]
+	mi := &file_authv1_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -522,7 +613,7 @@ func (x *RegisterDeviceBeginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterDeviceBeginRequest.ProtoReflect.Descriptor instead.
 func (*RegisterDeviceBeginRequest) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{5}
+	return file_authv1_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RegisterDeviceBeginRequest) GetInfo() *RegisterDeviceBeginRequest_Info {
@@ -542,7 +633,7 @@ type RegisterDeviceBeginResponse struct {
 
 func (x *RegisterDeviceBeginResponse) Reset() {
 	*x = RegisterDeviceBeginResponse{}
-	mi := &file_authv1_proto_msgTypes[6]
+	mi := &file_authv1_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -554,7 +645,7 @@ func (x *RegisterDeviceBeginResponse) String() string {
 func (*RegisterDeviceBeginResponse) ProtoMessage() {}
 
 func (x *RegisterDeviceBeginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[6]
+	mi := &file_authv1_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,7 +658,7 @@ func (x *RegisterDeviceBeginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterDeviceBeginResponse.ProtoReflect.Descriptor instead.
 func (*RegisterDeviceBeginResponse) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{6}
+	return file_authv1_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *RegisterDeviceBeginResponse) GetUid() string {
@@ -594,7 +685,7 @@ type RegisterDeviceFinishRequest struct {
 
 func (x *RegisterDeviceFinishRequest) Reset() {
 	*x = RegisterDeviceFinishRequest{}
-	mi := &file_authv1_proto_msgTypes[7]
+	mi := &file_authv1_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -606,7 +697,7 @@ func (x *RegisterDeviceFinishRequest) String() string {
 func (*RegisterDeviceFinishRequest) ProtoMessage() {}
 
 func (x *RegisterDeviceFinishRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[7]
+	mi := &file_authv1_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -619,7 +710,7 @@ func (x *RegisterDeviceFinishRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterDeviceFinishRequest.ProtoReflect.Descriptor instead.
 func (*RegisterDeviceFinishRequest) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{7}
+	return file_authv1_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *RegisterDeviceFinishRequest) GetUid() string {
@@ -644,7 +735,7 @@ type RegisterDeviceFinishResponse struct {
 
 func (x *RegisterDeviceFinishResponse) Reset() {
 	*x = RegisterDeviceFinishResponse{}
-	mi := &file_authv1_proto_msgTypes[8]
+	mi := &file_authv1_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -656,7 +747,7 @@ func (x *RegisterDeviceFinishResponse) String() string {
 func (*RegisterDeviceFinishResponse) ProtoMessage() {}
 
 func (x *RegisterDeviceFinishResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[8]
+	mi := &file_authv1_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -669,7 +760,7 @@ func (x *RegisterDeviceFinishResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterDeviceFinishResponse.ProtoReflect.Descriptor instead.
 func (*RegisterDeviceFinishResponse) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{8}
+	return file_authv1_proto_rawDescGZIP(), []int{10}
 }
 
 type AuthenticateWithAuthenticationTokenRequest struct {
@@ -682,7 +773,7 @@ type AuthenticateWithAuthenticationTokenRequest struct {
 
 func (x *AuthenticateWithAuthenticationTokenRequest) Reset() {
 	*x = AuthenticateWithAuthenticationTokenRequest{}
-	mi := &file_authv1_proto_msgTypes[9]
+	mi := &file_authv1_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -694,7 +785,7 @@ func (x *AuthenticateWithAuthenticationTokenRequest) String() string {
 func (*AuthenticateWithAuthenticationTokenRequest) ProtoMessage() {}
 
 func (x *AuthenticateWithAuthenticationTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[9]
+	mi := &file_authv1_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -707,7 +798,7 @@ func (x *AuthenticateWithAuthenticationTokenRequest) ProtoReflect() protoreflect
 
 // Deprecated: Use AuthenticateWithAuthenticationTokenRequest.ProtoReflect.Descriptor instead.
 func (*AuthenticateWithAuthenticationTokenRequest) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{9}
+	return file_authv1_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *AuthenticateWithAuthenticationTokenRequest) GetAuthenticationToken() string {
@@ -735,7 +826,7 @@ type AuthenticateWithAssertionRequest struct {
 
 func (x *AuthenticateWithAssertionRequest) Reset() {
 	*x = AuthenticateWithAssertionRequest{}
-	mi := &file_authv1_proto_msgTypes[10]
+	mi := &file_authv1_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -747,7 +838,7 @@ func (x *AuthenticateWithAssertionRequest) String() string {
 func (*AuthenticateWithAssertionRequest) ProtoMessage() {}
 
 func (x *AuthenticateWithAssertionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[10]
+	mi := &file_authv1_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -760,7 +851,7 @@ func (x *AuthenticateWithAssertionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthenticateWithAssertionRequest.ProtoReflect.Descriptor instead.
 func (*AuthenticateWithAssertionRequest) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{10}
+	return file_authv1_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *AuthenticateWithAssertionRequest) GetIdentityProviderRef() *metav1.ObjectReference {
@@ -792,7 +883,7 @@ type AuthenticateWithRefreshTokenRequest struct {
 
 func (x *AuthenticateWithRefreshTokenRequest) Reset() {
 	*x = AuthenticateWithRefreshTokenRequest{}
-	mi := &file_authv1_proto_msgTypes[11]
+	mi := &file_authv1_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -804,7 +895,7 @@ func (x *AuthenticateWithRefreshTokenRequest) String() string {
 func (*AuthenticateWithRefreshTokenRequest) ProtoMessage() {}
 
 func (x *AuthenticateWithRefreshTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[11]
+	mi := &file_authv1_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -817,7 +908,7 @@ func (x *AuthenticateWithRefreshTokenRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use AuthenticateWithRefreshTokenRequest.ProtoReflect.Descriptor instead.
 func (*AuthenticateWithRefreshTokenRequest) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{11}
+	return file_authv1_proto_rawDescGZIP(), []int{13}
 }
 
 type TokenT0 struct {
@@ -830,7 +921,7 @@ type TokenT0 struct {
 
 func (x *TokenT0) Reset() {
 	*x = TokenT0{}
-	mi := &file_authv1_proto_msgTypes[12]
+	mi := &file_authv1_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -842,7 +933,7 @@ func (x *TokenT0) String() string {
 func (*TokenT0) ProtoMessage() {}
 
 func (x *TokenT0) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[12]
+	mi := &file_authv1_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -855,7 +946,7 @@ func (x *TokenT0) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenT0.ProtoReflect.Descriptor instead.
 func (*TokenT0) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{12}
+	return file_authv1_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *TokenT0) GetSignature() []byte {
@@ -881,7 +972,7 @@ type AuthenticateAuthenticatorBeginRequest struct {
 
 func (x *AuthenticateAuthenticatorBeginRequest) Reset() {
 	*x = AuthenticateAuthenticatorBeginRequest{}
-	mi := &file_authv1_proto_msgTypes[13]
+	mi := &file_authv1_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -893,7 +984,7 @@ func (x *AuthenticateAuthenticatorBeginRequest) String() string {
 func (*AuthenticateAuthenticatorBeginRequest) ProtoMessage() {}
 
 func (x *AuthenticateAuthenticatorBeginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[13]
+	mi := &file_authv1_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -906,7 +997,7 @@ func (x *AuthenticateAuthenticatorBeginRequest) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use AuthenticateAuthenticatorBeginRequest.ProtoReflect.Descriptor instead.
 func (*AuthenticateAuthenticatorBeginRequest) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{13}
+	return file_authv1_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *AuthenticateAuthenticatorBeginRequest) GetAuthenticatorRef() *metav1.ObjectReference {
@@ -925,7 +1016,7 @@ type AuthenticateAuthenticatorBeginResponse struct {
 
 func (x *AuthenticateAuthenticatorBeginResponse) Reset() {
 	*x = AuthenticateAuthenticatorBeginResponse{}
-	mi := &file_authv1_proto_msgTypes[14]
+	mi := &file_authv1_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -937,7 +1028,7 @@ func (x *AuthenticateAuthenticatorBeginResponse) String() string {
 func (*AuthenticateAuthenticatorBeginResponse) ProtoMessage() {}
 
 func (x *AuthenticateAuthenticatorBeginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[14]
+	mi := &file_authv1_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -950,7 +1041,7 @@ func (x *AuthenticateAuthenticatorBeginResponse) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use AuthenticateAuthenticatorBeginResponse.ProtoReflect.Descriptor instead.
 func (*AuthenticateAuthenticatorBeginResponse) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{14}
+	return file_authv1_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *AuthenticateAuthenticatorBeginResponse) GetChallengeRequest() *AuthenticateAuthenticatorBeginResponse_ChallengeRequest {
@@ -970,7 +1061,7 @@ type RegisterAuthenticatorBeginRequest struct {
 
 func (x *RegisterAuthenticatorBeginRequest) Reset() {
 	*x = RegisterAuthenticatorBeginRequest{}
-	mi := &file_authv1_proto_msgTypes[15]
+	mi := &file_authv1_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -982,7 +1073,7 @@ func (x *RegisterAuthenticatorBeginRequest) String() string {
 func (*RegisterAuthenticatorBeginRequest) ProtoMessage() {}
 
 func (x *RegisterAuthenticatorBeginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[15]
+	mi := &file_authv1_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -995,7 +1086,7 @@ func (x *RegisterAuthenticatorBeginRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RegisterAuthenticatorBeginRequest.ProtoReflect.Descriptor instead.
 func (*RegisterAuthenticatorBeginRequest) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{15}
+	return file_authv1_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *RegisterAuthenticatorBeginRequest) GetAuthenticatorRef() *metav1.ObjectReference {
@@ -1021,7 +1112,7 @@ type RegisterAuthenticatorBeginResponse struct {
 
 func (x *RegisterAuthenticatorBeginResponse) Reset() {
 	*x = RegisterAuthenticatorBeginResponse{}
-	mi := &file_authv1_proto_msgTypes[16]
+	mi := &file_authv1_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1033,7 +1124,7 @@ func (x *RegisterAuthenticatorBeginResponse) String() string {
 func (*RegisterAuthenticatorBeginResponse) ProtoMessage() {}
 
 func (x *RegisterAuthenticatorBeginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[16]
+	mi := &file_authv1_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1046,7 +1137,7 @@ func (x *RegisterAuthenticatorBeginResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RegisterAuthenticatorBeginResponse.ProtoReflect.Descriptor instead.
 func (*RegisterAuthenticatorBeginResponse) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{16}
+	return file_authv1_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *RegisterAuthenticatorBeginResponse) GetChallengeRequest() *RegisterAuthenticatorBeginResponse_ChallengeRequest {
@@ -1074,7 +1165,7 @@ type Authenticator struct {
 
 func (x *Authenticator) Reset() {
 	*x = Authenticator{}
-	mi := &file_authv1_proto_msgTypes[17]
+	mi := &file_authv1_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1086,7 +1177,7 @@ func (x *Authenticator) String() string {
 func (*Authenticator) ProtoMessage() {}
 
 func (x *Authenticator) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[17]
+	mi := &file_authv1_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1099,7 +1190,7 @@ func (x *Authenticator) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Authenticator.ProtoReflect.Descriptor instead.
 func (*Authenticator) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{17}
+	return file_authv1_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *Authenticator) GetApiVersion() string {
@@ -1153,7 +1244,7 @@ type AuthenticatorList struct {
 
 func (x *AuthenticatorList) Reset() {
 	*x = AuthenticatorList{}
-	mi := &file_authv1_proto_msgTypes[18]
+	mi := &file_authv1_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1165,7 +1256,7 @@ func (x *AuthenticatorList) String() string {
 func (*AuthenticatorList) ProtoMessage() {}
 
 func (x *AuthenticatorList) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[18]
+	mi := &file_authv1_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1178,7 +1269,7 @@ func (x *AuthenticatorList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthenticatorList.ProtoReflect.Descriptor instead.
 func (*AuthenticatorList) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{18}
+	return file_authv1_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *AuthenticatorList) GetApiVersion() string {
@@ -1221,7 +1312,7 @@ type ListAuthenticatorOptions struct {
 
 func (x *ListAuthenticatorOptions) Reset() {
 	*x = ListAuthenticatorOptions{}
-	mi := &file_authv1_proto_msgTypes[19]
+	mi := &file_authv1_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1233,7 +1324,7 @@ func (x *ListAuthenticatorOptions) String() string {
 func (*ListAuthenticatorOptions) ProtoMessage() {}
 
 func (x *ListAuthenticatorOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[19]
+	mi := &file_authv1_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1246,7 +1337,7 @@ func (x *ListAuthenticatorOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAuthenticatorOptions.ProtoReflect.Descriptor instead.
 func (*ListAuthenticatorOptions) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{19}
+	return file_authv1_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ListAuthenticatorOptions) GetPage() uint32 {
@@ -1273,7 +1364,7 @@ type CreateAuthenticatorRequest struct {
 
 func (x *CreateAuthenticatorRequest) Reset() {
 	*x = CreateAuthenticatorRequest{}
-	mi := &file_authv1_proto_msgTypes[20]
+	mi := &file_authv1_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1285,7 +1376,7 @@ func (x *CreateAuthenticatorRequest) String() string {
 func (*CreateAuthenticatorRequest) ProtoMessage() {}
 
 func (x *CreateAuthenticatorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[20]
+	mi := &file_authv1_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1298,7 +1389,7 @@ func (x *CreateAuthenticatorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAuthenticatorRequest.ProtoReflect.Descriptor instead.
 func (*CreateAuthenticatorRequest) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{20}
+	return file_authv1_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *CreateAuthenticatorRequest) GetType() Authenticator_Status_Type {
@@ -1325,7 +1416,7 @@ type RegisterAuthenticatorFinishRequest struct {
 
 func (x *RegisterAuthenticatorFinishRequest) Reset() {
 	*x = RegisterAuthenticatorFinishRequest{}
-	mi := &file_authv1_proto_msgTypes[21]
+	mi := &file_authv1_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1337,7 +1428,7 @@ func (x *RegisterAuthenticatorFinishRequest) String() string {
 func (*RegisterAuthenticatorFinishRequest) ProtoMessage() {}
 
 func (x *RegisterAuthenticatorFinishRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[21]
+	mi := &file_authv1_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1350,7 +1441,7 @@ func (x *RegisterAuthenticatorFinishRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RegisterAuthenticatorFinishRequest.ProtoReflect.Descriptor instead.
 func (*RegisterAuthenticatorFinishRequest) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{21}
+	return file_authv1_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *RegisterAuthenticatorFinishRequest) GetAuthenticatorRef() *metav1.ObjectReference {
@@ -1375,7 +1466,7 @@ type RegisterAuthenticatorFinishResponse struct {
 
 func (x *RegisterAuthenticatorFinishResponse) Reset() {
 	*x = RegisterAuthenticatorFinishResponse{}
-	mi := &file_authv1_proto_msgTypes[22]
+	mi := &file_authv1_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1387,7 +1478,7 @@ func (x *RegisterAuthenticatorFinishResponse) String() string {
 func (*RegisterAuthenticatorFinishResponse) ProtoMessage() {}
 
 func (x *RegisterAuthenticatorFinishResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[22]
+	mi := &file_authv1_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1400,7 +1491,7 @@ func (x *RegisterAuthenticatorFinishResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use RegisterAuthenticatorFinishResponse.ProtoReflect.Descriptor instead.
 func (*RegisterAuthenticatorFinishResponse) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{22}
+	return file_authv1_proto_rawDescGZIP(), []int{24}
 }
 
 type ChallengeResponse struct {
@@ -1417,7 +1508,7 @@ type ChallengeResponse struct {
 
 func (x *ChallengeResponse) Reset() {
 	*x = ChallengeResponse{}
-	mi := &file_authv1_proto_msgTypes[23]
+	mi := &file_authv1_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1429,7 +1520,7 @@ func (x *ChallengeResponse) String() string {
 func (*ChallengeResponse) ProtoMessage() {}
 
 func (x *ChallengeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[23]
+	mi := &file_authv1_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1442,7 +1533,7 @@ func (x *ChallengeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChallengeResponse.ProtoReflect.Descriptor instead.
 func (*ChallengeResponse) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{23}
+	return file_authv1_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ChallengeResponse) GetType() isChallengeResponse_Type {
@@ -1511,7 +1602,7 @@ type AuthenticateWithAuthenticatorRequest struct {
 
 func (x *AuthenticateWithAuthenticatorRequest) Reset() {
 	*x = AuthenticateWithAuthenticatorRequest{}
-	mi := &file_authv1_proto_msgTypes[24]
+	mi := &file_authv1_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1523,7 +1614,7 @@ func (x *AuthenticateWithAuthenticatorRequest) String() string {
 func (*AuthenticateWithAuthenticatorRequest) ProtoMessage() {}
 
 func (x *AuthenticateWithAuthenticatorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[24]
+	mi := &file_authv1_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1536,7 +1627,7 @@ func (x *AuthenticateWithAuthenticatorRequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use AuthenticateWithAuthenticatorRequest.ProtoReflect.Descriptor instead.
 func (*AuthenticateWithAuthenticatorRequest) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{24}
+	return file_authv1_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *AuthenticateWithAuthenticatorRequest) GetAuthenticatorRef() *metav1.ObjectReference {
@@ -1561,7 +1652,7 @@ type GetAvailableAuthenticatorRequest struct {
 
 func (x *GetAvailableAuthenticatorRequest) Reset() {
 	*x = GetAvailableAuthenticatorRequest{}
-	mi := &file_authv1_proto_msgTypes[25]
+	mi := &file_authv1_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1573,7 +1664,7 @@ func (x *GetAvailableAuthenticatorRequest) String() string {
 func (*GetAvailableAuthenticatorRequest) ProtoMessage() {}
 
 func (x *GetAvailableAuthenticatorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[25]
+	mi := &file_authv1_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1586,7 +1677,7 @@ func (x *GetAvailableAuthenticatorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAvailableAuthenticatorRequest.ProtoReflect.Descriptor instead.
 func (*GetAvailableAuthenticatorRequest) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{25}
+	return file_authv1_proto_rawDescGZIP(), []int{27}
 }
 
 type GetAvailableAuthenticatorResponse struct {
@@ -1599,7 +1690,7 @@ type GetAvailableAuthenticatorResponse struct {
 
 func (x *GetAvailableAuthenticatorResponse) Reset() {
 	*x = GetAvailableAuthenticatorResponse{}
-	mi := &file_authv1_proto_msgTypes[26]
+	mi := &file_authv1_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1611,7 +1702,7 @@ func (x *GetAvailableAuthenticatorResponse) String() string {
 func (*GetAvailableAuthenticatorResponse) ProtoMessage() {}
 
 func (x *GetAvailableAuthenticatorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[26]
+	mi := &file_authv1_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1624,7 +1715,7 @@ func (x *GetAvailableAuthenticatorResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetAvailableAuthenticatorResponse.ProtoReflect.Descriptor instead.
 func (*GetAvailableAuthenticatorResponse) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{26}
+	return file_authv1_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetAvailableAuthenticatorResponse) GetMainAuthenticator() *Authenticator {
@@ -1650,7 +1741,7 @@ type AuthenticateWithPasskeyBeginRequest struct {
 
 func (x *AuthenticateWithPasskeyBeginRequest) Reset() {
 	*x = AuthenticateWithPasskeyBeginRequest{}
-	mi := &file_authv1_proto_msgTypes[27]
+	mi := &file_authv1_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1662,7 +1753,7 @@ func (x *AuthenticateWithPasskeyBeginRequest) String() string {
 func (*AuthenticateWithPasskeyBeginRequest) ProtoMessage() {}
 
 func (x *AuthenticateWithPasskeyBeginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[27]
+	mi := &file_authv1_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1675,7 +1766,7 @@ func (x *AuthenticateWithPasskeyBeginRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use AuthenticateWithPasskeyBeginRequest.ProtoReflect.Descriptor instead.
 func (*AuthenticateWithPasskeyBeginRequest) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{27}
+	return file_authv1_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *AuthenticateWithPasskeyBeginRequest) GetQuery() string {
@@ -1694,7 +1785,7 @@ type AuthenticateWithPasskeyBeginResponse struct {
 
 func (x *AuthenticateWithPasskeyBeginResponse) Reset() {
 	*x = AuthenticateWithPasskeyBeginResponse{}
-	mi := &file_authv1_proto_msgTypes[28]
+	mi := &file_authv1_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1706,7 +1797,7 @@ func (x *AuthenticateWithPasskeyBeginResponse) String() string {
 func (*AuthenticateWithPasskeyBeginResponse) ProtoMessage() {}
 
 func (x *AuthenticateWithPasskeyBeginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[28]
+	mi := &file_authv1_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1719,7 +1810,7 @@ func (x *AuthenticateWithPasskeyBeginResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use AuthenticateWithPasskeyBeginResponse.ProtoReflect.Descriptor instead.
 func (*AuthenticateWithPasskeyBeginResponse) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{28}
+	return file_authv1_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *AuthenticateWithPasskeyBeginResponse) GetRequest() string {
@@ -1738,7 +1829,7 @@ type AuthenticateWithPasskeyRequest struct {
 
 func (x *AuthenticateWithPasskeyRequest) Reset() {
 	*x = AuthenticateWithPasskeyRequest{}
-	mi := &file_authv1_proto_msgTypes[29]
+	mi := &file_authv1_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1750,7 +1841,7 @@ func (x *AuthenticateWithPasskeyRequest) String() string {
 func (*AuthenticateWithPasskeyRequest) ProtoMessage() {}
 
 func (x *AuthenticateWithPasskeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[29]
+	mi := &file_authv1_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1763,7 +1854,7 @@ func (x *AuthenticateWithPasskeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthenticateWithPasskeyRequest.ProtoReflect.Descriptor instead.
 func (*AuthenticateWithPasskeyRequest) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{29}
+	return file_authv1_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *AuthenticateWithPasskeyRequest) GetResponse() string {
@@ -1786,7 +1877,7 @@ type RegisterDeviceBeginRequest_Info struct {
 
 func (x *RegisterDeviceBeginRequest_Info) Reset() {
 	*x = RegisterDeviceBeginRequest_Info{}
-	mi := &file_authv1_proto_msgTypes[30]
+	mi := &file_authv1_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1798,7 +1889,7 @@ func (x *RegisterDeviceBeginRequest_Info) String() string {
 func (*RegisterDeviceBeginRequest_Info) ProtoMessage() {}
 
 func (x *RegisterDeviceBeginRequest_Info) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[30]
+	mi := &file_authv1_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1811,7 +1902,7 @@ func (x *RegisterDeviceBeginRequest_Info) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterDeviceBeginRequest_Info.ProtoReflect.Descriptor instead.
 func (*RegisterDeviceBeginRequest_Info) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{5, 0}
+	return file_authv1_proto_rawDescGZIP(), []int{7, 0}
 }
 
 func (x *RegisterDeviceBeginRequest_Info) GetOsType() RegisterDeviceBeginRequest_Info_OSType {
@@ -1863,7 +1954,7 @@ type RegisterDeviceBeginResponse_Request struct {
 
 func (x *RegisterDeviceBeginResponse_Request) Reset() {
 	*x = RegisterDeviceBeginResponse_Request{}
-	mi := &file_authv1_proto_msgTypes[31]
+	mi := &file_authv1_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1875,7 +1966,7 @@ func (x *RegisterDeviceBeginResponse_Request) String() string {
 func (*RegisterDeviceBeginResponse_Request) ProtoMessage() {}
 
 func (x *RegisterDeviceBeginResponse_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[31]
+	mi := &file_authv1_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1888,7 +1979,7 @@ func (x *RegisterDeviceBeginResponse_Request) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use RegisterDeviceBeginResponse_Request.ProtoReflect.Descriptor instead.
 func (*RegisterDeviceBeginResponse_Request) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{6, 0}
+	return file_authv1_proto_rawDescGZIP(), []int{8, 0}
 }
 
 func (x *RegisterDeviceBeginResponse_Request) GetUid() string {
@@ -1949,7 +2040,7 @@ type RegisterDeviceBeginResponse_Request_Command struct {
 
 func (x *RegisterDeviceBeginResponse_Request_Command) Reset() {
 	*x = RegisterDeviceBeginResponse_Request_Command{}
-	mi := &file_authv1_proto_msgTypes[32]
+	mi := &file_authv1_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1961,7 +2052,7 @@ func (x *RegisterDeviceBeginResponse_Request_Command) String() string {
 func (*RegisterDeviceBeginResponse_Request_Command) ProtoMessage() {}
 
 func (x *RegisterDeviceBeginResponse_Request_Command) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[32]
+	mi := &file_authv1_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1974,7 +2065,7 @@ func (x *RegisterDeviceBeginResponse_Request_Command) ProtoReflect() protoreflec
 
 // Deprecated: Use RegisterDeviceBeginResponse_Request_Command.ProtoReflect.Descriptor instead.
 func (*RegisterDeviceBeginResponse_Request_Command) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{6, 0, 0}
+	return file_authv1_proto_rawDescGZIP(), []int{8, 0, 0}
 }
 
 func (x *RegisterDeviceBeginResponse_Request_Command) GetCommand() string {
@@ -2000,7 +2091,7 @@ type RegisterDeviceBeginResponse_Request_File struct {
 
 func (x *RegisterDeviceBeginResponse_Request_File) Reset() {
 	*x = RegisterDeviceBeginResponse_Request_File{}
-	mi := &file_authv1_proto_msgTypes[33]
+	mi := &file_authv1_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2012,7 +2103,7 @@ func (x *RegisterDeviceBeginResponse_Request_File) String() string {
 func (*RegisterDeviceBeginResponse_Request_File) ProtoMessage() {}
 
 func (x *RegisterDeviceBeginResponse_Request_File) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[33]
+	mi := &file_authv1_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2025,7 +2116,7 @@ func (x *RegisterDeviceBeginResponse_Request_File) ProtoReflect() protoreflect.M
 
 // Deprecated: Use RegisterDeviceBeginResponse_Request_File.ProtoReflect.Descriptor instead.
 func (*RegisterDeviceBeginResponse_Request_File) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{6, 0, 1}
+	return file_authv1_proto_rawDescGZIP(), []int{8, 0, 1}
 }
 
 func (x *RegisterDeviceBeginResponse_Request_File) GetPath() string {
@@ -2049,7 +2140,7 @@ type RegisterDeviceFinishRequest_Response struct {
 
 func (x *RegisterDeviceFinishRequest_Response) Reset() {
 	*x = RegisterDeviceFinishRequest_Response{}
-	mi := &file_authv1_proto_msgTypes[34]
+	mi := &file_authv1_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2061,7 +2152,7 @@ func (x *RegisterDeviceFinishRequest_Response) String() string {
 func (*RegisterDeviceFinishRequest_Response) ProtoMessage() {}
 
 func (x *RegisterDeviceFinishRequest_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[34]
+	mi := &file_authv1_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2074,7 +2165,7 @@ func (x *RegisterDeviceFinishRequest_Response) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use RegisterDeviceFinishRequest_Response.ProtoReflect.Descriptor instead.
 func (*RegisterDeviceFinishRequest_Response) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{7, 0}
+	return file_authv1_proto_rawDescGZIP(), []int{9, 0}
 }
 
 func (x *RegisterDeviceFinishRequest_Response) GetUid() string {
@@ -2134,7 +2225,7 @@ type RegisterDeviceFinishRequest_Response_Command struct {
 
 func (x *RegisterDeviceFinishRequest_Response_Command) Reset() {
 	*x = RegisterDeviceFinishRequest_Response_Command{}
-	mi := &file_authv1_proto_msgTypes[35]
+	mi := &file_authv1_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2146,7 +2237,7 @@ func (x *RegisterDeviceFinishRequest_Response_Command) String() string {
 func (*RegisterDeviceFinishRequest_Response_Command) ProtoMessage() {}
 
 func (x *RegisterDeviceFinishRequest_Response_Command) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[35]
+	mi := &file_authv1_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2159,7 +2250,7 @@ func (x *RegisterDeviceFinishRequest_Response_Command) ProtoReflect() protorefle
 
 // Deprecated: Use RegisterDeviceFinishRequest_Response_Command.ProtoReflect.Descriptor instead.
 func (*RegisterDeviceFinishRequest_Response_Command) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{7, 0, 0}
+	return file_authv1_proto_rawDescGZIP(), []int{9, 0, 0}
 }
 
 func (x *RegisterDeviceFinishRequest_Response_Command) GetOutput() []byte {
@@ -2178,7 +2269,7 @@ type RegisterDeviceFinishRequest_Response_File struct {
 
 func (x *RegisterDeviceFinishRequest_Response_File) Reset() {
 	*x = RegisterDeviceFinishRequest_Response_File{}
-	mi := &file_authv1_proto_msgTypes[36]
+	mi := &file_authv1_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2190,7 +2281,7 @@ func (x *RegisterDeviceFinishRequest_Response_File) String() string {
 func (*RegisterDeviceFinishRequest_Response_File) ProtoMessage() {}
 
 func (x *RegisterDeviceFinishRequest_Response_File) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[36]
+	mi := &file_authv1_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2203,7 +2294,7 @@ func (x *RegisterDeviceFinishRequest_Response_File) ProtoReflect() protoreflect.
 
 // Deprecated: Use RegisterDeviceFinishRequest_Response_File.ProtoReflect.Descriptor instead.
 func (*RegisterDeviceFinishRequest_Response_File) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{7, 0, 1}
+	return file_authv1_proto_rawDescGZIP(), []int{9, 0, 1}
 }
 
 func (x *RegisterDeviceFinishRequest_Response_File) GetOutput() []byte {
@@ -2226,7 +2317,7 @@ type TokenT0_Content struct {
 
 func (x *TokenT0_Content) Reset() {
 	*x = TokenT0_Content{}
-	mi := &file_authv1_proto_msgTypes[37]
+	mi := &file_authv1_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2238,7 +2329,7 @@ func (x *TokenT0_Content) String() string {
 func (*TokenT0_Content) ProtoMessage() {}
 
 func (x *TokenT0_Content) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[37]
+	mi := &file_authv1_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2251,7 +2342,7 @@ func (x *TokenT0_Content) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenT0_Content.ProtoReflect.Descriptor instead.
 func (*TokenT0_Content) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{12, 0}
+	return file_authv1_proto_rawDescGZIP(), []int{14, 0}
 }
 
 func (x *TokenT0_Content) GetType() TokenT0_Content_Type {
@@ -2303,7 +2394,7 @@ type AuthenticateAuthenticatorBeginResponse_ChallengeRequest struct {
 
 func (x *AuthenticateAuthenticatorBeginResponse_ChallengeRequest) Reset() {
 	*x = AuthenticateAuthenticatorBeginResponse_ChallengeRequest{}
-	mi := &file_authv1_proto_msgTypes[38]
+	mi := &file_authv1_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2315,7 +2406,7 @@ func (x *AuthenticateAuthenticatorBeginResponse_ChallengeRequest) String() strin
 func (*AuthenticateAuthenticatorBeginResponse_ChallengeRequest) ProtoMessage() {}
 
 func (x *AuthenticateAuthenticatorBeginResponse_ChallengeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[38]
+	mi := &file_authv1_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2328,7 +2419,7 @@ func (x *AuthenticateAuthenticatorBeginResponse_ChallengeRequest) ProtoReflect()
 
 // Deprecated: Use AuthenticateAuthenticatorBeginResponse_ChallengeRequest.ProtoReflect.Descriptor instead.
 func (*AuthenticateAuthenticatorBeginResponse_ChallengeRequest) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{14, 0}
+	return file_authv1_proto_rawDescGZIP(), []int{16, 0}
 }
 
 func (x *AuthenticateAuthenticatorBeginResponse_ChallengeRequest) GetType() isAuthenticateAuthenticatorBeginResponse_ChallengeRequest_Type {
@@ -2399,7 +2490,7 @@ type AuthenticateAuthenticatorBeginResponse_ChallengeRequest_FIDO struct {
 
 func (x *AuthenticateAuthenticatorBeginResponse_ChallengeRequest_FIDO) Reset() {
 	*x = AuthenticateAuthenticatorBeginResponse_ChallengeRequest_FIDO{}
-	mi := &file_authv1_proto_msgTypes[39]
+	mi := &file_authv1_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2411,7 +2502,7 @@ func (x *AuthenticateAuthenticatorBeginResponse_ChallengeRequest_FIDO) String()
 func (*AuthenticateAuthenticatorBeginResponse_ChallengeRequest_FIDO) ProtoMessage() {}
 
 func (x *AuthenticateAuthenticatorBeginResponse_ChallengeRequest_FIDO) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[39]
+	mi := &file_authv1_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2424,7 +2515,7 @@ func (x *AuthenticateAuthenticatorBeginResponse_ChallengeRequest_FIDO) ProtoRefl
 
 // Deprecated: Use AuthenticateAuthenticatorBeginResponse_ChallengeRequest_FIDO.ProtoReflect.Descriptor instead.
 func (*AuthenticateAuthenticatorBeginResponse_ChallengeRequest_FIDO) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{14, 0, 0}
+	return file_authv1_proto_rawDescGZIP(), []int{16, 0, 0}
 }
 
 func (x *AuthenticateAuthenticatorBeginResponse_ChallengeRequest_FIDO) GetRequest() string {
@@ -2442,7 +2533,7 @@ type AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TOTP struct {
 
 func (x *AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TOTP) Reset() {
 	*x = AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TOTP{}
-	mi := &file_authv1_proto_msgTypes[40]
+	mi := &file_authv1_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2454,7 +2545,7 @@ func (x *AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TOTP) String()
 func (*AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TOTP) ProtoMessage() {}
 
 func (x *AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TOTP) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[40]
+	mi := &file_authv1_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2467,7 +2558,7 @@ func (x *AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TOTP) ProtoRefl
 
 // Deprecated: Use AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TOTP.ProtoReflect.Descriptor instead.
 func (*AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TOTP) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{14, 0, 1}
+	return file_authv1_proto_rawDescGZIP(), []int{16, 0, 1}
 }
 
 type AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TPM struct {
@@ -2480,7 +2571,7 @@ type AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TPM struct {
 
 func (x *AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TPM) Reset() {
 	*x = AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TPM{}
-	mi := &file_authv1_proto_msgTypes[41]
+	mi := &file_authv1_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2492,7 +2583,7 @@ func (x *AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TPM) String() s
 func (*AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TPM) ProtoMessage() {}
 
 func (x *AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TPM) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[41]
+	mi := &file_authv1_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2505,7 +2596,7 @@ func (x *AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TPM) ProtoRefle
 
 // Deprecated: Use AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TPM.ProtoReflect.Descriptor instead.
 func (*AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TPM) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{14, 0, 2}
+	return file_authv1_proto_rawDescGZIP(), []int{16, 0, 2}
 }
 
 func (x *AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TPM) GetEncryptedCredential() *AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TPM_EncryptedCredential {
@@ -2532,7 +2623,7 @@ type AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TPM_EncryptedCreden
 
 func (x *AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TPM_EncryptedCredential) Reset() {
 	*x = AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TPM_EncryptedCredential{}
-	mi := &file_authv1_proto_msgTypes[42]
+	mi := &file_authv1_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2545,7 +2636,7 @@ func (*AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TPM_EncryptedCred
 }
 
 func (x *AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TPM_EncryptedCredential) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[42]
+	mi := &file_authv1_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2558,7 +2649,7 @@ func (x *AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TPM_EncryptedCr
 
 // Deprecated: Use AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TPM_EncryptedCredential.ProtoReflect.Descriptor instead.
 func (*AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TPM_EncryptedCredential) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{14, 0, 2, 0}
+	return file_authv1_proto_rawDescGZIP(), []int{16, 0, 2, 0}
 }
 
 func (x *AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TPM_EncryptedCredential) GetCredential() []byte {
@@ -2587,7 +2678,7 @@ type RegisterAuthenticatorBeginRequest_PreChallenge struct {
 
 func (x *RegisterAuthenticatorBeginRequest_PreChallenge) Reset() {
 	*x = RegisterAuthenticatorBeginRequest_PreChallenge{}
-	mi := &file_authv1_proto_msgTypes[43]
+	mi := &file_authv1_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2599,7 +2690,7 @@ func (x *RegisterAuthenticatorBeginRequest_PreChallenge) String() string {
 func (*RegisterAuthenticatorBeginRequest_PreChallenge) ProtoMessage() {}
 
 func (x *RegisterAuthenticatorBeginRequest_PreChallenge) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[43]
+	mi := &file_authv1_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2612,7 +2703,7 @@ func (x *RegisterAuthenticatorBeginRequest_PreChallenge) ProtoReflect() protoref
 
 // Deprecated: Use RegisterAuthenticatorBeginRequest_PreChallenge.ProtoReflect.Descriptor instead.
 func (*RegisterAuthenticatorBeginRequest_PreChallenge) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{15, 0}
+	return file_authv1_proto_rawDescGZIP(), []int{17, 0}
 }
 
 func (x *RegisterAuthenticatorBeginRequest_PreChallenge) GetType() isRegisterAuthenticatorBeginRequest_PreChallenge_Type {
@@ -2657,7 +2748,7 @@ type RegisterAuthenticatorBeginRequest_PreChallenge_TPM struct {
 
 func (x *RegisterAuthenticatorBeginRequest_PreChallenge_TPM) Reset() {
 	*x = RegisterAuthenticatorBeginRequest_PreChallenge_TPM{}
-	mi := &file_authv1_proto_msgTypes[44]
+	mi := &file_authv1_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2669,7 +2760,7 @@ func (x *RegisterAuthenticatorBeginRequest_PreChallenge_TPM) String() string {
 func (*RegisterAuthenticatorBeginRequest_PreChallenge_TPM) ProtoMessage() {}
 
 func (x *RegisterAuthenticatorBeginRequest_PreChallenge_TPM) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[44]
+	mi := &file_authv1_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2682,7 +2773,7 @@ func (x *RegisterAuthenticatorBeginRequest_PreChallenge_TPM) ProtoReflect() prot
 
 // Deprecated: Use RegisterAuthenticatorBeginRequest_PreChallenge_TPM.ProtoReflect.Descriptor instead.
 func (*RegisterAuthenticatorBeginRequest_PreChallenge_TPM) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{15, 0, 0}
+	return file_authv1_proto_rawDescGZIP(), []int{17, 0, 0}
 }
 
 func (x *RegisterAuthenticatorBeginRequest_PreChallenge_TPM) GetAkBytes() []byte {
@@ -2754,7 +2845,7 @@ type RegisterAuthenticatorBeginRequest_PreChallenge_TPM_AttestationParameters st
 
 func (x *RegisterAuthenticatorBeginRequest_PreChallenge_TPM_AttestationParameters) Reset() {
 	*x = RegisterAuthenticatorBeginRequest_PreChallenge_TPM_AttestationParameters{}
-	mi := &file_authv1_proto_msgTypes[45]
+	mi := &file_authv1_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2766,7 +2857,7 @@ func (x *RegisterAuthenticatorBeginRequest_PreChallenge_TPM_AttestationParameter
 func (*RegisterAuthenticatorBeginRequest_PreChallenge_TPM_AttestationParameters) ProtoMessage() {}
 
 func (x *RegisterAuthenticatorBeginRequest_PreChallenge_TPM_AttestationParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[45]
+	mi := &file_authv1_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2779,7 +2870,7 @@ func (x *RegisterAuthenticatorBeginRequest_PreChallenge_TPM_AttestationParameter
 
 // Deprecated: Use RegisterAuthenticatorBeginRequest_PreChallenge_TPM_AttestationParameters.ProtoReflect.Descriptor instead.
 func (*RegisterAuthenticatorBeginRequest_PreChallenge_TPM_AttestationParameters) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{15, 0, 0, 0}
+	return file_authv1_proto_rawDescGZIP(), []int{17, 0, 0, 0}
 }
 
 func (x *RegisterAuthenticatorBeginRequest_PreChallenge_TPM_AttestationParameters) GetPublic() []byte {
@@ -2824,7 +2915,7 @@ type RegisterAuthenticatorBeginResponse_ChallengeRequest struct {
 
 func (x *RegisterAuthenticatorBeginResponse_ChallengeRequest) Reset() {
 	*x = RegisterAuthenticatorBeginResponse_ChallengeRequest{}
-	mi := &file_authv1_proto_msgTypes[46]
+	mi := &file_authv1_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2836,7 +2927,7 @@ func (x *RegisterAuthenticatorBeginResponse_ChallengeRequest) String() string {
 func (*RegisterAuthenticatorBeginResponse_ChallengeRequest) ProtoMessage() {}
 
 func (x *RegisterAuthenticatorBeginResponse_ChallengeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[46]
+	mi := &file_authv1_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2849,7 +2940,7 @@ func (x *RegisterAuthenticatorBeginResponse_ChallengeRequest) ProtoReflect() pro
 
 // Deprecated: Use RegisterAuthenticatorBeginResponse_ChallengeRequest.ProtoReflect.Descriptor instead.
 func (*RegisterAuthenticatorBeginResponse_ChallengeRequest) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{16, 0}
+	return file_authv1_proto_rawDescGZIP(), []int{18, 0}
 }
 
 func (x *RegisterAuthenticatorBeginResponse_ChallengeRequest) GetType() isRegisterAuthenticatorBeginResponse_ChallengeRequest_Type {
@@ -2920,7 +3011,7 @@ type RegisterAuthenticatorBeginResponse_ChallengeRequest_FIDO struct {
 
 func (x *RegisterAuthenticatorBeginResponse_ChallengeRequest_FIDO) Reset() {
 	*x = RegisterAuthenticatorBeginResponse_ChallengeRequest_FIDO{}
-	mi := &file_authv1_proto_msgTypes[47]
+	mi := &file_authv1_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2932,7 +3023,7 @@ func (x *RegisterAuthenticatorBeginResponse_ChallengeRequest_FIDO) String() stri
 func (*RegisterAuthenticatorBeginResponse_ChallengeRequest_FIDO) ProtoMessage() {}
 
 func (x *RegisterAuthenticatorBeginResponse_ChallengeRequest_FIDO) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[47]
+	mi := &file_authv1_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2945,7 +3036,7 @@ func (x *RegisterAuthenticatorBeginResponse_ChallengeRequest_FIDO) ProtoReflect(
 
 // Deprecated: Use RegisterAuthenticatorBeginResponse_ChallengeRequest_FIDO.ProtoReflect.Descriptor instead.
 func (*RegisterAuthenticatorBeginResponse_ChallengeRequest_FIDO) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{16, 0, 0}
+	return file_authv1_proto_rawDescGZIP(), []int{18, 0, 0}
 }
 
 func (x *RegisterAuthenticatorBeginResponse_ChallengeRequest_FIDO) GetRequest() string {
@@ -2964,7 +3055,7 @@ type RegisterAuthenticatorBeginResponse_ChallengeRequest_TOTP struct {
 
 func (x *RegisterAuthenticatorBeginResponse_ChallengeRequest_TOTP) Reset() {
 	*x = RegisterAuthenticatorBeginResponse_ChallengeRequest_TOTP{}
-	mi := &file_authv1_proto_msgTypes[48]
+	mi := &file_authv1_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2976,7 +3067,7 @@ func (x *RegisterAuthenticatorBeginResponse_ChallengeRequest_TOTP) String() stri
 func (*RegisterAuthenticatorBeginResponse_ChallengeRequest_TOTP) ProtoMessage() {}
 
 func (x *RegisterAuthenticatorBeginResponse_ChallengeRequest_TOTP) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[48]
+	mi := &file_authv1_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2989,7 +3080,7 @@ func (x *RegisterAuthenticatorBeginResponse_ChallengeRequest_TOTP) ProtoReflect(
 
 // Deprecated: Use RegisterAuthenticatorBeginResponse_ChallengeRequest_TOTP.ProtoReflect.Descriptor instead.
 func (*RegisterAuthenticatorBeginResponse_ChallengeRequest_TOTP) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{16, 0, 1}
+	return file_authv1_proto_rawDescGZIP(), []int{18, 0, 1}
 }
 
 func (x *RegisterAuthenticatorBeginResponse_ChallengeRequest_TOTP) GetUrl() string {
@@ -3009,7 +3100,7 @@ type RegisterAuthenticatorBeginResponse_ChallengeRequest_TPM struct {
 
 func (x *RegisterAuthenticatorBeginResponse_ChallengeRequest_TPM) Reset() {
 	*x = RegisterAuthenticatorBeginResponse_ChallengeRequest_TPM{}
-	mi := &file_authv1_proto_msgTypes[49]
+	mi := &file_authv1_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3021,7 +3112,7 @@ func (x *RegisterAuthenticatorBeginResponse_ChallengeRequest_TPM) String() strin
 func (*RegisterAuthenticatorBeginResponse_ChallengeRequest_TPM) ProtoMessage() {}
 
 func (x *RegisterAuthenticatorBeginResponse_ChallengeRequest_TPM) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[49]
+	mi := &file_authv1_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3034,7 +3125,7 @@ func (x *RegisterAuthenticatorBeginResponse_ChallengeRequest_TPM) ProtoReflect()
 
 // Deprecated: Use RegisterAuthenticatorBeginResponse_ChallengeRequest_TPM.ProtoReflect.Descriptor instead.
 func (*RegisterAuthenticatorBeginResponse_ChallengeRequest_TPM) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{16, 0, 2}
+	return file_authv1_proto_rawDescGZIP(), []int{18, 0, 2}
 }
 
 func (x *RegisterAuthenticatorBeginResponse_ChallengeRequest_TPM) GetEncryptedCredential() *RegisterAuthenticatorBeginResponse_ChallengeRequest_TPM_EncryptedCredential {
@@ -3061,7 +3152,7 @@ type RegisterAuthenticatorBeginResponse_ChallengeRequest_TPM_EncryptedCredential
 
 func (x *RegisterAuthenticatorBeginResponse_ChallengeRequest_TPM_EncryptedCredential) Reset() {
 	*x = RegisterAuthenticatorBeginResponse_ChallengeRequest_TPM_EncryptedCredential{}
-	mi := &file_authv1_proto_msgTypes[50]
+	mi := &file_authv1_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3073,7 +3164,7 @@ func (x *RegisterAuthenticatorBeginResponse_ChallengeRequest_TPM_EncryptedCreden
 func (*RegisterAuthenticatorBeginResponse_ChallengeRequest_TPM_EncryptedCredential) ProtoMessage() {}
 
 func (x *RegisterAuthenticatorBeginResponse_ChallengeRequest_TPM_EncryptedCredential) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[50]
+	mi := &file_authv1_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3086,7 +3177,7 @@ func (x *RegisterAuthenticatorBeginResponse_ChallengeRequest_TPM_EncryptedCreden
 
 // Deprecated: Use RegisterAuthenticatorBeginResponse_ChallengeRequest_TPM_EncryptedCredential.ProtoReflect.Descriptor instead.
 func (*RegisterAuthenticatorBeginResponse_ChallengeRequest_TPM_EncryptedCredential) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{16, 0, 2, 0}
+	return file_authv1_proto_rawDescGZIP(), []int{18, 0, 2, 0}
 }
 
 func (x *RegisterAuthenticatorBeginResponse_ChallengeRequest_TPM_EncryptedCredential) GetCredential() []byte {
@@ -3112,7 +3203,7 @@ type Authenticator_Spec struct {
 
 func (x *Authenticator_Spec) Reset() {
 	*x = Authenticator_Spec{}
-	mi := &file_authv1_proto_msgTypes[51]
+	mi := &file_authv1_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3124,7 +3215,7 @@ func (x *Authenticator_Spec) String() string {
 func (*Authenticator_Spec) ProtoMessage() {}
 
 func (x *Authenticator_Spec) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[51]
+	mi := &file_authv1_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3137,7 +3228,7 @@ func (x *Authenticator_Spec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Authenticator_Spec.ProtoReflect.Descriptor instead.
 func (*Authenticator_Spec) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{17, 0}
+	return file_authv1_proto_rawDescGZIP(), []int{19, 0}
 }
 
 func (x *Authenticator_Spec) GetDisplayName() string {
@@ -3158,7 +3249,7 @@ type Authenticator_Status struct {
 
 func (x *Authenticator_Status) Reset() {
 	*x = Authenticator_Status{}
-	mi := &file_authv1_proto_msgTypes[52]
+	mi := &file_authv1_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3170,7 +3261,7 @@ func (x *Authenticator_Status) String() string {
 func (*Authenticator_Status) ProtoMessage() {}
 
 func (x *Authenticator_Status) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[52]
+	mi := &file_authv1_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3183,7 +3274,7 @@ func (x *Authenticator_Status) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Authenticator_Status.ProtoReflect.Descriptor instead.
 func (*Authenticator_Status) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{17, 1}
+	return file_authv1_proto_rawDescGZIP(), []int{19, 1}
 }
 
 func (x *Authenticator_Status) GetIsRegistered() bool {
@@ -3216,7 +3307,7 @@ type ChallengeResponse_FIDO struct {
 
 func (x *ChallengeResponse_FIDO) Reset() {
 	*x = ChallengeResponse_FIDO{}
-	mi := &file_authv1_proto_msgTypes[53]
+	mi := &file_authv1_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3228,7 +3319,7 @@ func (x *ChallengeResponse_FIDO) String() string {
 func (*ChallengeResponse_FIDO) ProtoMessage() {}
 
 func (x *ChallengeResponse_FIDO) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[53]
+	mi := &file_authv1_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3241,7 +3332,7 @@ func (x *ChallengeResponse_FIDO) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChallengeResponse_FIDO.ProtoReflect.Descriptor instead.
 func (*ChallengeResponse_FIDO) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{23, 0}
+	return file_authv1_proto_rawDescGZIP(), []int{25, 0}
 }
 
 func (x *ChallengeResponse_FIDO) GetResponse() string {
@@ -3260,7 +3351,7 @@ type ChallengeResponse_TOTP struct {
 
 func (x *ChallengeResponse_TOTP) Reset() {
 	*x = ChallengeResponse_TOTP{}
-	mi := &file_authv1_proto_msgTypes[54]
+	mi := &file_authv1_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3272,7 +3363,7 @@ func (x *ChallengeResponse_TOTP) String() string {
 func (*ChallengeResponse_TOTP) ProtoMessage() {}
 
 func (x *ChallengeResponse_TOTP) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[54]
+	mi := &file_authv1_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3285,7 +3376,7 @@ func (x *ChallengeResponse_TOTP) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChallengeResponse_TOTP.ProtoReflect.Descriptor instead.
 func (*ChallengeResponse_TOTP) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{23, 1}
+	return file_authv1_proto_rawDescGZIP(), []int{25, 1}
 }
 
 func (x *ChallengeResponse_TOTP) GetResponse() string {
@@ -3304,7 +3395,7 @@ type ChallengeResponse_TPM struct {
 
 func (x *ChallengeResponse_TPM) Reset() {
 	*x = ChallengeResponse_TPM{}
-	mi := &file_authv1_proto_msgTypes[55]
+	mi := &file_authv1_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3316,7 +3407,7 @@ func (x *ChallengeResponse_TPM) String() string {
 func (*ChallengeResponse_TPM) ProtoMessage() {}
 
 func (x *ChallengeResponse_TPM) ProtoReflect() protoreflect.Message {
-	mi := &file_authv1_proto_msgTypes[55]
+	mi := &file_authv1_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3329,7 +3420,7 @@ func (x *ChallengeResponse_TPM) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChallengeResponse_TPM.ProtoReflect.Descriptor instead.
 func (*ChallengeResponse_TPM) Descriptor() ([]byte, []int) {
-	return file_authv1_proto_rawDescGZIP(), []int{23, 2}
+	return file_authv1_proto_rawDescGZIP(), []int{25, 2}
 }
 
 func (x *ChallengeResponse_TPM) GetResponse() []byte {
@@ -3381,291 +3472,196 @@ var file_authv1_proto_rawDesc = []byte{
 	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x0f,
 	0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
 	0x10, 0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x9a, 0x03, 0x0a, 0x1a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x4e, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a,
+	0x65, 0x22, 0x3d, 0x0a, 0x1d, 0x49, 0x73, 0x73, 0x75, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x22, 0x42, 0x0a, 0x1e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x22, 0x9a, 0x03, 0x0a, 0x1a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3a, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x42, 0x65, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69,
+	0x6e, 0x66, 0x6f, 0x1a, 0xab, 0x02, 0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x59, 0x0a, 0x06,
+	0x6f, 0x73, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x41, 0x2e, 0x6f,
+	0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4f, 0x53, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x06, 0x6f, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x63, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6d,
+	0x61, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x54, 0x0a, 0x06, 0x4f,
+	0x53, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x49,
+	0x4e, 0x55, 0x58, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x53,
+	0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x41, 0x43, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x41,
+	0x4e, 0x44, 0x52, 0x4f, 0x49, 0x44, 0x10, 0x04, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x4f, 0x53, 0x10,
+	0x05, 0x22, 0xc5, 0x03, 0x0a, 0x1b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x69, 0x64, 0x12, 0x5a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a,
+	0xb7, 0x02, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x62, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x46,
 	0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61,
 	0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
 	0x74, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f,
-	0x1a, 0xab, 0x02, 0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x59, 0x0a, 0x06, 0x6f, 0x73, 0x54,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x41, 0x2e, 0x6f, 0x63, 0x74, 0x65,
-	0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4f, 0x53, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x6f, 0x73,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x63, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x54, 0x0a, 0x06, 0x4f, 0x53, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
-	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x49, 0x4e, 0x55, 0x58,
-	0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x53, 0x10, 0x02, 0x12,
-	0x07, 0x0a, 0x03, 0x4d, 0x41, 0x43, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x4e, 0x44, 0x52,
-	0x4f, 0x49, 0x44, 0x10, 0x04, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x4f, 0x53, 0x10, 0x05, 0x22, 0xc5,
-	0x03, 0x0a, 0x1b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64,
-	0x12, 0x5a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x42, 0x65, 0x67,
-	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a, 0xb7, 0x02, 0x0a,
-	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x62, 0x0a, 0x07, 0x63, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x6f, 0x63,
-	0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e,
-	0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x59,
-	0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x6f,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x12, 0x59, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x43, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d,
+	0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x46, 0x69, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x1a, 0x37, 0x0a, 0x07,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x61, 0x72, 0x67, 0x73, 0x1a, 0x1a, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xb9, 0x03, 0x0a, 0x1b, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x5d, 0x0a, 0x09, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f,
+	0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61,
+	0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x1a, 0xa8, 0x02, 0x0a, 0x08, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x63, 0x0a, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x6f, 0x63, 0x74,
+	0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x5a,
+	0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x6f,
 	0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e,
 	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c,
-	0x65, 0x48, 0x00, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x1a, 0x37, 0x0a, 0x07, 0x43, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72,
-	0x67, 0x73, 0x1a, 0x1a, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x42, 0x06,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xb9, 0x03, 0x0a, 0x1b, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x5d, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x6f, 0x63,
-	0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e,
-	0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x1a, 0xa8, 0x02, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x63, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69,
+	0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69,
+	0x6c, 0x65, 0x48, 0x00, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x1a, 0x21, 0x0a, 0x07, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x1a, 0x1e, 0x0a,
+	0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x06, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x76, 0x0a, 0x2a, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x13, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0xb6, 0x01,
+	0x0a, 0x20, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x57, 0x69,
+	0x74, 0x68, 0x41, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x5c, 0x0a, 0x13, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d,
+	0x61, 0x69, 0x6e, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x66,
+	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0x25, 0x0a, 0x23, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x91, 0x03,
+	0x0a, 0x07, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x30, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c,
+	0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x30, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x1a, 0xa1, 0x02,
+	0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69,
 	0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x5a, 0x0a, 0x04, 0x66,
-	0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x6f, 0x63, 0x74, 0x65,
-	0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x48,
-	0x00, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x1a, 0x21, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x1a, 0x1e, 0x0a, 0x04, 0x46, 0x69,
-	0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x76, 0x0a, 0x2a, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x30, 0x0a, 0x13, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x61,
-	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x20, 0x41,
-	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x41,
-	0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x5c, 0x0a, 0x13, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x52, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f,
-	0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e,
-	0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x66, 0x12, 0x1c, 0x0a,
-	0x09, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f,
-	0x70, 0x65, 0x73, 0x22, 0x25, 0x0a, 0x23, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x91, 0x03, 0x0a, 0x07, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x30, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x30, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x1a, 0xa1, 0x02, 0x0a, 0x07, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x30, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6b,
-	0x65, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49,
-	0x44, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
-	0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22,
-	0x4d, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x43, 0x43,
-	0x45, 0x53, 0x53, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x52,
-	0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x0e,
-	0x0a, 0x0a, 0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x03, 0x22, 0x7f,
-	0x0a, 0x25, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x68, 0x65,
-	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x10, 0x61,
-	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x66, 0x22,
-	0xc9, 0x06, 0x0a, 0x26, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x30, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x6b,
+	0x65, 0x79, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x22, 0x4d, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c,
+	0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x11,
+	0x0a, 0x0d, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10,
+	0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x10,
+	0x03, 0x22, 0x7f, 0x0a, 0x25, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x65,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x10, 0x61, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x66, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x10, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x66, 0x22, 0xc9, 0x06, 0x0a, 0x26, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72,
+	0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7e, 0x0a,
+	0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x52, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69,
+	0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65,
 	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x65, 0x67,
-	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7e, 0x0a, 0x10, 0x63, 0x68,
-	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x52, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74,
-	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
-	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x9e, 0x05, 0x0a, 0x10, 0x43,
-	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x6d, 0x0a, 0x04, 0x66, 0x69, 0x64, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x57, 0x2e,
+	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x10, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x9e, 0x05,
+	0x0a, 0x10, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x6d, 0x0a, 0x04, 0x66, 0x69, 0x64, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x57, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x49, 0x44, 0x4f, 0x48, 0x00, 0x52, 0x04, 0x66, 0x69, 0x64,
+	0x6f, 0x12, 0x6d, 0x0a, 0x04, 0x74, 0x6f, 0x74, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x57, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d,
+	0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x54, 0x4f, 0x54, 0x50, 0x48, 0x00, 0x52, 0x04, 0x74, 0x6f, 0x74, 0x70,
+	0x12, 0x6a, 0x0a, 0x03, 0x74, 0x70, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x56, 0x2e,
 	0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69,
 	0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
 	0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
 	0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x2e, 0x46, 0x49, 0x44, 0x4f, 0x48, 0x00, 0x52, 0x04, 0x66, 0x69, 0x64, 0x6f, 0x12, 0x6d,
-	0x0a, 0x04, 0x74, 0x6f, 0x74, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x57, 0x2e, 0x6f,
+	0x74, 0x2e, 0x54, 0x50, 0x4d, 0x48, 0x00, 0x52, 0x03, 0x74, 0x70, 0x6d, 0x1a, 0x20, 0x0a, 0x04,
+	0x46, 0x49, 0x44, 0x4f, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x06,
+	0x0a, 0x04, 0x54, 0x4f, 0x54, 0x50, 0x1a, 0x8d, 0x02, 0x0a, 0x03, 0x54, 0x50, 0x4d, 0x12, 0x9c,
+	0x01, 0x0a, 0x13, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x6a, 0x2e, 0x6f,
 	0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e,
 	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
 	0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
 	0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
 	0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x2e, 0x54, 0x4f, 0x54, 0x50, 0x48, 0x00, 0x52, 0x04, 0x74, 0x6f, 0x74, 0x70, 0x12, 0x6a, 0x0a,
-	0x03, 0x74, 0x70, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x56, 0x2e, 0x6f, 0x63, 0x74,
-	0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61,
-	0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72,
-	0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68,
-	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54,
-	0x50, 0x4d, 0x48, 0x00, 0x52, 0x03, 0x74, 0x70, 0x6d, 0x1a, 0x20, 0x0a, 0x04, 0x46, 0x49, 0x44,
-	0x4f, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x06, 0x0a, 0x04, 0x54,
-	0x4f, 0x54, 0x50, 0x1a, 0x8d, 0x02, 0x0a, 0x03, 0x54, 0x50, 0x4d, 0x12, 0x9c, 0x01, 0x0a, 0x13,
-	0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x6a, 0x2e, 0x6f, 0x63, 0x74, 0x65,
-	0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42,
-	0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61,
-	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x50,
-	0x4d, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x13, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64,
-	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6b,
-	0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x6b, 0x42,
-	0x79, 0x74, 0x65, 0x73, 0x1a, 0x4d, 0x0a, 0x13, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65,
-	0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xaa, 0x06, 0x0a, 0x21,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
-	0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x56, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
-	0x6f, 0x72, 0x52, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x63,
-	0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e,
-	0x6d, 0x65, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x10, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
-	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x66, 0x12, 0x6d, 0x0a, 0x0c, 0x70, 0x72, 0x65,
-	0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x49, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d,
-	0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f,
-	0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x72,
-	0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x43,
-	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x1a, 0xbd, 0x04, 0x0a, 0x0c, 0x50, 0x72, 0x65,
-	0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x61, 0x0a, 0x03, 0x74, 0x70, 0x6d,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4d, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75,
-	0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65,
-	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
-	0x65, 0x2e, 0x54, 0x50, 0x4d, 0x48, 0x00, 0x52, 0x03, 0x74, 0x70, 0x6d, 0x1a, 0xc1, 0x03, 0x0a,
-	0x03, 0x54, 0x50, 0x4d, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6b, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x6b, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x99,
-	0x01, 0x0a, 0x15, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x63,
-	0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61,
-	0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72,
-	0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x65,
-	0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x2e, 0x54, 0x50, 0x4d, 0x2e, 0x41, 0x74,
-	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
-	0x65, 0x72, 0x73, 0x52, 0x15, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x10, 0x65, 0x6b,
-	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x44, 0x45, 0x52, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x10, 0x65, 0x6b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x44, 0x45, 0x52, 0x12, 0x22, 0x0a, 0x0b, 0x65, 0x6b, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52,
-	0x0b, 0x65, 0x6b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x1a, 0xa7, 0x01, 0x0a,
-	0x15, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x1e,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2c,
-	0x0a, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x65, 0x6b, 0x54, 0x79, 0x70, 0x65,
-	0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xc3, 0x06, 0x0a, 0x22, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
-	0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x7a, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4e, 0x2e, 0x6f, 0x63, 0x74, 0x65,
-	0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c,
-	0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0xa0, 0x05, 0x0a, 0x10,
-	0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x69, 0x0a, 0x04, 0x66, 0x69, 0x64, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x53,
-	0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61,
-	0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72,
-	0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68,
-	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46,
-	0x49, 0x44, 0x4f, 0x48, 0x00, 0x52, 0x04, 0x66, 0x69, 0x64, 0x6f, 0x12, 0x69, 0x0a, 0x04, 0x74,
-	0x6f, 0x74, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x53, 0x2e, 0x6f, 0x63, 0x74, 0x65,
-	0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x4f, 0x54, 0x50, 0x48, 0x00,
-	0x52, 0x04, 0x74, 0x6f, 0x74, 0x70, 0x12, 0x66, 0x0a, 0x03, 0x74, 0x70, 0x6d, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x52, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
-	0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x54, 0x50, 0x4d, 0x48, 0x00, 0x52, 0x03, 0x74, 0x70, 0x6d, 0x1a, 0x20,
-	0x0a, 0x04, 0x46, 0x49, 0x44, 0x4f, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x0a, 0x04, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x1a, 0x89, 0x02, 0x0a, 0x03, 0x54,
-	0x50, 0x4d, 0x12, 0x98, 0x01, 0x0a, 0x13, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64,
-	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x66, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
-	0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x2e, 0x54, 0x50, 0x4d, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x43, 0x72,
 	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x13, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70,
 	0x74, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x18, 0x0a,
@@ -3675,306 +3671,418 @@ var file_authv1_proto_rawDesc = []byte{
 	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0c, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x16,
 	0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
-	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x8c,
-	0x04, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72,
-	0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x3f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75,
-	0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x41, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xaa,
+	0x06, 0x0a, 0x21, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61,
+	0x69, 0x6e, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x10, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x66, 0x12, 0x6d, 0x0a, 0x0c,
+	0x70, 0x72, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x49, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x50, 0x72, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x0c, 0x70,
+	0x72, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x1a, 0xbd, 0x04, 0x0a, 0x0c,
+	0x50, 0x72, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x61, 0x0a, 0x03,
+	0x74, 0x70, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4d, 0x2e, 0x6f, 0x63, 0x74, 0x65,
+	0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x2e, 0x54, 0x50, 0x4d, 0x48, 0x00, 0x52, 0x03, 0x74, 0x70, 0x6d, 0x1a,
+	0xc1, 0x03, 0x0a, 0x03, 0x54, 0x50, 0x4d, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6b, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x6b, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x99, 0x01, 0x0a, 0x15, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x63, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x50, 0x72, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x2e, 0x54, 0x50, 0x4d,
+	0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x15, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a,
+	0x10, 0x65, 0x6b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x44, 0x45,
+	0x52, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x10, 0x65, 0x6b, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x44, 0x45, 0x52, 0x12, 0x22, 0x0a, 0x0b, 0x65,
+	0x6b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x48, 0x00, 0x52, 0x0b, 0x65, 0x6b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x1a,
+	0xa7, 0x01, 0x0a, 0x15, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x28, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x65, 0x6b, 0x54,
+	0x79, 0x70, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xc3, 0x06, 0x0a, 0x22,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x7a, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4e, 0x2e, 0x6f,
+	0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x65,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x10, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0xa0,
+	0x05, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x69, 0x0a, 0x04, 0x66, 0x69, 0x64, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x53, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x46, 0x49, 0x44, 0x4f, 0x48, 0x00, 0x52, 0x04, 0x66, 0x69, 0x64, 0x6f, 0x12, 0x69,
+	0x0a, 0x04, 0x74, 0x6f, 0x74, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x53, 0x2e, 0x6f,
+	0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x65,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x4f, 0x54,
+	0x50, 0x48, 0x00, 0x52, 0x04, 0x74, 0x6f, 0x74, 0x70, 0x12, 0x66, 0x0a, 0x03, 0x74, 0x70, 0x6d,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x52, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75,
+	0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x50, 0x4d, 0x48, 0x00, 0x52, 0x03, 0x74, 0x70,
+	0x6d, 0x1a, 0x20, 0x0a, 0x04, 0x46, 0x49, 0x44, 0x4f, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x0a, 0x04, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x1a, 0x89, 0x02,
+	0x0a, 0x03, 0x54, 0x50, 0x4d, 0x12, 0x98, 0x01, 0x0a, 0x13, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x66, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61,
 	0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e,
-	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x70,
-	0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x47, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c,
-	0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
-	0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x1a, 0x28, 0x0a, 0x04, 0x53, 0x70, 0x65, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73,
-	0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0xcf, 0x01, 0x0a, 0x06,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x73, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x48, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c,
-	0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
-	0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x35, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10,
-	0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
-	0x12, 0x08, 0x0a, 0x04, 0x46, 0x49, 0x44, 0x4f, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x4f,
-	0x54, 0x50, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x50, 0x4d, 0x10, 0x03, 0x22, 0xe0, 0x01,
-	0x0a, 0x11, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x54, 0x50, 0x4d, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65,
+	0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x13, 0x65, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x6b, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x61, 0x6b, 0x42, 0x79, 0x74, 0x65, 0x73, 0x1a, 0x4d, 0x0a, 0x13, 0x45, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0x8c, 0x04, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
 	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73,
 	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x3e, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75,
+	0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x3f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x63, 0x74, 0x65,
+	0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x6d, 0x65,
+	0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x41, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75,
 	0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
 	0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72,
-	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x57, 0x0a, 0x10, 0x6c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2b, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x10,
-	0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x74, 0x61,
-	0x22, 0x52, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
-	0x63, 0x61, 0x74, 0x6f, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
-	0x12, 0x22, 0x0a, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x50, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x50, 0x65, 0x72,
-	0x50, 0x61, 0x67, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
-	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x34, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22,
-	0xd8, 0x01, 0x0a, 0x22, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68,
-	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
-	0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2a, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x10, 0x61, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x66, 0x12, 0x5a,
-	0x0a, 0x11, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6f, 0x63, 0x74, 0x65,
+	0x2e, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x47, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6f, 0x63,
+	0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x1a, 0x28, 0x0a, 0x04, 0x53, 0x70, 0x65, 0x63, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0xcf,
+	0x01, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x73, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x69, 0x73, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x48, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x6f, 0x63,
+	0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x35, 0x0a, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x49, 0x44, 0x4f, 0x10, 0x01, 0x12, 0x08, 0x0a,
+	0x04, 0x54, 0x4f, 0x54, 0x50, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x50, 0x4d, 0x10, 0x03,
+	0x22, 0xe0, 0x01, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x3e, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x63, 0x74, 0x65,
 	0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x11, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
-	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x0a, 0x23, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x6f, 0x72, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xde, 0x02, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x04, 0x66, 0x69, 0x64, 0x6f, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x46, 0x49, 0x44, 0x4f, 0x48, 0x00, 0x52, 0x04, 0x66, 0x69, 0x64, 0x6f,
-	0x12, 0x47, 0x0a, 0x04, 0x74, 0x6f, 0x74, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31,
-	0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61,
-	0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c,
-	0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x4f, 0x54,
-	0x50, 0x48, 0x00, 0x52, 0x04, 0x74, 0x6f, 0x74, 0x70, 0x12, 0x44, 0x0a, 0x03, 0x74, 0x70, 0x6d,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75,
-	0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x50, 0x4d, 0x48, 0x00, 0x52, 0x03, 0x74, 0x70, 0x6d, 0x1a,
-	0x22, 0x0a, 0x04, 0x46, 0x49, 0x44, 0x4f, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x1a, 0x22, 0x0a, 0x04, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x1a, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x21, 0x0a, 0x03, 0x54, 0x50, 0x4d, 0x12, 0x1a,
-	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x22, 0xda, 0x01, 0x0a, 0x24, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x10, 0x61,
-	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x66, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x76,
-	0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x52, 0x10, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72,
-	0x52, 0x65, 0x66, 0x12, 0x5a, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c,
-	0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61,
-	0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c,
-	0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x11, 0x63, 0x68,
-	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x22, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x41,
-	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0xdf, 0x01, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x6d, 0x61, 0x69,
-	0x6e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e,
+	0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x57, 0x0a, 0x10, 0x6c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x74,
+	0x61, 0x52, 0x10, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d,
+	0x65, 0x74, 0x61, 0x22, 0x52, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x50, 0x65, 0x72, 0x50,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x50, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e,
 	0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x11,
-	0x6d, 0x61, 0x69, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f,
-	0x72, 0x12, 0x62, 0x0a, 0x17, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41,
-	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x17, 0x61, 0x76,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0xd8, 0x01, 0x0a, 0x22, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x46, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x10, 0x61, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x66, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x10, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x66, 0x12, 0x5a, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6f,
+	0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x11, 0x63, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x0a,
+	0x23, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xde, 0x02, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x04, 0x66, 0x69,
+	0x64, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c,
+	0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x49, 0x44, 0x4f, 0x48, 0x00, 0x52, 0x04, 0x66,
+	0x69, 0x64, 0x6f, 0x12, 0x47, 0x0a, 0x04, 0x74, 0x6f, 0x74, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x31, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x54, 0x4f, 0x54, 0x50, 0x48, 0x00, 0x52, 0x04, 0x74, 0x6f, 0x74, 0x70, 0x12, 0x44, 0x0a, 0x03,
+	0x74, 0x70, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6f, 0x63, 0x74, 0x65,
+	0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x50, 0x4d, 0x48, 0x00, 0x52, 0x03, 0x74,
+	0x70, 0x6d, 0x1a, 0x22, 0x0a, 0x04, 0x46, 0x49, 0x44, 0x4f, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x22, 0x0a, 0x04, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x21, 0x0a, 0x03, 0x54, 0x50,
+	0x4d, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xda, 0x01, 0x0a, 0x24, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56,
+	0x0a, 0x10, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c,
+	0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x6d, 0x65, 0x74,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x10, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x66, 0x12, 0x5a, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x11, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x22, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xdf, 0x01, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x41, 0x76,
 	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x3b, 0x0a, 0x23, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11,
+	0x6d, 0x61, 0x69, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69,
+	0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x52, 0x11, 0x6d, 0x61, 0x69, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x62, 0x0a, 0x17, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x17, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x3b, 0x0a, 0x23, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73,
+	0x6b, 0x65, 0x79, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x40, 0x0a, 0x24, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
 	0x69, 0x63, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79,
-	0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65,
-	0x72, 0x79, 0x22, 0x40, 0x0a, 0x24, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x42, 0x65, 0x67,
-	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x3c, 0x0a, 0x1e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x32, 0x9d, 0x13, 0x0a, 0x0b, 0x4d, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x97, 0x01, 0x0a, 0x23, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x45, 0x2e, 0x6f, 0x63, 0x74,
-	0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61,
-	0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x00, 0x12, 0x83, 0x01, 0x0a,
-	0x19, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74,
-	0x68, 0x41, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x2e, 0x6f, 0x63, 0x74,
+	0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3c, 0x0a, 0x1e, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x6b,
+	0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xaf, 0x14, 0x0a, 0x0b, 0x4d, 0x61, 0x69, 0x6e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x97, 0x01, 0x0a, 0x23, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x45, 0x2e,
+	0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69,
+	0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x00, 0x12,
+	0x83, 0x01, 0x0a, 0x19, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x57, 0x69, 0x74, 0x68, 0x41, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x2e,
+	0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69,
+	0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x41, 0x73, 0x73, 0x65, 0x72, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x63, 0x74,
 	0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61,
-	0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x41, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69,
-	0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x22, 0x00, 0x12, 0x89, 0x01, 0x0a, 0x1c, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x3e, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x00, 0x12, 0x89, 0x01, 0x0a, 0x1c, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3e, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75,
+	0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x57,
+	0x69, 0x74, 0x68, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75,
+	0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x00, 0x12, 0x8b, 0x01, 0x0a, 0x1d, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x3f, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61,
 	0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e,
 	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68,
-	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x00, 0x12,
+	0x5f, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x28, 0x2e, 0x6f, 0x63, 0x74, 0x65,
+	0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61,
 	0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x00, 0x12, 0x8b,
-	0x01, 0x0a, 0x1d, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x57,
-	0x69, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72,
-	0x12, 0x3f, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74,
-	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x41, 0x75, 0x74,
-	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x06,
-	0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x28, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75,
-	0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x29, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67,
-	0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x86, 0x01,
-	0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x42, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x35, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6f,
-	0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x89, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12,
+	0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x86, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x35, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c,
+	0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x36, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d,
 	0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69,
-	0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x65, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
-	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x25, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75,
-	0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x28, 0x2e,
+	0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x89, 0x01, 0x0a, 0x14, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x12, 0x36, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6f, 0x63, 0x74,
+	0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x25, 0x2e, 0x6f, 0x63, 0x74, 0x65,
+	0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x6d, 0x65,
+	0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x1a, 0x28, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x78, 0x0a, 0x13,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x35, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x63, 0x74,
+	0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x2e,
 	0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69,
 	0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
-	0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x00, 0x12, 0x78, 0x0a, 0x13, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72,
-	0x12, 0x35, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x1a, 0x28, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69,
 	0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68,
 	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f,
-	0x72, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74,
-	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x2e, 0x6f, 0x63, 0x74,
-	0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61,
-	0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x6f, 0x72, 0x1a, 0x28, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x00,
-	0x12, 0x78, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
-	0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x33, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x6f, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x2c, 0x2e, 0x6f, 0x63, 0x74,
-	0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61,
-	0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x13, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f,
-	0x72, 0x12, 0x28, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x2a, 0x2e, 0x6f, 0x63,
-	0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e,
-	0x6d, 0x65, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x9b, 0x01, 0x0a, 0x1a, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x3c, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c,
+	0x72, 0x22, 0x00, 0x12, 0x78, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x33, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c,
 	0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74,
-	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75,
-	0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65,
-	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x9e, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f,
-	0x72, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x3d, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69,
-	0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68,
-	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75,
-	0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65,
-	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0xa7, 0x01, 0x0a, 0x1e, 0x41, 0x75, 0x74,
-	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
-	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x40, 0x2e, 0x6f, 0x63,
-	0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e,
-	0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f,
-	0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x41, 0x2e,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x2c, 0x2e,
 	0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69,
 	0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
-	0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x98, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
-	0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72,
-	0x12, 0x3b, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
-	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e,
-	0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69,
-	0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61,
-	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0xa1, 0x01,
-	0x0a, 0x1c, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x57, 0x69,
-	0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x3e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x6d, 0x0a,
+	0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x2a,
 	0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61,
-	0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65,
-	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x6b,
-	0x65, 0x79, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3f,
+	0x69, 0x6e, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x9b, 0x01, 0x0a,
+	0x1a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x3c, 0x2e, 0x6f, 0x63,
+	0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x65, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x6f, 0x63, 0x74, 0x65,
+	0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x9e, 0x01, 0x0a, 0x1b, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x6f, 0x72, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x3d, 0x2e, 0x6f, 0x63, 0x74,
+	0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x46, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x6f, 0x63, 0x74, 0x65,
+	0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x46, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0xa7, 0x01, 0x0a, 0x1e,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x40,
 	0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61,
 	0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65,
-	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x6b,
-	0x65, 0x79, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x7f, 0x0a, 0x17, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x12, 0x39, 0x2e, 0x6f,
-	0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69,
-	0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x22, 0x00, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2f, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69,
-	0x75, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x75, 0x74,
-	0x68, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x41, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x98, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x3b, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x3c, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0xa1, 0x01, 0x0a, 0x1c, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x42, 0x65, 0x67, 0x69,
+	0x6e, 0x12, 0x3e, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61,
+	0x73, 0x73, 0x6b, 0x65, 0x79, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x3f, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61,
+	0x73, 0x73, 0x6b, 0x65, 0x79, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x17, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x12,
+	0x39, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d,
+	0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73,
+	0x6b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x63, 0x74,
+	0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x00, 0x12, 0x8f, 0x01, 0x0a, 0x16, 0x49, 0x73, 0x73, 0x75, 0x65, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x12, 0x38, 0x2e, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x73,
+	0x75, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x6f, 0x63, 0x74,
+	0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2f, 0x6f,
+	0x63, 0x74, 0x65, 0x6c, 0x69, 0x75, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x6d, 0x61, 0x69,
+	0x6e, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3990,7 +4098,7 @@ func file_authv1_proto_rawDescGZIP() []byte {
 }
 
 var file_authv1_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_authv1_proto_msgTypes = make([]protoimpl.MessageInfo, 56)
+var file_authv1_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
 var file_authv1_proto_goTypes = []any{
 	(ClientLoginRequest_APIVersion)(0),                                                      // 0: octelium.api.main.auth.v1.ClientLoginRequest.APIVersion
 	(RegisterDeviceBeginRequest_Info_OSType)(0),                                             // 1: octelium.api.main.auth.v1.RegisterDeviceBeginRequest.Info.OSType
@@ -4001,148 +4109,152 @@ var file_authv1_proto_goTypes = []any{
 	(*ClientLoginResponse)(nil),                                                             // 6: octelium.api.main.auth.v1.ClientLoginResponse
 	(*LogoutRequest)(nil),                                                                   // 7: octelium.api.main.auth.v1.LogoutRequest
 	(*LogoutResponse)(nil),                                                                  // 8: octelium.api.main.auth.v1.LogoutResponse
-	(*RegisterDeviceBeginRequest)(nil),                                                      // 9: octelium.api.main.auth.v1.RegisterDeviceBeginRequest
-	(*RegisterDeviceBeginResponse)(nil),                                                     // 10: octelium.api.main.auth.v1.RegisterDeviceBeginResponse
-	(*RegisterDeviceFinishRequest)(nil),                                                     // 11: octelium.api.main.auth.v1.RegisterDeviceFinishRequest
-	(*RegisterDeviceFinishResponse)(nil),                                                    // 12: octelium.api.main.auth.v1.RegisterDeviceFinishResponse
-	(*AuthenticateWithAuthenticationTokenRequest)(nil),                                      // 13: octelium.api.main.auth.v1.AuthenticateWithAuthenticationTokenRequest
-	(*AuthenticateWithAssertionRequest)(nil),                                                // 14: octelium.api.main.auth.v1.AuthenticateWithAssertionRequest
-	(*AuthenticateWithRefreshTokenRequest)(nil),                                             // 15: octelium.api.main.auth.v1.AuthenticateWithRefreshTokenRequest
-	(*TokenT0)(nil),                                                                         // 16: octelium.api.main.auth.v1.TokenT0
-	(*AuthenticateAuthenticatorBeginRequest)(nil),                                           // 17: octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginRequest
-	(*AuthenticateAuthenticatorBeginResponse)(nil),                                          // 18: octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse
-	(*RegisterAuthenticatorBeginRequest)(nil),                                               // 19: octelium.api.main.auth.v1.RegisterAuthenticatorBeginRequest
-	(*RegisterAuthenticatorBeginResponse)(nil),                                              // 20: octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse
-	(*Authenticator)(nil),                                                                   // 21: octelium.api.main.auth.v1.Authenticator
-	(*AuthenticatorList)(nil),                                                               // 22: octelium.api.main.auth.v1.AuthenticatorList
-	(*ListAuthenticatorOptions)(nil),                                                        // 23: octelium.api.main.auth.v1.ListAuthenticatorOptions
-	(*CreateAuthenticatorRequest)(nil),                                                      // 24: octelium.api.main.auth.v1.CreateAuthenticatorRequest
-	(*RegisterAuthenticatorFinishRequest)(nil),                                              // 25: octelium.api.main.auth.v1.RegisterAuthenticatorFinishRequest
-	(*RegisterAuthenticatorFinishResponse)(nil),                                             // 26: octelium.api.main.auth.v1.RegisterAuthenticatorFinishResponse
-	(*ChallengeResponse)(nil),                                                               // 27: octelium.api.main.auth.v1.ChallengeResponse
-	(*AuthenticateWithAuthenticatorRequest)(nil),                                            // 28: octelium.api.main.auth.v1.AuthenticateWithAuthenticatorRequest
-	(*GetAvailableAuthenticatorRequest)(nil),                                                // 29: octelium.api.main.auth.v1.GetAvailableAuthenticatorRequest
-	(*GetAvailableAuthenticatorResponse)(nil),                                               // 30: octelium.api.main.auth.v1.GetAvailableAuthenticatorResponse
-	(*AuthenticateWithPasskeyBeginRequest)(nil),                                             // 31: octelium.api.main.auth.v1.AuthenticateWithPasskeyBeginRequest
-	(*AuthenticateWithPasskeyBeginResponse)(nil),                                            // 32: octelium.api.main.auth.v1.AuthenticateWithPasskeyBeginResponse
-	(*AuthenticateWithPasskeyRequest)(nil),                                                  // 33: octelium.api.main.auth.v1.AuthenticateWithPasskeyRequest
-	(*RegisterDeviceBeginRequest_Info)(nil),                                                 // 34: octelium.api.main.auth.v1.RegisterDeviceBeginRequest.Info
-	(*RegisterDeviceBeginResponse_Request)(nil),                                             // 35: octelium.api.main.auth.v1.RegisterDeviceBeginResponse.Request
-	(*RegisterDeviceBeginResponse_Request_Command)(nil),                                     // 36: octelium.api.main.auth.v1.RegisterDeviceBeginResponse.Request.Command
-	(*RegisterDeviceBeginResponse_Request_File)(nil),                                        // 37: octelium.api.main.auth.v1.RegisterDeviceBeginResponse.Request.File
-	(*RegisterDeviceFinishRequest_Response)(nil),                                            // 38: octelium.api.main.auth.v1.RegisterDeviceFinishRequest.Response
-	(*RegisterDeviceFinishRequest_Response_Command)(nil),                                    // 39: octelium.api.main.auth.v1.RegisterDeviceFinishRequest.Response.Command
-	(*RegisterDeviceFinishRequest_Response_File)(nil),                                       // 40: octelium.api.main.auth.v1.RegisterDeviceFinishRequest.Response.File
-	(*TokenT0_Content)(nil),                                                                 // 41: octelium.api.main.auth.v1.TokenT0.Content
-	(*AuthenticateAuthenticatorBeginResponse_ChallengeRequest)(nil),                         // 42: octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest
-	(*AuthenticateAuthenticatorBeginResponse_ChallengeRequest_FIDO)(nil),                    // 43: octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest.FIDO
-	(*AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TOTP)(nil),                    // 44: octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest.TOTP
-	(*AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TPM)(nil),                     // 45: octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest.TPM
-	(*AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TPM_EncryptedCredential)(nil), // 46: octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest.TPM.EncryptedCredential
-	(*RegisterAuthenticatorBeginRequest_PreChallenge)(nil),                                  // 47: octelium.api.main.auth.v1.RegisterAuthenticatorBeginRequest.PreChallenge
-	(*RegisterAuthenticatorBeginRequest_PreChallenge_TPM)(nil),                              // 48: octelium.api.main.auth.v1.RegisterAuthenticatorBeginRequest.PreChallenge.TPM
-	(*RegisterAuthenticatorBeginRequest_PreChallenge_TPM_AttestationParameters)(nil),        // 49: octelium.api.main.auth.v1.RegisterAuthenticatorBeginRequest.PreChallenge.TPM.AttestationParameters
-	(*RegisterAuthenticatorBeginResponse_ChallengeRequest)(nil),                             // 50: octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest
-	(*RegisterAuthenticatorBeginResponse_ChallengeRequest_FIDO)(nil),                        // 51: octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest.FIDO
-	(*RegisterAuthenticatorBeginResponse_ChallengeRequest_TOTP)(nil),                        // 52: octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest.TOTP
-	(*RegisterAuthenticatorBeginResponse_ChallengeRequest_TPM)(nil),                         // 53: octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest.TPM
-	(*RegisterAuthenticatorBeginResponse_ChallengeRequest_TPM_EncryptedCredential)(nil),     // 54: octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest.TPM.EncryptedCredential
-	(*Authenticator_Spec)(nil),                                                              // 55: octelium.api.main.auth.v1.Authenticator.Spec
-	(*Authenticator_Status)(nil),                                                            // 56: octelium.api.main.auth.v1.Authenticator.Status
-	(*ChallengeResponse_FIDO)(nil),                                                          // 57: octelium.api.main.auth.v1.ChallengeResponse.FIDO
-	(*ChallengeResponse_TOTP)(nil),                                                          // 58: octelium.api.main.auth.v1.ChallengeResponse.TOTP
-	(*ChallengeResponse_TPM)(nil),                                                           // 59: octelium.api.main.auth.v1.ChallengeResponse.TPM
-	(*metav1.ObjectReference)(nil),                                                          // 60: octelium.api.main.meta.v1.ObjectReference
-	(*metav1.Metadata)(nil),                                                                 // 61: octelium.api.main.meta.v1.Metadata
-	(*metav1.ListResponseMeta)(nil),                                                         // 62: octelium.api.main.meta.v1.ListResponseMeta
-	(*timestamppb.Timestamp)(nil),                                                           // 63: google.protobuf.Timestamp
-	(*metav1.GetOptions)(nil),                                                               // 64: octelium.api.main.meta.v1.GetOptions
-	(*metav1.DeleteOptions)(nil),                                                            // 65: octelium.api.main.meta.v1.DeleteOptions
-	(*metav1.OperationResult)(nil),                                                          // 66: octelium.api.main.meta.v1.OperationResult
+	(*IssueClientCertificateRequest)(nil),                                                   // 9: octelium.api.main.auth.v1.IssueClientCertificateRequest
+	(*IssueClientCertificateResponse)(nil),                                                  // 10: octelium.api.main.auth.v1.IssueClientCertificateResponse
+	(*RegisterDeviceBeginRequest)(nil),                                                      // 11: octelium.api.main.auth.v1.RegisterDeviceBeginRequest
+	(*RegisterDeviceBeginResponse)(nil),                                                     // 12: octelium.api.main.auth.v1.RegisterDeviceBeginResponse
+	(*RegisterDeviceFinishRequest)(nil),                                                     // 13: octelium.api.main.auth.v1.RegisterDeviceFinishRequest
+	(*RegisterDeviceFinishResponse)(nil),                                                    // 14: octelium.api.main.auth.v1.RegisterDeviceFinishResponse
+	(*AuthenticateWithAuthenticationTokenRequest)(nil),                                      // 15: octelium.api.main.auth.v1.AuthenticateWithAuthenticationTokenRequest
+	(*AuthenticateWithAssertionRequest)(nil),                                                // 16: octelium.api.main.auth.v1.AuthenticateWithAssertionRequest
+	(*AuthenticateWithRefreshTokenRequest)(nil),                                             // 17: octelium.api.main.auth.v1.AuthenticateWithRefreshTokenRequest
+	(*TokenT0)(nil),                                                                         // 18: octelium.api.main.auth.v1.TokenT0
+	(*AuthenticateAuthenticatorBeginRequest)(nil),                                           // 19: octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginRequest
+	(*AuthenticateAuthenticatorBeginResponse)(nil),                                          // 20: octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse
+	(*RegisterAuthenticatorBeginRequest)(nil),                                               // 21: octelium.api.main.auth.v1.RegisterAuthenticatorBeginRequest
+	(*RegisterAuthenticatorBeginResponse)(nil),                                              // 22: octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse
+	(*Authenticator)(nil),                                                                   // 23: octelium.api.main.auth.v1.Authenticator
+	(*AuthenticatorList)(nil),                                                               // 24: octelium.api.main.auth.v1.AuthenticatorList
+	(*ListAuthenticatorOptions)(nil),                                                        // 25: octelium.api.main.auth.v1.ListAuthenticatorOptions
+	(*CreateAuthenticatorRequest)(nil),                                                      // 26: octelium.api.main.auth.v1.CreateAuthenticatorRequest
+	(*RegisterAuthenticatorFinishRequest)(nil),                                              // 27: octelium.api.main.auth.v1.RegisterAuthenticatorFinishRequest
+	(*RegisterAuthenticatorFinishResponse)(nil),                                             // 28: octelium.api.main.auth.v1.RegisterAuthenticatorFinishResponse
+	(*ChallengeResponse)(nil),                                                               // 29: octelium.api.main.auth.v1.ChallengeResponse
+	(*AuthenticateWithAuthenticatorRequest)(nil),                                            // 30: octelium.api.main.auth.v1.AuthenticateWithAuthenticatorRequest
+	(*GetAvailableAuthenticatorRequest)(nil),                                                // 31: octelium.api.main.auth.v1.GetAvailableAuthenticatorRequest
+	(*GetAvailableAuthenticatorResponse)(nil),                                               // 32: octelium.api.main.auth.v1.GetAvailableAuthenticatorResponse
+	(*AuthenticateWithPasskeyBeginRequest)(nil),                                             // 33: octelium.api.main.auth.v1.AuthenticateWithPasskeyBeginRequest
+	(*AuthenticateWithPasskeyBeginResponse)(nil),                                            // 34: octelium.api.main.auth.v1.AuthenticateWithPasskeyBeginResponse
+	(*AuthenticateWithPasskeyRequest)(nil),                                                  // 35: octelium.api.main.auth.v1.AuthenticateWithPasskeyRequest
+	(*RegisterDeviceBeginRequest_Info)(nil),                                                 // 36: octelium.api.main.auth.v1.RegisterDeviceBeginRequest.Info
+	(*RegisterDeviceBeginResponse_Request)(nil),                                             // 37: octelium.api.main.auth.v1.RegisterDeviceBeginResponse.Request
+	(*RegisterDeviceBeginResponse_Request_Command)(nil),                                     // 38: octelium.api.main.auth.v1.RegisterDeviceBeginResponse.Request.Command
+	(*RegisterDeviceBeginResponse_Request_File)(nil),                                        // 39: octelium.api.main.auth.v1.RegisterDeviceBeginResponse.Request.File
+	(*RegisterDeviceFinishRequest_Response)(nil),                                            // 40: octelium.api.main.auth.v1.RegisterDeviceFinishRequest.Response
+	(*RegisterDeviceFinishRequest_Response_Command)(nil),                                    // 41: octelium.api.main.auth.v1.RegisterDeviceFinishRequest.Response.Command
+	(*RegisterDeviceFinishRequest_Response_File)(nil),                                       // 42: octelium.api.main.auth.v1.RegisterDeviceFinishRequest.Response.File
+	(*TokenT0_Content)(nil),                                                                 // 43: octelium.api.main.auth.v1.TokenT0.Content
+	(*AuthenticateAuthenticatorBeginResponse_ChallengeRequest)(nil),                         // 44: octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest
+	(*AuthenticateAuthenticatorBeginResponse_ChallengeRequest_FIDO)(nil),                    // 45: octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest.FIDO
+	(*AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TOTP)(nil),                    // 46: octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest.TOTP
+	(*AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TPM)(nil),                     // 47: octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest.TPM
+	(*AuthenticateAuthenticatorBeginResponse_ChallengeRequest_TPM_EncryptedCredential)(nil), // 48: octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest.TPM.EncryptedCredential
+	(*RegisterAuthenticatorBeginRequest_PreChallenge)(nil),                                  // 49: octelium.api.main.auth.v1.RegisterAuthenticatorBeginRequest.PreChallenge
+	(*RegisterAuthenticatorBeginRequest_PreChallenge_TPM)(nil),                              // 50: octelium.api.main.auth.v1.RegisterAuthenticatorBeginRequest.PreChallenge.TPM
+	(*RegisterAuthenticatorBeginRequest_PreChallenge_TPM_AttestationParameters)(nil),        // 51: octelium.api.main.auth.v1.RegisterAuthenticatorBeginRequest.PreChallenge.TPM.AttestationParameters
+	(*RegisterAuthenticatorBeginResponse_ChallengeRequest)(nil),                             // 52: octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest
+	(*RegisterAuthenticatorBeginResponse_ChallengeRequest_FIDO)(nil),                        // 53: octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest.FIDO
+	(*RegisterAuthenticatorBeginResponse_ChallengeRequest_TOTP)(nil),                        // 54: octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest.TOTP
+	(*RegisterAuthenticatorBeginResponse_ChallengeRequest_TPM)(nil),                         // 55: octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest.TPM
+	(*RegisterAuthenticatorBeginResponse_ChallengeRequest_TPM_EncryptedCredential)(nil),     // 56: octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest.TPM.EncryptedCredential
+	(*Authenticator_Spec)(nil),                                                              // 57: octelium.api.main.auth.v1.Authenticator.Spec
+	(*Authenticator_Status)(nil),                                                            // 58: octelium.api.main.auth.v1.Authenticator.Status
+	(*ChallengeResponse_FIDO)(nil),                                                          // 59: octelium.api.main.auth.v1.ChallengeResponse.FIDO
+	(*ChallengeResponse_TOTP)(nil),                                                          // 60: octelium.api.main.auth.v1.ChallengeResponse.TOTP
+	(*ChallengeResponse_TPM)(nil),                                                           // 61: octelium.api.main.auth.v1.ChallengeResponse.TPM
+	(*metav1.ObjectReference)(nil),                                                          // 62: octelium.api.main.meta.v1.ObjectReference
+	(*metav1.Metadata)(nil),                                                                 // 63: octelium.api.main.meta.v1.Metadata
+	(*metav1.ListResponseMeta)(nil),                                                         // 64: octelium.api.main.meta.v1.ListResponseMeta
+	(*timestamppb.Timestamp)(nil),                                                           // 65: google.protobuf.Timestamp
+	(*metav1.GetOptions)(nil),                                                               // 66: octelium.api.main.meta.v1.GetOptions
+	(*metav1.DeleteOptions)(nil),                                                            // 67: octelium.api.main.meta.v1.DeleteOptions
+	(*metav1.OperationResult)(nil),                                                          // 68: octelium.api.main.meta.v1.OperationResult
 }
 var file_authv1_proto_depIdxs = []int32{
 	0,  // 0: octelium.api.main.auth.v1.ClientLoginRequest.apiVersion:type_name -> octelium.api.main.auth.v1.ClientLoginRequest.APIVersion
-	34, // 1: octelium.api.main.auth.v1.RegisterDeviceBeginRequest.info:type_name -> octelium.api.main.auth.v1.RegisterDeviceBeginRequest.Info
-	35, // 2: octelium.api.main.auth.v1.RegisterDeviceBeginResponse.requests:type_name -> octelium.api.main.auth.v1.RegisterDeviceBeginResponse.Request
-	38, // 3: octelium.api.main.auth.v1.RegisterDeviceFinishRequest.responses:type_name -> octelium.api.main.auth.v1.RegisterDeviceFinishRequest.Response
-	60, // 4: octelium.api.main.auth.v1.AuthenticateWithAssertionRequest.identityProviderRef:type_name -> octelium.api.main.meta.v1.ObjectReference
-	41, // 5: octelium.api.main.auth.v1.TokenT0.content:type_name -> octelium.api.main.auth.v1.TokenT0.Content
-	60, // 6: octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginRequest.authenticatorRef:type_name -> octelium.api.main.meta.v1.ObjectReference
-	42, // 7: octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.challengeRequest:type_name -> octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest
-	60, // 8: octelium.api.main.auth.v1.RegisterAuthenticatorBeginRequest.authenticatorRef:type_name -> octelium.api.main.meta.v1.ObjectReference
-	47, // 9: octelium.api.main.auth.v1.RegisterAuthenticatorBeginRequest.preChallenge:type_name -> octelium.api.main.auth.v1.RegisterAuthenticatorBeginRequest.PreChallenge
-	50, // 10: octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.challengeRequest:type_name -> octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest
-	61, // 11: octelium.api.main.auth.v1.Authenticator.metadata:type_name -> octelium.api.main.meta.v1.Metadata
-	55, // 12: octelium.api.main.auth.v1.Authenticator.spec:type_name -> octelium.api.main.auth.v1.Authenticator.Spec
-	56, // 13: octelium.api.main.auth.v1.Authenticator.status:type_name -> octelium.api.main.auth.v1.Authenticator.Status
-	21, // 14: octelium.api.main.auth.v1.AuthenticatorList.items:type_name -> octelium.api.main.auth.v1.Authenticator
-	62, // 15: octelium.api.main.auth.v1.AuthenticatorList.listResponseMeta:type_name -> octelium.api.main.meta.v1.ListResponseMeta
+	36, // 1: octelium.api.main.auth.v1.RegisterDeviceBeginRequest.info:type_name -> octelium.api.main.auth.v1.RegisterDeviceBeginRequest.Info
+	37, // 2: octelium.api.main.auth.v1.RegisterDeviceBeginResponse.requests:type_name -> octelium.api.main.auth.v1.RegisterDeviceBeginResponse.Request
+	40, // 3: octelium.api.main.auth.v1.RegisterDeviceFinishRequest.responses:type_name -> octelium.api.main.auth.v1.RegisterDeviceFinishRequest.Response
+	62, // 4: octelium.api.main.auth.v1.AuthenticateWithAssertionRequest.identityProviderRef:type_name -> octelium.api.main.meta.v1.ObjectReference
+	43, // 5: octelium.api.main.auth.v1.TokenT0.content:type_name -> octelium.api.main.auth.v1.TokenT0.Content
+	62, // 6: octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginRequest.authenticatorRef:type_name -> octelium.api.main.meta.v1.ObjectReference
+	44, // 7: octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.challengeRequest:type_name -> octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest
+	62, // 8: octelium.api.main.auth.v1.RegisterAuthenticatorBeginRequest.authenticatorRef:type_name -> octelium.api.main.meta.v1.ObjectReference
+	49, // 9: octelium.api.main.auth.v1.RegisterAuthenticatorBeginRequest.preChallenge:type_name -> octelium.api.main.auth.v1.RegisterAuthenticatorBeginRequest.PreChallenge
+	52, // 10: octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.challengeRequest:type_name -> octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest
+	63, // 11: octelium.api.main.auth.v1.Authenticator.metadata:type_name -> octelium.api.main.meta.v1.Metadata
+	57, // 12: octelium.api.main.auth.v1.Authenticator.spec:type_name -> octelium.api.main.auth.v1.Authenticator.Spec
+	58, // 13: octelium.api.main.auth.v1.Authenticator.status:type_name -> octelium.api.main.auth.v1.Authenticator.Status
+	23, // 14: octelium.api.main.auth.v1.AuthenticatorList.items:type_name -> octelium.api.main.auth.v1.Authenticator
+	64, // 15: octelium.api.main.auth.v1.AuthenticatorList.listResponseMeta:type_name -> octelium.api.main.meta.v1.ListResponseMeta
 	3,  // 16: octelium.api.main.auth.v1.CreateAuthenticatorRequest.type:type_name -> octelium.api.main.auth.v1.Authenticator.Status.Type
-	60, // 17: octelium.api.main.auth.v1.RegisterAuthenticatorFinishRequest.authenticatorRef:type_name -> octelium.api.main.meta.v1.ObjectReference
-	27, // 18: octelium.api.main.auth.v1.RegisterAuthenticatorFinishRequest.challengeResponse:type_name -> octelium.api.main.auth.v1.ChallengeResponse
-	57, // 19: octelium.api.main.auth.v1.ChallengeResponse.fido:type_name -> octelium.api.main.auth.v1.ChallengeResponse.FIDO
-	58, // 20: octelium.api.main.auth.v1.ChallengeResponse.totp:type_name -> octelium.api.main.auth.v1.ChallengeResponse.TOTP
-	59, // 21: octelium.api.main.auth.v1.ChallengeResponse.tpm:type_name -> octelium.api.main.auth.v1.ChallengeResponse.TPM
-	60, // 22: octelium.api.main.auth.v1.AuthenticateWithAuthenticatorRequest.authenticatorRef:type_name -> octelium.api.main.meta.v1.ObjectReference
-	27, // 23: octelium.api.main.auth.v1.AuthenticateWithAuthenticatorRequest.challengeResponse:type_name -> octelium.api.main.auth.v1.ChallengeResponse
-	21, // 24: octelium.api.main.auth.v1.GetAvailableAuthenticatorResponse.mainAuthenticator:type_name -> octelium.api.main.auth.v1.Authenticator
-	21, // 25: octelium.api.main.auth.v1.GetAvailableAuthenticatorResponse.availableAuthenticators:type_name -> octelium.api.main.auth.v1.Authenticator
+	62, // 17: octelium.api.main.auth.v1.RegisterAuthenticatorFinishRequest.authenticatorRef:type_name -> octelium.api.main.meta.v1.ObjectReference
+	29, // 18: octelium.api.main.auth.v1.RegisterAuthenticatorFinishRequest.challengeResponse:type_name -> octelium.api.main.auth.v1.ChallengeResponse
+	59, // 19: octelium.api.main.auth.v1.ChallengeResponse.fido:type_name -> octelium.api.main.auth.v1.ChallengeResponse.FIDO
+	60, // 20: octelium.api.main.auth.v1.ChallengeResponse.totp:type_name -> octelium.api.main.auth.v1.ChallengeResponse.TOTP
+	61, // 21: octelium.api.main.auth.v1.ChallengeResponse.tpm:type_name -> octelium.api.main.auth.v1.ChallengeResponse.TPM
+	62, // 22: octelium.api.main.auth.v1.AuthenticateWithAuthenticatorRequest.authenticatorRef:type_name -> octelium.api.main.meta.v1.ObjectReference
+	29, // 23: octelium.api.main.auth.v1.AuthenticateWithAuthenticatorRequest.challengeResponse:type_name -> octelium.api.main.auth.v1.ChallengeResponse
+	23, // 24: octelium.api.main.auth.v1.GetAvailableAuthenticatorResponse.mainAuthenticator:type_name -> octelium.api.main.auth.v1.Authenticator
+	23, // 25: octelium.api.main.auth.v1.GetAvailableAuthenticatorResponse.availableAuthenticators:type_name -> octelium.api.main.auth.v1.Authenticator
 	1,  // 26: octelium.api.main.auth.v1.RegisterDeviceBeginRequest.Info.osType:type_name -> octelium.api.main.auth.v1.RegisterDeviceBeginRequest.Info.OSType
-	36, // 27: octelium.api.main.auth.v1.RegisterDeviceBeginResponse.Request.command:type_name -> octelium.api.main.auth.v1.RegisterDeviceBeginResponse.Request.Command
-	37, // 28: octelium.api.main.auth.v1.RegisterDeviceBeginResponse.Request.file:type_name -> octelium.api.main.auth.v1.RegisterDeviceBeginResponse.Request.File
-	39, // 29: octelium.api.main.auth.v1.RegisterDeviceFinishRequest.Response.command:type_name -> octelium.api.main.auth.v1.RegisterDeviceFinishRequest.Response.Command
-	40, // 30: octelium.api.main.auth.v1.RegisterDeviceFinishRequest.Response.file:type_name -> octelium.api.main.auth.v1.RegisterDeviceFinishRequest.Response.File
+	38, // 27: octelium.api.main.auth.v1.RegisterDeviceBeginResponse.Request.command:type_name -> octelium.api.main.auth.v1.RegisterDeviceBeginResponse.Request.Command
+	39, // 28: octelium.api.main.auth.v1.RegisterDeviceBeginResponse.Request.file:type_name -> octelium.api.main.auth.v1.RegisterDeviceBeginResponse.Request.File
+	41, // 29: octelium.api.main.auth.v1.RegisterDeviceFinishRequest.Response.command:type_name -> octelium.api.main.auth.v1.RegisterDeviceFinishRequest.Response.Command
+	42, // 30: octelium.api.main.auth.v1.RegisterDeviceFinishRequest.Response.file:type_name -> octelium.api.main.auth.v1.RegisterDeviceFinishRequest.Response.File
 	2,  // 31: octelium.api.main.auth.v1.TokenT0.Content.type:type_name -> octelium.api.main.auth.v1.TokenT0.Content.Type
-	63, // 32: octelium.api.main.auth.v1.TokenT0.Content.expiresAt:type_name -> google.protobuf.Timestamp
-	43, // 33: octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest.fido:type_name -> octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest.FIDO
-	44, // 34: octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest.totp:type_name -> octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest.TOTP
-	45, // 35: octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest.tpm:type_name -> octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest.TPM
-	46, // 36: octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest.TPM.encryptedCredential:type_name -> octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest.TPM.EncryptedCredential
-	48, // 37: octelium.api.main.auth.v1.RegisterAuthenticatorBeginRequest.PreChallenge.tpm:type_name -> octelium.api.main.auth.v1.RegisterAuthenticatorBeginRequest.PreChallenge.TPM
-	49, // 38: octelium.api.main.auth.v1.RegisterAuthenticatorBeginRequest.PreChallenge.TPM.attestationParameters:type_name -> octelium.api.main.auth.v1.RegisterAuthenticatorBeginRequest.PreChallenge.TPM.AttestationParameters
-	51, // 39: octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest.fido:type_name -> octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest.FIDO
-	52, // 40: octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest.totp:type_name -> octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest.TOTP
-	53, // 41: octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest.tpm:type_name -> octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest.TPM
-	54, // 42: octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest.TPM.encryptedCredential:type_name -> octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest.TPM.EncryptedCredential
+	65, // 32: octelium.api.main.auth.v1.TokenT0.Content.expiresAt:type_name -> google.protobuf.Timestamp
+	45, // 33: octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest.fido:type_name -> octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest.FIDO
+	46, // 34: octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest.totp:type_name -> octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest.TOTP
+	47, // 35: octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest.tpm:type_name -> octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest.TPM
+	48, // 36: octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest.TPM.encryptedCredential:type_name -> octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse.ChallengeRequest.TPM.EncryptedCredential
+	50, // 37: octelium.api.main.auth.v1.RegisterAuthenticatorBeginRequest.PreChallenge.tpm:type_name -> octelium.api.main.auth.v1.RegisterAuthenticatorBeginRequest.PreChallenge.TPM
+	51, // 38: octelium.api.main.auth.v1.RegisterAuthenticatorBeginRequest.PreChallenge.TPM.attestationParameters:type_name -> octelium.api.main.auth.v1.RegisterAuthenticatorBeginRequest.PreChallenge.TPM.AttestationParameters
+	53, // 39: octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest.fido:type_name -> octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest.FIDO
+	54, // 40: octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest.totp:type_name -> octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest.TOTP
+	55, // 41: octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest.tpm:type_name -> octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest.TPM
+	56, // 42: octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest.TPM.encryptedCredential:type_name -> octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse.ChallengeRequest.TPM.EncryptedCredential
 	3,  // 43: octelium.api.main.auth.v1.Authenticator.Status.type:type_name -> octelium.api.main.auth.v1.Authenticator.Status.Type
-	13, // 44: octelium.api.main.auth.v1.MainService.AuthenticateWithAuthenticationToken:input_type -> octelium.api.main.auth.v1.AuthenticateWithAuthenticationTokenRequest
-	14, // 45: octelium.api.main.auth.v1.MainService.AuthenticateWithAssertion:input_type -> octelium.api.main.auth.v1.AuthenticateWithAssertionRequest
-	15, // 46: octelium.api.main.auth.v1.MainService.AuthenticateWithRefreshToken:input_type -> octelium.api.main.auth.v1.AuthenticateWithRefreshTokenRequest
-	28, // 47: octelium.api.main.auth.v1.MainService.AuthenticateWithAuthenticator:input_type -> octelium.api.main.auth.v1.AuthenticateWithAuthenticatorRequest
+	15, // 44: octelium.api.main.auth.v1.MainService.AuthenticateWithAuthenticationToken:input_type -> octelium.api.main.auth.v1.AuthenticateWithAuthenticationTokenRequest
+	16, // 45: octelium.api.main.auth.v1.MainService.AuthenticateWithAssertion:input_type -> octelium.api.main.auth.v1.AuthenticateWithAssertionRequest
+	17, // 46: octelium.api.main.auth.v1.MainService.AuthenticateWithRefreshToken:input_type -> octelium.api.main.auth.v1.AuthenticateWithRefreshTokenRequest
+	30, // 47: octelium.api.main.auth.v1.MainService.AuthenticateWithAuthenticator:input_type -> octelium.api.main.auth.v1.AuthenticateWithAuthenticatorRequest
 	7,  // 48: octelium.api.main.auth.v1.MainService.Logout:input_type -> octelium.api.main.auth.v1.LogoutRequest
-	9,  // 49: octelium.api.main.auth.v1.MainService.RegisterDeviceBegin:input_type -> octelium.api.main.auth.v1.RegisterDeviceBeginRequest
-	11, // 50: octelium.api.main.auth.v1.MainService.RegisterDeviceFinish:input_type -> octelium.api.main.auth.v1.RegisterDeviceFinishRequest
-	64, // 51: octelium.api.main.auth.v1.MainService.GetAuthenticator:input_type -> octelium.api.main.meta.v1.GetOptions
-	24, // 52: octelium.api.main.auth.v1.MainService.CreateAuthenticator:input_type -> octelium.api.main.auth.v1.CreateAuthenticatorRequest
-	21, // 53: octelium.api.main.auth.v1.MainService.UpdateAuthenticator:input_type -> octelium.api.main.auth.v1.Authenticator
-	23, // 54: octelium.api.main.auth.v1.MainService.ListAuthenticator:input_type -> octelium.api.main.auth.v1.ListAuthenticatorOptions
-	65, // 55: octelium.api.main.auth.v1.MainService.DeleteAuthenticator:input_type -> octelium.api.main.meta.v1.DeleteOptions
-	19, // 56: octelium.api.main.auth.v1.MainService.RegisterAuthenticatorBegin:input_type -> octelium.api.main.auth.v1.RegisterAuthenticatorBeginRequest
-	25, // 57: octelium.api.main.auth.v1.MainService.RegisterAuthenticatorFinish:input_type -> octelium.api.main.auth.v1.RegisterAuthenticatorFinishRequest
-	17, // 58: octelium.api.main.auth.v1.MainService.AuthenticateAuthenticatorBegin:input_type -> octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginRequest
-	29, // 59: octelium.api.main.auth.v1.MainService.GetAvailableAuthenticator:input_type -> octelium.api.main.auth.v1.GetAvailableAuthenticatorRequest
-	31, // 60: octelium.api.main.auth.v1.MainService.AuthenticateWithPasskeyBegin:input_type -> octelium.api.main.auth.v1.AuthenticateWithPasskeyBeginRequest
-	33, // 61: octelium.api.main.auth.v1.MainService.AuthenticateWithPasskey:input_type -> octelium.api.main.auth.v1.AuthenticateWithPasskeyRequest
-	4,  // 62: octelium.api.main.auth.v1.MainService.AuthenticateWithAuthenticationToken:output_type -> octelium.api.main.auth.v1.SessionToken
-	4,  // 63: octelium.api.main.auth.v1.MainService.AuthenticateWithAssertion:output_type -> octelium.api.main.auth.v1.SessionToken
-	4,  // 64: octelium.api.main.auth.v1.MainService.AuthenticateWithRefreshToken:output_type -> octelium.api.main.auth.v1.SessionToken
-	4,  // 65: octelium.api.main.auth.v1.MainService.AuthenticateWithAuthenticator:output_type -> octelium.api.main.auth.v1.SessionToken
-	8,  // 66: octelium.api.main.auth.v1.MainService.Logout:output_type -> octelium.api.main.auth.v1.LogoutResponse
-	10, // 67: octelium.api.main.auth.v1.MainService.RegisterDeviceBegin:output_type -> octelium.api.main.auth.v1.RegisterDeviceBeginResponse
-	12, // 68: octelium.api.main.auth.v1.MainService.RegisterDeviceFinish:output_type -> octelium.api.main.auth.v1.RegisterDeviceFinishResponse
-	21, // 69: octelium.api.main.auth.v1.MainService.GetAuthenticator:output_type -> octelium.api.main.auth.v1.Authenticator
-	21, // 70: octelium.api.main.auth.v1.MainService.CreateAuthenticator:output_type -> octelium.api.main.auth.v1.Authenticator
-	21, // 71: octelium.api.main.auth.v1.MainService.UpdateAuthenticator:output_type -> octelium.api.main.auth.v1.Authenticator
-	22, // 72: octelium.api.main.auth.v1.MainService.ListAuthenticator:output_type -> octelium.api.main.auth.v1.AuthenticatorList
-	66, // 73: octelium.api.main.auth.v1.MainService.DeleteAuthenticator:output_type -> octelium.api.main.meta.v1.OperationResult
-	20, // 74: octelium.api.main.auth.v1.MainService.RegisterAuthenticatorBegin:output_type -> octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse
-	26, // 75: octelium.api.main.auth.v1.MainService.RegisterAuthenticatorFinish:output_type -> octelium.api.main.auth.v1.RegisterAuthenticatorFinishResponse
-	18, // 76: octelium.api.main.auth.v1.MainService.AuthenticateAuthenticatorBegin:output_type -> octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse
-	30, // 77: octelium.api.main.auth.v1.MainService.GetAvailableAuthenticator:output_type -> octelium.api.main.auth.v1.GetAvailableAuthenticatorResponse
-	32, // 78: octelium.api.main.auth.v1.MainService.AuthenticateWithPasskeyBegin:output_type -> octelium.api.main.auth.v1.AuthenticateWithPasskeyBeginResponse
-	4,  // 79: octelium.api.main.auth.v1.MainService.AuthenticateWithPasskey:output_type -> octelium.api.main.auth.v1.SessionToken
-	62, // [62:80] is the sub-list for method output_type
-	44, // [44:62] is the sub-list for method input_type
+	11, // 49: octelium.api.main.auth.v1.MainService.RegisterDeviceBegin:input_type -> octelium.api.main.auth.v1.RegisterDeviceBeginRequest
+	13, // 50: octelium.api.main.auth.v1.MainService.RegisterDeviceFinish:input_type -> octelium.api.main.auth.v1.RegisterDeviceFinishRequest
+	66, // 51: octelium.api.main.auth.v1.MainService.GetAuthenticator:input_type -> octelium.api.main.meta.v1.GetOptions
+	26, // 52: octelium.api.main.auth.v1.MainService.CreateAuthenticator:input_type -> octelium.api.main.auth.v1.CreateAuthenticatorRequest
+	23, // 53: octelium.api.main.auth.v1.MainService.UpdateAuthenticator:input_type -> octelium.api.main.auth.v1.Authenticator
+	25, // 54: octelium.api.main.auth.v1.MainService.ListAuthenticator:input_type -> octelium.api.main.auth.v1.ListAuthenticatorOptions
+	67, // 55: octelium.api.main.auth.v1.MainService.DeleteAuthenticator:input_type -> octelium.api.main.meta.v1.DeleteOptions
+	21, // 56: octelium.api.main.auth.v1.MainService.RegisterAuthenticatorBegin:input_type -> octelium.api.main.auth.v1.RegisterAuthenticatorBeginRequest
+	27, // 57: octelium.api.main.auth.v1.MainService.RegisterAuthenticatorFinish:input_type -> octelium.api.main.auth.v1.RegisterAuthenticatorFinishRequest
+	19, // 58: octelium.api.main.auth.v1.MainService.AuthenticateAuthenticatorBegin:input_type -> octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginRequest
+	31, // 59: octelium.api.main.auth.v1.MainService.GetAvailableAuthenticator:input_type -> octelium.api.main.auth.v1.GetAvailableAuthenticatorRequest
+	33, // 60: octelium.api.main.auth.v1.MainService.AuthenticateWithPasskeyBegin:input_type -> octelium.api.main.auth.v1.AuthenticateWithPasskeyBeginRequest
+	35, // 61: octelium.api.main.auth.v1.MainService.AuthenticateWithPasskey:input_type -> octelium.api.main.auth.v1.AuthenticateWithPasskeyRequest
+	9,  // 62: octelium.api.main.auth.v1.MainService.IssueClientCertificate:input_type -> octelium.api.main.auth.v1.IssueClientCertificateRequest
+	4,  // 63: octelium.api.main.auth.v1.MainService.AuthenticateWithAuthenticationToken:output_type -> octelium.api.main.auth.v1.SessionToken
+	4,  // 64: octelium.api.main.auth.v1.MainService.AuthenticateWithAssertion:output_type -> octelium.api.main.auth.v1.SessionToken
+	4,  // 65: octelium.api.main.auth.v1.MainService.AuthenticateWithRefreshToken:output_type -> octelium.api.main.auth.v1.SessionToken
+	4,  // 66: octelium.api.main.auth.v1.MainService.AuthenticateWithAuthenticator:output_type -> octelium.api.main.auth.v1.SessionToken
+	8,  // 67: octelium.api.main.auth.v1.MainService.Logout:output_type -> octelium.api.main.auth.v1.LogoutResponse
+	12, // 68: octelium.api.main.auth.v1.MainService.RegisterDeviceBegin:output_type -> octelium.api.main.auth.v1.RegisterDeviceBeginResponse
+	14, // 69: octelium.api.main.auth.v1.MainService.RegisterDeviceFinish:output_type -> octelium.api.main.auth.v1.RegisterDeviceFinishResponse
+	23, // 70: octelium.api.main.auth.v1.MainService.GetAuthenticator:output_type -> octelium.api.main.auth.v1.Authenticator
+	23, // 71: octelium.api.main.auth.v1.MainService.CreateAuthenticator:output_type -> octelium.api.main.auth.v1.Authenticator
+	23, // 72: octelium.api.main.auth.v1.MainService.UpdateAuthenticator:output_type -> octelium.api.main.auth.v1.Authenticator
+	24, // 73: octelium.api.main.auth.v1.MainService.ListAuthenticator:output_type -> octelium.api.main.auth.v1.AuthenticatorList
+	68, // 74: octelium.api.main.auth.v1.MainService.DeleteAuthenticator:output_type -> octelium.api.main.meta.v1.OperationResult
+	22, // 75: octelium.api.main.auth.v1.MainService.RegisterAuthenticatorBegin:output_type -> octelium.api.main.auth.v1.RegisterAuthenticatorBeginResponse
+	28, // 76: octelium.api.main.auth.v1.MainService.RegisterAuthenticatorFinish:output_type -> octelium.api.main.auth.v1.RegisterAuthenticatorFinishResponse
+	20, // 77: octelium.api.main.auth.v1.MainService.AuthenticateAuthenticatorBegin:output_type -> octelium.api.main.auth.v1.AuthenticateAuthenticatorBeginResponse
+	32, // 78: octelium.api.main.auth.v1.MainService.GetAvailableAuthenticator:output_type -> octelium.api.main.auth.v1.GetAvailableAuthenticatorResponse
+	34, // 79: octelium.api.main.auth.v1.MainService.AuthenticateWithPasskeyBegin:output_type -> octelium.api.main.auth.v1.AuthenticateWithPasskeyBeginResponse
+	4,  // 80: octelium.api.main.auth.v1.MainService.AuthenticateWithPasskey:output_type -> octelium.api.main.auth.v1.SessionToken
+	10, // 81: octelium.api.main.auth.v1.MainService.IssueClientCertificate:output_type -> octelium.api.main.auth.v1.IssueClientCertificateResponse
+	63, // [63:82] is the sub-list for method output_type
+	44, // [44:63] is the sub-list for method input_type
 	44, // [44:44] is the sub-list for extension type_name
 	44, // [44:44] is the sub-list for extension extendee
 	0,  // [0:44] is the sub-list for field type_name
@@ -4153,32 +4265,32 @@ func file_authv1_proto_init() {
 	if File_authv1_proto != nil {
 		return
 	}
-	file_authv1_proto_msgTypes[23].OneofWrappers = []any{
+	file_authv1_proto_msgTypes[25].OneofWrappers = []any{
 		(*ChallengeResponse_Fido)(nil),
 		(*ChallengeResponse_Totp)(nil),
 		(*ChallengeResponse_Tpm)(nil),
 	}
-	file_authv1_proto_msgTypes[31].OneofWrappers = []any{
+	file_authv1_proto_msgTypes[33].OneofWrappers = []any{
 		(*RegisterDeviceBeginResponse_Request_Command_)(nil),
 		(*RegisterDeviceBeginResponse_Request_File_)(nil),
 	}
-	file_authv1_proto_msgTypes[34].OneofWrappers = []any{
+	file_authv1_proto_msgTypes[36].OneofWrappers = []any{
 		(*RegisterDeviceFinishRequest_Response_Command_)(nil),
 		(*RegisterDeviceFinishRequest_Response_File_)(nil),
 	}
-	file_authv1_proto_msgTypes[38].OneofWrappers = []any{
+	file_authv1_proto_msgTypes[40].OneofWrappers = []any{
 		(*AuthenticateAuthenticatorBeginResponse_ChallengeRequest_Fido)(nil),
 		(*AuthenticateAuthenticatorBeginResponse_ChallengeRequest_Totp)(nil),
 		(*AuthenticateAuthenticatorBeginResponse_ChallengeRequest_Tpm)(nil),
 	}
-	file_authv1_proto_msgTypes[43].OneofWrappers = []any{
+	file_authv1_proto_msgTypes[45].OneofWrappers = []any{
 		(*RegisterAuthenticatorBeginRequest_PreChallenge_Tpm)(nil),
 	}
-	file_authv1_proto_msgTypes[44].OneofWrappers = []any{
+	file_authv1_proto_msgTypes[46].OneofWrappers = []any{
 		(*RegisterAuthenticatorBeginRequest_PreChallenge_TPM_EkCertificateDER)(nil),
 		(*RegisterAuthenticatorBeginRequest_PreChallenge_TPM_EkPublicKey)(nil),
 	}
-	file_authv1_proto_msgTypes[46].OneofWrappers = []any{
+	file_authv1_proto_msgTypes[48].OneofWrappers = []any{
 		(*RegisterAuthenticatorBeginResponse_ChallengeRequest_Fido)(nil),
 		(*RegisterAuthenticatorBeginResponse_ChallengeRequest_Totp)(nil),
 		(*RegisterAuthenticatorBeginResponse_ChallengeRequest_Tpm)(nil),
@@ -4189,7 +4301,7 @@ func file_authv1_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_authv1_proto_rawDesc,
 			NumEnums:      4,
-			NumMessages:   56,
+			NumMessages:   58,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -52,6 +52,7 @@ const (
 	MainService_GetAvailableAuthenticator_FullMethodName           = "/octelium.api.main.auth.v1.MainService/GetAvailableAuthenticator"
 	MainService_AuthenticateWithPasskeyBegin_FullMethodName        = "/octelium.api.main.auth.v1.MainService/AuthenticateWithPasskeyBegin"
 	MainService_AuthenticateWithPasskey_FullMethodName             = "/octelium.api.main.auth.v1.MainService/AuthenticateWithPasskey"
+	MainService_IssueClientCertificate_FullMethodName              = "/octelium.api.main.auth.v1.MainService/IssueClientCertificate"
 )
 
 // MainServiceClient is the client API for MainService service.
@@ -76,6 +77,7 @@ type MainServiceClient interface {
 	GetAvailableAuthenticator(ctx context.Context, in *GetAvailableAuthenticatorRequest, opts ...grpc.CallOption) (*GetAvailableAuthenticatorResponse, error)
 	AuthenticateWithPasskeyBegin(ctx context.Context, in *AuthenticateWithPasskeyBeginRequest, opts ...grpc.CallOption) (*AuthenticateWithPasskeyBeginResponse, error)
 	AuthenticateWithPasskey(ctx context.Context, in *AuthenticateWithPasskeyRequest, opts ...grpc.CallOption) (*SessionToken, error)
+	IssueClientCertificate(ctx context.Context, in *IssueClientCertificateRequest, opts ...grpc.CallOption) (*IssueClientCertificateResponse, error)
 }
 
 type mainServiceClient struct {
@@ -266,6 +268,16 @@ func (c *mainServiceClient) AuthenticateWithPasskey(ctx context.Context, in *Aut
 	return out, nil
 }
 
+func (c *mainServiceClient) IssueClientCertificate(ctx context.Context, in *IssueClientCertificateRequest, opts ...grpc.CallOption) (*IssueClientCertificateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueClientCertificateResponse)
+	err := c.cc.Invoke(ctx, MainService_IssueClientCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MainServiceServer is the server API for MainService service.
 // All implementations must embed UnimplementedMainServiceServer
 // for forward compatibility.
@@ -288,6 +300,7 @@ type MainServiceServer interface {
 	GetAvailableAuthenticator(context.Context, *GetAvailableAuthenticatorRequest) (*GetAvailableAuthenticatorResponse, error)
 	AuthenticateWithPasskeyBegin(context.Context, *AuthenticateWithPasskeyBeginRequest) (*AuthenticateWithPasskeyBeginResponse, error)
 	AuthenticateWithPasskey(context.Context, *AuthenticateWithPasskeyRequest) (*SessionToken, error)
+	IssueClientCertificate(context.Context, *IssueClientCertificateRequest) (*IssueClientCertificateResponse, error)
 	mustEmbedUnimplementedMainServiceServer()
 }
 
@@ -352,6 +365,9 @@ func (UnimplementedMainServiceServer) AuthenticateWithPasskeyBegin(context.Conte
 func (UnimplementedMainServiceServer) AuthenticateWithPasskey(context.Context, *AuthenticateWithPasskeyRequest) (*SessionToken, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateWithPasskey not implemented")
 }
+func (UnimplementedMainServiceServer) IssueClientCertificate(context.Context, *IssueClientCertificateRequest) (*IssueClientCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueClientCertificate not implemented")
+}
 func (UnimplementedMainServiceServer) mustEmbedUnimplementedMainServiceServer() {}
 func (UnimplementedMainServiceServer) testEmbeddedByValue()                     {}
 
@@ -697,6 +713,24 @@ func _MainService_AuthenticateWithPasskey_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MainService_IssueClientCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueClientCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MainServiceServer).IssueClientCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MainService_IssueClientCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MainServiceServer).IssueClientCertificate(ctx, req.(*IssueClientCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MainService_ServiceDesc is the grpc.ServiceDesc for MainService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -776,6 +810,10 @@ var MainService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AuthenticateWithPasskey",
 			Handler:    _MainService_AuthenticateWithPasskey_Handler,
 		},
+		{
+			MethodName: "IssueClientCertificate",
+			Handler:    _MainService_IssueClientCertificate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "authv1.proto",
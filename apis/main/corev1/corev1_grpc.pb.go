@@ -92,6 +92,9 @@ const (
 	MainService_ListAuthenticator_FullMethodName       = "/octelium.api.main.core.v1.MainService/ListAuthenticator"
 	MainService_DeleteAuthenticator_FullMethodName     = "/octelium.api.main.core.v1.MainService/DeleteAuthenticator"
 	MainService_GetAuthenticator_FullMethodName        = "/octelium.api.main.core.v1.MainService/GetAuthenticator"
+	MainService_ListAuditLog_FullMethodName            = "/octelium.api.main.core.v1.MainService/ListAuditLog"
+	MainService_GetAuditLog_FullMethodName             = "/octelium.api.main.core.v1.MainService/GetAuditLog"
+	MainService_RestoreResourceRevision_FullMethodName = "/octelium.api.main.core.v1.MainService/RestoreResourceRevision"
 )
 
 // MainServiceClient is the client API for MainService service.
@@ -214,6 +217,13 @@ type MainServiceClient interface {
 	DeleteAuthenticator(ctx context.Context, in *metav1.DeleteOptions, opts ...grpc.CallOption) (*metav1.OperationResult, error)
 	// GetAuthenticator retrieves a specific Authenticator
 	GetAuthenticator(ctx context.Context, in *metav1.GetOptions, opts ...grpc.CallOption) (*Authenticator, error)
+	// ListAuditLog lists the AuditLogs of the resource write operations
+	ListAuditLog(ctx context.Context, in *ListAuditLogOptions, opts ...grpc.CallOption) (*AuditLogList, error)
+	// GetAuditLog retrieves a specific AuditLog by its UID
+	GetAuditLog(ctx context.Context, in *metav1.GetOptions, opts ...grpc.CallOption) (*AuditLog, error)
+	// RestoreResourceRevision restores the revision of a resource recorded by
+	// an AuditLog
+	RestoreResourceRevision(ctx context.Context, in *RestoreResourceRevisionRequest, opts ...grpc.CallOption) (*RestoreResourceRevisionResponse, error)
 }
 
 type mainServiceClient struct {
@@ -804,6 +814,36 @@ func (c *mainServiceClient) GetAuthenticator(ctx context.Context, in *metav1.Get
 	return out, nil
 }
 
+func (c *mainServiceClient) ListAuditLog(ctx context.Context, in *ListAuditLogOptions, opts ...grpc.CallOption) (*AuditLogList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditLogList)
+	err := c.cc.Invoke(ctx, MainService_ListAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mainServiceClient) GetAuditLog(ctx context.Context, in *metav1.GetOptions, opts ...grpc.CallOption) (*AuditLog, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditLog)
+	err := c.cc.Invoke(ctx, MainService_GetAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mainServiceClient) RestoreResourceRevision(ctx context.Context, in *RestoreResourceRevisionRequest, opts ...grpc.CallOption) (*RestoreResourceRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreResourceRevisionResponse)
+	err := c.cc.Invoke(ctx, MainService_RestoreResourceRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MainServiceServer is the server API for MainService service.
 // All implementations must embed UnimplementedMainServiceServer
 // for forward compatibility.
@@ -924,6 +964,13 @@ type MainServiceServer interface {
 	DeleteAuthenticator(context.Context, *metav1.DeleteOptions) (*metav1.OperationResult, error)
 	// GetAuthenticator retrieves a specific Authenticator
 	GetAuthenticator(context.Context, *metav1.GetOptions) (*Authenticator, error)
+	// ListAuditLog lists the AuditLogs of the resource write operations
+	ListAuditLog(context.Context, *ListAuditLogOptions) (*AuditLogList, error)
+	// GetAuditLog retrieves a specific AuditLog by its UID
+	GetAuditLog(context.Context, *metav1.GetOptions) (*AuditLog, error)
+	// RestoreResourceRevision restores the revision of a resource recorded by
+	// an AuditLog
+	RestoreResourceRevision(context.Context, *RestoreResourceRevisionRequest) (*RestoreResourceRevisionResponse, error)
 	mustEmbedUnimplementedMainServiceServer()
 }
 
@@ -1108,6 +1155,15 @@ func (UnimplementedMainServiceServer) DeleteAuthenticator(context.Context, *meta
 func (UnimplementedMainServiceServer) GetAuthenticator(context.Context, *metav1.GetOptions) (*Authenticator, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAuthenticator not implemented")
 }
+func (UnimplementedMainServiceServer) ListAuditLog(context.Context, *ListAuditLogOptions) (*AuditLogList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditLog not implemented")
+}
+func (UnimplementedMainServiceServer) GetAuditLog(context.Context, *metav1.GetOptions) (*AuditLog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLog not implemented")
+}
+func (UnimplementedMainServiceServer) RestoreResourceRevision(context.Context, *RestoreResourceRevisionRequest) (*RestoreResourceRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreResourceRevision not implemented")
+}
 func (UnimplementedMainServiceServer) mustEmbedUnimplementedMainServiceServer() {}
 func (UnimplementedMainServiceServer) testEmbeddedByValue()                     {}
 
@@ -2173,6 +2229,60 @@ func _MainService_GetAuthenticator_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MainService_ListAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MainServiceServer).ListAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MainService_ListAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MainServiceServer).ListAuditLog(ctx, req.(*ListAuditLogOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MainService_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(metav1.GetOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MainServiceServer).GetAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MainService_GetAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MainServiceServer).GetAuditLog(ctx, req.(*metav1.GetOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MainService_RestoreResourceRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreResourceRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MainServiceServer).RestoreResourceRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MainService_RestoreResourceRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MainServiceServer).RestoreResourceRevision(ctx, req.(*RestoreResourceRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MainService_ServiceDesc is the grpc.ServiceDesc for MainService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2412,6 +2522,18 @@ var MainService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAuthenticator",
 			Handler:    _MainService_GetAuthenticator_Handler,
 		},
+		{
+			MethodName: "ListAuditLog",
+			Handler:    _MainService_ListAuditLog_Handler,
+		},
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _MainService_GetAuditLog_Handler,
+		},
+		{
+			MethodName: "RestoreResourceRevision",
+			Handler:    _MainService_RestoreResourceRevision_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "corev1.proto",
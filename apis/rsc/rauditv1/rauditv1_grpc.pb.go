@@ -0,0 +1,174 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: rauditv1.proto
+
+package rauditv1
+
+import (
+	context "context"
+	corev1 "github.com/octelium/octelium/apis/main/corev1"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MainService_ListAuditLog_FullMethodName = "/octelium.api.rsc.audit.v1.MainService/ListAuditLog"
+	MainService_GetAuditLog_FullMethodName  = "/octelium.api.rsc.audit.v1.MainService/GetAuditLog"
+)
+
+// MainServiceClient is the client API for MainService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MainServiceClient interface {
+	ListAuditLog(ctx context.Context, in *corev1.ListAuditLogOptions, opts ...grpc.CallOption) (*corev1.AuditLogList, error)
+	GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*corev1.AuditLog, error)
+}
+
+type mainServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMainServiceClient(cc grpc.ClientConnInterface) MainServiceClient {
+	return &mainServiceClient{cc}
+}
+
+func (c *mainServiceClient) ListAuditLog(ctx context.Context, in *corev1.ListAuditLogOptions, opts ...grpc.CallOption) (*corev1.AuditLogList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(corev1.AuditLogList)
+	err := c.cc.Invoke(ctx, MainService_ListAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mainServiceClient) GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*corev1.AuditLog, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(corev1.AuditLog)
+	err := c.cc.Invoke(ctx, MainService_GetAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MainServiceServer is the server API for MainService service.
+// All implementations must embed UnimplementedMainServiceServer
+// for forward compatibility.
+type MainServiceServer interface {
+	ListAuditLog(context.Context, *corev1.ListAuditLogOptions) (*corev1.AuditLogList, error)
+	GetAuditLog(context.Context, *GetAuditLogRequest) (*corev1.AuditLog, error)
+	mustEmbedUnimplementedMainServiceServer()
+}
+
+// UnimplementedMainServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMainServiceServer struct{}
+
+func (UnimplementedMainServiceServer) ListAuditLog(context.Context, *corev1.ListAuditLogOptions) (*corev1.AuditLogList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditLog not implemented")
+}
+func (UnimplementedMainServiceServer) GetAuditLog(context.Context, *GetAuditLogRequest) (*corev1.AuditLog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLog not implemented")
+}
+func (UnimplementedMainServiceServer) mustEmbedUnimplementedMainServiceServer() {}
+func (UnimplementedMainServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeMainServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MainServiceServer will
+// result in compilation errors.
+type UnsafeMainServiceServer interface {
+	mustEmbedUnimplementedMainServiceServer()
+}
+
+func RegisterMainServiceServer(s grpc.ServiceRegistrar, srv MainServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMainServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MainService_ServiceDesc, srv)
+}
+
+func _MainService_ListAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(corev1.ListAuditLogOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MainServiceServer).ListAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MainService_ListAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MainServiceServer).ListAuditLog(ctx, req.(*corev1.ListAuditLogOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MainService_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MainServiceServer).GetAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MainService_GetAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MainServiceServer).GetAuditLog(ctx, req.(*GetAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MainService_ServiceDesc is the grpc.ServiceDesc for MainService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MainService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "octelium.api.rsc.audit.v1.MainService",
+	HandlerType: (*MainServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAuditLog",
+			Handler:    _MainService_ListAuditLog_Handler,
+		},
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _MainService_GetAuditLog_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rauditv1.proto",
+}
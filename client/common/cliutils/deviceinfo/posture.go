@@ -0,0 +1,51 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceinfo
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+)
+
+type Posture struct {
+	OSVersion           string
+	IsDiskEncrypted     bool
+	IsScreenLockEnabled bool
+	IsFirewallEnabled   bool
+}
+
+// GetPosture collects the security posture of the host. Checks that cannot be
+// performed, e.g. due to missing tools or insufficient permissions, are
+// reported as not satisfied rather than failing the whole collection.
+func GetPosture(ctx context.Context) (*Posture, error) {
+	ret := &Posture{
+		OSVersion:           getOSVersion(ctx),
+		IsDiskEncrypted:     isDiskEncrypted(ctx),
+		IsScreenLockEnabled: isScreenLockEnabled(ctx),
+		IsFirewallEnabled:   isFirewallEnabled(ctx),
+	}
+
+	return ret, nil
+}
+
+func runCmd(ctx context.Context, name string, args ...string) string {
+	out, err := exec.CommandContext(ctx, name, args...).Output()
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(out))
+}
@@ -0,0 +1,41 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceinfo
+
+import (
+	"context"
+	"strings"
+)
+
+func getOSVersion(ctx context.Context) string {
+	name := runCmd(ctx, "/usr/bin/sw_vers", "-productName")
+	version := runCmd(ctx, "/usr/bin/sw_vers", "-productVersion")
+
+	return strings.TrimSpace(name + " " + version)
+}
+
+func isDiskEncrypted(ctx context.Context) bool {
+	return strings.Contains(runCmd(ctx, "/usr/bin/fdesetup", "status"), "FileVault is On")
+}
+
+func isScreenLockEnabled(ctx context.Context) bool {
+	out := runCmd(ctx, "/usr/sbin/sysadminctl", "-screenLock", "status")
+	return strings.Contains(out, "immediate") || strings.Contains(out, "seconds")
+}
+
+func isFirewallEnabled(ctx context.Context) bool {
+	return strings.Contains(
+		runCmd(ctx, "/usr/libexec/ApplicationFirewall/socketfilterfw", "--getglobalstate"), "enabled")
+}
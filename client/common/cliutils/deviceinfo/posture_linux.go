@@ -0,0 +1,79 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceinfo
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func getOSVersion(ctx context.Context) string {
+	f, err := os.Open("/etc/os-release")
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if val, ok := strings.CutPrefix(scanner.Text(), "PRETTY_NAME="); ok {
+			return strings.Trim(val, `"`)
+		}
+	}
+
+	return ""
+}
+
+func isDiskEncrypted(ctx context.Context) bool {
+	if src := runCmd(ctx, "findmnt", "-n", "-o", "SOURCE", "/"); src != "" {
+		for _, typ := range strings.Fields(runCmd(ctx, "lsblk", "-s", "-n", "-o", "TYPE", src)) {
+			if typ == "crypt" {
+				return true
+			}
+		}
+	}
+
+	matches, _ := filepath.Glob("/sys/block/dm-*/dm/uuid")
+	for _, m := range matches {
+		uuid, err := os.ReadFile(m)
+		if err != nil {
+			continue
+		}
+		if strings.HasPrefix(string(uuid), "CRYPT-") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isScreenLockEnabled(ctx context.Context) bool {
+	return runCmd(ctx, "gsettings", "get", "org.gnome.desktop.screensaver", "lock-enabled") == "true"
+}
+
+func isFirewallEnabled(ctx context.Context) bool {
+	if strings.Contains(runCmd(ctx, "ufw", "status"), "Status: active") {
+		return true
+	}
+
+	if runCmd(ctx, "firewall-cmd", "--state") == "running" {
+		return true
+	}
+
+	return strings.Contains(runCmd(ctx, "nft", "list", "ruleset"), "chain ")
+}
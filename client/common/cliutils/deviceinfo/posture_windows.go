@@ -0,0 +1,52 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceinfo
+
+import (
+	"context"
+	"strings"
+
+	"github.com/yusufpapurcu/wmi"
+)
+
+type Win32_OperatingSystem struct {
+	Caption string
+	Version string
+}
+
+func getOSVersion(ctx context.Context) string {
+	var dst []Win32_OperatingSystem
+	query := wmi.CreateQuery(&dst, "")
+	if err := wmi.Query(query, &dst); err != nil || len(dst) == 0 {
+		return ""
+	}
+
+	return strings.TrimSpace(dst[0].Caption + " " + dst[0].Version)
+}
+
+func isDiskEncrypted(ctx context.Context) bool {
+	out := runCmd(ctx, "manage-bde", "-status", "C:")
+	return strings.Contains(out, "Protection On")
+}
+
+func isScreenLockEnabled(ctx context.Context) bool {
+	out := runCmd(ctx, "reg", "query", `HKCU\Control Panel\Desktop`, "/v", "ScreenSaverIsSecure")
+	return strings.Contains(out, "0x1") || strings.HasSuffix(out, " 1")
+}
+
+func isFirewallEnabled(ctx context.Context) bool {
+	out := runCmd(ctx, "netsh", "advfirewall", "show", "allprofiles", "state")
+	return strings.Contains(out, "ON")
+}
@@ -0,0 +1,114 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certificate
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"os"
+
+	"github.com/octelium/octelium/apis/main/authv1"
+	"github.com/octelium/octelium/client/common/cliutils"
+	utils_cert "github.com/octelium/octelium/pkg/utils/cert"
+	"github.com/spf13/cobra"
+)
+
+var cmdArgs args
+
+type args struct {
+	CertOut string
+	KeyOut  string
+}
+
+func init() {
+	Cmd.PersistentFlags().StringVar(&cmdArgs.CertOut, "cert-out", "octelium-client.crt", "The output path of the PEM-encoded client certificate")
+	Cmd.PersistentFlags().StringVar(&cmdArgs.KeyOut, "key-out", "octelium-client.key", "The output path of the PEM-encoded private key")
+}
+
+var Cmd = &cobra.Command{
+	Use:     "certificate",
+	Aliases: []string{"cert"},
+	Short:   "Issue a client certificate to access public TLS Services",
+	Long: `Issue a client certificate bound to your current Session in order to access public non-HTTP Services over TLS without a client.
+The certificate expires along with your Session's current access token.`,
+	Example: `
+octelium auth certificate
+octelium auth cert --cert-out /tmp/client.crt --key-out /tmp/client.key
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return doCmd(cmd, args)
+	},
+}
+
+func doCmd(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	i, err := cliutils.GetCLIInfo(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	c, err := cliutils.NewAuthClient(ctx, i.Domain, nil)
+	if err != nil {
+		return err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	pubDER, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.C().IssueClientCertificate(ctx, &authv1.IssueClientCertificateRequest{
+		PublicKey: pubDER,
+	})
+	if err != nil {
+		return err
+	}
+
+	crt, err := x509.ParseCertificate(resp.Certificate)
+	if err != nil {
+		return err
+	}
+
+	crtPEM, err := utils_cert.GetCertificatePEM(crt)
+	if err != nil {
+		return err
+	}
+
+	keyPEM, err := utils_cert.GetPrivateKeyPEM(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(cmdArgs.KeyOut, keyPEM, 0600); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(cmdArgs.CertOut, crtPEM, 0644); err != nil {
+		return err
+	}
+
+	cliutils.LineInfo("The client certificate is written to %s and expires at %s\n",
+		cmdArgs.CertOut, crt.NotAfter.Local().Format("2006-01-02 15:04:05"))
+
+	return nil
+}
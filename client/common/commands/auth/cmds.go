@@ -18,6 +18,7 @@ import (
 	"os"
 
 	"github.com/octelium/octelium/client/common/commands/auth/authenticator"
+	"github.com/octelium/octelium/client/common/commands/auth/certificate"
 	"github.com/octelium/octelium/client/common/commands/auth/create"
 	"github.com/octelium/octelium/client/common/commands/auth/delete"
 	"github.com/octelium/octelium/client/common/commands/auth/device"
@@ -46,6 +47,7 @@ var Cmd = &cobra.Command{
 
 func AddSubcommands() {
 	Cmd.AddCommand(device.Cmd)
+	Cmd.AddCommand(certificate.Cmd)
 	if ldflags.IsDev() {
 		Cmd.AddCommand(create.Cmd)
 		Cmd.AddCommand(delete.Cmd)
@@ -36,6 +36,8 @@ type db interface {
 	get(ctx context.Context, domain string) (*cliconfigv1.State_Domain, error)
 	set(ctx context.Context, domain string, sessToken *authv1.SessionToken) error
 	delete(ctx context.Context, domain string) error
+	setConnection(ctx context.Context, domain string, conn *cliconfigv1.State_Domain_Connection) error
+	list(ctx context.Context) (map[string]*cliconfigv1.State_Domain, error)
 	close(ctx context.Context) error
 	migrate(ctx context.Context) error
 }
@@ -97,6 +99,17 @@ func (d *DB) Delete(clusterDomain string) error {
 	return d.db.delete(context.Background(), clusterDomain)
 }
 
+// SetConnection sets the active Connection of the Cluster domain.
+// A nil conn removes the Connection.
+func (d *DB) SetConnection(clusterDomain string, conn *cliconfigv1.State_Domain_Connection) error {
+	return d.db.setConnection(context.Background(), clusterDomain, conn)
+}
+
+// List returns the state of all the Cluster domains stored in the DB.
+func (d *DB) List() (map[string]*cliconfigv1.State_Domain, error) {
+	return d.db.list(context.Background())
+}
+
 func (d *DB) ErrorIsNotFound(err error) bool {
 
 	return errors.Is(err, ErrNotFound)
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"sync"
 
 	"github.com/gofrs/flock"
 	"github.com/octelium/octelium/apis/client/cliconfigv1"
@@ -33,6 +34,7 @@ var ErrNotFound = errors.New("OcteliumDB: Not Found")
 type fsDB struct {
 	flock  *flock.Flock
 	dbPath string
+	mu     sync.Mutex
 }
 
 func newFSDB(dbPath string) (*fsDB, error) {
@@ -151,6 +153,8 @@ func (d *fsDB) get(_ context.Context, clusterDomain string) (*cliconfigv1.State_
 }
 
 func (d *fsDB) set(_ context.Context, clusterDomain string, resp *authv1.SessionToken) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
 
 	state, err := d.readState()
 	if err != nil {
@@ -160,12 +164,43 @@ func (d *fsDB) set(_ context.Context, clusterDomain string, resp *authv1.Session
 	state.DomainMap[clusterDomain] = &cliconfigv1.State_Domain{
 		SessionToken:      resp,
 		SessionTokenSetAt: pbutils.Now(),
+		Connection:        state.DomainMap[clusterDomain].GetConnection(),
 	}
 
 	return d.writeState(state)
 }
 
+func (d *fsDB) setConnection(_ context.Context, clusterDomain string, conn *cliconfigv1.State_Domain_Connection) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	state, err := d.readState()
+	if err != nil {
+		return err
+	}
+
+	domainState, ok := state.DomainMap[clusterDomain]
+	if !ok {
+		return ErrNotFound
+	}
+	domainState.Connection = conn
+
+	return d.writeState(state)
+}
+
+func (d *fsDB) list(_ context.Context) (map[string]*cliconfigv1.State_Domain, error) {
+	state, err := d.readState()
+	if err != nil {
+		return nil, err
+	}
+
+	return state.DomainMap, nil
+}
+
 func (d *fsDB) delete(_ context.Context, clusterDomain string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
 	state, err := d.readState()
 	if err != nil {
 		return err
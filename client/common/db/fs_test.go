@@ -21,6 +21,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/octelium/octelium/apis/client/cliconfigv1"
 	"github.com/octelium/octelium/apis/main/authv1"
 	"github.com/octelium/octelium/pkg/common/pbutils"
 	"github.com/octelium/octelium/pkg/utils/utilrand"
@@ -98,3 +99,58 @@ func TestFSDB(t *testing.T) {
 		os.RemoveAll(tmpDir)
 	}
 }
+
+func TestFSDBConnection(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "octeliumdb-*")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	db, err := newFSDB(tmpDir)
+	assert.Nil(t, err)
+
+	err = db.migrate(context.Background())
+	assert.Nil(t, err)
+
+	domain := "example.com"
+	conn := &cliconfigv1.State_Domain_Connection{
+		CreatedAt:  pbutils.Now(),
+		Pid:        int32(os.Getpid()),
+		DeviceName: "octelium-abcdef",
+		Cidrs:      []string{"fd00::/64"},
+	}
+
+	err = db.setConnection(context.Background(), domain, conn)
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	err = db.set(context.Background(), domain, &authv1.SessionToken{
+		AccessToken: utilrand.GetRandomString(32),
+	})
+	assert.Nil(t, err)
+
+	err = db.setConnection(context.Background(), domain, conn)
+	assert.Nil(t, err)
+
+	sessTkn := &authv1.SessionToken{
+		AccessToken: utilrand.GetRandomString(32),
+	}
+	err = db.set(context.Background(), domain, sessTkn)
+	assert.Nil(t, err)
+
+	state, err := db.get(context.Background(), domain)
+	assert.Nil(t, err)
+	assert.True(t, pbutils.IsEqual(sessTkn, state.SessionToken))
+	assert.True(t, pbutils.IsEqual(conn, state.Connection), "Connection must survive refreshing the Session token")
+
+	domainMap, err := db.list(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(domainMap))
+	assert.NotNil(t, domainMap[domain].Connection)
+
+	err = db.setConnection(context.Background(), domain, nil)
+	assert.Nil(t, err)
+
+	state, err = db.get(context.Background(), domain)
+	assert.Nil(t, err)
+	assert.Nil(t, state.Connection)
+	assert.NotNil(t, state.SessionToken)
+}
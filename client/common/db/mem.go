@@ -50,10 +50,30 @@ func (d *memDB) set(ctx context.Context, domain string, sessToken *authv1.Sessio
 	d.state.DomainMap[domain] = &cliconfigv1.State_Domain{
 		SessionToken:      sessToken,
 		SessionTokenSetAt: pbutils.Now(),
+		Connection:        d.state.DomainMap[domain].GetConnection(),
 	}
 	d.Unlock()
 	return nil
 }
+func (d *memDB) setConnection(ctx context.Context, domain string, conn *cliconfigv1.State_Domain_Connection) error {
+	d.Lock()
+	defer d.Unlock()
+	ret, ok := d.state.DomainMap[domain]
+	if !ok {
+		return ErrNotFound
+	}
+	ret.Connection = conn
+	return nil
+}
+func (d *memDB) list(ctx context.Context) (map[string]*cliconfigv1.State_Domain, error) {
+	d.RLock()
+	defer d.RUnlock()
+	ret := make(map[string]*cliconfigv1.State_Domain)
+	for k, v := range d.state.DomainMap {
+		ret[k] = v
+	}
+	return ret, nil
+}
 func (d *memDB) delete(ctx context.Context, domain string) error {
 	d.Lock()
 	delete(d.state.DomainMap, domain)
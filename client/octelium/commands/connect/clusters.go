@@ -0,0 +1,232 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package connect
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/octelium/octelium/apis/client/cliconfigv1"
+	"github.com/octelium/octelium/client/common/cliutils"
+	"github.com/octelium/octelium/client/octelium/commands/connect/controller"
+	"github.com/octelium/octelium/client/octelium/commands/connect/pprofsrv"
+	"github.com/octelium/octelium/pkg/utils/ldflags"
+	"github.com/octelium/octelium/pkg/utils/utilrand"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// getDomains returns the primary Cluster domain followed by the additional
+// Cluster domains set via the --cluster flag without duplicates.
+func getDomains(domain string) []string {
+	ret := []string{domain}
+	for _, itm := range cmdArgs.Clusters {
+		if itm == "" || slices.Contains(ret, itm) {
+			continue
+		}
+		ret = append(ret, itm)
+	}
+
+	return ret
+}
+
+// isPrimaryDomain reports whether the domain is the primary Cluster of the
+// command as opposed to the additional Clusters set via --cluster. Options
+// that bind to fixed host addresses such as published Services and the local
+// proxy only apply to the primary Cluster.
+func isPrimaryDomain(domain string) bool {
+	return !slices.Contains(cmdArgs.Clusters, domain)
+}
+
+// connectAll simultaneously connects to all the Cluster domains and returns
+// once all the Connections are closed.
+func connectAll(ctx context.Context, domains []string) error {
+	if ldflags.IsDev() || os.Getenv("OCTELIUM_PPROF") == "true" {
+		srv := pprofsrv.New()
+		if err := srv.Run(ctx); err != nil {
+			return err
+		}
+
+		defer srv.Close()
+	}
+
+	if len(domains) == 1 {
+		return connect(ctx, domains[0])
+	}
+
+	var wg sync.WaitGroup
+	errCh := make(chan error, len(domains))
+
+	for _, domain := range domains {
+		wg.Add(1)
+		go func(domain string) {
+			defer wg.Done()
+			if err := connect(ctx, domain); err != nil {
+				cliutils.LineWarn("Connection to the Cluster %s exited: %s\n", domain, err.Error())
+				errCh <- errors.Errorf("Cluster %s: %s", domain, err)
+			}
+		}(domain)
+	}
+
+	wg.Wait()
+	close(errCh)
+
+	return <-errCh
+}
+
+// cidrRegistry tracks the private CIDRs used by the active Connections of the
+// process since the routes of simultaneous Connections must not overlap.
+type cidrRegistry struct {
+	mu        sync.Mutex
+	domainMap map[string][]netip.Prefix
+}
+
+var connCIDRs = &cidrRegistry{
+	domainMap: make(map[string][]netip.Prefix),
+}
+
+func (r *cidrRegistry) register(domain string, cidrs []string) error {
+	var prefixes []netip.Prefix
+	for _, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return errors.Errorf("Invalid CIDR %s: %s", cidr, err)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for otherDomain, otherPrefixes := range r.domainMap {
+		if otherDomain == domain {
+			continue
+		}
+
+		for _, prefix := range prefixes {
+			for _, otherPrefix := range otherPrefixes {
+				if prefix.Overlaps(otherPrefix) {
+					return errors.Errorf(
+						"The private CIDR %s of the Cluster %s conflicts with the CIDR %s of the already connected Cluster %s",
+						prefix.String(), domain, otherPrefix.String(), otherDomain)
+				}
+			}
+		}
+	}
+
+	r.domainMap[domain] = prefixes
+	return nil
+}
+
+func (r *cidrRegistry) unregister(domain string) {
+	r.mu.Lock()
+	delete(r.domainMap, domain)
+	r.mu.Unlock()
+}
+
+// getConnectionCIDRs returns the private CIDRs that the Connection routes
+// through its device.
+func getConnectionCIDRs(connCfg *cliconfigv1.Connection) []string {
+	var ret []string
+
+	appendCIDR := func(cidr string) {
+		if cidr != "" && !slices.Contains(ret, cidr) {
+			ret = append(ret, cidr)
+		}
+	}
+
+	if cidr := connCfg.Connection.Cidr; cidr != nil {
+		appendCIDR(cidr.V4)
+		appendCIDR(cidr.V6)
+	}
+
+	for _, gw := range connCfg.Connection.Gateways {
+		for _, cidr := range gw.CIDRs {
+			appendCIDR(cidr)
+		}
+	}
+
+	return ret
+}
+
+// getDeviceName returns a device name that is unique among the simultaneous
+// Connections. Windows keeps the well-known "octelium" adapter name for the
+// primary Cluster.
+func getDeviceName(goos, domain string) string {
+	switch goos {
+	case "windows":
+		if isPrimaryDomain(domain) {
+			return "octelium"
+		}
+	case "darwin":
+		return "utun"
+	}
+
+	return fmt.Sprintf("octelium-%s", utilrand.GetRandomStringLowercase(6))
+}
+
+// setConnectionState stores the active Connection in the local DB so that
+// it can be listed by the status command.
+func setConnectionState(connCfg *cliconfigv1.Connection, devCtl *controller.Controller) {
+	conn := &cliconfigv1.State_Domain_Connection{
+		CreatedAt:      connCfg.CreatedAt,
+		Pid:            int32(os.Getpid()),
+		DeviceName:     connCfg.Preferences.DeviceName,
+		Cidrs:          getConnectionCIDRs(connCfg),
+		ConnectionType: connCfg.Preferences.ConnectionType,
+		Gateways:       devCtl.GetGatewayStats(),
+	}
+
+	for _, addr := range connCfg.Connection.Addresses {
+		if addr.V4 != "" {
+			conn.Addresses = append(conn.Addresses, addr.V4)
+		}
+		if addr.V6 != "" {
+			conn.Addresses = append(conn.Addresses, addr.V6)
+		}
+	}
+
+	if err := cliutils.GetDB().SetConnection(connCfg.Info.Cluster.Domain, conn); err != nil {
+		zap.L().Debug("Could not set Connection state", zap.Error(err))
+	}
+}
+
+// runConnectionStateLoop periodically refreshes the stored Connection state
+// with the current Gateway path quality until the context is done.
+func runConnectionStateLoop(ctx context.Context, connCfg *cliconfigv1.Connection, devCtl *controller.Controller) {
+	setConnectionState(connCfg, devCtl)
+
+	ticker := time.NewTicker(15 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			setConnectionState(connCfg, devCtl)
+		}
+	}
+}
+
+func unsetConnectionState(domain string) {
+	if err := cliutils.GetDB().SetConnection(domain, nil); err != nil {
+		zap.L().Debug("Could not unset Connection state", zap.Error(err))
+	}
+}
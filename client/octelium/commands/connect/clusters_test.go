@@ -0,0 +1,96 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package connect
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/octelium/octelium/apis/client/cliconfigv1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/apis/main/userv1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDomains(t *testing.T) {
+	t.Cleanup(func() {
+		cmdArgs.Clusters = nil
+	})
+
+	assert.Equal(t, []string{"example.com"}, getDomains("example.com"))
+	assert.True(t, isPrimaryDomain("example.com"))
+
+	cmdArgs.Clusters = []string{"example.org", "example.com", "", "example.org", "example.net"}
+	assert.Equal(t, []string{"example.com", "example.org", "example.net"}, getDomains("example.com"))
+	assert.False(t, isPrimaryDomain("example.org"))
+	assert.False(t, isPrimaryDomain("example.net"))
+}
+
+func TestGetDeviceName(t *testing.T) {
+	t.Cleanup(func() {
+		cmdArgs.Clusters = nil
+	})
+
+	cmdArgs.Clusters = []string{"example.org"}
+
+	assert.Equal(t, "octelium", getDeviceName("windows", "example.com"))
+	assert.NotEqual(t, "octelium", getDeviceName("windows", "example.org"))
+	assert.NotEqual(t, getDeviceName("windows", "example.org"), getDeviceName("windows", "example.org"))
+	assert.Equal(t, "utun", getDeviceName("darwin", "example.org"))
+	assert.NotEqual(t, getDeviceName("linux", "example.com"), getDeviceName("linux", "example.com"))
+}
+
+func TestCIDRRegistry(t *testing.T) {
+	r := &cidrRegistry{
+		domainMap: make(map[string][]netip.Prefix),
+	}
+
+	assert.Nil(t, r.register("example.com", []string{"10.0.0.0/16", "fd00:1::/64"}))
+	assert.Nil(t, r.register("example.org", []string{"10.1.0.0/16", "fd00:2::/64"}))
+
+	// Re-registering the same Cluster, e.g. upon reconnecting, must not conflict with itself
+	assert.Nil(t, r.register("example.com", []string{"10.0.0.0/16", "fd00:1::/64"}))
+
+	assert.NotNil(t, r.register("example.net", []string{"10.0.128.0/24"}))
+	assert.NotNil(t, r.register("example.net", []string{"10.0.0.0/8"}))
+	assert.NotNil(t, r.register("example.net", []string{"fd00:2::1234/128"}))
+	assert.NotNil(t, r.register("example.net", []string{"invalid"}))
+
+	r.unregister("example.com")
+	assert.Nil(t, r.register("example.net", []string{"10.0.128.0/24"}))
+	assert.Equal(t, 2, len(r.domainMap))
+}
+
+func TestGetConnectionCIDRs(t *testing.T) {
+	connCfg := &cliconfigv1.Connection{
+		Connection: &userv1.ConnectionState{
+			Cidr: &metav1.DualStackNetwork{
+				V4: "10.0.0.0/16",
+				V6: "fd00::/64",
+			},
+			Gateways: []*userv1.Gateway{
+				{
+					Id:    "gw1",
+					CIDRs: []string{"10.0.0.0/16", "fd00::/64"},
+				},
+				{
+					Id:    "gw2",
+					CIDRs: []string{"10.2.0.0/16"},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, []string{"10.0.0.0/16", "fd00::/64", "10.2.0.0/16"}, getConnectionCIDRs(connCfg))
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/octelium/octelium/client/common/authenticator"
 	"github.com/octelium/octelium/client/common/cliutils"
 	"github.com/octelium/octelium/client/common/cliutils/vhome"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
@@ -48,7 +49,14 @@ type args struct {
 	UseLocalDNS        bool
 	LocalDNSListenAddr string
 
+	UseLocalProxy        bool
+	LocalProxyListenAddr string
+
 	TunnelMode string
+
+	NoTLSFallback bool
+
+	Clusters []string
 }
 
 var example = `
@@ -77,11 +85,19 @@ octelium connect --serve-all
 # Serve embedded SSH Services
 octelium connect --essh
 
+# Simultaneously connect to additional Clusters
+octelium connect --domain example.com --cluster example.org --cluster corp.example.net
+
 # Connect with an Authentication Token
 octelium connect --detach --auth-token <AUTHENTICATION_TOKEN>
 
 # Connect using IPv4 only and skip using the Cluster DNS
 octelium connect --ip-mode v4 --no-dns
+
+# Connect without OS privileges and access Services through a local SOCKS5/HTTP proxy
+octelium connect --proxy
+# Use a custom proxy listen address
+octelium connect --proxy-addr 127.0.0.1:8888
 `
 
 var Cmd = &cobra.Command{
@@ -137,10 +153,23 @@ You can also use multiple scopes in the same command as follows "--scope service
 	Cmd.PersistentFlags().BoolVar(&cmdArgs.UseLocalDNS, "localdns", false, "Enable local DNS server")
 	Cmd.PersistentFlags().StringVar(&cmdArgs.LocalDNSListenAddr, "localdns-addr", "",
 		`Local DNS server listen address. By default it is set to "127.0.0.100:53"`)
+	Cmd.PersistentFlags().BoolVar(&cmdArgs.UseLocalProxy, "proxy", false,
+		`Enable a local SOCKS5 and HTTP CONNECT proxy through which apps can access Services by their names.
+On Linux, this also enables the rootless "gvisor" implementation unless --implementation is explicitly set`)
+	Cmd.PersistentFlags().StringVar(&cmdArgs.LocalProxyListenAddr, "proxy-addr", "",
+		`Local SOCKS5 and HTTP CONNECT proxy listen address. By default it is set to "127.0.0.1:1080"`)
 	Cmd.PersistentFlags().StringVar(&cmdArgs.TunnelMode, "tunnel-mode", "",
 		`
 	The tunneling mode for the  connection. The current available values are "wg", "wireguard" which use WireGuard (i.e. the default tunneling mode)
+	"masque" which uses the standard MASQUE CONNECT-IP protocol (RFC 9484) over HTTP/3,
+	"tls" which tunnels over WebSocket through the Cluster's public HTTPS ingress for networks that block UDP
 	and "quicv0" which uses QUIC. Currently "quicv0" is experimental and not suitable for production environments`)
+	Cmd.PersistentFlags().BoolVar(&cmdArgs.NoTLSFallback, "no-tls-fallback", false,
+		"Do not automatically fall back to the TLS tunnel mode when the Gateways cannot be reached over UDP")
+	Cmd.PersistentFlags().StringSliceVar(&cmdArgs.Clusters, "cluster", nil,
+		`Simultaneously connect to additional Clusters by their domains. You must already be logged in to these Clusters.
+Every Cluster uses its own device and routes while the local DNS server, if enabled, is shared and routes the queries by the Cluster domain.
+Published Services and the local proxy only apply to the main Cluster set by --domain`)
 }
 
 func doCmd(cmd *cobra.Command, args []string) error {
@@ -168,13 +197,24 @@ func doCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	domains := getDomains(domain)
+	for _, itm := range domains[1:] {
+		if err := authenticator.Authenticate(ctx, &authenticator.AuthenticateOpts{
+			Domain: itm,
+		}); err != nil {
+			return errors.Errorf("Could not authenticate to the Cluster %s: %s", itm, err)
+		}
+	}
+
 	if cmdArgs.Detached {
 		return runDetached(cmd, domain)
 	}
 
-	authenticator.StartGetAccessToken(ctx, domain)
+	for _, itm := range domains {
+		authenticator.StartGetAccessToken(ctx, itm)
+	}
 
-	if err := doConnect(ctx, domain); err != nil {
+	if err := doConnect(ctx, domains); err != nil {
 		return err
 	}
 
@@ -201,6 +241,13 @@ func runDetached(cmd *cobra.Command, domain string) error {
 			return
 		}
 
+		if sv, ok := f.Value.(pflag.SliceValue); ok {
+			for _, itm := range sv.GetSlice() {
+				args = append(args, fmt.Sprintf("--%s=%s", f.Name, itm))
+			}
+			return
+		}
+
 		args = append(args, fmt.Sprintf("--%s=%s", f.Name, f.Value.String()))
 	})
 
@@ -33,11 +33,9 @@ import (
 	"github.com/octelium/octelium/client/common/cliutils"
 	"github.com/octelium/octelium/client/octelium/commands/connect/controller"
 	"github.com/octelium/octelium/client/octelium/commands/connect/l3mode"
-	"github.com/octelium/octelium/client/octelium/commands/connect/pprofsrv"
 	"github.com/octelium/octelium/client/octelium/commands/connect/proxy"
 	"github.com/octelium/octelium/pkg/common/pbutils"
 	"github.com/octelium/octelium/pkg/grpcerr"
-	"github.com/octelium/octelium/pkg/utils/ldflags"
 	"github.com/octelium/octelium/pkg/utils/utilrand"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -45,7 +43,7 @@ import (
 
 const defaultESSHPort = 22022
 
-func sendInitializeRequest(streamC userv1.MainService_ConnectClient,
+func sendInitializeRequest(streamC userv1.MainService_ConnectClient, domain string,
 	publishedServices []*cliconfigv1.Connection_Preferences_PublishedService) error {
 
 	l3Mode, err := l3mode.GetL3Mode(cmdArgs.L3Mode)
@@ -69,6 +67,12 @@ func sendInitializeRequest(streamC userv1.MainService_ConnectClient,
 		Type: &userv1.ConnectRequest_Initialize_{
 			Initialize: &userv1.ConnectRequest_Initialize{
 				ConnectionType: func() userv1.ConnectRequest_Initialize_ConnectionType {
+					switch getTunnelMode(domain) {
+					case "masque":
+						return userv1.ConnectRequest_Initialize_MASQUE
+					case "tls":
+						return userv1.ConnectRequest_Initialize_TLS
+					}
 					if cmdArgs.TunnelMode == "quicv0" || os.Getenv("OCTELIUM_QUIC") == "true" {
 						return userv1.ConnectRequest_Initialize_QUICV0
 					}
@@ -183,9 +187,15 @@ func getConnectionConfig(ctx context.Context,
 
 		Preferences: &cliconfigv1.Connection_Preferences{
 			RuntimeMode: cliconfigv1.Connection_Preferences_MACHINE,
-			DeviceName:  fmt.Sprintf("octelium-%s", utilrand.GetRandomStringLowercase(6)),
+			DeviceName:  getDeviceName(runtime.GOOS, domain),
 
 			ConnectionType: func() cliconfigv1.Connection_Preferences_ConnectionType {
+				switch getTunnelMode(domain) {
+				case "masque":
+					return cliconfigv1.Connection_Preferences_CONNECTION_TYPE_MASQUE
+				case "tls":
+					return cliconfigv1.Connection_Preferences_CONNECTION_TYPE_TLS
+				}
 				if cmdArgs.TunnelMode == "quicv0" || os.Getenv("OCTELIUM_QUIC") == "true" {
 					return cliconfigv1.Connection_Preferences_CONNECTION_TYPE_QUICV0
 				}
@@ -287,6 +297,12 @@ func getConnectionConfig(ctx context.Context,
 					return "127.0.0.100:53"
 				}(),
 			},
+
+			LocalProxy: &cliconfigv1.Connection_Preferences_LocalProxy{
+				IsEnabled: (cmdArgs.UseLocalProxy || cmdArgs.LocalProxyListenAddr != "") &&
+					isPrimaryDomain(domain),
+				ListenAddress: cmdArgs.LocalProxyListenAddr,
+			},
 		},
 	}
 
@@ -310,17 +326,19 @@ func getConnectionConfig(ctx context.Context,
 				case "gvisor":
 					return cliconfigv1.Connection_Preferences_Linux_WG_NETSTACK
 				default:
+					if connCfg.Preferences.LocalProxy.IsEnabled {
+						return cliconfigv1.Connection_Preferences_Linux_WG_NETSTACK
+					}
 					return cliconfigv1.Connection_Preferences_Linux_WG_KERNEL
 				}
 			}(),
-			EnforceImplementationMode: cmdArgs.ImplementationMode != "",
+			// The local proxy mode uses the rootless gVisor netstack by default
+			EnforceImplementationMode: cmdArgs.ImplementationMode != "" || connCfg.Preferences.LocalProxy.IsEnabled,
 		}
 	case "windows":
 		connCfg.Preferences.WindowsPrefs = &cliconfigv1.Connection_Preferences_Windows{}
-		connCfg.Preferences.DeviceName = "octelium"
 	case "darwin":
 		connCfg.Preferences.MacosPrefs = &cliconfigv1.Connection_Preferences_MacOS{}
-		connCfg.Preferences.DeviceName = "utun"
 	}
 
 	if runtime.GOOS == "linux" && os.Getenv("OCTELIUM_CONTAINER_MODE") == "true" {
@@ -396,6 +414,7 @@ type ctl struct {
 	devCtl          *controller.Controller
 	proxyCtl        *proxy.Controller
 	stateController *stateController
+	postureReporter *postureReporter
 	mu              sync.Mutex
 	isClosed        bool
 	cancelFn        context.CancelFunc
@@ -420,6 +439,7 @@ func newCtl(ctx context.Context, streamC userv1.MainService_ConnectClient, connC
 	}
 
 	ret.stateController = newStateController(connCfg, ret.devCtl, ret.proxyCtl, streamC)
+	ret.postureReporter = newPostureReporter(connCfg, streamC)
 
 	return ret, nil
 }
@@ -449,6 +469,11 @@ func (c *ctl) start(ctx context.Context) error {
 		return err
 	}
 
+	zap.L().Debug("Starting posture reporter...")
+	if err := c.postureReporter.Start(ctx); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -472,15 +497,6 @@ func (c *ctl) close() error {
 }
 
 func connect(ctx context.Context, domain string) error {
-	if ldflags.IsDev() || os.Getenv("OCTELIUM_PPROF") == "true" {
-		srv := pprofsrv.New()
-		if err := srv.Run(ctx); err != nil {
-			return err
-		}
-
-		defer srv.Close()
-	}
-
 	for {
 		ret := make(chan tryConnectRet)
 		doneCh := make(chan struct{})
@@ -518,6 +534,50 @@ func connect(ctx context.Context, domain string) error {
 	}
 }
 
+const tunnelUpTimeout = 10 * time.Second
+
+// tlsFallback holds the Cluster domains whose default WireGuard tunnel could
+// not reach the Gateways so that all the subsequent reconnections to these
+// Clusters use the TLS fallback tunnel instead.
+var tlsFallback sync.Map
+
+func getTunnelMode(domain string) string {
+	if _, ok := tlsFallback.Load(domain); ok {
+		return "tls"
+	}
+
+	return cmdArgs.TunnelMode
+}
+
+// canFallbackToTLS reports whether a connection that failed to reach the
+// Gateways can be retried over the TLS fallback tunnel. Only the default
+// WireGuard mode falls back, and only if every Gateway supports the TLS mode.
+func canFallbackToTLS(connCfg *cliconfigv1.Connection) bool {
+	if cmdArgs.NoTLSFallback {
+		return false
+	}
+
+	if _, ok := tlsFallback.Load(connCfg.Info.Cluster.Domain); ok {
+		return false
+	}
+
+	if connCfg.Preferences.ConnectionType != cliconfigv1.Connection_Preferences_CONNECTION_TYPE_UNSET {
+		return false
+	}
+
+	if len(connCfg.Connection.Gateways) == 0 {
+		return false
+	}
+
+	for _, gw := range connCfg.Connection.Gateways {
+		if gw.Tls == nil {
+			return false
+		}
+	}
+
+	return true
+}
+
 type tryConnectRet struct {
 	err            error
 	needsReconnect bool
@@ -547,7 +607,7 @@ func tryConnect(ctx context.Context, domain string, doneCh chan<- struct{}) tryC
 
 	var publishedServices []*cliconfigv1.Connection_Preferences_PublishedService
 
-	if len(cmdArgs.PublishServices) > 0 {
+	if len(cmdArgs.PublishServices) > 0 && isPrimaryDomain(domain) {
 		publishedServices, err = getPublishedServices(ctx, c, domain)
 		if err != nil {
 			return tryConnectRet{
@@ -566,7 +626,7 @@ func tryConnect(ctx context.Context, domain string, doneCh chan<- struct{}) tryC
 		}
 	}
 
-	if err := sendInitializeRequest(streamC, publishedServices); err != nil {
+	if err := sendInitializeRequest(streamC, domain, publishedServices); err != nil {
 		return tryConnectRet{
 			err:            errors.Errorf("Could not send init request to API Server: %s", err),
 			needsReconnect: doNeedReconnect(err),
@@ -581,6 +641,14 @@ func tryConnect(ctx context.Context, domain string, doneCh chan<- struct{}) tryC
 		}
 	}
 
+	if err := connCIDRs.register(domain, getConnectionCIDRs(connCfg)); err != nil {
+		return tryConnectRet{
+			err:            err,
+			needsReconnect: false,
+		}
+	}
+	defer connCIDRs.unregister(domain)
+
 	ctl, err := newCtl(ctx, streamC, connCfg)
 	if err != nil {
 		return tryConnectRet{
@@ -597,9 +665,29 @@ func tryConnect(ctx context.Context, domain string, doneCh chan<- struct{}) tryC
 		}
 	}
 
+	if canFallbackToTLS(connCfg) && !ctl.devCtl.WaitTunnelUp(ctx, tunnelUpTimeout) && ctx.Err() == nil {
+		cliutils.LineWarn("Could not reach the Cluster Gateways over UDP. Falling back to the TLS tunnel...\n")
+		ctl.close()
+		tlsFallback.Store(domain, true)
+		return tryConnectRet{
+			needsReconnect: true,
+		}
+	}
+
 	needsReconnect := false
 	var retErr error
-	cliutils.LineNotify("Connected successfully...\n")
+	if len(cmdArgs.Clusters) == 0 {
+		cliutils.LineNotify("Connected successfully...\n")
+	} else {
+		cliutils.LineNotify("Connected successfully to %s...\n", domain)
+	}
+	stateCtx, stateCancel := context.WithCancel(ctx)
+	stateDoneCh := make(chan struct{})
+	go func() {
+		runConnectionStateLoop(stateCtx, connCfg, ctl.devCtl)
+		close(stateDoneCh)
+	}()
+
 	select {
 	case <-ctx.Done():
 		cliutils.LineInfo("Received shutdown signal\n")
@@ -610,7 +698,10 @@ func tryConnect(ctx context.Context, domain string, doneCh chan<- struct{}) tryC
 		cliutils.LineInfo("Disconnected by API Server\n")
 	}
 
+	stateCancel()
+	<-stateDoneCh
 	ctl.close()
+	unsetConnectionState(domain)
 	close(doneCh)
 
 	return tryConnectRet{
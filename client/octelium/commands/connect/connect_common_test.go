@@ -17,6 +17,8 @@ package connect
 import (
 	"testing"
 
+	"github.com/octelium/octelium/apis/client/cliconfigv1"
+	"github.com/octelium/octelium/apis/main/userv1"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -120,3 +122,58 @@ func TestParsePublishService(t *testing.T) {
 		assert.Equal(t, 9090, res.port)
 	}
 }
+
+func TestCanFallbackToTLS(t *testing.T) {
+	t.Cleanup(func() {
+		tlsFallback.Delete("example.com")
+		cmdArgs.NoTLSFallback = false
+	})
+
+	newCfg := func() *cliconfigv1.Connection {
+		return &cliconfigv1.Connection{
+			Info: &cliconfigv1.Connection_Info{
+				Cluster: &cliconfigv1.Connection_Info_Cluster{
+					Domain: "example.com",
+				},
+			},
+			Preferences: &cliconfigv1.Connection_Preferences{},
+			Connection: &userv1.ConnectionState{
+				Gateways: []*userv1.Gateway{
+					{
+						Id:  "gw1",
+						Tls: &userv1.Gateway_TLS{Path: "/octelium/tunnel/gw1"},
+					},
+				},
+			},
+		}
+	}
+
+	assert.True(t, canFallbackToTLS(newCfg()))
+	assert.Equal(t, "", getTunnelMode("example.com"))
+
+	{
+		cfg := newCfg()
+		cfg.Connection.Gateways = append(cfg.Connection.Gateways, &userv1.Gateway{Id: "gw2"})
+		assert.False(t, canFallbackToTLS(cfg))
+	}
+	{
+		cfg := newCfg()
+		cfg.Connection.Gateways = nil
+		assert.False(t, canFallbackToTLS(cfg))
+	}
+	{
+		cfg := newCfg()
+		cfg.Preferences.ConnectionType = cliconfigv1.Connection_Preferences_CONNECTION_TYPE_MASQUE
+		assert.False(t, canFallbackToTLS(cfg))
+	}
+	{
+		cmdArgs.NoTLSFallback = true
+		assert.False(t, canFallbackToTLS(newCfg()))
+		cmdArgs.NoTLSFallback = false
+	}
+
+	tlsFallback.Store("example.com", true)
+	assert.False(t, canFallbackToTLS(newCfg()))
+	assert.Equal(t, "tls", getTunnelMode("example.com"))
+	assert.Equal(t, "", getTunnelMode("example.org"))
+}
@@ -26,7 +26,7 @@ import (
 	"go.uber.org/zap"
 )
 
-func doConnect(ctx context.Context, domain string) error {
+func doConnect(ctx context.Context, domains []string) error {
 
 	signalCh := make(chan os.Signal, 1)
 	signal.Notify(signalCh, os.Interrupt, syscall.SIGTERM)
@@ -38,5 +38,5 @@ func doConnect(ctx context.Context, domain string) error {
 		cancelFn()
 	}()
 
-	return connect(ctx, domain)
+	return connectAll(ctx, domains)
 }
@@ -29,7 +29,7 @@ import (
 	"golang.zx2c4.com/wireguard/windows/ringlogger"
 )
 
-func doConnect(ctx context.Context, domain string) error {
+func doConnect(ctx context.Context, domains []string) error {
 
 	/*
 		if ldflags.IsDev() {
@@ -52,7 +52,7 @@ func doConnect(ctx context.Context, domain string) error {
 	isWindowsService, _ := svc.IsWindowsService()
 	if isWindowsService {
 		svcController := &serviceController{
-			domain: domain,
+			domains: domains,
 		}
 		return svc.Run(windowsServiceName, svcController)
 	}
@@ -67,12 +67,12 @@ func doConnect(ctx context.Context, domain string) error {
 		cancelFn()
 	}()
 
-	return connect(ctx, domain)
+	return connectAll(ctx, domains)
 
 }
 
 type serviceController struct {
-	domain string
+	domains []string
 }
 
 func (c *serviceController) Execute(args []string, r <-chan svc.ChangeRequest, changes chan<- svc.Status) (svcSpecificEC bool, exitCode uint32) {
@@ -125,7 +125,7 @@ func (c *serviceController) Execute(args []string, r <-chan svc.ChangeRequest, c
 		}
 	}()
 
-	connect(ctx, c.domain)
+	connectAll(ctx, c.domains)
 	return
 }
 
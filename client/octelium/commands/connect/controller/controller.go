@@ -24,6 +24,7 @@ import (
 	"github.com/octelium/octelium/apis/main/userv1"
 	"github.com/octelium/octelium/client/octelium/commands/connect/controller/esshmain"
 	"github.com/octelium/octelium/client/octelium/commands/connect/dnssrv"
+	"github.com/octelium/octelium/client/octelium/commands/connect/localproxy"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 	"golang.zx2c4.com/wireguard/device"
@@ -63,6 +64,8 @@ type Controller struct {
 
 	isNetstack bool
 	isQUIC     bool
+	isMASQUE   bool
+	isTLS      bool
 	nsTun      *netTun
 
 	dnsConfigSaved bool
@@ -75,6 +78,10 @@ type Controller struct {
 	eSSHHMainSrv *esshmain.ESSHMain
 
 	localDNSSrv *dnssrv.Server
+
+	localProxySrv *localproxy.Server
+
+	gwSelector *gwSelector
 }
 
 func NewController(c *cliconfigv1.Connection) (*Controller, error) {
@@ -88,7 +95,11 @@ func NewController(c *cliconfigv1.Connection) (*Controller, error) {
 		c:             c,
 		ipv4Supported: ipv4Supported,
 		ipv6Supported: ipv6Supported,
-		isQUIC:        c.Preferences.ConnectionType == cliconfigv1.Connection_Preferences_CONNECTION_TYPE_QUICV0,
+		isQUIC: c.Preferences.ConnectionType == cliconfigv1.Connection_Preferences_CONNECTION_TYPE_QUICV0 ||
+			c.Preferences.ConnectionType == cliconfigv1.Connection_Preferences_CONNECTION_TYPE_MASQUE ||
+			c.Preferences.ConnectionType == cliconfigv1.Connection_Preferences_CONNECTION_TYPE_TLS,
+		isMASQUE: c.Preferences.ConnectionType == cliconfigv1.Connection_Preferences_CONNECTION_TYPE_MASQUE,
+		isTLS:    c.Preferences.ConnectionType == cliconfigv1.Connection_Preferences_CONNECTION_TYPE_TLS,
 	}
 
 	switch runtime.GOOS {
@@ -159,11 +170,17 @@ func (c *Controller) Close() error {
 	}
 
 	if c.localDNSSrv != nil {
-		if err := c.localDNSSrv.Close(); err != nil {
+		if err := dnssrv.Unregister(c.localDNSSrv, c.c.Info.Cluster.Domain); err != nil {
 			zap.L().Warn("Could not close local DNS server", zap.Error(err))
 		}
 	}
 
+	if c.localProxySrv != nil {
+		if err := c.localProxySrv.Close(); err != nil {
+			zap.L().Warn("Could not close local proxy server", zap.Error(err))
+		}
+	}
+
 	zap.L().Debug("Closed dev controller")
 	return nil
 }
@@ -175,10 +192,18 @@ func (c *Controller) Start(ctx context.Context) error {
 		return err
 	}
 
+	if !c.isQUIC {
+		c.gwSelector = newGWSelector(c)
+	}
+
 	if err := c.doStart(ctx); err != nil {
 		return err
 	}
 
+	if c.gwSelector != nil {
+		go c.gwSelector.run(ctx)
+	}
+
 	svcProxy, err := newServiceProxy(c)
 	if err != nil {
 		return err
@@ -189,7 +214,7 @@ func (c *Controller) Start(ctx context.Context) error {
 	}
 
 	if c.c.Preferences.LocalDNS.IsEnabled {
-		localDNSServer, err := dnssrv.NewDNSServer(&dnssrv.Opts{
+		localDNSServer, err := dnssrv.Register(&dnssrv.Opts{
 			ClusterDomain: c.c.Info.Cluster.Domain,
 			HasV4:         c.ipv4Supported,
 			HasV6:         c.ipv6Supported,
@@ -201,12 +226,23 @@ func (c *Controller) Start(ctx context.Context) error {
 			*/
 		})
 		if err != nil {
-			zap.L().Warn("Could not initialize local DNS server", zap.Error(err))
+			zap.L().Warn("Could not run local DNS server", zap.Error(err))
+		} else {
+			c.localDNSSrv = localDNSServer
 		}
-		c.localDNSSrv = localDNSServer
+	}
 
-		if err := c.localDNSSrv.Run(); err != nil {
-			zap.L().Warn("Could not run local DNS server", zap.Error(err))
+	if c.c.Preferences.LocalProxy != nil && c.c.Preferences.LocalProxy.IsEnabled {
+		c.localProxySrv, err = localproxy.NewServer(&localproxy.Opts{
+			ListenAddr: c.c.Preferences.LocalProxy.ListenAddress,
+			Dialer:     &localProxyDialer{ctl: c},
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := c.localProxySrv.Run(ctx); err != nil {
+			return errors.Errorf("Could not run local proxy server: %+v", err)
 		}
 	}
 
@@ -48,7 +48,9 @@ func (c *Controller) InitDev(ctx context.Context) error {
 	}
 
 	switch c.c.Preferences.ConnectionType {
-	case cliconfigv1.Connection_Preferences_CONNECTION_TYPE_QUICV0:
+	case cliconfigv1.Connection_Preferences_CONNECTION_TYPE_QUICV0,
+		cliconfigv1.Connection_Preferences_CONNECTION_TYPE_MASQUE,
+		cliconfigv1.Connection_Preferences_CONNECTION_TYPE_TLS:
 	default:
 		zap.S().Debugf("initializing dev wg config")
 		if err := c.setWGDev(); err != nil {
@@ -49,8 +49,10 @@ func (c *Controller) doStart(ctx context.Context) error {
 		return err
 	}
 
-	if err := c.pre(); err != nil {
-		return err
+	if !c.isEmbedded() {
+		if err := c.pre(); err != nil {
+			return err
+		}
 	}
 
 	if err := c.doInitDev(ctx); err != nil {
@@ -58,7 +60,9 @@ func (c *Controller) doStart(ctx context.Context) error {
 	}
 
 	switch c.c.Preferences.ConnectionType {
-	case cliconfigv1.Connection_Preferences_CONNECTION_TYPE_QUICV0:
+	case cliconfigv1.Connection_Preferences_CONNECTION_TYPE_QUICV0,
+		cliconfigv1.Connection_Preferences_CONNECTION_TYPE_MASQUE,
+		cliconfigv1.Connection_Preferences_CONNECTION_TYPE_TLS:
 	default:
 		zap.S().Debugf("initializing dev wg config")
 		if err := c.setWGDev(); err != nil {
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/octelium/octelium/apis/client/cliconfigv1"
 	"golang.zx2c4.com/wireguard/conn"
 	"golang.zx2c4.com/wireguard/device"
 	"golang.zx2c4.com/wireguard/tun"
@@ -39,6 +40,14 @@ func (c *Controller) SetDevAddrs() error {
 	return c.doSetDevAddrs()
 }
 
+// isEmbedded reports whether the controller runs within the process of an
+// application that uses the SDK. Such a controller always uses the gVisor
+// netstack mode since it must neither require elevated privileges nor
+// modify the host's network configuration.
+func (c *Controller) isEmbedded() bool {
+	return c.c.Preferences.RuntimeMode == cliconfigv1.Connection_Preferences_IN_APP
+}
+
 func (c *Controller) doInitDevNetstack(ctx context.Context) error {
 	if err := c.createNetstackTUN(); err != nil {
 		return err
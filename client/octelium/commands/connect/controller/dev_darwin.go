@@ -30,6 +30,10 @@ import (
 )
 
 func (c *Controller) doInitDev(ctx context.Context) error {
+	if c.isEmbedded() {
+		return c.doInitDevNetstack(ctx)
+	}
+
 	err := c.doInitDevTUN(ctx)
 	if err == nil {
 		return nil
@@ -36,6 +36,10 @@ import (
 func (c *Controller) doInitDev(ctx context.Context) error {
 	zap.L().Debug("Initializing dev")
 
+	if c.isEmbedded() {
+		return c.doInitDevNetstack(ctx)
+	}
+
 	if c.c.Preferences.LinuxPrefs.EnforceImplementationMode {
 		zap.L().Debug("Enforcing WireGuard mode",
 			zap.String("mode", c.c.Preferences.LinuxPrefs.ImplementationMode.String()))
@@ -38,6 +38,10 @@ func setTunDev() error {
 }
 
 func (c *Controller) doInitDev(ctx context.Context) error {
+	if c.isEmbedded() {
+		return c.doInitDevNetstack(ctx)
+	}
+
 	err := c.doInitDevTUN(ctx)
 	if err == nil {
 		return nil
@@ -84,7 +88,7 @@ func (c *Controller) doInitDevTUN(ctx context.Context) error {
 			time.Sleep(time.Second)
 			log.Printf("Retrying adapter creation after failure because system just booted (T+%v): %v", windows.DurationSinceBoot(), err)
 		}
-		c.opts.adapter, err = driver.CreateAdapter("octelium", "WireGuard", guid)
+		c.opts.adapter, err = driver.CreateAdapter(c.c.Preferences.DeviceName, "WireGuard", guid)
 		if err == nil || !services.StartedAtBoot() {
 			break
 		}
@@ -191,6 +195,10 @@ func (c *Controller) doSetDevAddrs() error {
 func (c *Controller) getGUID() (*windows.GUID, error) {
 
 	arg := "octelium.com"
+	if c.c.Preferences.DeviceName != "octelium" {
+		// Every simultaneous Cluster Connection needs its own adapter
+		arg = fmt.Sprintf("octelium.com/%s", c.c.Preferences.DeviceName)
+	}
 	h := sha256.New()
 	_, err := h.Write([]byte(arg))
 	if err != nil {
@@ -0,0 +1,572 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package controller
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"errors"
+	"net"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/octelium/octelium/apis/client/cliconfigv1"
+	"github.com/octelium/octelium/apis/main/userv1"
+	"go.uber.org/zap"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	gwProbeInterval = 10 * time.Second
+	gwProbeTimeout  = 3 * time.Second
+
+	// gwProbePort is a port that is not expected to be listened on by the
+	// Gateway. The TCP RST sent back by the Gateway is enough to measure the
+	// round-trip time through it.
+	gwProbePort = 1
+
+	// gwHandshakeStallTimeout is the WireGuard reject-after time after which
+	// the session with a Gateway that failed to re-handshake cannot carry
+	// any traffic anymore.
+	gwHandshakeStallTimeout = 180 * time.Second
+
+	// gwInitialHandshakeTimeout is the time after which a Gateway that has
+	// not completed any handshake is considered unhealthy.
+	gwInitialHandshakeTimeout = 20 * time.Second
+
+	gwMaxProbeFailures = 3
+
+	// gwSwitchMinRTTDiff is the minimum RTT improvement, which must also be
+	// at least a fifth of the current RTT, required to move the traffic of a
+	// Region to another healthy Gateway.
+	gwSwitchMinRTTDiff = 5 * time.Millisecond
+)
+
+type gwState struct {
+	rtt           time.Duration
+	hasProbed     bool
+	probeFailures int
+	isFailed      bool
+	failedAt      time.Time
+	addedAt       time.Time
+}
+
+// gwSelector probes the Gateways of a WireGuard-based Connection and routes
+// the traffic of every Region through its lowest-latency healthy Gateway by
+// moving the CIDRs of the other Gateways of the Region to the allowed IPs of
+// the preferred Gateway peer. The traffic of unhealthy Gateways of a Region
+// without any healthy Gateway fails over to the lowest-latency healthy
+// Gateway overall. The Session itself is left intact.
+type gwSelector struct {
+	ctl *Controller
+
+	mu           sync.RWMutex
+	stateMap     map[string]*gwState
+	preferredMap map[string]string
+	routeMap     map[string]string
+}
+
+func newGWSelector(ctl *Controller) *gwSelector {
+	return &gwSelector{
+		ctl:          ctl,
+		stateMap:     make(map[string]*gwState),
+		preferredMap: make(map[string]string),
+		routeMap:     make(map[string]string),
+	}
+}
+
+func (s *gwSelector) run(ctx context.Context) {
+	ticker := time.NewTicker(gwProbeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.doRun(ctx)
+		}
+	}
+}
+
+func (s *gwSelector) doRun(ctx context.Context) {
+	gws := slices.Clone(s.ctl.c.Connection.Gateways)
+
+	handshakes, err := s.ctl.getWGHandshakes()
+	if err != nil {
+		zap.L().Debug("Could not get Gateway handshakes", zap.Error(err))
+		return
+	}
+
+	s.mu.RLock()
+	toProbe := []*userv1.Gateway{}
+	for _, gw := range gws {
+		if st, ok := s.stateMap[gw.Id]; !ok || !st.isFailed {
+			toProbe = append(toProbe, gw)
+		}
+	}
+	s.mu.RUnlock()
+
+	rtts := make([]time.Duration, len(toProbe))
+	errs := make([]error, len(toProbe))
+	var wg sync.WaitGroup
+	for i, gw := range toProbe {
+		wg.Add(1)
+		go func(i int, gw *userv1.Gateway) {
+			defer wg.Done()
+			rtts[i], errs[i] = s.ctl.probeGateway(ctx, gw)
+		}(i, gw)
+	}
+	wg.Wait()
+
+	now := time.Now()
+
+	s.mu.Lock()
+	for i, gw := range toProbe {
+		st := s.getOrCreateState(gw.Id, now)
+		st.updateProbe(rtts[i], errs[i])
+	}
+
+	for _, gw := range gws {
+		st := s.getOrCreateState(gw.Id, now)
+		handshake := getGatewayHandshake(gw, handshakes)
+		if st.updateHealth(handshake, now) {
+			zap.L().Info("Gateway health changed",
+				zap.String("id", gw.Id), zap.String("region", gw.Region), zap.Bool("isFailed", st.isFailed))
+		}
+	}
+
+	for id := range s.stateMap {
+		if !slices.ContainsFunc(gws, func(gw *userv1.Gateway) bool { return gw.Id == id }) {
+			delete(s.stateMap, id)
+		}
+	}
+
+	s.preferredMap = getPreferredGateways(gws, s.stateMap, s.preferredMap)
+	routeMap := getRouteMap(gws, s.stateMap, s.preferredMap)
+	changed := !isRouteMapEqual(routeMap, s.routeMap)
+	s.routeMap = routeMap
+	s.mu.Unlock()
+
+	if !changed {
+		return
+	}
+
+	zap.L().Info("Updating Gateway routes", zap.Any("routes", routeMap))
+	if err := s.ctl.setGatewayPeers(); err != nil {
+		zap.L().Warn("Could not update Gateway peers after rerouting", zap.Error(err))
+	}
+}
+
+func (s *gwSelector) getOrCreateState(id string, now time.Time) *gwState {
+	st, ok := s.stateMap[id]
+	if !ok {
+		st = &gwState{
+			addedAt: now,
+		}
+		s.stateMap[id] = st
+	}
+	return st
+}
+
+func (st *gwState) updateProbe(rtt time.Duration, err error) {
+	if err != nil {
+		st.probeFailures++
+		return
+	}
+
+	st.probeFailures = 0
+	if !st.hasProbed {
+		st.rtt = rtt
+		st.hasProbed = true
+		return
+	}
+
+	st.rtt = (st.rtt*7 + rtt*3) / 10
+}
+
+// updateHealth updates the health of the Gateway and reports whether it has
+// changed. A Gateway fails once its WireGuard handshake stalls or once its
+// probes that used to succeed keep failing. It only recovers after
+// completing a new handshake.
+func (st *gwState) updateHealth(lastHandshake time.Time, now time.Time) bool {
+	if st.isFailed {
+		if !lastHandshake.IsZero() && lastHandshake.After(st.failedAt) {
+			st.isFailed = false
+			st.probeFailures = 0
+			st.hasProbed = false
+			return true
+		}
+		return false
+	}
+
+	isStalled := func() bool {
+		if lastHandshake.IsZero() {
+			return now.Sub(st.addedAt) > gwInitialHandshakeTimeout
+		}
+
+		return now.Sub(lastHandshake) > gwHandshakeStallTimeout
+	}()
+
+	if isStalled || (st.hasProbed && st.probeFailures >= gwMaxProbeFailures) {
+		st.isFailed = true
+		st.failedAt = now
+		return true
+	}
+
+	return false
+}
+
+func isGatewayBetter(a, b *gwState, aID, bID string) bool {
+	switch {
+	case a.hasProbed && !b.hasProbed:
+		return true
+	case !a.hasProbed && b.hasProbed:
+		return false
+	case a.hasProbed && b.hasProbed && a.rtt != b.rtt:
+		return a.rtt < b.rtt
+	default:
+		return aID < bID
+	}
+}
+
+// isGatewayBetterToSwitch reports whether a is better enough than the
+// currently preferred b to move the traffic to it so that the traffic does
+// not keep moving between Gateways of similar latencies.
+func isGatewayBetterToSwitch(a, b *gwState) bool {
+	if !b.hasProbed {
+		return a.hasProbed
+	}
+
+	if !a.hasProbed {
+		return false
+	}
+
+	diff := b.rtt - a.rtt
+	return diff > gwSwitchMinRTTDiff && diff > b.rtt/5
+}
+
+// getPreferredGateways returns the lowest-latency healthy Gateway of every
+// Region. The previously preferred Gateway of a Region is kept as long as it
+// is healthy and no other Gateway is significantly better.
+func getPreferredGateways(gws []*userv1.Gateway, stateMap map[string]*gwState,
+	prev map[string]string) map[string]string {
+	ret := make(map[string]string)
+
+	for _, gw := range gws {
+		st, ok := stateMap[gw.Id]
+		if !ok || st.isFailed {
+			continue
+		}
+
+		curID, ok := ret[gw.Region]
+		if !ok || isGatewayBetter(st, stateMap[curID], gw.Id, curID) {
+			ret[gw.Region] = gw.Id
+		}
+	}
+
+	for region, id := range ret {
+		prevID, ok := prev[region]
+		if !ok || prevID == id {
+			continue
+		}
+
+		prevSt, ok := stateMap[prevID]
+		if !ok || prevSt.isFailed ||
+			!slices.ContainsFunc(gws, func(gw *userv1.Gateway) bool {
+				return gw.Id == prevID && gw.Region == region
+			}) {
+			continue
+		}
+
+		if !isGatewayBetterToSwitch(stateMap[id], prevSt) {
+			ret[region] = prevID
+		}
+	}
+
+	return ret
+}
+
+// getRouteMap maps every Gateway whose traffic is carried by another Gateway
+// to the latter. The traffic of a Gateway is carried by the preferred Gateway
+// of its Region, or by the lowest-latency preferred Gateway overall if its
+// Region has no healthy Gateway. A healthy Gateway keeps its own traffic
+// until both it and the preferred Gateway have been probed.
+func getRouteMap(gws []*userv1.Gateway, stateMap map[string]*gwState,
+	preferred map[string]string) map[string]string {
+	ret := make(map[string]string)
+
+	bestID := ""
+	for _, id := range preferred {
+		if bestID == "" || isGatewayBetter(stateMap[id], stateMap[bestID], id, bestID) {
+			bestID = id
+		}
+	}
+
+	for _, gw := range gws {
+		st, ok := stateMap[gw.Id]
+		if !ok {
+			continue
+		}
+
+		preferredID, hasPreferred := preferred[gw.Region]
+
+		switch {
+		case st.isFailed && hasPreferred:
+			ret[gw.Id] = preferredID
+		case st.isFailed && bestID != "":
+			ret[gw.Id] = bestID
+		case !st.isFailed && hasPreferred && preferredID != gw.Id &&
+			st.hasProbed && stateMap[preferredID].hasProbed:
+			ret[gw.Id] = preferredID
+		}
+	}
+
+	return ret
+}
+
+func isRouteMapEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for k, v := range a {
+		if b[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// getGatewayRoutedCIDRs returns the CIDRs routed through the Gateway peer
+// which are the Gateway's own CIDRs unless its traffic is carried by another
+// Gateway as well as the CIDRs of the Gateways whose traffic it carries. A
+// rerouted Gateway keeps its own probe address so that it can still be probed
+// directly.
+func (c *Controller) getGatewayRoutedCIDRs(gw *userv1.Gateway) []string {
+	if c.gwSelector == nil {
+		return gw.CIDRs
+	}
+
+	c.gwSelector.mu.RLock()
+	defer c.gwSelector.mu.RUnlock()
+
+	var ret []string
+	if _, ok := c.gwSelector.routeMap[gw.Id]; !ok {
+		ret = append(ret, gw.CIDRs...)
+	} else if addr, err := c.getGatewayProbeAddr(gw); err == nil {
+		ret = append(ret, netip.PrefixFrom(addr, addr.BitLen()).String())
+	}
+
+	for _, cur := range c.c.Connection.Gateways {
+		if c.gwSelector.routeMap[cur.Id] == gw.Id {
+			ret = append(ret, cur.CIDRs...)
+		}
+	}
+
+	return ret
+}
+
+// GetGatewayStats returns the current path quality of the Gateways.
+func (c *Controller) GetGatewayStats() []*cliconfigv1.State_Domain_Connection_Gateway {
+	if c.gwSelector == nil {
+		return nil
+	}
+
+	gws := slices.Clone(c.c.Connection.Gateways)
+
+	c.gwSelector.mu.RLock()
+	defer c.gwSelector.mu.RUnlock()
+
+	var ret []*cliconfigv1.State_Domain_Connection_Gateway
+	for _, gw := range gws {
+		itm := &cliconfigv1.State_Domain_Connection_Gateway{
+			Id:           gw.Id,
+			Region:       gw.Region,
+			IsPreferred:  c.gwSelector.preferredMap[gw.Region] == gw.Id,
+			ViaGatewayID: c.gwSelector.routeMap[gw.Id],
+			IsHealthy:    true,
+		}
+
+		if st, ok := c.gwSelector.stateMap[gw.Id]; ok {
+			itm.IsHealthy = !st.isFailed
+			if st.hasProbed {
+				itm.Rtt = durationpb.New(st.rtt)
+			}
+		}
+
+		ret = append(ret, itm)
+	}
+
+	return ret
+}
+
+func (c *Controller) setGatewayPeers() error {
+	if c.isNetstack {
+		return c.dev.IpcSet(c.toUAPI())
+	}
+
+	for _, gw := range slices.Clone(c.c.Connection.Gateways) {
+		if err := c.SetGatewayWGPeer(gw); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getGatewayProbeAddr returns the Gateway's own address inside its CIDR
+// which is the first address of the CIDR.
+func (c *Controller) getGatewayProbeAddr(gw *userv1.Gateway) (netip.Addr, error) {
+	for _, cidr := range gw.CIDRs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return netip.Addr{}, err
+		}
+
+		if (prefix.Addr().Is4() && c.ipv4Supported) || (prefix.Addr().Is6() && c.ipv6Supported) {
+			return prefix.Masked().Addr().Next(), nil
+		}
+	}
+
+	return netip.Addr{}, errors.New("No valid Gateway CIDR")
+}
+
+func (c *Controller) probeGateway(ctx context.Context, gw *userv1.Gateway) (time.Duration, error) {
+	addr, err := c.getGatewayProbeAddr(gw)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gwProbeTimeout)
+	defer cancel()
+
+	tcpAddr := net.TCPAddrFromAddrPort(netip.AddrPortFrom(addr, gwProbePort))
+
+	startedAt := time.Now()
+	if c.isNetstack {
+		var conn net.Conn
+		conn, err = c.GetNetstackNet().DialContextTCP(ctx, tcpAddr)
+		if err == nil {
+			conn.Close()
+		}
+	} else {
+		var conn net.Conn
+		conn, err = (&net.Dialer{}).DialContext(ctx, "tcp", tcpAddr.String())
+		if err == nil {
+			conn.Close()
+		}
+	}
+	rtt := time.Since(startedAt)
+
+	if err != nil && !isConnRefused(err) {
+		return 0, err
+	}
+
+	return rtt, nil
+}
+
+func isConnRefused(err error) bool {
+	return errors.Is(err, syscall.ECONNREFUSED) ||
+		strings.Contains(strings.ToLower(err.Error()), "connection was refused")
+}
+
+func getGatewayHandshake(gw *userv1.Gateway, handshakes map[wgtypes.Key]time.Time) time.Time {
+	if gw.Wireguard == nil {
+		return time.Time{}
+	}
+
+	key, err := wgtypes.ParseKey(gw.Wireguard.PublicKey)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return handshakes[key]
+}
+
+func (c *Controller) getWGHandshakes() (map[wgtypes.Key]time.Time, error) {
+	if c.dev != nil {
+		uapi, err := c.dev.IpcGet()
+		if err != nil {
+			return nil, err
+		}
+
+		return getUAPIHandshakes(uapi), nil
+	}
+
+	dev, err := c.wgC.Device(c.c.Preferences.DeviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make(map[wgtypes.Key]time.Time)
+	for _, peer := range dev.Peers {
+		if !peer.LastHandshakeTime.IsZero() && peer.LastHandshakeTime.Unix() > 0 {
+			ret[peer.PublicKey] = peer.LastHandshakeTime
+		}
+	}
+
+	return ret, nil
+}
+
+func getUAPIHandshakes(uapi string) map[wgtypes.Key]time.Time {
+	ret := make(map[wgtypes.Key]time.Time)
+
+	var curKey *wgtypes.Key
+	var sec, nsec int64
+
+	setCur := func() {
+		if curKey != nil && sec > 0 {
+			ret[*curKey] = time.Unix(sec, nsec)
+		}
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(uapi))
+	for scanner.Scan() {
+		k, v, ok := strings.Cut(scanner.Text(), "=")
+		if !ok {
+			continue
+		}
+
+		switch k {
+		case "public_key":
+			setCur()
+			curKey, sec, nsec = nil, 0, 0
+			keyBytes, err := hex.DecodeString(v)
+			if err != nil {
+				continue
+			}
+			key, err := wgtypes.NewKey(keyBytes)
+			if err != nil {
+				continue
+			}
+			curKey = &key
+		case "last_handshake_time_sec":
+			sec, _ = strconv.ParseInt(v, 10, 64)
+		case "last_handshake_time_nsec":
+			nsec, _ = strconv.ParseInt(v, 10, 64)
+		}
+	}
+	setCur()
+
+	return ret
+}
@@ -0,0 +1,249 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package controller
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/octelium/octelium/apis/client/cliconfigv1"
+	"github.com/octelium/octelium/apis/main/userv1"
+	"github.com/stretchr/testify/assert"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+)
+
+func TestGWStateHealth(t *testing.T) {
+	now := time.Now()
+
+	{
+		st := &gwState{addedAt: now}
+		assert.False(t, st.updateHealth(time.Time{}, now.Add(5*time.Second)))
+		assert.True(t, st.updateHealth(time.Time{}, now.Add(gwInitialHandshakeTimeout+time.Second)))
+		assert.True(t, st.isFailed)
+
+		// Only a new handshake recovers a failed Gateway
+		assert.False(t, st.updateHealth(now, now.Add(30*time.Second)))
+		assert.True(t, st.updateHealth(now.Add(40*time.Second), now.Add(50*time.Second)))
+		assert.False(t, st.isFailed)
+	}
+
+	{
+		st := &gwState{addedAt: now}
+		assert.False(t, st.updateHealth(now, now.Add(gwHandshakeStallTimeout)))
+		assert.True(t, st.updateHealth(now, now.Add(gwHandshakeStallTimeout+time.Second)))
+		assert.True(t, st.isFailed)
+	}
+
+	{
+		// Failing probes do not fail a Gateway that has never been successfully probed
+		st := &gwState{addedAt: now}
+		for i := 0; i < gwMaxProbeFailures+2; i++ {
+			st.updateProbe(0, errors.New("timeout"))
+		}
+		assert.False(t, st.updateHealth(now, now.Add(time.Second)))
+
+		st.updateProbe(10*time.Millisecond, nil)
+		for i := 0; i < gwMaxProbeFailures; i++ {
+			st.updateProbe(0, errors.New("timeout"))
+		}
+		assert.True(t, st.updateHealth(now, now.Add(time.Second)))
+	}
+}
+
+func TestGWStateProbe(t *testing.T) {
+	st := &gwState{}
+	st.updateProbe(100*time.Millisecond, nil)
+	assert.Equal(t, 100*time.Millisecond, st.rtt)
+	st.updateProbe(200*time.Millisecond, nil)
+	assert.Equal(t, 130*time.Millisecond, st.rtt)
+	st.updateProbe(0, errors.New("timeout"))
+	assert.Equal(t, 1, st.probeFailures)
+	assert.Equal(t, 130*time.Millisecond, st.rtt)
+	st.updateProbe(130*time.Millisecond, nil)
+	assert.Equal(t, 0, st.probeFailures)
+}
+
+func TestGetRouteMap(t *testing.T) {
+	gws := []*userv1.Gateway{
+		{Id: "eu1", Region: "eu"},
+		{Id: "eu2", Region: "eu"},
+		{Id: "apac1", Region: "apac"},
+		{Id: "apac2", Region: "apac"},
+		{Id: "us1", Region: "us"},
+	}
+
+	stateMap := map[string]*gwState{
+		"eu1":   {hasProbed: true, rtt: 200 * time.Millisecond},
+		"eu2":   {hasProbed: true, rtt: 180 * time.Millisecond},
+		"apac1": {hasProbed: true, rtt: 20 * time.Millisecond},
+		"apac2": {hasProbed: true, rtt: 15 * time.Millisecond},
+		"us1":   {hasProbed: true, rtt: 120 * time.Millisecond},
+	}
+
+	preferred := getPreferredGateways(gws, stateMap, nil)
+	assert.Equal(t, map[string]string{
+		"eu":   "eu2",
+		"apac": "apac2",
+		"us":   "us1",
+	}, preferred)
+	assert.Equal(t, map[string]string{
+		"eu1":   "eu2",
+		"apac1": "apac2",
+	}, getRouteMap(gws, stateMap, preferred))
+
+	stateMap["apac1"].hasProbed = false
+	assert.Equal(t, map[string]string{
+		"eu1": "eu2",
+	}, getRouteMap(gws, stateMap, getPreferredGateways(gws, stateMap, preferred)))
+	stateMap["apac1"].hasProbed = true
+
+	stateMap["apac2"].isFailed = true
+	stateMap["us1"].isFailed = true
+	preferred = getPreferredGateways(gws, stateMap, preferred)
+	assert.Equal(t, map[string]string{
+		"eu":   "eu2",
+		"apac": "apac1",
+	}, preferred)
+	assert.Equal(t, map[string]string{
+		"eu1":   "eu2",
+		"apac2": "apac1",
+		"us1":   "apac1",
+	}, getRouteMap(gws, stateMap, preferred))
+
+	for _, st := range stateMap {
+		st.isFailed = true
+	}
+	preferred = getPreferredGateways(gws, stateMap, preferred)
+	assert.Equal(t, map[string]string{}, preferred)
+	assert.Equal(t, map[string]string{}, getRouteMap(gws, stateMap, preferred))
+
+	assert.True(t, isRouteMapEqual(map[string]string{}, nil))
+	assert.False(t, isRouteMapEqual(map[string]string{"a": "b"}, map[string]string{"a": "c"}))
+}
+
+func TestGetPreferredGatewaysHysteresis(t *testing.T) {
+	gws := []*userv1.Gateway{
+		{Id: "eu1", Region: "eu"},
+		{Id: "eu2", Region: "eu"},
+	}
+
+	stateMap := map[string]*gwState{
+		"eu1": {hasProbed: true, rtt: 50 * time.Millisecond},
+		"eu2": {hasProbed: true, rtt: 45 * time.Millisecond},
+	}
+
+	prev := map[string]string{"eu": "eu1"}
+	assert.Equal(t, prev, getPreferredGateways(gws, stateMap, prev))
+
+	stateMap["eu2"].rtt = 30 * time.Millisecond
+	assert.Equal(t, map[string]string{"eu": "eu2"}, getPreferredGateways(gws, stateMap, prev))
+
+	stateMap["eu1"].isFailed = true
+	stateMap["eu2"].rtt = 49 * time.Millisecond
+	assert.Equal(t, map[string]string{"eu": "eu2"}, getPreferredGateways(gws, stateMap, prev))
+
+	stateMap["eu1"].isFailed = false
+	stateMap["eu1"].hasProbed = false
+	assert.Equal(t, map[string]string{"eu": "eu2"}, getPreferredGateways(gws, stateMap, prev))
+}
+
+func TestGetGatewayRoutedCIDRs(t *testing.T) {
+	c := &Controller{
+		ipv4Supported: true,
+		c: &cliconfigv1.Connection{
+			Connection: &userv1.ConnectionState{
+				Gateways: []*userv1.Gateway{
+					{Id: "gw1", CIDRs: []string{"10.0.1.0/24", "fd00:1::/64"}},
+					{Id: "gw2", CIDRs: []string{"10.0.2.0/24"}},
+					{Id: "gw3", CIDRs: []string{"10.0.3.0/24"}},
+				},
+			},
+		},
+	}
+
+	gws := c.c.Connection.Gateways
+
+	assert.Equal(t, []string{"10.0.1.0/24", "fd00:1::/64"}, c.getGatewayRoutedCIDRs(gws[0]))
+	assert.Nil(t, c.GetGatewayStats())
+
+	c.gwSelector = newGWSelector(c)
+	c.gwSelector.routeMap = map[string]string{
+		"gw1": "gw2",
+		"gw3": "gw2",
+	}
+
+	assert.Equal(t, []string{"10.0.1.1/32"}, c.getGatewayRoutedCIDRs(gws[0]))
+	assert.Equal(t, []string{"10.0.2.0/24", "10.0.1.0/24", "fd00:1::/64", "10.0.3.0/24"}, c.getGatewayRoutedCIDRs(gws[1]))
+	assert.Equal(t, []string{"10.0.3.1/32"}, c.getGatewayRoutedCIDRs(gws[2]))
+
+	stats := c.GetGatewayStats()
+	assert.Equal(t, 3, len(stats))
+	assert.Equal(t, "gw2", stats[0].ViaGatewayID)
+	assert.Equal(t, "", stats[1].ViaGatewayID)
+}
+
+func TestGetGatewayProbeAddr(t *testing.T) {
+	c := &Controller{
+		ipv6Supported: true,
+	}
+
+	addr, err := c.getGatewayProbeAddr(&userv1.Gateway{
+		CIDRs: []string{"10.0.1.0/24", "fd00:1::/64"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "fd00:1::1", addr.String())
+
+	c.ipv4Supported = true
+	addr, err = c.getGatewayProbeAddr(&userv1.Gateway{
+		CIDRs: []string{"10.0.1.0/24", "fd00:1::/64"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "10.0.1.1", addr.String())
+
+	_, err = c.getGatewayProbeAddr(&userv1.Gateway{})
+	assert.NotNil(t, err)
+}
+
+func TestGetUAPIHandshakes(t *testing.T) {
+	k1, _ := wgtypes.GeneratePrivateKey()
+	k2, _ := wgtypes.GeneratePrivateKey()
+	pk1 := k1.PublicKey()
+	pk2 := k2.PublicKey()
+
+	res := getUAPIHandshakes(fmt.Sprintf(`private_key=abcd
+public_key=%s
+last_handshake_time_sec=0
+last_handshake_time_nsec=0
+public_key=%s
+last_handshake_time_sec=1760000000
+last_handshake_time_nsec=12
+`, hex.EncodeToString(pk1[:]), hex.EncodeToString(pk2[:])))
+
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, time.Unix(1760000000, 12), res[pk2])
+
+	assert.Equal(t, time.Unix(1760000000, 12), getGatewayHandshake(&userv1.Gateway{
+		Wireguard: &userv1.Gateway_WireGuard{
+			PublicKey: pk2.String(),
+		},
+	}, res))
+	assert.True(t, getGatewayHandshake(&userv1.Gateway{
+		Wireguard: &userv1.Gateway_WireGuard{
+			PublicKey: pk1.String(),
+		},
+	}, res).IsZero())
+}
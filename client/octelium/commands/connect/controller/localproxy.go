@@ -0,0 +1,135 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+
+	"github.com/miekg/dns"
+	"github.com/pkg/errors"
+)
+
+// localProxyDialer dials the targets of the local SOCKS5/HTTP proxy. Cluster
+// hostnames and addresses are resolved through the Cluster private DNS and
+// dialed through the tunnel, while any other target is dialed directly.
+type localProxyDialer struct {
+	ctl *Controller
+}
+
+func (d *localProxyDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	return d.ctl.DialContext(ctx, network, address)
+}
+
+// DialContext dials the address through the tunnel if its host is a Cluster
+// hostname or address. Otherwise the address is dialed directly.
+func (c *Controller) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+
+	host, isCluster := c.getClusterHost(host)
+	if !isCluster {
+		return (&net.Dialer{}).DialContext(ctx, network, address)
+	}
+
+	if nsNet := c.GetNetstackNet(); nsNet != nil {
+		return nsNet.DialContext(ctx, network, net.JoinHostPort(host, port))
+	}
+
+	// The tunnel routes are set in the host network namespace in the non
+	// netstack modes. Only the name resolution has to be done here since the
+	// host might not be using the Cluster private DNS.
+	ip, err := c.resolveClusterHost(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+
+	return (&net.Dialer{}).DialContext(ctx, network, net.JoinHostPort(ip.String(), port))
+}
+
+// getClusterHost returns whether the host is a Cluster hostname or an address
+// that belongs to the Gateways' Service ranges. Single-label hostnames are
+// considered names of Services in the default Namespace and are completed
+// with the Cluster DNS search domain.
+func (c *Controller) getClusterHost(host string) (string, bool) {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		for _, gw := range c.c.Connection.Gateways {
+			for _, cidrStr := range gw.CIDRs {
+				if cidr, err := netip.ParsePrefix(cidrStr); err == nil && cidr.Contains(addr.Unmap()) {
+					return host, true
+				}
+			}
+		}
+		return host, false
+	}
+
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	domain := c.c.Info.Cluster.Domain
+
+	if host == domain || strings.HasSuffix(host, fmt.Sprintf(".%s", domain)) {
+		return host, true
+	}
+
+	if host != "" && host != "localhost" && !strings.Contains(host, ".") {
+		return fmt.Sprintf("%s.%s", host, c.getDNSSearchDomains()[0]), true
+	}
+
+	return host, false
+}
+
+func (c *Controller) resolveClusterHost(ctx context.Context, host string) (net.IP, error) {
+	dnsServer := c.getCurrentDNS()
+	if dnsServer == nil {
+		return nil, errors.Errorf("No Cluster DNS server is set")
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		return ip, nil
+	}
+
+	var qtypes []uint16
+	if c.ipv4Supported {
+		qtypes = append(qtypes, dns.TypeA)
+	}
+	if c.ipv6Supported {
+		qtypes = append(qtypes, dns.TypeAAAA)
+	}
+
+	client := dns.Client{}
+	for _, qtype := range qtypes {
+		m := &dns.Msg{}
+		m.SetQuestion(dns.Fqdn(host), qtype)
+
+		r, _, err := client.ExchangeContext(ctx, m, net.JoinHostPort(dnsServer.String(), "53"))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ans := range r.Answer {
+			switch record := ans.(type) {
+			case *dns.A:
+				return record.A, nil
+			case *dns.AAAA:
+				return record.AAAA, nil
+			}
+		}
+	}
+
+	return nil, errors.Errorf("Could not resolve Cluster host: %s", host)
+}
@@ -0,0 +1,64 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package controller
+
+import (
+	"testing"
+
+	"github.com/octelium/octelium/apis/client/cliconfigv1"
+	"github.com/octelium/octelium/apis/main/userv1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetClusterHost(t *testing.T) {
+	c := &Controller{
+		c: &cliconfigv1.Connection{
+			Info: &cliconfigv1.Connection_Info{
+				Cluster: &cliconfigv1.Connection_Info_Cluster{
+					Domain: "example.com",
+				},
+			},
+			Connection: &userv1.ConnectionState{
+				Gateways: []*userv1.Gateway{
+					{
+						CIDRs: []string{"100.64.0.0/24", "fdee::/112"},
+					},
+				},
+			},
+		},
+	}
+
+	tstCases := []struct {
+		host      string
+		out       string
+		isCluster bool
+	}{
+		{host: "svc1.ns1.local.example.com", out: "svc1.ns1.local.example.com", isCluster: true},
+		{host: "SVC1.example.com.", out: "svc1.example.com", isCluster: true},
+		{host: "example.com", out: "example.com", isCluster: true},
+		{host: "svc1", out: "svc1.local.example.com", isCluster: true},
+		{host: "100.64.0.10", out: "100.64.0.10", isCluster: true},
+		{host: "fdee::5", out: "fdee::5", isCluster: true},
+		{host: "localhost", out: "localhost", isCluster: false},
+		{host: "notexample.com", out: "notexample.com", isCluster: false},
+		{host: "www.google.com", out: "www.google.com", isCluster: false},
+		{host: "100.64.1.10", out: "100.64.1.10", isCluster: false},
+	}
+
+	for _, tstCase := range tstCases {
+		out, isCluster := c.getClusterHost(tstCase.host)
+		assert.Equal(t, tstCase.out, out, "%s", tstCase.host)
+		assert.Equal(t, tstCase.isCluster, isCluster, "%s", tstCase.host)
+	}
+}
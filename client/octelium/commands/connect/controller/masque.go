@@ -0,0 +1,276 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"time"
+
+	"github.com/octelium/octelium/client/common/cliutils"
+	"github.com/octelium/octelium/pkg/utils"
+	"github.com/octelium/octelium/pkg/utils/ldflags"
+	"github.com/octelium/octelium/pkg/utils/masque"
+	"github.com/pkg/errors"
+	"github.com/quic-go/quic-go"
+	"github.com/quic-go/quic-go/http3"
+	"github.com/quic-go/quic-go/quicvarint"
+	"go.uber.org/zap"
+)
+
+// masqueConn is a MASQUE CONNECT-IP (RFC 9484) request stream whose HTTP
+// datagrams carry the IP packets to and from the Gateway.
+type masqueConn struct {
+	conn     *quic.Conn
+	str      *http3.RequestStream
+	ctx      context.Context
+	cancelFn context.CancelFunc
+}
+
+func newMASQUEConn(conn *quic.Conn, str *http3.RequestStream) *masqueConn {
+	ctx, cancelFn := context.WithCancel(str.Context())
+	return &masqueConn{
+		conn:     conn,
+		str:      str,
+		ctx:      ctx,
+		cancelFn: cancelFn,
+	}
+}
+
+func (c *masqueConn) SendDatagram(b []byte) error {
+	return c.str.SendDatagram(masque.EncodeDatagram(b))
+}
+
+func (c *masqueConn) ReceiveDatagram(ctx context.Context) ([]byte, error) {
+	for {
+		msg, err := c.str.ReceiveDatagram(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		pkt, err := masque.DecodeDatagram(msg)
+		if err != nil {
+			continue
+		}
+
+		return pkt, nil
+	}
+}
+
+func (c *masqueConn) Context() context.Context {
+	return c.ctx
+}
+
+func (c *masqueConn) Close() error {
+	c.cancelFn()
+	c.str.Close()
+	return c.conn.CloseWithError(0, "")
+}
+
+func (c *quicGW) connectMASQUE(ctx context.Context) error {
+	tlsCfg := &tls.Config{
+		MinVersion:         tls.VersionTLS13,
+		MaxVersion:         tls.VersionTLS13,
+		NextProtos:         []string{http3.NextProtoH3},
+		InsecureSkipVerify: ldflags.IsDev() || utils.IsInsecureTLS(),
+	}
+
+	quicCfg := &quic.Config{
+		EnableDatagrams: true,
+		Versions:        []quic.Version{quic.Version1, quic.Version2},
+		KeepAlivePeriod: func() time.Duration {
+			if c.gw.Masque.KeepAliveSeconds == 0 {
+				return 30 * time.Second
+			}
+			return time.Duration(c.gw.Masque.KeepAliveSeconds) * time.Second
+		}(),
+	}
+
+	addr := net.JoinHostPort(c.gw.Hostname, fmt.Sprintf("%d", c.gw.Masque.Port))
+
+	zap.L().Debug("Connecting to MASQUE gw", zap.String("id", c.gw.Id), zap.String("addr", addr))
+	conn, err := quic.DialAddr(ctx, addr, tlsCfg, quicCfg)
+	if err != nil {
+		return errors.Errorf("Could not dial MASQUE gw: %s: %+v", c.gw.Id, err)
+	}
+
+	mconn, routes, err := c.doConnectMASQUE(ctx, conn, addr)
+	if err != nil {
+		conn.CloseWithError(0, "")
+		return err
+	}
+
+	c.conn = mconn
+	c.routes = routes
+
+	go c.startMASQUECapsuleLoop(mconn)
+
+	zap.L().Debug("Successfully connected to MASQUE gw", zap.String("id", c.gw.Id))
+
+	return nil
+}
+
+func (c *quicGW) doConnectMASQUE(ctx context.Context, conn *quic.Conn, addr string) (*masqueConn, []masque.IPRange, error) {
+	clientConn := (&http3.Transport{
+		EnableDatagrams: true,
+	}).NewClientConn(conn)
+
+	select {
+	case <-clientConn.ReceivedSettings():
+	case <-ctx.Done():
+		return nil, nil, ctx.Err()
+	case <-time.After(5 * time.Second):
+		return nil, nil, errors.Errorf("Timeout waiting for the gw HTTP/3 settings")
+	}
+
+	if settings := clientConn.Settings(); !settings.EnableExtendedConnect || !settings.EnableDatagrams {
+		return nil, nil, errors.Errorf("The gw does not support extended CONNECT with HTTP datagrams")
+	}
+
+	str, err := clientConn.OpenRequestStream(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sessToken, err := cliutils.GetDB().GetSessionToken(c.engine.ctl.c.Info.Cluster.Domain)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		Proto:  masque.Protocol,
+		Host:   addr,
+		URL: &url.URL{
+			Scheme: "https",
+			Host:   addr,
+			Path:   masque.Path,
+		},
+		Header: http.Header{
+			http3.CapsuleProtocolHeader: []string{"?1"},
+			"Authorization":             []string{fmt.Sprintf("Bearer %s", sessToken.AccessToken)},
+		},
+	}
+
+	zap.L().Debug("Sending CONNECT-IP request", zap.String("id", c.gw.Id))
+	if err := str.SendRequestHeader(req); err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := str.ReadResponse()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, errors.Errorf("Could not establish CONNECT-IP tunnel: %s", resp.Status)
+	}
+
+	if err := str.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		return nil, nil, err
+	}
+
+	addrs, routes, err := readMASQUEInitCapsules(quicvarint.NewReader(str))
+	if err != nil {
+		return nil, nil, errors.Errorf("Could not read initial CONNECT-IP capsules: %+v", err)
+	}
+
+	if err := str.SetReadDeadline(time.Time{}); err != nil {
+		return nil, nil, err
+	}
+
+	for _, addr := range addrs {
+		if !c.isAssignedAddrValid(addr.Prefix.Addr()) {
+			return nil, nil, errors.Errorf("The gw assigned an unknown address: %s", addr.Prefix.String())
+		}
+	}
+
+	return newMASQUEConn(conn, str), routes, nil
+}
+
+// readMASQUEInitCapsules reads the capsules until both the Gateway's
+// ADDRESS_ASSIGN and ROUTE_ADVERTISEMENT capsules are received.
+func readMASQUEInitCapsules(r quicvarint.Reader) ([]masque.AssignedAddress, []masque.IPRange, error) {
+	var addrs []masque.AssignedAddress
+	var routes []masque.IPRange
+	var hasAddrs, hasRoutes bool
+
+	for !hasAddrs || !hasRoutes {
+		typ, value, err := masque.ReadCapsule(r)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		switch typ {
+		case masque.CapsuleTypeAddressAssign:
+			addrs, err = masque.ParseAddressAssign(bytes.NewReader(value))
+			if err != nil {
+				return nil, nil, err
+			}
+			hasAddrs = true
+		case masque.CapsuleTypeRouteAdvertisement:
+			routes, err = masque.ParseRouteAdvertisement(bytes.NewReader(value))
+			if err != nil {
+				return nil, nil, err
+			}
+			hasRoutes = true
+		}
+	}
+
+	return addrs, routes, nil
+}
+
+func (c *quicGW) isAssignedAddrValid(addr netip.Addr) bool {
+	for _, connAddr := range c.engine.ctl.c.Connection.Addresses {
+		for _, prefixStr := range []string{connAddr.V4, connAddr.V6} {
+			if prefixStr == "" {
+				continue
+			}
+			prefix, err := netip.ParsePrefix(prefixStr)
+			if err != nil {
+				continue
+			}
+			if prefix.Addr() == addr {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// startMASQUECapsuleLoop keeps reading the request stream for the capsules
+// sent after the tunnel is established. The tunnel is closed once the
+// stream is closed by the Gateway.
+func (c *quicGW) startMASQUECapsuleLoop(conn *masqueConn) {
+	defer conn.cancelFn()
+
+	r := quicvarint.NewReader(conn.str)
+	for {
+		typ, _, err := masque.ReadCapsule(r)
+		if err != nil {
+			zap.L().Debug("MASQUE capsule loop exited", zap.String("id", c.gw.Id), zap.Error(err))
+			return
+		}
+
+		zap.L().Debug("Received MASQUE capsule", zap.String("id", c.gw.Id), zap.Uint64("type", uint64(typ)))
+	}
+}
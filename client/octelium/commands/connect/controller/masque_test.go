@@ -0,0 +1,81 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"bytes"
+	"net/netip"
+	"testing"
+
+	"github.com/octelium/octelium/apis/client/cliconfigv1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/apis/main/userv1"
+	"github.com/octelium/octelium/pkg/utils/masque"
+	"github.com/quic-go/quic-go/quicvarint"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadMASQUEInitCapsules(t *testing.T) {
+	addrs := []masque.AssignedAddress{
+		{
+			Prefix: netip.MustParsePrefix("100.64.1.2/32"),
+		},
+	}
+	routes := []masque.IPRange{
+		masque.PrefixToRange(netip.MustParsePrefix("100.64.0.0/16")),
+	}
+
+	{
+		buf := &bytes.Buffer{}
+		assert.Nil(t, masque.WriteRouteAdvertisement(buf, routes))
+		assert.Nil(t, masque.WriteAddressAssign(buf, addrs))
+
+		resAddrs, resRoutes, err := readMASQUEInitCapsules(quicvarint.NewReader(buf))
+		assert.Nil(t, err)
+		assert.Equal(t, addrs, resAddrs)
+		assert.Equal(t, routes, resRoutes)
+	}
+
+	{
+		buf := &bytes.Buffer{}
+		assert.Nil(t, masque.WriteAddressAssign(buf, addrs))
+
+		_, _, err := readMASQUEInitCapsules(quicvarint.NewReader(buf))
+		assert.NotNil(t, err, "route advertisement is missing")
+	}
+}
+
+func TestIsAssignedAddrValid(t *testing.T) {
+	gw := &quicGW{
+		engine: &quicEngine{
+			ctl: &Controller{
+				c: &cliconfigv1.Connection{
+					Connection: &userv1.ConnectionState{
+						Addresses: []*metav1.DualStackNetwork{
+							{
+								V4: "100.64.1.2/32",
+								V6: "fdee::2/128",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	assert.True(t, gw.isAssignedAddrValid(netip.MustParseAddr("100.64.1.2")))
+	assert.True(t, gw.isAssignedAddrValid(netip.MustParseAddr("fdee::2")))
+	assert.False(t, gw.isAssignedAddrValid(netip.MustParseAddr("100.64.1.3")))
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/octelium/octelium/pkg/common/pbutils"
 	"github.com/octelium/octelium/pkg/utils"
 	"github.com/octelium/octelium/pkg/utils/ldflags"
+	"github.com/octelium/octelium/pkg/utils/masque"
 	"github.com/pkg/errors"
 	"github.com/quic-go/quic-go"
 	"go.uber.org/zap"
@@ -81,6 +82,8 @@ type quicEngine struct {
 	mu            sync.Mutex
 	isClosed      bool
 	clusterDomain string
+	isMASQUE      bool
+	isTLS         bool
 
 	gwCloseCh chan string
 }
@@ -90,10 +93,29 @@ type quicGWMap struct {
 	gwMap map[string]*quicGW
 }
 
+// gwConn is the transport carrying the IP packets to and from a Gateway. It is
+// implemented by QUICv0 connections, MASQUE CONNECT-IP streams and TLS
+// fallback WebSocket connections.
+type gwConn interface {
+	SendDatagram(b []byte) error
+	ReceiveDatagram(ctx context.Context) ([]byte, error)
+	Context() context.Context
+	Close() error
+}
+
+type quicv0Conn struct {
+	*quic.Conn
+}
+
+func (c *quicv0Conn) Close() error {
+	return c.CloseWithError(0, "")
+}
+
 type quicGW struct {
 	sync.Mutex
 	gw            *userv1.Gateway
-	conn          *quic.Conn
+	conn          gwConn
+	routes        []masque.IPRange
 	tunCh         chan<- []byte
 	gwCh          chan []byte
 	cidrs         []netip.Prefix
@@ -114,6 +136,8 @@ func newQUICEngine(ctl *Controller) *quicEngine {
 			gwMap: make(map[string]*quicGW),
 		},
 		clusterDomain: ctl.c.Info.Cluster.Domain,
+		isMASQUE:      ctl.isMASQUE,
+		isTLS:         ctl.isTLS,
 	}
 }
 
@@ -223,7 +247,16 @@ func (c *quicEngine) addGW(ctx context.Context, gw *userv1.Gateway) error {
 
 func newQUIGW(engine *quicEngine, gw *userv1.Gateway) (*quicGW, error) {
 
-	if gw.Quicv0 == nil {
+	switch {
+	case engine.isMASQUE:
+		if gw.Masque == nil {
+			return nil, errors.Errorf("Gateway %s does not have MASQUE info", gw.Id)
+		}
+	case engine.isTLS:
+		if gw.Tls == nil {
+			return nil, errors.Errorf("Gateway %s does not have TLS info", gw.Id)
+		}
+	case gw.Quicv0 == nil:
 		return nil, errors.Errorf("Gateway %s does not have QUICv0 info", gw.Id)
 	}
 
@@ -319,7 +352,13 @@ func decodeMsg(stream *quic.Stream) ([]byte, uint32, error) {
 }
 
 func (c *quicGW) connect(ctx context.Context) error {
-	var err error
+	if c.engine.isMASQUE {
+		return c.connectMASQUE(ctx)
+	}
+
+	if c.engine.isTLS {
+		return c.connectTLS(ctx)
+	}
 
 	tlsCfg := &tls.Config{
 		MinVersion:         tls.VersionTLS13,
@@ -342,13 +381,14 @@ func (c *quicGW) connect(ctx context.Context) error {
 	addr := net.JoinHostPort(c.gw.Hostname, fmt.Sprintf("%d", c.gw.Quicv0.Port))
 
 	zap.L().Debug("Connecting to gw", zap.String("id", c.gw.Id), zap.String("addr", addr))
-	c.conn, err = quic.DialAddr(ctx, addr, tlsCfg, quicCfg)
+	conn, err := quic.DialAddr(ctx, addr, tlsCfg, quicCfg)
 	if err != nil {
 		return errors.Errorf("Could not dial QUIC gw: %s: %+v", c.gw.Id, err)
 	}
+	c.conn = &quicv0Conn{conn}
 
 	zap.L().Debug("Opening the init stream", zap.String("id", c.gw.Id))
-	initStream, err := c.conn.OpenStreamSync(ctx)
+	initStream, err := conn.OpenStreamSync(ctx)
 	if err != nil {
 		return err
 	}
@@ -439,7 +479,7 @@ func (c *quicGW) close() {
 	c.gwCloseCh <- c.gw.Id
 
 	if c.conn != nil {
-		c.conn.CloseWithError(0, "")
+		c.conn.Close()
 	}
 }
 
@@ -590,6 +630,15 @@ func (c *quicEngine) getGWFromPkt(pkt []byte) *quicGW {
 	}
 
 	for _, gw := range c.quicGWMap.gwMap {
+		if len(gw.routes) > 0 {
+			for _, route := range gw.routes {
+				if route.Contains(dst) {
+					return gw
+				}
+			}
+			continue
+		}
+
 		for _, cidr := range gw.cidrs {
 			if cidr.Contains(dst) {
 				return gw
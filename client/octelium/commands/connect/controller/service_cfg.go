@@ -26,6 +26,10 @@ import (
 )
 
 func (c *Controller) setServiceConfigs() error {
+	if c.isEmbedded() {
+		return nil
+	}
+
 	for _, svcCfg := range c.c.Connection.ServiceConfigs {
 		switch svcCfg.Type.(type) {
 		case *userv1.ConnectionState_ServiceConfig_Ssh:
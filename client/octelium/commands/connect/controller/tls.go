@@ -0,0 +1,170 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package controller
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/octelium/octelium/client/common/cliutils"
+	"github.com/octelium/octelium/octelium-go/authc"
+	"github.com/octelium/octelium/pkg/utils"
+	"github.com/octelium/octelium/pkg/utils/ldflags"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	tlsPingInterval = 15 * time.Second
+	tlsReadTimeout  = 45 * time.Second
+)
+
+// wsConn is a WebSocket connection to a Gateway carried through the Cluster's
+// public TLS ingress. Each binary message carries exactly one IP packet.
+type wsConn struct {
+	conn     *websocket.Conn
+	ctx      context.Context
+	cancelFn context.CancelFunc
+
+	mu sync.Mutex
+}
+
+func newWSConn(conn *websocket.Conn) *wsConn {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	ret := &wsConn{
+		conn:     conn,
+		ctx:      ctx,
+		cancelFn: cancelFn,
+	}
+
+	conn.SetReadDeadline(time.Now().Add(tlsReadTimeout))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(tlsReadTimeout))
+	})
+
+	go ret.startPingLoop()
+
+	return ret
+}
+
+func (c *wsConn) startPingLoop() {
+	ticker := time.NewTicker(tlsPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+			c.mu.Lock()
+			err := c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(5*time.Second))
+			c.mu.Unlock()
+			if err != nil {
+				zap.L().Debug("Could not send WebSocket ping", zap.Error(err))
+				c.cancelFn()
+				return
+			}
+		}
+	}
+}
+
+func (c *wsConn) SendDatagram(b []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+	return c.conn.WriteMessage(websocket.BinaryMessage, b)
+}
+
+func (c *wsConn) ReceiveDatagram(ctx context.Context) ([]byte, error) {
+	for {
+		typ, msg, err := c.conn.ReadMessage()
+		if err != nil {
+			// Read errors are permanent for WebSocket connections
+			c.cancelFn()
+			return nil, err
+		}
+
+		if typ != websocket.BinaryMessage {
+			continue
+		}
+
+		c.conn.SetReadDeadline(time.Now().Add(tlsReadTimeout))
+
+		return msg, nil
+	}
+}
+
+func (c *wsConn) Context() context.Context {
+	return c.ctx
+}
+
+func (c *wsConn) Close() error {
+	c.cancelFn()
+
+	c.mu.Lock()
+	c.conn.WriteControl(websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+	c.mu.Unlock()
+
+	return c.conn.Close()
+}
+
+func getTLSTunnelURL(domain, path string) string {
+	return (&url.URL{
+		Scheme: "wss",
+		Host:   authc.GetAPIServerAddr(domain),
+		Path:   path,
+	}).String()
+}
+
+func (c *quicGW) connectTLS(ctx context.Context) error {
+	sessToken, err := cliutils.GetDB().GetSessionToken(c.engine.ctl.c.Info.Cluster.Domain)
+	if err != nil {
+		return err
+	}
+
+	dialer := &websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		HandshakeTimeout: 10 * time.Second,
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: ldflags.IsDev() || utils.IsInsecureTLS(),
+		},
+	}
+
+	tunURL := getTLSTunnelURL(c.clusterDomain, c.gw.Tls.Path)
+
+	zap.L().Debug("Connecting to TLS gw", zap.String("id", c.gw.Id), zap.String("url", tunURL))
+	conn, resp, err := dialer.DialContext(ctx, tunURL, http.Header{
+		"Authorization": []string{fmt.Sprintf("Bearer %s", sessToken.AccessToken)},
+	})
+	if err != nil {
+		if resp != nil {
+			return errors.Errorf("Could not dial TLS gw: %s: %s", c.gw.Id, resp.Status)
+		}
+		return errors.Errorf("Could not dial TLS gw: %s: %+v", c.gw.Id, err)
+	}
+
+	c.conn = newWSConn(conn)
+
+	zap.L().Debug("Successfully connected to TLS gw", zap.String("id", c.gw.Id))
+
+	return nil
+}
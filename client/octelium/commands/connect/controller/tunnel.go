@@ -0,0 +1,90 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package controller
+
+import (
+	"bufio"
+	"context"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// WaitTunnelUp waits until the tunnel has successfully exchanged traffic with
+// at least one Gateway or the timeout expires. QUIC-based connections are
+// considered to be up once the controller is started since connecting to
+// their Gateways is already part of starting the controller. This is used to
+// detect networks that silently drop the WireGuard UDP traffic.
+func (c *Controller) WaitTunnelUp(ctx context.Context, timeout time.Duration) bool {
+	if c.isQUIC {
+		return true
+	}
+
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		if c.hasWGHandshake() {
+			return true
+		}
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-timer.C:
+			zap.L().Debug("No WireGuard handshake has been completed", zap.Duration("timeout", timeout))
+			return false
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Controller) hasWGHandshake() bool {
+	if c.dev != nil {
+		uapi, err := c.dev.IpcGet()
+		if err != nil {
+			return false
+		}
+
+		return hasUAPIHandshake(uapi)
+	}
+
+	dev, err := c.wgC.Device(c.c.Preferences.DeviceName)
+	if err != nil {
+		return false
+	}
+
+	for _, peer := range dev.Peers {
+		if !peer.LastHandshakeTime.IsZero() && peer.LastHandshakeTime.Unix() > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasUAPIHandshake(uapi string) bool {
+	scanner := bufio.NewScanner(strings.NewReader(uapi))
+	for scanner.Scan() {
+		val, ok := strings.CutPrefix(scanner.Text(), "last_handshake_time_sec=")
+		if ok && val != "" && val != "0" {
+			return true
+		}
+	}
+
+	return false
+}
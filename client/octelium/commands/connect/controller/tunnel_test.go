@@ -0,0 +1,37 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasUAPIHandshake(t *testing.T) {
+	assert.False(t, hasUAPIHandshake(""))
+	assert.False(t, hasUAPIHandshake(`private_key=abcd
+public_key=efgh
+last_handshake_time_sec=0
+last_handshake_time_nsec=0
+`))
+	assert.True(t, hasUAPIHandshake(`private_key=abcd
+public_key=efgh
+last_handshake_time_sec=0
+last_handshake_time_nsec=0
+public_key=ijkl
+last_handshake_time_sec=1760000000
+last_handshake_time_nsec=12
+`))
+}
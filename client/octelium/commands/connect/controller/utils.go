@@ -41,7 +41,7 @@ func (c *Controller) toUAPI() string {
 
 		output.WriteString("replace_allowed_ips=true\n")
 
-		for _, svcCIDR := range gw.CIDRs {
+		for _, svcCIDR := range c.getGatewayRoutedCIDRs(gw) {
 			ip, _, _ := net.ParseCIDR(svcCIDR)
 			if govalidator.IsIPv4(ip.String()) && c.ipv4Supported {
 				output.WriteString(fmt.Sprintf("allowed_ip=%s\n", svcCIDR))
@@ -82,7 +82,7 @@ func (c *Controller) setWGDev() error {
 func (c *Controller) getWGPeerAllowedIPs(gw *userv1.Gateway) ([]net.IPNet, error) {
 	allowedIPs := []net.IPNet{}
 
-	for _, cidrStr := range gw.CIDRs {
+	for _, cidrStr := range c.getGatewayRoutedCIDRs(gw) {
 		mip, cidr, err := net.ParseCIDR(cidrStr)
 		if err != nil {
 			return nil, err
@@ -65,7 +65,7 @@ func (c *Controller) getWgConf() (*conf.Config, error) {
 		}
 
 		allowedIPs := []netip.Prefix{}
-		for _, svcCIDR := range gw.CIDRs {
+		for _, svcCIDR := range c.getGatewayRoutedCIDRs(gw) {
 
 			netaddr, err := netip.ParsePrefix(svcCIDR)
 			if err != nil {
@@ -39,10 +39,10 @@ type Opts struct {
 }
 
 type Server struct {
-	domain    string
-	hasV4     bool
-	hasV6     bool
-	dnsGetter DNSGetter
+	clusters struct {
+		sync.RWMutex
+		items []*cluster
+	}
 
 	srv      *dns.Server
 	mu       sync.Mutex
@@ -58,28 +58,44 @@ type DNSGetter interface {
 	GetClusterDNSServers() []string
 }
 
-func NewDNSServer(opts *Opts) (*Server, error) {
+// cluster is a Cluster served by the local DNS server. Queries are routed to
+// the Cluster whose domain matches the query name.
+type cluster struct {
+	domain    string
+	hasV4     bool
+	hasV6     bool
+	dnsGetter DNSGetter
+}
 
-	listenAddr := func() string {
-		if opts.ListenAddr != "" {
-			if _, _, err := net.SplitHostPort(opts.ListenAddr); err == nil {
-				return opts.ListenAddr
-			}
-			if govalidator.IsIP(opts.ListenAddr) {
-				return net.JoinHostPort(opts.ListenAddr, "53")
-			}
-			return ""
-		}
-		return "127.0.0.100:53"
-	}()
-	if listenAddr == "" {
-		return nil, errors.Errorf("Local DNS: invalid listen address: %s", opts.ListenAddr)
-	}
-	return &Server{
+func newCluster(opts *Opts) *cluster {
+	return &cluster{
 		domain:    opts.ClusterDomain,
 		hasV4:     opts.HasV4,
 		hasV6:     opts.HasV6,
 		dnsGetter: opts.DNSGetter,
+	}
+}
+
+func getListenAddr(arg string) string {
+	if arg != "" {
+		if _, _, err := net.SplitHostPort(arg); err == nil {
+			return arg
+		}
+		if govalidator.IsIP(arg) {
+			return net.JoinHostPort(arg, "53")
+		}
+		return ""
+	}
+	return "127.0.0.100:53"
+}
+
+func NewDNSServer(opts *Opts) (*Server, error) {
+
+	listenAddr := getListenAddr(opts.ListenAddr)
+	if listenAddr == "" {
+		return nil, errors.Errorf("Local DNS: invalid listen address: %s", opts.ListenAddr)
+	}
+	ret := &Server{
 
 		/*
 			useFallback: opts.UseFallback,
@@ -110,7 +126,69 @@ func NewDNSServer(opts *Opts) (*Server, error) {
 		*/
 		cache:      newCache(),
 		listenAddr: listenAddr,
-	}, nil
+	}
+
+	ret.AddCluster(opts)
+
+	return ret, nil
+}
+
+// AddCluster adds a Cluster to the server, replacing any Cluster that was
+// previously added with the same domain.
+func (s *Server) AddCluster(opts *Opts) {
+	s.clusters.Lock()
+	defer s.clusters.Unlock()
+
+	for i, itm := range s.clusters.items {
+		if itm.domain == opts.ClusterDomain {
+			s.clusters.items[i] = newCluster(opts)
+			return
+		}
+	}
+
+	s.clusters.items = append(s.clusters.items, newCluster(opts))
+}
+
+// RemoveCluster removes the Cluster of the given domain and returns the
+// number of the remaining Clusters.
+func (s *Server) RemoveCluster(domain string) int {
+	s.clusters.Lock()
+	defer s.clusters.Unlock()
+
+	for i, itm := range s.clusters.items {
+		if itm.domain == domain {
+			s.clusters.items = append(s.clusters.items[:i], s.clusters.items[i+1:]...)
+			break
+		}
+	}
+
+	return len(s.clusters.items)
+}
+
+// getCluster returns the Cluster that should answer the query name and
+// whether the name belongs to a Cluster zone at all. Names outside of all
+// the Cluster zones as well as the generic ".local" zone are handled by the
+// first added Cluster.
+func (s *Server) getCluster(name string) (*cluster, bool) {
+	s.clusters.RLock()
+	defer s.clusters.RUnlock()
+
+	if len(s.clusters.items) == 0 {
+		return nil, false
+	}
+
+	var ret *cluster
+	for _, itm := range s.clusters.items {
+		if itm.isClusterDomain(name) && (ret == nil || len(itm.domain) > len(ret.domain)) {
+			ret = itm
+		}
+	}
+
+	if ret != nil {
+		return ret, true
+	}
+
+	return s.clusters.items[0], strings.HasSuffix(name, ".local.")
 }
 
 func (s *Server) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
@@ -128,10 +206,17 @@ func (s *Server) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 	q := msg.Question[0]
 	domain := q.Name
 
-	if !s.isClusterDomain(domain) {
+	cl, isClusterDomain := s.getCluster(domain)
+	if cl == nil {
+		msg.SetRcode(r, dns.RcodeRefused)
+		w.WriteMsg(&msg)
+		return
+	}
+
+	if !isClusterDomain {
 
 		ret, err := s.getExchangeAnswer(&msg, domain, q.Qtype,
-			net.JoinHostPort(s.dnsGetter.GetClusterDNSServers()[0], "53"))
+			net.JoinHostPort(cl.dnsGetter.GetClusterDNSServers()[0], "53"))
 		if err != nil {
 			zap.L().Debug("Local DNS: Could not exchange answer for Cluster zone", zap.Error(err))
 			msg.SetRcode(r, dns.RcodeServerFailure)
@@ -165,12 +250,12 @@ func (s *Server) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 	}
 
 	switch {
-	case q.Qtype == dns.TypeA && !s.hasV4:
+	case q.Qtype == dns.TypeA && !cl.hasV4:
 		// zap.L().Debug("Local DNS: IPv4 is not supported", zap.String("domain", domain))
 		msg.SetRcode(r, dns.RcodeRefused)
 		w.WriteMsg(&msg)
 		return
-	case q.Qtype == dns.TypeAAAA && !s.hasV6:
+	case q.Qtype == dns.TypeAAAA && !cl.hasV6:
 		// zap.L().Debug("Local DNS: IPv6 is not supported", zap.String("domain", domain))
 		msg.SetRcode(r, dns.RcodeRefused)
 		w.WriteMsg(&msg)
@@ -178,7 +263,7 @@ func (s *Server) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 	}
 
 	ret, err := s.getExchangeAnswer(&msg, domain, q.Qtype,
-		net.JoinHostPort(s.dnsGetter.GetClusterDNSServers()[0], "53"))
+		net.JoinHostPort(cl.dnsGetter.GetClusterDNSServers()[0], "53"))
 	if err != nil {
 		zap.L().Debug("Local DNS: Could not exchange answer for Cluster zone", zap.Error(err))
 		msg.SetRcode(r, dns.RcodeServerFailure)
@@ -220,12 +305,11 @@ func (s *Server) getExchangeAnswer(msg *dns.Msg, domain string, typ uint16, srvA
 	return r, nil
 }
 
-func (s *Server) isClusterDomain(domain string) bool {
+func (c *cluster) isClusterDomain(domain string) bool {
 	suffixList := []string{
-		".local.",
-		fmt.Sprintf(".local.%s.", s.domain),
-		fmt.Sprintf(".%s.local.", s.domain),
-		fmt.Sprintf(".%s.", s.domain),
+		fmt.Sprintf(".local.%s.", c.domain),
+		fmt.Sprintf(".%s.local.", c.domain),
+		fmt.Sprintf(".%s.", c.domain),
 	}
 
 	for _, suffix := range suffixList {
@@ -239,6 +323,8 @@ func (s *Server) isClusterDomain(domain string) bool {
 
 func (s *Server) Run() error {
 	zap.L().Debug("Starting running local DNS server")
+	s.srv = &dns.Server{Addr: s.listenAddr, Net: "udp"}
+	s.srv.Handler = s
 	go func() {
 		if err := s.doRun(); err != nil {
 			zap.L().Warn("Could not run local DNS server", zap.Error(err))
@@ -252,8 +338,6 @@ func (s *Server) Run() error {
 }
 
 func (s *Server) doRun() error {
-	s.srv = &dns.Server{Addr: s.listenAddr, Net: "udp"}
-	s.srv.Handler = s
 	if err := s.srv.ListenAndServe(); err != nil {
 		zap.L().Warn("Failed to serve local DNS", zap.Error(err))
 		return err
@@ -97,3 +97,102 @@ func TestServer(t *testing.T) {
 	err = srv.Close()
 	assert.Nil(t, err)
 }
+
+func TestGetCluster(t *testing.T) {
+	srv, err := NewDNSServer(&Opts{
+		ClusterDomain: "example.com",
+		ListenAddr:    "127.0.0.100:18054",
+		HasV4:         true,
+		DNSGetter:     &tstDNSGetter{},
+	})
+	assert.Nil(t, err)
+
+	srv.AddCluster(&Opts{
+		ClusterDomain: "corp.example.com",
+		HasV6:         true,
+		DNSGetter:     &tstDNSGetter{},
+	})
+	srv.AddCluster(&Opts{
+		ClusterDomain: "example.org",
+		HasV4:         true,
+		DNSGetter:     &tstDNSGetter{},
+	})
+
+	tests := []struct {
+		name            string
+		domain          string
+		isClusterDomain bool
+	}{
+		{"svc1.local.example.com.", "example.com", true},
+		{"svc1.ns1.example.com.", "example.com", true},
+		{"svc1.local.corp.example.com.", "corp.example.com", true},
+		{"svc1.corp.example.com.", "corp.example.com", true},
+		{"svc1.local.example.org.", "example.org", true},
+		{"svc1.local.", "example.com", true},
+		{"google.com.", "example.com", false},
+	}
+
+	for _, tt := range tests {
+		cl, isClusterDomain := srv.getCluster(tt.name)
+		assert.NotNil(t, cl, tt.name)
+		assert.Equal(t, tt.domain, cl.domain, tt.name)
+		assert.Equal(t, tt.isClusterDomain, isClusterDomain, tt.name)
+	}
+
+	assert.Equal(t, 2, srv.RemoveCluster("example.com"))
+	cl, isClusterDomain := srv.getCluster("svc1.local.")
+	assert.Equal(t, "corp.example.com", cl.domain)
+	assert.True(t, isClusterDomain)
+
+	assert.Equal(t, 1, srv.RemoveCluster("corp.example.com"))
+	assert.Equal(t, 0, srv.RemoveCluster("example.org"))
+
+	cl, _ = srv.getCluster("svc1.local.example.org.")
+	assert.Nil(t, cl)
+}
+
+func TestRegister(t *testing.T) {
+	srv1, err := Register(&Opts{
+		ClusterDomain: "example.com",
+		ListenAddr:    "127.0.0.100:18055",
+		HasV4:         true,
+		DNSGetter:     &tstDNSGetter{},
+	})
+	assert.Nil(t, err)
+
+	srv2, err := Register(&Opts{
+		ClusterDomain: "example.org",
+		ListenAddr:    "127.0.0.100:18055",
+		HasV4:         true,
+		DNSGetter:     &tstDNSGetter{},
+	})
+	assert.Nil(t, err)
+	assert.True(t, srv1 == srv2)
+
+	srv3, err := Register(&Opts{
+		ClusterDomain: "example.net",
+		ListenAddr:    "127.0.0.100:18056",
+		HasV4:         true,
+		DNSGetter:     &tstDNSGetter{},
+	})
+	assert.Nil(t, err)
+	assert.False(t, srv1 == srv3)
+
+	time.Sleep(500 * time.Millisecond)
+
+	err = Unregister(srv1, "example.com")
+	assert.Nil(t, err)
+	assert.False(t, srv1.isClosed)
+
+	err = Unregister(srv2, "example.org")
+	assert.Nil(t, err)
+	assert.True(t, srv1.isClosed)
+
+	err = Unregister(srv3, "example.net")
+	assert.Nil(t, err)
+
+	_, err = Register(&Opts{
+		ListenAddr: "invalid",
+	})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,79 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package dnssrv
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// sharedServers holds the local DNS servers shared by all the Connections of
+// the process keyed by their listen addresses. This allows multiple
+// simultaneous Cluster Connections to use a single local DNS responder that
+// routes the queries according to the Cluster domain.
+var sharedServers = struct {
+	sync.Mutex
+	srvMap map[string]*Server
+}{
+	srvMap: make(map[string]*Server),
+}
+
+// Register adds the Cluster to the shared local DNS server listening on
+// opts.ListenAddr. The server is created and started upon the first
+// registration.
+func Register(opts *Opts) (*Server, error) {
+	listenAddr := getListenAddr(opts.ListenAddr)
+	if listenAddr == "" {
+		return nil, errors.Errorf("Local DNS: invalid listen address: %s", opts.ListenAddr)
+	}
+
+	sharedServers.Lock()
+	defer sharedServers.Unlock()
+
+	if srv, ok := sharedServers.srvMap[listenAddr]; ok {
+		srv.AddCluster(opts)
+		return srv, nil
+	}
+
+	srv, err := NewDNSServer(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := srv.Run(); err != nil {
+		return nil, err
+	}
+
+	sharedServers.srvMap[listenAddr] = srv
+
+	return srv, nil
+}
+
+// Unregister removes the Cluster from the shared local DNS server and closes
+// the server once no more Clusters use it.
+func Unregister(srv *Server, domain string) error {
+	sharedServers.Lock()
+	defer sharedServers.Unlock()
+
+	if srv.RemoveCluster(domain) > 0 {
+		return nil
+	}
+
+	if sharedServers.srvMap[srv.listenAddr] == srv {
+		delete(sharedServers.srvMap, srv.listenAddr)
+	}
+
+	return srv.Close()
+}
@@ -0,0 +1,102 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package localproxy
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+func (s *Server) serveHTTP(ctx context.Context, conn net.Conn, r *bufio.Reader) error {
+	req, err := http.ReadRequest(r)
+	if err != nil {
+		return err
+	}
+
+	if req.Method == http.MethodConnect {
+		return s.serveHTTPConnect(ctx, conn, r, req)
+	}
+
+	return s.serveHTTPForward(ctx, conn, r, req)
+}
+
+func (s *Server) serveHTTPConnect(ctx context.Context, conn net.Conn, r *bufio.Reader, req *http.Request) error {
+	address := req.Host
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		writeHTTPError(conn, http.StatusBadRequest)
+		return errors.Errorf("Invalid CONNECT address: %s", address)
+	}
+
+	target, err := s.dial(ctx, address)
+	if err != nil {
+		writeHTTPError(conn, http.StatusBadGateway)
+		return errors.Errorf("Could not dial %s: %+v", address, err)
+	}
+
+	if _, err := fmt.Fprintf(conn, "HTTP/1.1 200 Connection Established\r\n\r\n"); err != nil {
+		target.Close()
+		return err
+	}
+
+	pipe(conn, r, target)
+
+	return nil
+}
+
+// serveHTTPForward serves plain HTTP requests sent with an absolute URI. A
+// single request is served per client connection.
+func (s *Server) serveHTTPForward(ctx context.Context, conn net.Conn, r *bufio.Reader, req *http.Request) error {
+	if req.URL.Scheme != "http" || req.URL.Host == "" {
+		writeHTTPError(conn, http.StatusBadRequest)
+		return errors.Errorf("Invalid proxy request URI: %s", req.RequestURI)
+	}
+
+	address := req.URL.Host
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(strings.Trim(address, "[]"), "80")
+	}
+
+	target, err := s.dial(ctx, address)
+	if err != nil {
+		writeHTTPError(conn, http.StatusBadGateway)
+		return errors.Errorf("Could not dial %s: %+v", address, err)
+	}
+
+	for _, hdr := range []string{"Proxy-Connection", "Proxy-Authorization", "Proxy-Authenticate"} {
+		req.Header.Del(hdr)
+	}
+	req.Close = true
+	req.RequestURI = ""
+
+	if err := req.Write(target); err != nil {
+		target.Close()
+		writeHTTPError(conn, http.StatusBadGateway)
+		return err
+	}
+
+	pipe(conn, r, target)
+
+	return nil
+}
+
+func writeHTTPError(conn net.Conn, code int) {
+	fmt.Fprintf(conn, "HTTP/1.1 %d %s\r\nContent-Length: 0\r\nConnection: close\r\n\r\n",
+		code, http.StatusText(code))
+}
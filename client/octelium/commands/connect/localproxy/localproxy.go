@@ -0,0 +1,192 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package localproxy
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// Dialer dials the proxied targets. Resolving and routing Cluster hostnames
+// through the tunnel is up to the Dialer implementation.
+type Dialer interface {
+	DialContext(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+type Opts struct {
+	ListenAddr string
+	Dialer     Dialer
+}
+
+// Server is a local forward proxy that serves both SOCKS5 and HTTP proxy
+// clients over the same listener. The protocol is detected from the first
+// byte sent by the client.
+type Server struct {
+	listenAddr string
+	dialer     Dialer
+
+	lis      net.Listener
+	cancelFn context.CancelFunc
+	mu       sync.Mutex
+	isClosed bool
+}
+
+const defaultListenAddr = "127.0.0.1:1080"
+
+func NewServer(opts *Opts) (*Server, error) {
+	if opts.Dialer == nil {
+		return nil, errors.Errorf("Local proxy: nil dialer")
+	}
+
+	listenAddr := opts.ListenAddr
+	if listenAddr == "" {
+		listenAddr = defaultListenAddr
+	}
+
+	if _, _, err := net.SplitHostPort(listenAddr); err != nil {
+		return nil, errors.Errorf("Local proxy: invalid listen address: %s", opts.ListenAddr)
+	}
+
+	return &Server{
+		listenAddr: listenAddr,
+		dialer:     opts.Dialer,
+	}, nil
+}
+
+func (s *Server) Run(ctx context.Context) error {
+	ctx, cancelFn := context.WithCancel(ctx)
+	s.cancelFn = cancelFn
+
+	lis, err := net.Listen("tcp", s.listenAddr)
+	if err != nil {
+		cancelFn()
+		return err
+	}
+	s.lis = lis
+
+	zap.L().Debug("Local proxy is now listening", zap.String("addr", lis.Addr().String()))
+
+	go s.startLoop(ctx)
+
+	return nil
+}
+
+// Addr returns the listener address of a running Server.
+func (s *Server) Addr() net.Addr {
+	return s.lis.Addr()
+}
+
+func (s *Server) startLoop(ctx context.Context) {
+	for {
+		conn, err := s.lis.Accept()
+		if err != nil {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+			zap.L().Debug("Local proxy: Could not accept conn", zap.Error(err))
+			time.Sleep(100 * time.Millisecond)
+			continue
+		}
+
+		go s.handleConn(ctx, conn)
+	}
+}
+
+func (s *Server) handleConn(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+
+	conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+
+	r := bufio.NewReader(conn)
+	b, err := r.Peek(1)
+	if err != nil {
+		return
+	}
+
+	if b[0] == socks5Version {
+		err = s.serveSOCKS5(ctx, conn, r)
+	} else {
+		err = s.serveHTTP(ctx, conn, r)
+	}
+
+	if err != nil {
+		zap.L().Debug("Local proxy: Could not serve conn",
+			zap.String("remote", conn.RemoteAddr().String()), zap.Error(err))
+	}
+}
+
+func (s *Server) dial(ctx context.Context, address string) (net.Conn, error) {
+	ctx, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+
+	return s.dialer.DialContext(ctx, "tcp", address)
+}
+
+func (s *Server) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.isClosed {
+		return nil
+	}
+	s.isClosed = true
+
+	if s.cancelFn != nil {
+		s.cancelFn()
+	}
+
+	if s.lis != nil {
+		return s.lis.Close()
+	}
+
+	return nil
+}
+
+// pipe copies the data between the client and the target until either side
+// is done. The client reader might still hold bytes buffered during the
+// protocol detection and handshake.
+func pipe(client net.Conn, clientReader io.Reader, target net.Conn) {
+	defer target.Close()
+
+	client.SetReadDeadline(time.Time{})
+
+	doneCh := make(chan struct{}, 2)
+
+	go func() {
+		io.Copy(target, clientReader)
+		if c, ok := target.(interface{ CloseWrite() error }); ok {
+			c.CloseWrite()
+		}
+		doneCh <- struct{}{}
+	}()
+
+	go func() {
+		io.Copy(client, target)
+		if c, ok := client.(interface{ CloseWrite() error }); ok {
+			c.CloseWrite()
+		}
+		doneCh <- struct{}{}
+	}()
+
+	<-doneCh
+	<-doneCh
+}
@@ -0,0 +1,174 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package localproxy
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/proxy"
+)
+
+type tstDialer struct {
+	mu     sync.Mutex
+	target string
+	addrs  []string
+}
+
+func (d *tstDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	d.mu.Lock()
+	d.addrs = append(d.addrs, address)
+	d.mu.Unlock()
+	return (&net.Dialer{}).DialContext(ctx, network, d.target)
+}
+
+func (d *tstDialer) lastAddr() string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.addrs[len(d.addrs)-1]
+}
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "hello %s", r.URL.Path)
+	}))
+	defer upstream.Close()
+
+	dialer := &tstDialer{
+		target: upstream.Listener.Addr().String(),
+	}
+
+	srv, err := NewServer(&Opts{
+		ListenAddr: "127.0.0.1:0",
+		Dialer:     dialer,
+	})
+	assert.Nil(t, err)
+	err = srv.Run(ctx)
+	assert.Nil(t, err)
+	defer srv.Close()
+
+	proxyAddr := srv.Addr().String()
+
+	doGet := func(c *http.Client, u string) string {
+		resp, err := c.Get(u)
+		assert.Nil(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		assert.Nil(t, err)
+		return string(body)
+	}
+
+	t.Run("socks5", func(t *testing.T) {
+		socksDialer, err := proxy.SOCKS5("tcp", proxyAddr, nil, proxy.Direct)
+		assert.Nil(t, err)
+
+		c := &http.Client{
+			Transport: &http.Transport{
+				DialContext: socksDialer.(proxy.ContextDialer).DialContext,
+			},
+		}
+
+		assert.Equal(t, "hello /socks", doGet(c, "http://svc1.ns1.example.com:8080/socks"))
+		assert.Equal(t, "svc1.ns1.example.com:8080", dialer.lastAddr())
+	})
+
+	t.Run("http-forward", func(t *testing.T) {
+		c := &http.Client{
+			Transport: &http.Transport{
+				Proxy: http.ProxyURL(&url.URL{Scheme: "http", Host: proxyAddr}),
+			},
+		}
+
+		assert.Equal(t, "hello /forward", doGet(c, "http://svc2.example.com/forward"))
+		assert.Equal(t, "svc2.example.com:80", dialer.lastAddr())
+	})
+
+	t.Run("http-connect", func(t *testing.T) {
+		conn, err := net.Dial("tcp", proxyAddr)
+		assert.Nil(t, err)
+		defer conn.Close()
+
+		_, err = fmt.Fprintf(conn, "CONNECT svc3.example.com:443 HTTP/1.1\r\nHost: svc3.example.com:443\r\n\r\n")
+		assert.Nil(t, err)
+
+		r := bufio.NewReader(conn)
+		resp, err := http.ReadResponse(r, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "svc3.example.com:443", dialer.lastAddr())
+
+		_, err = fmt.Fprintf(conn, "GET /connect HTTP/1.1\r\nHost: svc3.example.com\r\nConnection: close\r\n\r\n")
+		assert.Nil(t, err)
+
+		resp, err = http.ReadResponse(r, nil)
+		assert.Nil(t, err)
+		body, err := io.ReadAll(resp.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, "hello /connect", string(body))
+	})
+}
+
+func TestSOCKS5ReadRequest(t *testing.T) {
+	{
+		addr, _, err := socks5ReadRequest(newReader([]byte{5, 1, 0, 1, 10, 0, 0, 1, 0x1f, 0x90}))
+		assert.Nil(t, err)
+		assert.Equal(t, "10.0.0.1:8080", addr)
+	}
+	{
+		req := []byte{5, 1, 0, 3, 7}
+		req = append(req, []byte("svc1.ns")...)
+		req = append(req, 0, 80)
+		addr, _, err := socks5ReadRequest(newReader(req))
+		assert.Nil(t, err)
+		assert.Equal(t, "svc1.ns:80", addr)
+	}
+	{
+		_, rep, err := socks5ReadRequest(newReader([]byte{5, 2, 0, 1, 10, 0, 0, 1, 0, 80}))
+		assert.NotNil(t, err)
+		assert.Equal(t, byte(socks5ReplyCmdNotSupported), rep)
+	}
+	{
+		_, rep, err := socks5ReadRequest(newReader([]byte{5, 1, 0, 9, 10, 0, 0, 1, 0, 80}))
+		assert.NotNil(t, err)
+		assert.Equal(t, byte(socks5ReplyAddrTypeUnsupported), rep)
+	}
+}
+
+func TestNewServer(t *testing.T) {
+	_, err := NewServer(&Opts{})
+	assert.NotNil(t, err)
+
+	_, err = NewServer(&Opts{ListenAddr: "127.0.0.1", Dialer: &tstDialer{}})
+	assert.NotNil(t, err)
+
+	srv, err := NewServer(&Opts{Dialer: &tstDialer{}})
+	assert.Nil(t, err)
+	assert.Equal(t, defaultListenAddr, srv.listenAddr)
+}
+
+func newReader(b []byte) *bufio.Reader {
+	return bufio.NewReader(bytes.NewReader(b))
+}
@@ -0,0 +1,158 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package localproxy
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// SOCKS5 (RFC 1928) constants. Only the CONNECT command without
+// authentication is supported. Domain name targets are resolved by the
+// Dialer, i.e. remotely from the client's point of view.
+const (
+	socks5Version = 0x05
+
+	socks5MethodNoAuth       = 0x00
+	socks5MethodNoAcceptable = 0xff
+
+	socks5CmdConnect = 0x01
+
+	socks5AddrTypeIPv4   = 0x01
+	socks5AddrTypeDomain = 0x03
+	socks5AddrTypeIPv6   = 0x04
+
+	socks5ReplySucceeded           = 0x00
+	socks5ReplyGeneralFailure      = 0x01
+	socks5ReplyHostUnreachable     = 0x04
+	socks5ReplyCmdNotSupported     = 0x07
+	socks5ReplyAddrTypeUnsupported = 0x08
+)
+
+func (s *Server) serveSOCKS5(ctx context.Context, conn net.Conn, r *bufio.Reader) error {
+	if err := socks5Negotiate(conn, r); err != nil {
+		return err
+	}
+
+	address, rep, err := socks5ReadRequest(r)
+	if err != nil {
+		if rep != socks5ReplySucceeded {
+			socks5WriteReply(conn, rep)
+		}
+		return err
+	}
+
+	target, err := s.dial(ctx, address)
+	if err != nil {
+		socks5WriteReply(conn, socks5ReplyHostUnreachable)
+		return errors.Errorf("Could not dial %s: %+v", address, err)
+	}
+
+	if err := socks5WriteReply(conn, socks5ReplySucceeded); err != nil {
+		target.Close()
+		return err
+	}
+
+	pipe(conn, r, target)
+
+	return nil
+}
+
+func socks5Negotiate(w io.Writer, r io.Reader) error {
+	hdr := make([]byte, 2)
+	if _, err := io.ReadFull(r, hdr); err != nil {
+		return err
+	}
+
+	if hdr[0] != socks5Version {
+		return errors.Errorf("Invalid SOCKS version: %d", hdr[0])
+	}
+
+	methods := make([]byte, hdr[1])
+	if _, err := io.ReadFull(r, methods); err != nil {
+		return err
+	}
+
+	for _, method := range methods {
+		if method == socks5MethodNoAuth {
+			_, err := w.Write([]byte{socks5Version, socks5MethodNoAuth})
+			return err
+		}
+	}
+
+	w.Write([]byte{socks5Version, socks5MethodNoAcceptable})
+	return errors.Errorf("No acceptable SOCKS5 authentication method")
+}
+
+// socks5ReadRequest reads the client request and returns the target address.
+// On failure, it also returns the reply code to be sent to the client, if any.
+func socks5ReadRequest(r io.Reader) (string, byte, error) {
+	hdr := make([]byte, 4)
+	if _, err := io.ReadFull(r, hdr); err != nil {
+		return "", socks5ReplySucceeded, err
+	}
+
+	if hdr[0] != socks5Version {
+		return "", socks5ReplySucceeded, errors.Errorf("Invalid SOCKS version: %d", hdr[0])
+	}
+
+	if hdr[1] != socks5CmdConnect {
+		return "", socks5ReplyCmdNotSupported, errors.Errorf("Unsupported SOCKS5 command: %d", hdr[1])
+	}
+
+	var host string
+	switch hdr[3] {
+	case socks5AddrTypeIPv4, socks5AddrTypeIPv6:
+		ip := make([]byte, net.IPv4len)
+		if hdr[3] == socks5AddrTypeIPv6 {
+			ip = make([]byte, net.IPv6len)
+		}
+		if _, err := io.ReadFull(r, ip); err != nil {
+			return "", socks5ReplyGeneralFailure, err
+		}
+		host = net.IP(ip).String()
+	case socks5AddrTypeDomain:
+		l := make([]byte, 1)
+		if _, err := io.ReadFull(r, l); err != nil {
+			return "", socks5ReplyGeneralFailure, err
+		}
+		domain := make([]byte, l[0])
+		if _, err := io.ReadFull(r, domain); err != nil {
+			return "", socks5ReplyGeneralFailure, err
+		}
+		host = string(domain)
+	default:
+		return "", socks5ReplyAddrTypeUnsupported, errors.Errorf("Unsupported SOCKS5 address type: %d", hdr[3])
+	}
+
+	port := make([]byte, 2)
+	if _, err := io.ReadFull(r, port); err != nil {
+		return "", socks5ReplyGeneralFailure, err
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port)))), socks5ReplySucceeded, nil
+}
+
+func socks5WriteReply(w io.Writer, rep byte) error {
+	// The bound address is not meaningful for a proxy dialing through the
+	// tunnel and is always set to 0.0.0.0:0
+	_, err := w.Write([]byte{socks5Version, rep, 0x00, socks5AddrTypeIPv4, 0, 0, 0, 0, 0, 0})
+	return err
+}
@@ -0,0 +1,111 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connect
+
+import (
+	"context"
+	"crypto/ed25519"
+	"time"
+
+	"github.com/octelium/octelium/apis/client/cliconfigv1"
+	"github.com/octelium/octelium/apis/main/userv1"
+	"github.com/octelium/octelium/client/common/cliutils/deviceinfo"
+	"github.com/octelium/octelium/pkg/utils/ldflags"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const postureReportInterval = 3 * time.Minute
+
+type postureReporter struct {
+	c       *cliconfigv1.Connection
+	streamC userv1.MainService_ConnectClient
+}
+
+func newPostureReporter(c *cliconfigv1.Connection, streamC userv1.MainService_ConnectClient) *postureReporter {
+	return &postureReporter{
+		c:       c,
+		streamC: streamC,
+	}
+}
+
+func (r *postureReporter) Start(ctx context.Context) error {
+	zap.L().Debug("Starting posture reporter")
+	go r.doStartLoop(ctx)
+	return nil
+}
+
+func (r *postureReporter) doStartLoop(ctx context.Context) {
+	tickerCh := time.NewTicker(postureReportInterval)
+	defer tickerCh.Stop()
+
+	for {
+		if err := r.report(ctx); err != nil {
+			zap.L().Debug("Could not report posture", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			zap.L().Debug("Posture reporter loop ctx done")
+			return
+		case <-tickerCh.C:
+		}
+	}
+}
+
+func (r *postureReporter) report(ctx context.Context) error {
+	req, err := r.getRequest(ctx)
+	if err != nil {
+		return err
+	}
+
+	return r.streamC.Send(req)
+}
+
+func (r *postureReporter) getRequest(ctx context.Context) (*userv1.ConnectRequest, error) {
+	if r.c.Connection == nil || len(r.c.Connection.Ed25519Key) != ed25519.PrivateKeySize {
+		return nil, errors.Errorf("No valid Connection Ed25519 key")
+	}
+
+	posture, err := deviceinfo.GetPosture(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &userv1.ConnectRequest_Posture_Info{
+		OsVersion:           posture.OSVersion,
+		IsDiskEncrypted:     posture.IsDiskEncrypted,
+		IsScreenLockEnabled: posture.IsScreenLockEnabled,
+		IsFirewallEnabled:   posture.IsFirewallEnabled,
+		ClientVersion:       ldflags.SemVer,
+		Timestamp:           timestamppb.Now(),
+	}
+
+	infoBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(info)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userv1.ConnectRequest{
+		Type: &userv1.ConnectRequest_Posture_{
+			Posture: &userv1.ConnectRequest_Posture{
+				Info:      info,
+				Signature: ed25519.Sign(ed25519.PrivateKey(r.c.Connection.Ed25519Key), infoBytes),
+			},
+		},
+	}, nil
+}
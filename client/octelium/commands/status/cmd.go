@@ -16,21 +16,30 @@ package status
 
 import (
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/octelium/octelium/apis/client/cliconfigv1"
 	"github.com/octelium/octelium/apis/main/userv1"
 	"github.com/octelium/octelium/client/common/client"
 	"github.com/octelium/octelium/client/common/cliutils"
+	"github.com/octelium/octelium/client/common/printer"
+	utils_types "github.com/octelium/octelium/pkg/utils/types"
 	"github.com/spf13/cobra"
 )
 
 type args struct {
 	Out string
+	All bool
 }
 
 var cmdArgs args
 
 func init() {
 	Cmd.PersistentFlags().StringVarP(&cmdArgs.Out, "out", "o", "yaml", "Output format")
+	Cmd.PersistentFlags().BoolVarP(&cmdArgs.All, "all", "A", false,
+		"List all the active Connections of this host. This is the default if no Cluster domain is set")
 }
 
 var Cmd = &cobra.Command{
@@ -40,6 +49,8 @@ var Cmd = &cobra.Command{
 octelium status
 octelium status -o yaml
 octelium status -o json
+# List all the active Connections to all Clusters
+octelium status --all
 	`,
 	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -48,9 +59,13 @@ octelium status -o json
 }
 
 func doCmd(cmd *cobra.Command, args []string) error {
+	if cmdArgs.All {
+		return doListConnections(cmd)
+	}
+
 	i, err := cliutils.GetCLIInfo(cmd, args)
 	if err != nil {
-		return err
+		return doListConnections(cmd)
 	}
 
 	conn, err := client.GetGRPCClientConn(cmd.Context(), i.Domain)
@@ -77,3 +92,105 @@ func doCmd(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func doListConnections(cmd *cobra.Command) error {
+	domainMap, err := cliutils.GetDB().List()
+	if err != nil {
+		return err
+	}
+
+	state := getConnectionsState(domainMap, isProcessRunning)
+
+	if cmd.Flags().Changed("out") {
+		out, err := cliutils.OutFormatPrint(cmdArgs.Out, state)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s\n", string(out))
+		return nil
+	}
+
+	if len(state.DomainMap) == 0 {
+		cliutils.LineInfo("No active Connections Found\n")
+		return nil
+	}
+
+	var domains []string
+	for domain := range state.DomainMap {
+		domains = append(domains, domain)
+	}
+	slices.Sort(domains)
+
+	p := printer.NewPrinter("Cluster", "Device", "Type", "Addresses", "CIDRs", "Gateways", "PID", "Age")
+	for _, domain := range domains {
+		conn := state.DomainMap[domain].Connection
+		p.AppendRow(domain, conn.DeviceName,
+			getConnectionType(conn.ConnectionType),
+			strings.Join(conn.Addresses, ", "),
+			strings.Join(conn.Cidrs, ", "),
+			getGateways(conn.Gateways),
+			fmt.Sprintf("%d", conn.Pid),
+			utils_types.HumanDuration(time.Since(conn.CreatedAt.AsTime())))
+	}
+
+	p.Render()
+	return nil
+}
+
+// getConnectionsState returns the active Connections stored in the DB while
+// skipping the Session tokens as well as the stale Connections whose client
+// process is no longer running.
+func getConnectionsState(domainMap map[string]*cliconfigv1.State_Domain,
+	isRunning func(pid int) bool) *cliconfigv1.State {
+	ret := &cliconfigv1.State{
+		DomainMap: make(map[string]*cliconfigv1.State_Domain),
+	}
+
+	for domain, itm := range domainMap {
+		if itm.Connection == nil || !isRunning(int(itm.Connection.Pid)) {
+			continue
+		}
+
+		ret.DomainMap[domain] = &cliconfigv1.State_Domain{
+			Connection: itm.Connection,
+		}
+	}
+
+	return ret
+}
+
+// getGateways returns the chosen Gateway of every Region and its RTT as
+// well as the unhealthy Gateways that are currently failed over.
+func getGateways(gws []*cliconfigv1.State_Domain_Connection_Gateway) string {
+	var ret []string
+	for _, gw := range gws {
+		name := gw.Id
+		if gw.Region != "" {
+			name = fmt.Sprintf("%s/%s", gw.Region, gw.Id)
+		}
+
+		switch {
+		case gw.IsPreferred && gw.Rtt != nil:
+			ret = append(ret, fmt.Sprintf("%s (%s)", name, gw.Rtt.AsDuration().Round(time.Millisecond/10).String()))
+		case gw.IsPreferred:
+			ret = append(ret, name)
+		case !gw.IsHealthy && gw.ViaGatewayID != "":
+			ret = append(ret, fmt.Sprintf("%s (failed over to %s)", name, gw.ViaGatewayID))
+		}
+	}
+
+	return strings.Join(ret, ", ")
+}
+
+func getConnectionType(arg cliconfigv1.Connection_Preferences_ConnectionType) string {
+	switch arg {
+	case cliconfigv1.Connection_Preferences_CONNECTION_TYPE_QUICV0:
+		return "QUICv0"
+	case cliconfigv1.Connection_Preferences_CONNECTION_TYPE_MASQUE:
+		return "MASQUE"
+	case cliconfigv1.Connection_Preferences_CONNECTION_TYPE_TLS:
+		return "TLS"
+	default:
+		return "WireGuard"
+	}
+}
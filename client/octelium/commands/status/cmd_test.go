@@ -0,0 +1,94 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package status
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/octelium/octelium/apis/client/cliconfigv1"
+	"github.com/octelium/octelium/apis/main/authv1"
+	"github.com/octelium/octelium/pkg/common/pbutils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestGetConnectionsState(t *testing.T) {
+	domainMap := map[string]*cliconfigv1.State_Domain{
+		"example.com": {
+			SessionToken: &authv1.SessionToken{AccessToken: "tkn1"},
+			Connection: &cliconfigv1.State_Domain_Connection{
+				CreatedAt:  pbutils.Now(),
+				Pid:        100,
+				DeviceName: "octelium-abcdef",
+			},
+		},
+		"example.org": {
+			SessionToken: &authv1.SessionToken{AccessToken: "tkn2"},
+			Connection: &cliconfigv1.State_Domain_Connection{
+				CreatedAt:  pbutils.Now(),
+				Pid:        200,
+				DeviceName: "octelium-ghijkl",
+			},
+		},
+		"example.net": {
+			SessionToken: &authv1.SessionToken{AccessToken: "tkn3"},
+		},
+	}
+
+	res := getConnectionsState(domainMap, func(pid int) bool {
+		return pid == 100
+	})
+
+	assert.Equal(t, 1, len(res.DomainMap))
+	assert.Equal(t, "octelium-abcdef", res.DomainMap["example.com"].Connection.DeviceName)
+	assert.Nil(t, res.DomainMap["example.com"].SessionToken)
+}
+
+func TestGetGateways(t *testing.T) {
+	assert.Equal(t, "", getGateways(nil))
+	assert.Equal(t, "apac/gw2 (12.3ms), eu/gw3, eu/gw1 (failed over to gw3)", getGateways([]*cliconfigv1.State_Domain_Connection_Gateway{
+		{
+			Id:          "gw2",
+			Region:      "apac",
+			IsPreferred: true,
+			IsHealthy:   true,
+			Rtt:         durationpb.New(12345 * time.Microsecond),
+		},
+		{
+			Id:          "gw3",
+			Region:      "eu",
+			IsPreferred: true,
+			IsHealthy:   true,
+		},
+		{
+			Id:           "gw4",
+			Region:       "apac",
+			IsHealthy:    true,
+			ViaGatewayID: "gw2",
+		},
+		{
+			Id:           "gw1",
+			Region:       "eu",
+			ViaGatewayID: "gw3",
+		},
+	}))
+}
+
+func TestIsProcessRunning(t *testing.T) {
+	assert.True(t, isProcessRunning(os.Getpid()))
+	assert.False(t, isProcessRunning(0))
+	assert.False(t, isProcessRunning(-1))
+}
@@ -0,0 +1,213 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/client/common/client"
+	"github.com/octelium/octelium/client/common/cliutils"
+	"github.com/octelium/octelium/client/common/printer"
+	"github.com/octelium/octelium/client/octeliumctl/commands/audit/restore"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type args struct {
+	Out          string
+	Kind         string
+	Name         string
+	UID          string
+	Actor        string
+	ActorUID     string
+	Since        string
+	Until        string
+	Page         uint32
+	ItemsPerPage uint32
+	OldestFirst  bool
+}
+
+const example = `
+octeliumctl audit
+octeliumctl audit --kind Policy --name allow-all
+octeliumctl audit --actor john --since 24h
+octeliumctl audit --kind Service --since 2025-01-01T00:00:00Z --until 2025-01-08T00:00:00Z
+octeliumctl audit <AUDIT_LOG_ID> -o json
+octeliumctl audit restore <AUDIT_LOG_ID>
+`
+
+var Cmd = &cobra.Command{
+	Use:     "audit",
+	Short:   "List the AuditLogs of the Cluster resources and restore their revisions",
+	Example: example,
+	Args:    cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return doCmd(cmd, args)
+	},
+}
+
+var cmdArgs args
+
+func init() {
+	Cmd.PersistentFlags().StringVarP(&cmdArgs.Out, "out", "o", "", "Output format")
+	Cmd.Flags().StringVar(&cmdArgs.Kind, "kind", "", "Filter by the resource kind (e.g. Policy)")
+	Cmd.Flags().StringVar(&cmdArgs.Name, "name", "", "Filter by the resource name")
+	Cmd.Flags().StringVar(&cmdArgs.UID, "uid", "", "Filter by the resource UID")
+	Cmd.Flags().StringVar(&cmdArgs.Actor, "actor", "", "Filter by the actor's Session name")
+	Cmd.Flags().StringVar(&cmdArgs.ActorUID, "actor-uid", "", "Filter by the actor's Session UID")
+	Cmd.Flags().StringVar(&cmdArgs.Since, "since", "",
+		"Only list the AuditLogs since that time. It can be a duration (e.g. 24h) or an RFC3339 timestamp")
+	Cmd.Flags().StringVar(&cmdArgs.Until, "until", "",
+		"Only list the AuditLogs until that time. It can be a duration (e.g. 1h) or an RFC3339 timestamp")
+	Cmd.Flags().Uint32Var(&cmdArgs.Page, "page", 1, "List page")
+	Cmd.Flags().Uint32Var(&cmdArgs.ItemsPerPage, "items-per-page", 0, "Items per page")
+	Cmd.Flags().BoolVar(&cmdArgs.OldestFirst, "oldest-first", false, "List the oldest AuditLogs first")
+}
+
+func AddSubcommands() {
+	Cmd.AddCommand(restore.Cmd)
+}
+
+func doCmd(cmd *cobra.Command, args []string) error {
+	i, err := cliutils.GetCLIInfo(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	req, err := getListOptions(time.Now())
+	if err != nil {
+		return err
+	}
+
+	conn, err := client.GetGRPCClientConn(cmd.Context(), i.Domain)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	c := corev1.NewMainServiceClient(conn)
+
+	if i.FirstArg() != "" {
+		res, err := c.GetAuditLog(cmd.Context(), &metav1.GetOptions{
+			Uid: i.FirstArg(),
+		})
+		if err != nil {
+			return err
+		}
+		out, err := cliutils.OutFormatPrint(cmdArgs.Out, res)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s\n", string(out))
+		return nil
+	}
+
+	itmList, err := c.ListAuditLog(cmd.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	if len(itmList.Items) == 0 {
+		cliutils.LineInfo("No AuditLogs found\n")
+		return nil
+	}
+
+	if cmdArgs.Out != "" {
+		out, err := cliutils.OutFormatPrint(cmdArgs.Out, itmList)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s\n", string(out))
+		return nil
+	}
+
+	p := printer.NewPrinter("ID", "Operation", "Kind", "Name", "Actor", "Time")
+
+	for _, itm := range itmList.Items {
+		md := itm.Metadata
+		p.AppendRow(md.Id, itm.Entry.GetOperation().String(),
+			md.TargetRef.GetKind(), md.TargetRef.GetName(),
+			md.ActorRef.GetName(), md.CreatedAt.AsTime().Format(time.RFC3339))
+	}
+	p.Render()
+
+	return nil
+}
+
+func getListOptions(now time.Time) (*corev1.ListAuditLogOptions, error) {
+	ret := &corev1.ListAuditLogOptions{
+		Common: &metav1.CommonListOptions{
+			ItemsPerPage: cmdArgs.ItemsPerPage,
+		},
+	}
+
+	if cmdArgs.Page > 1 {
+		ret.Common.Page = cmdArgs.Page - 1
+	}
+
+	if cmdArgs.OldestFirst {
+		ret.Common.OrderBy = &metav1.CommonListOptions_OrderBy{
+			Type: metav1.CommonListOptions_OrderBy_CREATED_AT,
+			Mode: metav1.CommonListOptions_OrderBy_ASC,
+		}
+	}
+
+	if cmdArgs.Kind != "" || cmdArgs.Name != "" || cmdArgs.UID != "" {
+		ret.ResourceRef = &metav1.ObjectReference{
+			Kind: cmdArgs.Kind,
+			Name: cmdArgs.Name,
+			Uid:  cmdArgs.UID,
+		}
+	}
+
+	if cmdArgs.Actor != "" || cmdArgs.ActorUID != "" {
+		ret.ActorRef = &metav1.ObjectReference{
+			Name: cmdArgs.Actor,
+			Uid:  cmdArgs.ActorUID,
+		}
+	}
+
+	var err error
+	if ret.From, err = parseTime(cmdArgs.Since, now); err != nil {
+		return nil, err
+	}
+	if ret.To, err = parseTime(cmdArgs.Until, now); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// parseTime parses either an RFC3339 timestamp or a duration relative to now.
+func parseTime(arg string, now time.Time) (*timestamppb.Timestamp, error) {
+	if arg == "" {
+		return nil, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, arg); err == nil {
+		return timestamppb.New(t), nil
+	}
+
+	d, err := time.ParseDuration(arg)
+	if err != nil || d < 0 {
+		return nil, errors.Errorf("Invalid time: %s. It must be a duration or an RFC3339 timestamp", arg)
+	}
+
+	return timestamppb.New(now.Add(-d)), nil
+}
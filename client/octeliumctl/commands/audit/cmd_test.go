@@ -0,0 +1,75 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTime(t *testing.T) {
+	now := time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC)
+
+	{
+		res, err := parseTime("", now)
+		assert.Nil(t, err)
+		assert.Nil(t, res)
+	}
+
+	{
+		res, err := parseTime("24h", now)
+		assert.Nil(t, err)
+		assert.Equal(t, now.Add(-24*time.Hour), res.AsTime())
+	}
+
+	{
+		res, err := parseTime("2025-01-01T00:00:00Z", now)
+		assert.Nil(t, err)
+		assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), res.AsTime())
+	}
+
+	for _, invalid := range []string{"yesterday", "-1h", "2025-01-01"} {
+		_, err := parseTime(invalid, now)
+		assert.NotNil(t, err, "%s", invalid)
+	}
+}
+
+func TestGetListOptions(t *testing.T) {
+	now := time.Now()
+
+	cmdArgs = args{
+		Kind:        "Policy",
+		Name:        "allow-all",
+		Actor:       "john",
+		Since:       "1h",
+		Page:        2,
+		OldestFirst: true,
+	}
+	t.Cleanup(func() {
+		cmdArgs = args{}
+	})
+
+	res, err := getListOptions(now)
+	assert.Nil(t, err)
+	assert.Equal(t, "Policy", res.ResourceRef.Kind)
+	assert.Equal(t, "allow-all", res.ResourceRef.Name)
+	assert.Equal(t, "john", res.ActorRef.Name)
+	assert.Equal(t, now.Add(-time.Hour).UnixNano(), res.From.AsTime().UnixNano())
+	assert.Nil(t, res.To)
+	assert.Equal(t, uint32(1), res.Common.Page)
+	assert.NotNil(t, res.Common.OrderBy)
+}
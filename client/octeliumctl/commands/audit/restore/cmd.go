@@ -0,0 +1,97 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"fmt"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/client/common/client"
+	"github.com/octelium/octelium/client/common/cliutils"
+	"github.com/spf13/cobra"
+)
+
+type args struct {
+	Yes bool
+}
+
+var Cmd = &cobra.Command{
+	Use:   "restore",
+	Short: "Restore the resource revision recorded by an AuditLog",
+	Long: `Restore the resource revision recorded by an AuditLog.
+The revision is the resource after the recorded operation, or the resource before its deletion in the case of DELETE operations.
+The spec of the resource is restored the same way as an update, or the resource is recreated if it no longer exists. The recorded status is never restored.
+`,
+	Example: `
+octeliumctl audit restore <AUDIT_LOG_ID>
+octeliumctl audit restore <AUDIT_LOG_ID> --yes
+	`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return doCmd(cmd, args)
+	},
+}
+
+var cmdArgs args
+
+func init() {
+	Cmd.Flags().BoolVarP(&cmdArgs.Yes, "yes", "y", false, "Skip the confirmation prompt")
+}
+
+func doCmd(cmd *cobra.Command, args []string) error {
+	i, err := cliutils.GetCLIInfo(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	conn, err := client.GetGRPCClientConn(cmd.Context(), i.Domain)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	c := corev1.NewMainServiceClient(conn)
+
+	auditLog, err := c.GetAuditLog(cmd.Context(), &metav1.GetOptions{
+		Uid: i.FirstArg(),
+	})
+	if err != nil {
+		return err
+	}
+
+	targetRef := auditLog.Metadata.TargetRef
+
+	if !cmdArgs.Yes {
+		if err := cliutils.RunPromptConfirm(fmt.Sprintf(
+			"Restore the %s `%s` revision recorded by this AuditLog", targetRef.Kind, targetRef.Name)); err != nil {
+			return err
+		}
+	}
+
+	res, err := c.RestoreResourceRevision(cmd.Context(), &corev1.RestoreResourceRevisionRequest{
+		AuditLogRef: &metav1.ObjectReference{
+			Uid: auditLog.Metadata.Id,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	cliutils.LineInfo("%s `%s` successfully restored to resourceVersion %s\n",
+		res.ResourceRef.Kind, res.ResourceRef.Name, res.ResourceVersion)
+
+	return nil
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/octelium/octelium/client/common/commands/logout"
 	"github.com/octelium/octelium/client/common/commands/version"
 	"github.com/octelium/octelium/client/octeliumctl/commands/apply"
+	"github.com/octelium/octelium/client/octeliumctl/commands/audit"
 	"github.com/octelium/octelium/client/octeliumctl/commands/create"
 	"github.com/octelium/octelium/client/octeliumctl/commands/delete"
 	"github.com/octelium/octelium/client/octeliumctl/commands/get"
@@ -49,6 +50,7 @@ func InitCmds() {
 	Cmd.AddCommand(version.Cmd)
 	Cmd.AddCommand(auth.Cmd)
 	Cmd.AddCommand(update.Cmd)
+	Cmd.AddCommand(audit.Cmd)
 
 	Cmd.AddCommand(login.Cmd)
 	Cmd.AddCommand(logout.Cmd)
@@ -58,6 +60,7 @@ func InitCmds() {
 	get.AddSubcommands()
 	auth.AddSubcommands()
 	update.AddSubcommands()
+	audit.AddSubcommands()
 }
 
 func init() {
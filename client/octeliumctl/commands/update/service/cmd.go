@@ -0,0 +1,131 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/client/common/client"
+	"github.com/octelium/octelium/client/common/cliutils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const example = `
+  octeliumctl update service --split default=95 --split canary=5 svc1
+  octeliumctl update svc --split canary=100 svc1.ns1
+  `
+
+var Cmd = &cobra.Command{
+	Use:     "service",
+	Short:   "Update a Service",
+	Example: example,
+	Aliases: []string{"svc"},
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return doCmd(cmd, args)
+	},
+}
+
+type args struct {
+	Split []string
+}
+
+var cmdArgs args
+
+func init() {
+	Cmd.PersistentFlags().StringSliceVar(&cmdArgs.Split, "split", nil,
+		"Set the weight of a named Config in the Service's traffic split in the form of `<config>=<weight>`. Use `default` for the default Config")
+}
+
+func doCmd(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	i, err := cliutils.GetCLIInfo(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	if len(cmdArgs.Split) == 0 {
+		return errors.Errorf("Nothing to update")
+	}
+
+	targets, err := parseSplit(cmdArgs.Split)
+	if err != nil {
+		return err
+	}
+
+	conn, err := client.GetGRPCClientConn(cmd.Context(), i.Domain)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	c := corev1.NewMainServiceClient(conn)
+
+	svc, err := c.GetService(ctx, &metav1.GetOptions{
+		Name: i.FirstArg(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if svc.Spec.DynamicConfig == nil {
+		svc.Spec.DynamicConfig = &corev1.Service_Spec_DynamicConfig{}
+	}
+	if svc.Spec.DynamicConfig.Split == nil {
+		svc.Spec.DynamicConfig.Split = &corev1.Service_Spec_DynamicConfig_Split{}
+	}
+
+	svc.Spec.DynamicConfig.Split.Targets = targets
+
+	_, err = c.UpdateService(ctx, svc)
+	if err != nil {
+		return err
+	}
+
+	cliutils.LineNotify("Service %s successfully updated\n", i.FirstArg())
+
+	return nil
+}
+
+func parseSplit(args []string) ([]*corev1.Service_Spec_DynamicConfig_Split_Target, error) {
+	var ret []*corev1.Service_Spec_DynamicConfig_Split_Target
+
+	for _, arg := range args {
+		name, weightStr, ok := strings.Cut(arg, "=")
+		if !ok || name == "" {
+			return nil, errors.Errorf("Invalid split: %s. It must be in the form of <config>=<weight>", arg)
+		}
+
+		weight, err := strconv.ParseUint(weightStr, 10, 32)
+		if err != nil {
+			return nil, errors.Errorf("Invalid split weight: %s", weightStr)
+		}
+
+		if name == "default" {
+			name = ""
+		}
+
+		ret = append(ret, &corev1.Service_Spec_DynamicConfig_Split_Target{
+			ConfigName: name,
+			Weight:     uint32(weight),
+		})
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,357 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// FormatVersion is the version of the backup archive format. Archives of a
+// more recent format version cannot be restored.
+const FormatVersion = 1
+
+const (
+	fileManifest  = "manifest.json"
+	fileResources = "resources.json"
+	fileSecrets   = "secrets.enc"
+
+	maxArchiveFileSize = 2 << 30
+	minKeySize         = 32
+)
+
+var encryptionAAD = []byte("octelium-backup-v1")
+
+type Manifest struct {
+	FormatVersion  int               `json:"formatVersion"`
+	ClusterVersion string            `json:"clusterVersion"`
+	Domain         string            `json:"domain"`
+	CreatedAt      time.Time         `json:"createdAt"`
+	Kinds          map[string]int    `json:"kinds"`
+	Checksums      map[string]string `json:"checksums"`
+}
+
+// Resource is a row of the primary storage resources table.
+type Resource struct {
+	API      string          `json:"api"`
+	Version  string          `json:"version"`
+	Kind     string          `json:"kind"`
+	Resource json.RawMessage `json:"resource"`
+}
+
+type Archive struct {
+	Manifest  *Manifest
+	Resources []*Resource
+	// K8sSecrets are the data of the k8s Secrets required to run the Cluster
+	// (e.g. bootstrap, DB and Redis credentials) indexed by their names.
+	K8sSecrets map[string]map[string][]byte
+}
+
+type secretsPayload struct {
+	Resources  []*Resource                  `json:"resources"`
+	K8sSecrets map[string]map[string][]byte `json:"k8sSecrets"`
+}
+
+// IsSecretKind reports whether the resources of the kind hold sensitive data.
+// Such resources are only stored encrypted by the operator key in the archive.
+func IsSecretKind(kind string) bool {
+	return strings.HasSuffix(kind, "Secret")
+}
+
+// LoadKey reads the operator-provided key used to encrypt the sensitive
+// parts of the archive.
+func LoadKey(path string) ([]byte, error) {
+	if path == "" {
+		return nil, errors.Errorf("The encryption key file must be set")
+	}
+
+	keyBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	keyBytes = bytes.TrimSpace(keyBytes)
+	if len(keyBytes) < minKeySize {
+		return nil, errors.Errorf("The encryption key must be at least %d bytes long", minKeySize)
+	}
+
+	return keyBytes, nil
+}
+
+func Write(w io.Writer, a *Archive, key []byte) error {
+	if a == nil || a.Manifest == nil {
+		return errors.Errorf("Nil archive")
+	}
+
+	payload := &secretsPayload{
+		K8sSecrets: a.K8sSecrets,
+	}
+	var resources []*Resource
+
+	a.Manifest.FormatVersion = FormatVersion
+	a.Manifest.Kinds = make(map[string]int)
+
+	for _, rsc := range a.Resources {
+		a.Manifest.Kinds[rsc.Kind]++
+		if IsSecretKind(rsc.Kind) {
+			payload.Resources = append(payload.Resources, rsc)
+		} else {
+			resources = append(resources, rsc)
+		}
+	}
+
+	resourcesBytes, err := json.Marshal(resources)
+	if err != nil {
+		return err
+	}
+
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	secretsBytes, err := encrypt(key, payloadBytes)
+	if err != nil {
+		return err
+	}
+
+	a.Manifest.Checksums = map[string]string{
+		fileResources: getChecksum(resourcesBytes),
+		fileSecrets:   getChecksum(secretsBytes),
+	}
+
+	manifestBytes, err := json.MarshalIndent(a.Manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	gzipW := gzip.NewWriter(w)
+	tarW := tar.NewWriter(gzipW)
+
+	for _, f := range []struct {
+		name string
+		data []byte
+	}{
+		{fileManifest, manifestBytes},
+		{fileResources, resourcesBytes},
+		{fileSecrets, secretsBytes},
+	} {
+		if err := tarW.WriteHeader(&tar.Header{
+			Name:    f.name,
+			Mode:    0600,
+			Size:    int64(len(f.data)),
+			ModTime: a.Manifest.CreatedAt,
+		}); err != nil {
+			return err
+		}
+
+		if _, err := tarW.Write(f.data); err != nil {
+			return err
+		}
+	}
+
+	if err := tarW.Close(); err != nil {
+		return err
+	}
+
+	return gzipW.Close()
+}
+
+// Read reads and verifies an archive. It fails if the archive format is not
+// supported, if any of its files is corrupted or if the key is wrong.
+func Read(r io.Reader, key []byte) (*Archive, error) {
+	gzipR, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, errors.Errorf("Invalid archive: %+v", err)
+	}
+	defer gzipR.Close()
+
+	files := make(map[string][]byte)
+	tarR := tar.NewReader(gzipR)
+	for {
+		hdr, err := tarR.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Errorf("Invalid archive: %+v", err)
+		}
+
+		switch hdr.Name {
+		case fileManifest, fileResources, fileSecrets:
+		default:
+			return nil, errors.Errorf("Unknown archive file: %s", hdr.Name)
+		}
+
+		if hdr.Size > maxArchiveFileSize {
+			return nil, errors.Errorf("Archive file is too large: %s", hdr.Name)
+		}
+
+		data, err := io.ReadAll(io.LimitReader(tarR, maxArchiveFileSize))
+		if err != nil {
+			return nil, err
+		}
+		files[hdr.Name] = data
+	}
+
+	for _, name := range []string{fileManifest, fileResources, fileSecrets} {
+		if _, ok := files[name]; !ok {
+			return nil, errors.Errorf("Archive file is missing: %s", name)
+		}
+	}
+
+	manifest := &Manifest{}
+	if err := json.Unmarshal(files[fileManifest], manifest); err != nil {
+		return nil, errors.Errorf("Invalid archive manifest: %+v", err)
+	}
+
+	if manifest.FormatVersion < 1 || manifest.FormatVersion > FormatVersion {
+		return nil, errors.Errorf("Unsupported archive format version: %d", manifest.FormatVersion)
+	}
+
+	for _, name := range []string{fileResources, fileSecrets} {
+		if getChecksum(files[name]) != manifest.Checksums[name] {
+			return nil, errors.Errorf("Checksum mismatch for the archive file: %s", name)
+		}
+	}
+
+	ret := &Archive{
+		Manifest: manifest,
+	}
+
+	if err := json.Unmarshal(files[fileResources], &ret.Resources); err != nil {
+		return nil, errors.Errorf("Invalid archive resources: %+v", err)
+	}
+
+	payloadBytes, err := decrypt(key, files[fileSecrets])
+	if err != nil {
+		return nil, errors.Errorf("Could not decrypt the archive Secrets. Possibly a wrong key")
+	}
+
+	payload := &secretsPayload{}
+	if err := json.Unmarshal(payloadBytes, payload); err != nil {
+		return nil, errors.Errorf("Invalid archive Secrets: %+v", err)
+	}
+
+	ret.Resources = append(ret.Resources, payload.Resources...)
+	ret.K8sSecrets = payload.K8sSecrets
+
+	kinds := make(map[string]int)
+	for _, rsc := range ret.Resources {
+		if rsc.API == "" || rsc.Version == "" || rsc.Kind == "" {
+			return nil, errors.Errorf("Invalid archive resource: missing api, version or kind")
+		}
+		if _, err := GetResourceMetadata(rsc); err != nil {
+			return nil, err
+		}
+		kinds[rsc.Kind]++
+	}
+
+	for kind, count := range manifest.Kinds {
+		if kinds[kind] != count {
+			return nil, errors.Errorf("Archive resource count mismatch for the kind: %s", kind)
+		}
+	}
+
+	return ret, nil
+}
+
+type ResourceMetadata struct {
+	UID       string    `json:"uid"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func GetResourceMetadata(rsc *Resource) (*ResourceMetadata, error) {
+	var itm struct {
+		Metadata *ResourceMetadata `json:"metadata"`
+	}
+
+	if err := json.Unmarshal(rsc.Resource, &itm); err != nil {
+		return nil, errors.Errorf("Invalid %s resource: %+v", rsc.Kind, err)
+	}
+
+	if itm.Metadata == nil || itm.Metadata.UID == "" || itm.Metadata.Name == "" {
+		return nil, errors.Errorf("Invalid %s resource: missing uid or name", rsc.Kind)
+	}
+
+	return itm.Metadata, nil
+}
+
+// SortedKinds returns the resource kinds of the archive sorted by name.
+func (m *Manifest) SortedKinds() []string {
+	var ret []string
+	for kind := range m.Kinds {
+		ret = append(ret, kind)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+func getChecksum(in []byte) string {
+	sum := sha256.Sum256(in)
+	return hex.EncodeToString(sum[:])
+}
+
+func getAEAD(key []byte) (cipher.AEAD, error) {
+	derived := sha256.Sum256(key)
+	block, err := aes.NewCipher(derived[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func encrypt(key, plaintext []byte) ([]byte, error) {
+	aead, err := getAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, encryptionAAD), nil
+}
+
+func decrypt(key, in []byte) ([]byte, error) {
+	aead, err := getAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(in) < aead.NonceSize() {
+		return nil, errors.Errorf("Ciphertext is too short")
+	}
+
+	return aead.Open(nil, in[:aead.NonceSize()], in[aead.NonceSize():], encryptionAAD)
+}
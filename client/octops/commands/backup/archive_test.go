@@ -0,0 +1,131 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/octelium/octelium/pkg/utils/utilrand"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArchive(t *testing.T) {
+	key := []byte(utilrand.GetRandomString(32))
+
+	archive := &Archive{
+		Manifest: &Manifest{
+			ClusterVersion: "0.20.0",
+			Domain:         "example.com",
+			CreatedAt:      time.Now().UTC(),
+		},
+		Resources: []*Resource{
+			{
+				API:      "core",
+				Version:  "v1",
+				Kind:     "User",
+				Resource: json.RawMessage(`{"kind":"User","metadata":{"uid":"uid-1","name":"usr1"}}`),
+			},
+			{
+				API:      "core",
+				Version:  "v1",
+				Kind:     "Secret",
+				Resource: json.RawMessage(`{"kind":"Secret","metadata":{"uid":"uid-2","name":"sec1"},"data":{"value":"secret-value"}}`),
+			},
+		},
+		K8sSecrets: map[string]map[string][]byte{
+			"octelium-postgres": {
+				"postgres-password": []byte("pg-password"),
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	assert.Nil(t, Write(&buf, archive, key))
+	archiveBytes := buf.Bytes()
+
+	assert.False(t, bytes.Contains(archiveBytes, []byte("secret-value")))
+
+	res, err := Read(bytes.NewReader(archiveBytes), key)
+	assert.Nil(t, err)
+	assert.Equal(t, FormatVersion, res.Manifest.FormatVersion)
+	assert.Equal(t, "0.20.0", res.Manifest.ClusterVersion)
+	assert.Equal(t, 1, res.Manifest.Kinds["Secret"])
+	assert.Equal(t, []string{"Secret", "User"}, res.Manifest.SortedKinds())
+	assert.Len(t, res.Resources, 2)
+	assert.Equal(t, []byte("pg-password"), res.K8sSecrets["octelium-postgres"]["postgres-password"])
+
+	_, err = Read(bytes.NewReader(archiveBytes), []byte(utilrand.GetRandomString(32)))
+	assert.NotNil(t, err)
+
+	_, err = Read(bytes.NewReader(archiveBytes[:len(archiveBytes)/2]), key)
+	assert.NotNil(t, err)
+
+	{
+		manifestBytes, err := json.Marshal(&Manifest{
+			FormatVersion: FormatVersion + 1,
+		})
+		assert.Nil(t, err)
+
+		var buf bytes.Buffer
+		gzipW := gzip.NewWriter(&buf)
+		tarW := tar.NewWriter(gzipW)
+		for _, name := range []string{fileManifest, fileResources, fileSecrets} {
+			data := manifestBytes
+			if name != fileManifest {
+				data = []byte("[]")
+			}
+			assert.Nil(t, tarW.WriteHeader(&tar.Header{
+				Name: name,
+				Mode: 0600,
+				Size: int64(len(data)),
+			}))
+			_, err := tarW.Write(data)
+			assert.Nil(t, err)
+		}
+		assert.Nil(t, tarW.Close())
+		assert.Nil(t, gzipW.Close())
+
+		_, err = Read(&buf, key)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "Unsupported archive format version")
+	}
+}
+
+func TestLoadKey(t *testing.T) {
+	_, err := LoadKey("")
+	assert.NotNil(t, err)
+
+	{
+		path := t.TempDir() + "/key"
+		assert.Nil(t, os.WriteFile(path, []byte("short\n"), 0600))
+		_, err := LoadKey(path)
+		assert.NotNil(t, err)
+	}
+
+	{
+		path := t.TempDir() + "/key"
+		val := utilrand.GetRandomString(44)
+		assert.Nil(t, os.WriteFile(path, []byte(val+"\n"), 0600))
+		key, err := LoadKey(path)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte(val), key)
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/octelium/octelium/client/common/cliutils"
+	"github.com/octelium/octelium/client/octops/commands/initcmd"
+	"github.com/octelium/octelium/cluster/rscserver/rscserver"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+)
+
+type args struct {
+	KubeConfigFilePath string
+	KubeContext        string
+	Output             string
+	KeyFile            string
+	PostgresAddr       string
+}
+
+var examples = `
+octops backup example.com --output backup.tar.gz --key-file /path/to/key
+octops backup octelium.example.com --kubeconfig /path/to/kueconfig --output backup.tar.gz --key-file /path/to/key
+octops backup example.com --output backup.tar.gz --key-file /path/to/key --postgres-addr localhost:5432
+`
+
+var Cmd = &cobra.Command{
+	Use:   "backup [DOMAIN]",
+	Short: "Back up the Cluster resources to an archive",
+	Long: `Back up all the Cluster resources as well as the k8s Secrets required to run the Cluster to a portable archive.
+The Secrets and the k8s Secrets are encrypted by the key read from the key file which must be at least 32 bytes long
+(e.g. generated by "openssl rand -base64 32"). The same key is required to restore the archive.
+The primary storage is accessed directly using the credentials stored in the Cluster. If it is not reachable from outside
+the k8s cluster, you can use a port-forward and set its address via --postgres-addr.`,
+	Args:    cobra.ExactArgs(1),
+	Example: examples,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return doCmd(cmd, args)
+	},
+}
+
+var cmdArgs args
+
+func init() {
+	Cmd.PersistentFlags().StringVar(&cmdArgs.KubeConfigFilePath, "kubeconfig", "", "kubeconfig file path")
+	Cmd.PersistentFlags().StringVar(&cmdArgs.KubeContext, "kubecontext", "", "kubecontext")
+	Cmd.PersistentFlags().StringVarP(&cmdArgs.Output, "output", "o", "", "The archive output file path")
+	Cmd.PersistentFlags().StringVar(&cmdArgs.KeyFile, "key-file", "",
+		"The path of the file containing the key used to encrypt the archive Secrets")
+	Cmd.PersistentFlags().StringVar(&cmdArgs.PostgresAddr, "postgres-addr", "",
+		"Override the Postgres host:port address (e.g. when using a port-forward)")
+
+	Cmd.MarkPersistentFlagRequired("output")
+	Cmd.MarkPersistentFlagRequired("key-file")
+}
+
+func doCmd(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	key, err := LoadKey(cmdArgs.KeyFile)
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(cmdArgs.Output); err == nil {
+		return errors.Errorf("The output file already exists: %s", cmdArgs.Output)
+	}
+
+	cfg, err := initcmd.BuildConfigFromFlags("", cmdArgs.KubeConfigFilePath)
+	if err != nil {
+		return err
+	}
+
+	k8sC, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	db, err := NewDB(ctx, k8sC, &StorageOpts{
+		PostgresAddr: cmdArgs.PostgresAddr,
+	})
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	archive, err := getArchive(ctx, db, k8sC, args[0])
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(cmdArgs.Output, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	if err := Write(f, archive, key); err != nil {
+		f.Close()
+		os.Remove(cmdArgs.Output)
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	cliutils.LineNotify("The Cluster has been backed up to %s\n", cmdArgs.Output)
+	cliutils.LineInfo("Cluster Version: %s\n", archive.Manifest.ClusterVersion)
+	cliutils.LineInfo("Resources: %d\n", len(archive.Resources))
+
+	return nil
+}
+
+func getArchive(ctx context.Context, db *sql.DB, k8sC kubernetes.Interface, domain string) (*Archive, error) {
+	clusterVersion, err := GetClusterVersion(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	kr, err := GetKeyring(ctx, k8sC)
+	if err != nil {
+		return nil, errors.Errorf("Could not load the KEK keyring: %+v", err)
+	}
+
+	ret := &Archive{
+		Manifest: &Manifest{
+			ClusterVersion: clusterVersion,
+			Domain:         domain,
+			CreatedAt:      time.Now().UTC(),
+		},
+		K8sSecrets: make(map[string]map[string][]byte),
+	}
+
+	for _, name := range K8sSecretNames {
+		data, err := getK8sSecretData(ctx, k8sC, name)
+		if err != nil {
+			return nil, errors.Errorf("Could not get the k8s Secret %s: %+v", name, err)
+		}
+		ret.K8sSecrets[name] = data
+	}
+
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelRepeatableRead,
+		ReadOnly:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx,
+		fmt.Sprintf("SELECT api, version, kind, resource FROM %s ORDER BY id", tableName))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		rsc := &Resource{}
+		var rscBytes []byte
+		if err := rows.Scan(&rsc.API, &rsc.Version, &rsc.Kind, &rscBytes); err != nil {
+			return nil, err
+		}
+
+		if IsSecretKind(rsc.Kind) {
+			rscBytes, err = rscserver.OpenResourceJSON(kr, rscBytes)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		rsc.Resource = rscBytes
+		ret.Resources = append(ret.Resources, rsc)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,178 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"crypto/tls"
+	"database/sql"
+	"net"
+	"strconv"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/octelium/octelium/cluster/common/ocrypto/keyring"
+	"github.com/octelium/octelium/cluster/common/postgresutils"
+	"github.com/pkg/errors"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const k8sNS = "octelium"
+
+// K8sSecretNames are the k8s Secrets holding the Cluster bootstrap and the
+// primary and secondary storage credentials.
+var K8sSecretNames = []string{
+	"octelium-init",
+	"octelium-postgres",
+	"octelium-redis",
+}
+
+const tableName = "octelium_resources"
+
+// StorageOpts overrides the storage addresses found in the Cluster k8s Secrets.
+// This is needed when the storage is only reachable from within the k8s
+// cluster and is accessed instead through a port-forward.
+type StorageOpts struct {
+	PostgresAddr string
+	RedisAddr    string
+}
+
+func getK8sSecretData(ctx context.Context, k8sC kubernetes.Interface, name string) (map[string][]byte, error) {
+	secret, err := k8sC.CoreV1().Secrets(k8sNS).Get(ctx, name, k8smetav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return secret.Data, nil
+}
+
+func overrideHostPort(addr string, host string, port int) (string, int, error) {
+	if addr == "" {
+		return host, port, nil
+	}
+
+	h, p, err := net.SplitHostPort(addr)
+	if err != nil {
+		return "", 0, errors.Errorf("Invalid address: %s", addr)
+	}
+
+	portNum, err := strconv.Atoi(p)
+	if err != nil {
+		return "", 0, errors.Errorf("Invalid port: %s", addr)
+	}
+
+	return h, portNum, nil
+}
+
+func NewDB(ctx context.Context, k8sC kubernetes.Interface, o *StorageOpts) (*sql.DB, error) {
+	data, err := getK8sSecretData(ctx, k8sC, "octelium-postgres")
+	if err != nil {
+		return nil, errors.Errorf("Could not get the Postgres credentials: %+v", err)
+	}
+
+	port, _ := strconv.Atoi(string(data["port"]))
+	host, port, err := overrideHostPort(o.PostgresAddr, string(data["host"]), port)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := postgresutils.NewDBWithURL(postgresutils.GetPostgresURLFromArgs(&postgresutils.PostgresDBArgs{
+		Username: string(data["username"]),
+		Password: string(data["postgres-password"]),
+		Host:     host,
+		Port:     port,
+		DB:       string(data["database"]),
+		NoSSL:    string(data["no_ssl"]) == "true",
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, errors.Errorf("Could not connect to Postgres at %s. Use --postgres-addr if it is not reachable: %+v",
+			net.JoinHostPort(host, strconv.Itoa(port)), err)
+	}
+
+	return db, nil
+}
+
+func NewRedisClient(ctx context.Context, k8sC kubernetes.Interface, o *StorageOpts) (*redis.Client, error) {
+	data, err := getK8sSecretData(ctx, k8sC, "octelium-redis")
+	if err != nil {
+		return nil, errors.Errorf("Could not get the Redis credentials: %+v", err)
+	}
+
+	port, _ := strconv.Atoi(string(data["port"]))
+	if port == 0 {
+		port = 6379
+	}
+	host, port, err := overrideHostPort(o.RedisAddr, string(data["host"]), port)
+	if err != nil {
+		return nil, err
+	}
+
+	db, _ := strconv.Atoi(string(data["database"]))
+
+	ret := redis.NewClient(&redis.Options{
+		Addr:     net.JoinHostPort(host, strconv.Itoa(port)),
+		Username: string(data["username"]),
+		Password: string(data["password"]),
+		DB:       db,
+		TLSConfig: func() *tls.Config {
+			if string(data["use_tls"]) == "true" {
+				return &tls.Config{
+					MinVersion: tls.VersionTLS12,
+				}
+			}
+			return nil
+		}(),
+	})
+
+	if err := ret.Ping(ctx).Err(); err != nil {
+		ret.Close()
+		return nil, errors.Errorf("Could not connect to Redis at %s. Use --redis-addr if it is not reachable: %+v",
+			net.JoinHostPort(host, strconv.Itoa(port)), err)
+	}
+
+	return ret, nil
+}
+
+// GetClusterVersion returns the version of the Cluster stored in the default Region.
+func GetClusterVersion(ctx context.Context, db *sql.DB) (string, error) {
+	var ret sql.NullString
+	if err := db.QueryRowContext(ctx,
+		`SELECT resource->'status'->>'version' FROM `+tableName+
+			` WHERE kind = 'Region' AND resource->'metadata'->>'name' = 'default'`).Scan(&ret); err != nil {
+		return "", errors.Errorf("Could not get the Cluster version: %+v", err)
+	}
+
+	return ret.String, nil
+}
+
+// GetKeyring returns the KEK keyring used by the rscserver to encrypt the
+// Secrets at rest. It returns nil if encryption at rest is not enabled.
+func GetKeyring(ctx context.Context, k8sC kubernetes.Interface) (*keyring.Keyring, error) {
+	data, err := getK8sSecretData(ctx, k8sC, keyring.K8sSecretName)
+	if err != nil {
+		if k8serr.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return keyring.New(data)
+}
@@ -17,8 +17,11 @@ package commands
 import (
 	"github.com/octelium/octelium/client/common/cliutils"
 	"github.com/octelium/octelium/client/common/commands/version"
+	"github.com/octelium/octelium/client/octops/commands/backup"
 	"github.com/octelium/octelium/client/octops/commands/cert"
 	"github.com/octelium/octelium/client/octops/commands/initcmd"
+	"github.com/octelium/octelium/client/octops/commands/restore"
+	"github.com/octelium/octelium/client/octops/commands/rotatekek"
 	"github.com/octelium/octelium/client/octops/commands/uninstall"
 	"github.com/octelium/octelium/client/octops/commands/upgrade"
 	"github.com/spf13/cobra"
@@ -39,6 +42,9 @@ func InitCmds() {
 	Cmd.AddCommand(initcmd.Cmd)
 	Cmd.AddCommand(upgrade.Cmd)
 	Cmd.AddCommand(cert.Cmd)
+	Cmd.AddCommand(rotatekek.Cmd)
+	Cmd.AddCommand(backup.Cmd)
+	Cmd.AddCommand(restore.Cmd)
 	Cmd.AddCommand(version.Cmd)
 	Cmd.AddCommand(uninstall.Cmd)
 }
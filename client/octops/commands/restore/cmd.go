@@ -0,0 +1,239 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/octelium/octelium/client/common/cliutils"
+	"github.com/octelium/octelium/client/octops/commands/backup"
+	"github.com/octelium/octelium/client/octops/commands/initcmd"
+	"github.com/octelium/octelium/cluster/rscserver/rscserver"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	k8scorev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+type args struct {
+	KubeConfigFilePath string
+	KubeContext        string
+	File               string
+	KeyFile            string
+	PostgresAddr       string
+	RedisAddr          string
+	DryRun             bool
+	RestoreK8sSecrets  bool
+}
+
+var examples = `
+octops restore example.com --file backup.tar.gz --key-file /path/to/key --dry-run
+octops restore example.com --file backup.tar.gz --key-file /path/to/key
+octops restore octelium.example.com --kubeconfig /path/to/kueconfig --file backup.tar.gz --key-file /path/to/key
+`
+
+var Cmd = &cobra.Command{
+	Use:   "restore [DOMAIN]",
+	Short: "Restore the Cluster resources from a backup archive",
+	Long: `Restore the Cluster resources from an archive created by "octops backup".
+The archive is restored into an already installed Cluster whose version must not be older than the archive version.
+Resources that already exist in the Cluster by name are updated in place while keeping their UIDs and statuses.
+The rest are created with new UIDs and all the references to them are rewritten accordingly.
+The resources are written through the Resource server so that the Secrets are encrypted at rest by the current KEK,
+the writes are recorded in the audit log and the running Cluster components are notified of the changes.
+The storage is accessed directly using the credentials stored in the Cluster. If it is not reachable from outside
+the k8s cluster, you can use port-forwards and set their addresses via --postgres-addr and --redis-addr.
+The k8s Secrets of the archive (bootstrap, DB and Redis credentials) are only restored if they do not exist
+and --restore-k8s-secrets is set. Use --dry-run to verify the archive and its compatibility with the Cluster
+without applying it.`,
+	Args:    cobra.ExactArgs(1),
+	Example: examples,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return doCmd(cmd, args)
+	},
+}
+
+var cmdArgs args
+
+func init() {
+	Cmd.PersistentFlags().StringVar(&cmdArgs.KubeConfigFilePath, "kubeconfig", "", "kubeconfig file path")
+	Cmd.PersistentFlags().StringVar(&cmdArgs.KubeContext, "kubecontext", "", "kubecontext")
+	Cmd.PersistentFlags().StringVarP(&cmdArgs.File, "file", "f", "", "The archive file path")
+	Cmd.PersistentFlags().StringVar(&cmdArgs.KeyFile, "key-file", "",
+		"The path of the file containing the key used to encrypt the archive Secrets")
+	Cmd.PersistentFlags().StringVar(&cmdArgs.PostgresAddr, "postgres-addr", "",
+		"Override the Postgres host:port address (e.g. when using a port-forward)")
+	Cmd.PersistentFlags().StringVar(&cmdArgs.RedisAddr, "redis-addr", "",
+		"Override the Redis host:port address (e.g. when using a port-forward)")
+	Cmd.PersistentFlags().BoolVar(&cmdArgs.DryRun, "dry-run", false,
+		"Only verify the archive and its compatibility with the Cluster without applying it")
+	Cmd.PersistentFlags().BoolVar(&cmdArgs.RestoreK8sSecrets, "restore-k8s-secrets", false,
+		"Create the archive k8s Secrets that do not exist in the Cluster")
+
+	Cmd.MarkPersistentFlagRequired("file")
+	Cmd.MarkPersistentFlagRequired("key-file")
+}
+
+func doCmd(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	key, err := backup.LoadKey(cmdArgs.KeyFile)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(cmdArgs.File)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	archive, err := backup.Read(f, key)
+	if err != nil {
+		return err
+	}
+
+	printArchiveInfo(archive)
+
+	if archive.Manifest.Domain != args[0] {
+		cliutils.LineWarn("The archive was taken from the Cluster %s\n", archive.Manifest.Domain)
+	}
+
+	cfg, err := initcmd.BuildConfigFromFlags("", cmdArgs.KubeConfigFilePath)
+	if err != nil {
+		return err
+	}
+
+	k8sC, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	storageOpts := &backup.StorageOpts{
+		PostgresAddr: cmdArgs.PostgresAddr,
+		RedisAddr:    cmdArgs.RedisAddr,
+	}
+
+	db, err := backup.NewDB(ctx, k8sC, storageOpts)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	clusterVersion, err := backup.GetClusterVersion(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	if err := checkVersion(archive.Manifest.ClusterVersion, clusterVersion); err != nil {
+		return err
+	}
+
+	target, err := getTargetResources(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	itms, err := getRestoreItems(archive.Resources, target)
+	if err != nil {
+		return err
+	}
+
+	if cmdArgs.DryRun {
+		cliutils.LineNotify("The archive has been verified successfully. %d resources can be restored to the Cluster.\n",
+			len(itms))
+		return nil
+	}
+
+	redisC, err := backup.NewRedisClient(ctx, k8sC, storageOpts)
+	if err != nil {
+		return err
+	}
+	defer redisC.Close()
+
+	kr, err := backup.GetKeyring(ctx, k8sC)
+	if err != nil {
+		return errors.Errorf("Could not load the KEK keyring: %+v", err)
+	}
+
+	rscSrv, err := rscserver.NewServer(ctx, &rscserver.Opts{
+		DB:      db,
+		RedisC:  redisC,
+		Keyring: kr,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := cliutils.RunPromptConfirm(
+		fmt.Sprintf("Confirm to restore %d resources to the Cluster", len(itms))); err != nil {
+		return err
+	}
+
+	if cmdArgs.RestoreK8sSecrets {
+		if err := restoreK8sSecrets(ctx, k8sC, archive.K8sSecrets); err != nil {
+			return err
+		}
+	}
+
+	if err := applyRestoreItems(ctx, rscSrv, itms); err != nil {
+		return errors.Errorf("Could not restore the resources: %+v", err)
+	}
+
+	cliutils.LineNotify("The Cluster has been restored.\n")
+
+	return nil
+}
+
+func printArchiveInfo(archive *backup.Archive) {
+	cliutils.LineInfo("Archive Cluster: %s\n", archive.Manifest.Domain)
+	cliutils.LineInfo("Archive Cluster Version: %s\n", archive.Manifest.ClusterVersion)
+	cliutils.LineInfo("Archive Created At: %s\n", archive.Manifest.CreatedAt.Format(time.RFC3339))
+	for _, kind := range archive.Manifest.SortedKinds() {
+		cliutils.LineInfo("  %s: %d\n", kind, archive.Manifest.Kinds[kind])
+	}
+}
+
+func restoreK8sSecrets(ctx context.Context, k8sC kubernetes.Interface, secrets map[string]map[string][]byte) error {
+	for _, name := range backup.K8sSecretNames {
+		data, ok := secrets[name]
+		if !ok {
+			continue
+		}
+
+		_, err := k8sC.CoreV1().Secrets("octelium").Create(ctx, &k8scorev1.Secret{
+			ObjectMeta: k8smetav1.ObjectMeta{
+				Name:      name,
+				Namespace: "octelium",
+			},
+			Data: data,
+		}, k8smetav1.CreateOptions{})
+		switch {
+		case err == nil:
+			cliutils.LineInfo("Restored the k8s Secret %s\n", name)
+		case k8serr.IsAlreadyExists(err):
+			cliutils.LineWarn("The k8s Secret %s already exists. Skipping it\n", name)
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
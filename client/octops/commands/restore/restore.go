@@ -0,0 +1,214 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-version"
+	"github.com/octelium/octelium/client/octops/commands/backup"
+	"github.com/octelium/octelium/cluster/common/vutils"
+	"github.com/octelium/octelium/cluster/rscserver/rscserver"
+	"github.com/octelium/octelium/pkg/common/pbutils"
+	"github.com/pkg/errors"
+)
+
+const tableName = "octelium_resources"
+
+type targetResource struct {
+	uid    string
+	status json.RawMessage
+}
+
+type restoreItem struct {
+	uid      string
+	isUpdate bool
+	rsc      *backup.Resource
+}
+
+func getResourceKey(api, version, kind, name string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", api, version, kind, name)
+}
+
+// checkVersion refuses to restore an archive into a Cluster that is older than
+// the Cluster from which the archive was taken or that has a different major version.
+func checkVersion(archiveVersion, clusterVersion string) error {
+	if archiveVersion == clusterVersion {
+		return nil
+	}
+
+	archiveV, err := version.NewSemver(archiveVersion)
+	if err != nil {
+		return errors.Errorf("Could not parse the archive Cluster version: %s", archiveVersion)
+	}
+
+	clusterV, err := version.NewSemver(clusterVersion)
+	if err != nil {
+		return errors.Errorf("Could not parse the Cluster version: %s", clusterVersion)
+	}
+
+	if archiveV.Segments()[0] != clusterV.Segments()[0] {
+		return errors.Errorf("Incompatible major versions. Archive version: %s, Cluster version: %s",
+			archiveVersion, clusterVersion)
+	}
+
+	if clusterV.LessThan(archiveV) {
+		return errors.Errorf("The Cluster version %s is older than the archive version %s. Upgrade the Cluster first",
+			clusterVersion, archiveVersion)
+	}
+
+	return nil
+}
+
+// getRestoreItems maps the archive resources to the target Cluster resources.
+// A resource that already exists in the target Cluster by its name keeps its
+// UID and status. Otherwise it is given a new UID. All the references to the
+// archive UIDs within the resources are rewritten accordingly.
+func getRestoreItems(rscs []*backup.Resource, target map[string]*targetResource) ([]*restoreItem, error) {
+	uidMap := make(map[string]string)
+	var ret []*restoreItem
+
+	for _, rsc := range rscs {
+		md, err := backup.GetResourceMetadata(rsc)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := uidMap[md.UID]; ok {
+			return nil, errors.Errorf("Duplicate resource UID in the archive: %s", md.UID)
+		}
+
+		itm := &restoreItem{
+			rsc: rsc,
+		}
+
+		if t, ok := target[getResourceKey(rsc.API, rsc.Version, rsc.Kind, md.Name)]; ok {
+			itm.uid = t.uid
+			itm.isUpdate = true
+		} else {
+			itm.uid = uuid.New().String()
+		}
+
+		uidMap[md.UID] = itm.uid
+		ret = append(ret, itm)
+	}
+
+	for _, itm := range ret {
+		var rscMap map[string]any
+		dec := json.NewDecoder(bytes.NewReader(itm.rsc.Resource))
+		dec.UseNumber()
+		if err := dec.Decode(&rscMap); err != nil {
+			return nil, err
+		}
+
+		rscMap = replaceUIDs(rscMap, uidMap).(map[string]any)
+
+		if itm.isUpdate {
+			md, _ := backup.GetResourceMetadata(itm.rsc)
+			t := target[getResourceKey(itm.rsc.API, itm.rsc.Version, itm.rsc.Kind, md.Name)]
+			if len(t.status) > 0 && string(t.status) != "null" {
+				rscMap["status"] = t.status
+			}
+		}
+
+		rscBytes, err := json.Marshal(rscMap)
+		if err != nil {
+			return nil, err
+		}
+
+		itm.rsc = &backup.Resource{
+			API:      itm.rsc.API,
+			Version:  itm.rsc.Version,
+			Kind:     itm.rsc.Kind,
+			Resource: rscBytes,
+		}
+	}
+
+	return ret, nil
+}
+
+func replaceUIDs(in any, uidMap map[string]string) any {
+	switch v := in.(type) {
+	case map[string]any:
+		for k, val := range v {
+			v[k] = replaceUIDs(val, uidMap)
+		}
+		return v
+	case []any:
+		for i, val := range v {
+			v[i] = replaceUIDs(val, uidMap)
+		}
+		return v
+	case string:
+		if newUID, ok := uidMap[v]; ok {
+			return newUID
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func getTargetResources(ctx context.Context, db *sql.DB) (map[string]*targetResource, error) {
+	rows, err := db.QueryContext(ctx,
+		fmt.Sprintf("SELECT uid, api, version, kind, resource->'metadata'->>'name', resource->'status' FROM %s",
+			tableName))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ret := make(map[string]*targetResource)
+	for rows.Next() {
+		var api, version, kind, name string
+		var status []byte
+		t := &targetResource{}
+		if err := rows.Scan(&t.uid, &api, &version, &kind, &name, &status); err != nil {
+			return nil, err
+		}
+		t.status = status
+		ret[getResourceKey(api, version, kind, name)] = t
+	}
+
+	return ret, rows.Err()
+}
+
+// applyRestoreItems writes the restored resources through the Resource server
+// which encrypts the Secrets at rest, records the writes in the audit log and
+// notifies the watchers.
+func applyRestoreItems(ctx context.Context, rscSrv *rscserver.Server, itms []*restoreItem) error {
+	for _, itm := range itms {
+		obj, err := vutils.NewResourceObject(itm.rsc.API, itm.rsc.Version, itm.rsc.Kind)
+		if err != nil {
+			return err
+		}
+
+		if err := pbutils.UnmarshalJSON(itm.rsc.Resource, obj); err != nil {
+			return err
+		}
+
+		if _, err := rscSrv.RestoreResource(ctx, obj, itm.rsc.API, itm.rsc.Version, itm.rsc.Kind); err != nil {
+			return errors.Errorf("Could not restore %s %s: %+v",
+				itm.rsc.Kind, obj.GetMetadata().GetName(), err)
+		}
+	}
+
+	return nil
+}
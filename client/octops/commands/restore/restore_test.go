@@ -0,0 +1,108 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/octelium/octelium/client/octops/commands/backup"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckVersion(t *testing.T) {
+	assert.Nil(t, checkVersion("0.20.0", "0.20.0"))
+	assert.Nil(t, checkVersion("0.20.0", "0.21.3"))
+	assert.Nil(t, checkVersion("dev", "dev"))
+
+	assert.NotNil(t, checkVersion("0.21.0", "0.20.0"))
+	assert.NotNil(t, checkVersion("1.0.0", "2.0.0"))
+	assert.NotNil(t, checkVersion("dev", "0.20.0"))
+	assert.NotNil(t, checkVersion("0.20.0", "dev"))
+}
+
+func TestGetRestoreItems(t *testing.T) {
+	rscs := []*backup.Resource{
+		{
+			API:      "core",
+			Version:  "v1",
+			Kind:     "User",
+			Resource: json.RawMessage(`{"metadata":{"uid":"old-usr1","name":"usr1"},"status":{"x":1}}`),
+		},
+		{
+			API:      "core",
+			Version:  "v1",
+			Kind:     "User",
+			Resource: json.RawMessage(`{"metadata":{"uid":"old-usr2","name":"usr2"},"spec":{"n":12345678901234567}}`),
+		},
+		{
+			API:     "core",
+			Version: "v1",
+			Kind:    "Session",
+			Resource: json.RawMessage(
+				`{"metadata":{"uid":"old-sess1","name":"sess1"},"status":{"userRef":{"uid":"old-usr2","name":"usr2"},"refs":["old-usr1"]}}`),
+		},
+	}
+
+	target := map[string]*targetResource{
+		getResourceKey("core", "v1", "User", "usr1"): {
+			uid:    "target-usr1",
+			status: json.RawMessage(`{"y":2}`),
+		},
+	}
+
+	itms, err := getRestoreItems(rscs, target)
+	assert.Nil(t, err)
+	assert.Len(t, itms, 3)
+
+	assert.True(t, itms[0].isUpdate)
+	assert.Equal(t, "target-usr1", itms[0].uid)
+	assert.JSONEq(t, `{"metadata":{"uid":"target-usr1","name":"usr1"},"status":{"y":2}}`,
+		string(itms[0].rsc.Resource))
+
+	assert.False(t, itms[1].isUpdate)
+	assert.NotEqual(t, "old-usr2", itms[1].uid)
+	assert.Contains(t, string(itms[1].rsc.Resource), "12345678901234567")
+
+	assert.False(t, itms[2].isUpdate)
+	var sess struct {
+		Metadata struct {
+			UID string `json:"uid"`
+		} `json:"metadata"`
+		Status struct {
+			UserRef struct {
+				UID string `json:"uid"`
+			} `json:"userRef"`
+			Refs []string `json:"refs"`
+		} `json:"status"`
+	}
+	assert.Nil(t, json.Unmarshal(itms[2].rsc.Resource, &sess))
+	assert.Equal(t, itms[2].uid, sess.Metadata.UID)
+	assert.Equal(t, itms[1].uid, sess.Status.UserRef.UID)
+	assert.Equal(t, []string{"target-usr1"}, sess.Status.Refs)
+
+	_, err = getRestoreItems(append(rscs, rscs[0]), nil)
+	assert.NotNil(t, err)
+
+	_, err = getRestoreItems([]*backup.Resource{
+		{
+			API:      "core",
+			Version:  "v1",
+			Kind:     "User",
+			Resource: json.RawMessage(`{"metadata":{"name":"usr1"}}`),
+		},
+	}, nil)
+	assert.NotNil(t, err)
+}
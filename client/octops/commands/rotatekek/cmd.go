@@ -0,0 +1,99 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rotatekek
+
+import (
+	"github.com/octelium/octelium/client/common/cliutils"
+	"github.com/octelium/octelium/client/octops/commands/initcmd"
+	"github.com/octelium/octelium/cluster/common/ocrypto/keyring"
+	"github.com/spf13/cobra"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+type args struct {
+	KubeConfigFilePath string
+	KubeContext        string
+}
+
+var examples = `
+octops rotate-kek example.com
+octops rotate-kek octelium.example.com --kubeconfig /path/to/kueconfig
+`
+
+var Cmd = &cobra.Command{
+	Use:   "rotate-kek [DOMAIN]",
+	Short: "Rotate the key-encryption key (KEK) used to encrypt the Cluster Secrets at rest",
+	Long: `Rotate the key-encryption key (KEK) used to encrypt the Cluster Secrets at rest.
+A new KEK is generated and set as the current KEK. The rscserver then re-wraps the per-Secret data keys
+by the new KEK online once the updated keyring is propagated to its pods. Previous KEKs are retained in the keyring.`,
+	Args:    cobra.ExactArgs(1),
+	Example: examples,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return doCmd(cmd, args)
+	},
+}
+
+var cmdArgs args
+
+func init() {
+	Cmd.PersistentFlags().StringVar(&cmdArgs.KubeConfigFilePath, "kubeconfig", "", "kubeconfig file path")
+	Cmd.PersistentFlags().StringVar(&cmdArgs.KubeContext, "kubecontext", "", "kubecontext")
+}
+
+func doCmd(cmd *cobra.Command, args []string) error {
+
+	ctx := cmd.Context()
+
+	cfg, err := initcmd.BuildConfigFromFlags("", cmdArgs.KubeConfigFilePath)
+	if err != nil {
+		return err
+	}
+
+	k8sC, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	secret, err := k8sC.CoreV1().Secrets("octelium").Get(ctx, keyring.K8sSecretName, k8smetav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte)
+	}
+
+	if err := cliutils.RunPromptConfirm("Are you sure that you want to rotate the Cluster KEK"); err != nil {
+		return err
+	}
+
+	keyID, err := keyring.AddKey(secret.Data)
+	if err != nil {
+		return err
+	}
+
+	if _, err := keyring.New(secret.Data); err != nil {
+		return err
+	}
+
+	if _, err := k8sC.CoreV1().Secrets("octelium").Update(ctx, secret, k8smetav1.UpdateOptions{}); err != nil {
+		return err
+	}
+
+	cliutils.LineNotify("The Cluster KEK has been rotated. The current KEK is now %s\n", keyID)
+
+	return nil
+}
@@ -0,0 +1,261 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package admin
+
+import (
+	"context"
+	"regexp"
+	"slices"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/apis/rsc/rauditv1"
+	"github.com/octelium/octelium/apis/rsc/rmetav1"
+	"github.com/octelium/octelium/cluster/apiserver/apiserver/serr"
+	"github.com/octelium/octelium/cluster/common/apivalidation"
+	"github.com/octelium/octelium/cluster/common/vutils"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
+	"github.com/octelium/octelium/pkg/apiutils/umetav1"
+	"github.com/octelium/octelium/pkg/common/pbutils"
+	"github.com/octelium/octelium/pkg/grpcerr"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var rgxAuditLogKind = regexp.MustCompile(`^[A-Z][a-zA-Z]{1,31}$`)
+var rgxAuditLogUID = regexp.MustCompile(`^[a-zA-Z0-9-]{1,64}$`)
+
+// restorableKinds are the kinds whose revisions can be restored. Other kinds
+// are either managed by the Cluster (e.g. Sessions and Gateways) or cannot be
+// safely resurrected (e.g. Credentials).
+var restorableKinds = []string{
+	ucorev1.KindPolicy,
+	ucorev1.KindUser,
+	ucorev1.KindGroup,
+	ucorev1.KindNamespace,
+	ucorev1.KindService,
+	ucorev1.KindIdentityProvider,
+}
+
+func (s *Server) ListAuditLog(ctx context.Context, req *corev1.ListAuditLogOptions) (*corev1.AuditLogList, error) {
+	if err := validateListAuditLogOptions(req); err != nil {
+		return nil, err
+	}
+
+	ret, err := s.octeliumC.AuditC().ListAuditLog(ctx, req)
+	if err != nil {
+		return nil, serr.InternalWithErr(err)
+	}
+
+	return ret, nil
+}
+
+func (s *Server) GetAuditLog(ctx context.Context, req *metav1.GetOptions) (*corev1.AuditLog, error) {
+	if req == nil || !rgxAuditLogUID.MatchString(req.Uid) {
+		return nil, serr.InvalidArg("A valid AuditLog UID must be set")
+	}
+
+	ret, err := s.octeliumC.AuditC().GetAuditLog(ctx, &rauditv1.GetAuditLogRequest{
+		Uid: req.Uid,
+	})
+	if err != nil {
+		return nil, serr.K8sNotFoundOrInternalWithErr(err)
+	}
+
+	return ret, nil
+}
+
+func (s *Server) RestoreResourceRevision(ctx context.Context,
+	req *corev1.RestoreResourceRevisionRequest) (*corev1.RestoreResourceRevisionResponse, error) {
+	if req.AuditLogRef == nil || !rgxAuditLogUID.MatchString(req.AuditLogRef.Uid) {
+		return nil, serr.InvalidArg("A valid AuditLog UID must be set")
+	}
+
+	auditLog, err := s.octeliumC.AuditC().GetAuditLog(ctx, &rauditv1.GetAuditLogRequest{
+		Uid: req.AuditLogRef.Uid,
+	})
+	if err != nil {
+		return nil, serr.K8sNotFoundOrInternalWithErr(err)
+	}
+
+	if err := checkRestorableAuditLog(auditLog); err != nil {
+		return nil, err
+	}
+
+	ret, err := s.restoreRevision(ctx, auditLog)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.RestoreResourceRevisionResponse{
+		ResourceRef:     umetav1.GetObjectReference(ret),
+		ResourceVersion: ret.GetMetadata().ResourceVersion,
+	}, nil
+}
+
+// restoreRevision restores the revision recorded by the AuditLog through the
+// same Create and Update methods used by the admin API so that the revision
+// is validated and defaulted like any other write. The recorded status is
+// discarded since it is owned by the Cluster and is likely stale.
+func (s *Server) restoreRevision(ctx context.Context, auditLog *corev1.AuditLog) (umetav1.ResourceObjectI, error) {
+	revision := auditLog.Entry.New
+	if auditLog.Entry.Operation == corev1.AuditLog_Entry_DELETE {
+		revision = auditLog.Entry.Old
+	}
+
+	revisionMap := revision.AsMap()
+	delete(revisionMap, "status")
+
+	switch auditLog.Metadata.TargetRef.Kind {
+	case ucorev1.KindPolicy:
+		return doRestoreRevision(ctx, revisionMap, &corev1.Policy{},
+			s.octeliumC.CoreC().GetPolicy, s.CreatePolicy, s.UpdatePolicy)
+	case ucorev1.KindUser:
+		return doRestoreRevision(ctx, revisionMap, &corev1.User{},
+			s.octeliumC.CoreC().GetUser, s.CreateUser, s.UpdateUser)
+	case ucorev1.KindGroup:
+		return doRestoreRevision(ctx, revisionMap, &corev1.Group{},
+			s.octeliumC.CoreC().GetGroup, s.CreateGroup, s.UpdateGroup)
+	case ucorev1.KindNamespace:
+		return doRestoreRevision(ctx, revisionMap, &corev1.Namespace{},
+			s.octeliumC.CoreC().GetNamespace, s.CreateNamespace, s.UpdateNamespace)
+	case ucorev1.KindService:
+		return doRestoreRevision(ctx, revisionMap, &corev1.Service{},
+			s.octeliumC.CoreC().GetService, s.CreateService, s.UpdateService)
+	case ucorev1.KindIdentityProvider:
+		return doRestoreRevision(ctx, revisionMap, &corev1.IdentityProvider{},
+			s.octeliumC.CoreC().GetIdentityProvider, s.CreateIdentityProvider, s.UpdateIdentityProvider)
+	default:
+		return nil, serr.InvalidArg("Revisions of %s cannot be restored", auditLog.Metadata.TargetRef.Kind)
+	}
+}
+
+func doRestoreRevision[T umetav1.ResourceObjectI](ctx context.Context, revision map[string]any, req T,
+	getFn func(context.Context, *rmetav1.GetOptions, ...grpc.CallOption) (T, error),
+	createFn, updateFn func(context.Context, T) (T, error)) (umetav1.ResourceObjectI, error) {
+
+	if err := pbutils.UnmarshalFromMap(revision, req); err != nil {
+		return nil, serr.InvalidArgWithErr(err)
+	}
+
+	if req.GetMetadata() == nil {
+		return nil, serr.InvalidArg("The revision has no metadata")
+	}
+
+	var ret T
+	_, err := getFn(ctx, &rmetav1.GetOptions{Name: req.GetMetadata().Name})
+	switch {
+	case err == nil:
+		ret, err = updateFn(ctx, req)
+	case grpcerr.IsNotFound(err):
+		ret, err = createFn(ctx, req)
+	default:
+		return nil, serr.InternalWithErr(err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func validateListAuditLogOptions(req *corev1.ListAuditLogOptions) error {
+	checkRef := func(ref *metav1.ObjectReference) error {
+		if ref == nil {
+			return nil
+		}
+
+		if ref.Kind != "" && !rgxAuditLogKind.MatchString(ref.Kind) {
+			return serr.InvalidArg("Invalid kind: %s", ref.Kind)
+		}
+
+		if ref.Name != "" {
+			if err := apivalidation.ValidateName(ref.Name, 0, 0); err != nil {
+				return err
+			}
+		}
+
+		if ref.Uid != "" && !govalidator.IsUUIDv4(ref.Uid) {
+			return serr.InvalidArg("Invalid UID: %s", ref.Uid)
+		}
+
+		return nil
+	}
+
+	if err := checkRef(req.ResourceRef); err != nil {
+		return err
+	}
+
+	if err := checkRef(req.ActorRef); err != nil {
+		return err
+	}
+
+	if req.ResourceRef != nil && req.ResourceRef.ApiVersion != "" &&
+		req.ResourceRef.ApiVersion != vutils.GetApiVersion(ucorev1.API, ucorev1.Version) {
+		return serr.InvalidArg("Unsupported apiVersion: %s", req.ResourceRef.ApiVersion)
+	}
+
+	if req.From != nil && req.To != nil && req.From.AsTime().After(req.To.AsTime()) {
+		return serr.InvalidArg("from must be before to")
+	}
+
+	if req.Common.GetPage() > 10000 {
+		return serr.InvalidArg("Page number is too high")
+	}
+
+	return nil
+}
+
+func checkRestorableAuditLog(auditLog *corev1.AuditLog) error {
+	if auditLog.Metadata == nil || auditLog.Metadata.TargetRef == nil || auditLog.Entry == nil {
+		return serr.InvalidArg("Invalid AuditLog")
+	}
+
+	targetRef := auditLog.Metadata.TargetRef
+
+	if targetRef.ApiVersion != vutils.GetApiVersion(ucorev1.API, ucorev1.Version) ||
+		!slices.Contains(restorableKinds, targetRef.Kind) {
+		return serr.InvalidArg("Revisions of %s cannot be restored", targetRef.Kind)
+	}
+
+	if auditLog.Entry.IsRedacted {
+		return serr.InvalidArg("Redacted revisions cannot be restored")
+	}
+
+	isSystem := func(arg *structpb.Struct) bool {
+		if arg == nil {
+			return false
+		}
+		md := arg.Fields["metadata"].GetStructValue()
+		return md != nil && md.Fields["isSystem"].GetBoolValue()
+	}
+
+	if isSystem(auditLog.Entry.New) || isSystem(auditLog.Entry.Old) {
+		return serr.Unauthorized("This is a System Resource")
+	}
+
+	revision := auditLog.Entry.New
+	if auditLog.Entry.Operation == corev1.AuditLog_Entry_DELETE {
+		revision = auditLog.Entry.Old
+	}
+	if revision == nil {
+		return serr.InvalidArg("The AuditLog has no revision to restore")
+	}
+
+	return nil
+}
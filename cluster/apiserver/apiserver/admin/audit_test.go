@@ -0,0 +1,267 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/apis/rsc/rmetav1"
+	"github.com/octelium/octelium/cluster/common/tests"
+	"github.com/octelium/octelium/pkg/common/pbutils"
+	"github.com/octelium/octelium/pkg/grpcerr"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestValidateListAuditLogOptions(t *testing.T) {
+	now := time.Now()
+
+	valids := []*corev1.ListAuditLogOptions{
+		{},
+		{
+			ResourceRef: &metav1.ObjectReference{
+				ApiVersion: "core/v1",
+				Kind:       "Policy",
+				Name:       "allow-all",
+			},
+			ActorRef: &metav1.ObjectReference{
+				Name: "john-abcdef",
+			},
+			From: timestamppb.New(now.Add(-time.Hour)),
+			To:   timestamppb.New(now),
+		},
+	}
+
+	for _, valid := range valids {
+		assert.Nil(t, validateListAuditLogOptions(valid))
+	}
+
+	invalids := []*corev1.ListAuditLogOptions{
+		{
+			ResourceRef: &metav1.ObjectReference{
+				Kind: "policy",
+			},
+		},
+		{
+			ResourceRef: &metav1.ObjectReference{
+				ApiVersion: "cluster/v1",
+				Kind:       "Policy",
+			},
+		},
+		{
+			ActorRef: &metav1.ObjectReference{
+				Uid: "not-a-uuid",
+			},
+		},
+		{
+			From: timestamppb.New(now),
+			To:   timestamppb.New(now.Add(-time.Hour)),
+		},
+		{
+			Common: &metav1.CommonListOptions{
+				Page: 10001,
+			},
+		},
+	}
+
+	for _, invalid := range invalids {
+		err := validateListAuditLogOptions(invalid)
+		assert.NotNil(t, err)
+		assert.True(t, grpcerr.IsInvalidArg(err))
+	}
+}
+
+func TestCheckRestorableAuditLog(t *testing.T) {
+	getAuditLog := func(kind string, op corev1.AuditLog_Entry_Operation, isSystem bool) *corev1.AuditLog {
+		revision, _ := structpb.NewStruct(map[string]any{
+			"metadata": map[string]any{
+				"name":     "rsc",
+				"isSystem": isSystem,
+			},
+		})
+
+		ret := &corev1.AuditLog{
+			Metadata: &metav1.LogMetadata{
+				TargetRef: &metav1.ObjectReference{
+					ApiVersion: "core/v1",
+					Kind:       kind,
+					Name:       "rsc",
+				},
+			},
+			Entry: &corev1.AuditLog_Entry{
+				Operation: op,
+			},
+		}
+
+		if op == corev1.AuditLog_Entry_DELETE {
+			ret.Entry.Old = revision
+		} else {
+			ret.Entry.New = revision
+		}
+
+		return ret
+	}
+
+	assert.Nil(t, checkRestorableAuditLog(getAuditLog("Policy", corev1.AuditLog_Entry_UPDATE, false)))
+	assert.Nil(t, checkRestorableAuditLog(getAuditLog("Service", corev1.AuditLog_Entry_DELETE, false)))
+
+	{
+		err := checkRestorableAuditLog(getAuditLog("Session", corev1.AuditLog_Entry_UPDATE, false))
+		assert.True(t, grpcerr.IsInvalidArg(err))
+	}
+
+	{
+		err := checkRestorableAuditLog(getAuditLog("Policy", corev1.AuditLog_Entry_UPDATE, true))
+		assert.True(t, grpcerr.IsUnauthorized(err))
+	}
+
+	{
+		auditLog := getAuditLog("Policy", corev1.AuditLog_Entry_UPDATE, false)
+		auditLog.Entry.IsRedacted = true
+		err := checkRestorableAuditLog(auditLog)
+		assert.True(t, grpcerr.IsInvalidArg(err))
+	}
+
+	{
+		auditLog := getAuditLog("Policy", corev1.AuditLog_Entry_DELETE, false)
+		auditLog.Entry.Operation = corev1.AuditLog_Entry_CREATE
+		err := checkRestorableAuditLog(auditLog)
+		assert.True(t, grpcerr.IsInvalidArg(err))
+	}
+}
+
+func TestRestoreRevision(t *testing.T) {
+	ctx := context.Background()
+	tst, err := tests.Initialize(nil)
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		tst.Destroy()
+	})
+	srv := newFakeServer(tst.C)
+
+	getAuditLog := func(kind string, op corev1.AuditLog_Entry_Operation, revision *structpb.Struct) *corev1.AuditLog {
+		ret := &corev1.AuditLog{
+			Metadata: &metav1.LogMetadata{
+				TargetRef: &metav1.ObjectReference{
+					ApiVersion: "core/v1",
+					Kind:       kind,
+				},
+			},
+			Entry: &corev1.AuditLog_Entry{
+				Operation: op,
+			},
+		}
+
+		if op == corev1.AuditLog_Entry_DELETE {
+			ret.Entry.Old = revision
+		} else {
+			ret.Entry.New = revision
+		}
+
+		return ret
+	}
+
+	pol, err := srv.CreatePolicy(ctx, &corev1.Policy{
+		Metadata: &metav1.Metadata{Name: "pol-1"},
+		Spec: &corev1.Policy_Spec{
+			IsDisabled: true,
+		},
+	})
+	assert.Nil(t, err)
+
+	pol.Status.ParentPolicyRef = &metav1.ObjectReference{
+		Uid:  "stale-uid",
+		Name: "stale",
+	}
+	polRevision, err := pbutils.MessageToStruct(pol)
+	assert.Nil(t, err)
+
+	{
+		// Restoring a revision of an existing resource updates its spec
+		pol.Spec.IsDisabled = false
+		_, err = srv.UpdatePolicy(ctx, pol)
+		assert.Nil(t, err)
+
+		ret, err := srv.restoreRevision(ctx, getAuditLog("Policy", corev1.AuditLog_Entry_UPDATE, polRevision))
+		assert.Nil(t, err)
+		assert.Equal(t, pol.Metadata.Uid, ret.GetMetadata().Uid)
+		assert.True(t, ret.(*corev1.Policy).Spec.IsDisabled)
+		assert.Nil(t, ret.(*corev1.Policy).Status.ParentPolicyRef)
+	}
+
+	{
+		// Restoring a deleted resource recreates it without its recorded status
+		_, err = srv.DeletePolicy(ctx, &metav1.DeleteOptions{Name: pol.Metadata.Name})
+		assert.Nil(t, err)
+
+		ret, err := srv.restoreRevision(ctx, getAuditLog("Policy", corev1.AuditLog_Entry_DELETE, polRevision))
+		assert.Nil(t, err)
+		assert.NotEqual(t, pol.Metadata.Uid, ret.GetMetadata().Uid)
+
+		restored, err := srv.octeliumC.CoreC().GetPolicy(ctx, &rmetav1.GetOptions{Name: pol.Metadata.Name})
+		assert.Nil(t, err)
+		assert.True(t, restored.Spec.IsDisabled)
+		assert.Nil(t, restored.Status.ParentPolicyRef)
+	}
+
+	{
+		// Restored revisions are validated like any other create
+		revision, err := pbutils.MessageToStruct(&corev1.Policy{
+			Metadata: &metav1.Metadata{Name: "pol-2.does-not-exist"},
+			Spec:     &corev1.Policy_Spec{},
+		})
+		assert.Nil(t, err)
+
+		_, err = srv.restoreRevision(ctx, getAuditLog("Policy", corev1.AuditLog_Entry_DELETE, revision))
+		assert.NotNil(t, err)
+		assert.True(t, grpcerr.IsInvalidArg(err))
+	}
+
+	{
+		// Services cannot be restored into a Namespace that no longer exists
+		revision, err := pbutils.MessageToStruct(&corev1.Service{
+			Metadata: &metav1.Metadata{Name: "svc-1.does-not-exist"},
+			Spec: &corev1.Service_Spec{
+				Port: 8080,
+				Config: &corev1.Service_Spec_Config{
+					Upstream: &corev1.Service_Spec_Config_Upstream{
+						Type: &corev1.Service_Spec_Config_Upstream_Url{
+							Url: "http://localhost:8080",
+						},
+					},
+				},
+			},
+			Status: &corev1.Service_Status{
+				NamespaceRef: &metav1.ObjectReference{
+					Name: "does-not-exist",
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		_, err = srv.restoreRevision(ctx, getAuditLog("Service", corev1.AuditLog_Entry_DELETE, revision))
+		assert.NotNil(t, err)
+
+		_, err = srv.octeliumC.CoreC().GetService(ctx, &rmetav1.GetOptions{Name: "svc-1.does-not-exist"})
+		assert.True(t, grpcerr.IsNotFound(err))
+	}
+}
@@ -18,13 +18,21 @@ package admin
 
 import (
 	"context"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/asaskevich/govalidator"
 	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
 	apisrvcommon "github.com/octelium/octelium/cluster/apiserver/apiserver/common"
 	"github.com/octelium/octelium/cluster/apiserver/apiserver/serr"
 	"github.com/octelium/octelium/cluster/common/apivalidation"
 	"github.com/octelium/octelium/cluster/common/grpcutils"
+	"github.com/octelium/octelium/pkg/apiutils/umetav1"
+	utils_cert "github.com/octelium/octelium/pkg/utils/cert"
 )
 
 func (s *Server) GetClusterConfig(ctx context.Context, req *corev1.GetClusterConfigRequest) (*corev1.ClusterConfig, error) {
@@ -91,6 +99,14 @@ func (s *Server) validateClusterConfigSpec(ctx context.Context, c *corev1.Cluste
 		return err
 	}
 
+	if err := validateCertificate(c); err != nil {
+		return err
+	}
+
+	if err := validateAuditLog(c); err != nil {
+		return err
+	}
+
 	if err := s.validateCCAuthorization(ctx, c); err != nil {
 		return err
 	}
@@ -122,6 +138,13 @@ func validateSession(c *corev1.ClusterConfig) error {
 		if err := apivalidation.ValidateDuration(c.Spec.Session.Human.AccessTokenDuration); err != nil {
 			return err
 		}
+
+		if err := validateSessionExpiration(c.Spec.Session.Human.ClientIdleTimeout,
+			c.Spec.Session.Human.ClientlessIdleTimeout,
+			c.Spec.Session.Human.ReauthenticationInterval,
+			c.Spec.Session.Human.MaxPerDevice); err != nil {
+			return err
+		}
 	}
 
 	if c.Spec.Session.Workload != nil {
@@ -139,6 +162,57 @@ func validateSession(c *corev1.ClusterConfig) error {
 		if err := apivalidation.ValidateDuration(c.Spec.Session.Workload.AccessTokenDuration); err != nil {
 			return err
 		}
+
+		if err := validateSessionExpiration(c.Spec.Session.Workload.ClientIdleTimeout,
+			c.Spec.Session.Workload.ClientlessIdleTimeout,
+			c.Spec.Session.Workload.ReauthenticationInterval,
+			c.Spec.Session.Workload.MaxPerDevice); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateSessionExpiration(clientIdleTimeout, clientlessIdleTimeout,
+	reauthenticationInterval *metav1.Duration, maxPerDevice uint32) error {
+	for _, d := range []*metav1.Duration{
+		clientIdleTimeout, clientlessIdleTimeout, reauthenticationInterval,
+	} {
+		if d == nil {
+			continue
+		}
+
+		if err := apivalidation.ValidateDuration(d); err != nil {
+			return err
+		}
+
+		// Connected clients and Octovigil only report the Session activity
+		// every few minutes
+		if umetav1.ToDuration(d).ToGo() < 15*time.Minute {
+			return grpcutils.InvalidArg("Session idle timeouts and reauthentication interval cannot be shorter than 15 minutes")
+		}
+	}
+
+	if maxPerDevice > 10000 {
+		return grpcutils.InvalidArg("maxPerDevice is too large")
+	}
+
+	return nil
+}
+
+func validateAuditLog(c *corev1.ClusterConfig) error {
+	retention := c.Spec.AuditLog.GetRetention()
+	if retention == nil {
+		return nil
+	}
+
+	if err := apivalidation.ValidateDuration(retention); err != nil {
+		return err
+	}
+
+	if umetav1.ToDuration(retention).ToGo() < 24*time.Hour {
+		return grpcutils.InvalidArg("AuditLog retention cannot be shorter than 24 hours")
 	}
 
 	return nil
@@ -173,23 +247,211 @@ func validateDNS(c *corev1.ClusterConfig) error {
 	}
 
 	if c.Spec.Dns.FallbackZone != nil {
-		if c.Spec.Dns.FallbackZone.Servers != nil {
-			if err := apivalidation.ValidateDuration(c.Spec.Dns.FallbackZone.CacheDuration); err != nil {
-				return err
+		if err := validateDNSZone(c.Spec.Dns.FallbackZone); err != nil {
+			return err
+		}
+	}
+
+	if len(c.Spec.Dns.ConditionalZones) > 128 {
+		return grpcutils.InvalidArg("Too many conditional zones")
+	}
+
+	var zoneDomains []string
+	for _, zone := range c.Spec.Dns.ConditionalZones {
+		if !govalidator.IsDNSName(zone.Domain) {
+			return grpcutils.InvalidArg("Invalid conditional zone domain: %s", zone.Domain)
+		}
+
+		domain := strings.ToLower(strings.TrimSuffix(zone.Domain, "."))
+		if slices.Contains(zoneDomains, domain) {
+			return grpcutils.InvalidArg("Duplicate conditional zone domain: %s", zone.Domain)
+		}
+		zoneDomains = append(zoneDomains, domain)
+
+		if zone.Zone == nil || len(zone.Zone.Servers) == 0 {
+			return grpcutils.InvalidArg("Conditional zone %s must have at least one server", zone.Domain)
+		}
+
+		if err := validateDNSZone(zone.Zone); err != nil {
+			return err
+		}
+	}
+
+	if len(c.Spec.Dns.Records) > 1024 {
+		return grpcutils.InvalidArg("Too many DNS records")
+	}
+
+	for _, record := range c.Spec.Dns.Records {
+		if err := validateDNSRecord(record); err != nil {
+			return err
+		}
+	}
+
+	if sd := c.Spec.Dns.ServiceDiscovery; sd != nil {
+		if len(sd.TxtAttrs) > 32 {
+			return grpcutils.InvalidArg("Too many service discovery TXT attrs")
+		}
+
+		for _, attr := range sd.TxtAttrs {
+			if attr == "" || len(attr) > 64 || strings.ContainsAny(attr, "= ") {
+				return grpcutils.InvalidArg("Invalid service discovery TXT attr: %s", attr)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateCertificate(c *corev1.ClusterConfig) error {
+	if c.Spec.Certificate == nil {
+		return nil
+	}
+
+	acme := c.Spec.Certificate.GetAcme()
+	if acme == nil {
+		return grpcutils.InvalidArg("Certificate type must be set")
+	}
+
+	if acme.DirectoryURL != "" {
+		u, err := url.Parse(acme.DirectoryURL)
+		if err != nil || u.Scheme != "https" || u.Hostname() == "" {
+			return grpcutils.InvalidArg("Invalid ACME directory URL: %s", acme.DirectoryURL)
+		}
+	}
+
+	if acme.Email != "" && !govalidator.IsEmail(acme.Email) {
+		return grpcutils.InvalidArg("Invalid ACME email: %s", acme.Email)
+	}
+
+	if err := apivalidation.ValidateDuration(acme.RenewBefore); err != nil {
+		return err
+	}
+
+	if renewBefore := umetav1.ToDuration(acme.RenewBefore).ToGo(); renewBefore != 0 &&
+		(renewBefore < time.Hour || renewBefore > 60*24*time.Hour) {
+		return grpcutils.InvalidArg("ACME renewBefore must be between 1 hour and 60 days")
+	}
+
+	if len(acme.TrustedCAs) > 32 {
+		return grpcutils.InvalidArg("Too many ACME trusted CAs")
+	}
+
+	for _, ca := range acme.TrustedCAs {
+		if _, err := utils_cert.ParseX509LeafCertificateChainPEM([]byte(ca)); err != nil {
+			return grpcutils.InvalidArg("Invalid ACME trusted CA")
+		}
+	}
+
+	switch acme.Challenge.(type) {
+	case *corev1.ClusterConfig_Spec_Certificate_ACME_Http01:
+	case *corev1.ClusterConfig_Spec_Certificate_ACME_Dns01:
+		rfc2136 := acme.GetDns01().GetRfc2136()
+		if rfc2136 == nil {
+			return grpcutils.InvalidArg("ACME DNS-01 provider must be set")
+		}
+
+		host, port, err := net.SplitHostPort(rfc2136.Server)
+		if err != nil || !govalidator.IsPort(port) ||
+			!(govalidator.IsDNSName(host) || govalidator.IsIP(host)) {
+			return grpcutils.InvalidArg("Invalid RFC2136 server: %s", rfc2136.Server)
+		}
+
+		if rfc2136.Zone != "" && !govalidator.IsDNSName(rfc2136.Zone) {
+			return grpcutils.InvalidArg("Invalid RFC2136 zone: %s", rfc2136.Zone)
+		}
+
+		if tsig := rfc2136.Tsig; tsig != nil {
+			if !govalidator.IsDNSName(tsig.KeyName) {
+				return grpcutils.InvalidArg("Invalid TSIG key name: %s", tsig.KeyName)
 			}
 
-			if len(c.Spec.Dns.FallbackZone.Servers) > 32 {
-				return grpcutils.InvalidArg("Too many DNS servers")
+			switch tsig.Algorithm {
+			case "", "hmac-sha1", "hmac-sha224", "hmac-sha256", "hmac-sha384", "hmac-sha512":
+			default:
+				return grpcutils.InvalidArg("Unsupported TSIG algorithm: %s", tsig.Algorithm)
 			}
 
-			for _, srv := range c.Spec.Dns.FallbackZone.Servers {
-				switch {
-				case govalidator.IsDNSName(srv), govalidator.IsIP(srv), govalidator.IsURL(srv):
-				default:
-					return grpcutils.InvalidArg("Invalid DNS server: %s", srv)
-				}
+			if err := apivalidation.ValidateName(tsig.GetFromSecret(), 0, 0); err != nil {
+				return grpcutils.InvalidArg("Invalid TSIG key Secret: %s", tsig.GetFromSecret())
 			}
 		}
+	default:
+		return grpcutils.InvalidArg("ACME challenge must be set")
+	}
+
+	return nil
+}
+
+func validateDNSZone(zone *corev1.ClusterConfig_Spec_DNS_Zone) error {
+	if err := apivalidation.ValidateDuration(zone.CacheDuration); err != nil {
+		return err
+	}
+
+	if err := apivalidation.ValidateDuration(zone.NegativeCacheDuration); err != nil {
+		return err
+	}
+
+	if len(zone.Servers) > 32 {
+		return grpcutils.InvalidArg("Too many DNS servers")
+	}
+
+	for _, srv := range zone.Servers {
+		switch {
+		case govalidator.IsDNSName(srv), govalidator.IsIP(srv), isValidDNSServerURL(srv):
+		default:
+			return grpcutils.InvalidArg("Invalid DNS server: %s", srv)
+		}
+	}
+
+	return nil
+}
+
+func isValidDNSServerURL(arg string) bool {
+	u, err := url.Parse(arg)
+	if err != nil || u.Hostname() == "" {
+		return false
+	}
+
+	switch u.Scheme {
+	case "dns", "udp", "tls", "https":
+		return true
+	default:
+		return false
+	}
+}
+
+func validateDNSRecord(record *corev1.ClusterConfig_Spec_DNS_Record) error {
+	if !govalidator.IsDNSName(record.Name) {
+		return grpcutils.InvalidArg("Invalid DNS record name: %s", record.Name)
+	}
+
+	switch record.Type.(type) {
+	case *corev1.ClusterConfig_Spec_DNS_Record_A:
+		if !govalidator.IsIPv4(record.GetA()) {
+			return grpcutils.InvalidArg("Invalid A record value: %s", record.GetA())
+		}
+	case *corev1.ClusterConfig_Spec_DNS_Record_Aaaa:
+		if !govalidator.IsIPv6(record.GetAaaa()) {
+			return grpcutils.InvalidArg("Invalid AAAA record value: %s", record.GetAaaa())
+		}
+	case *corev1.ClusterConfig_Spec_DNS_Record_Cname:
+		if !govalidator.IsDNSName(record.GetCname()) {
+			return grpcutils.InvalidArg("Invalid CNAME record value: %s", record.GetCname())
+		}
+	case *corev1.ClusterConfig_Spec_DNS_Record_Txt:
+		if len(record.GetTxt()) > 4096 {
+			return grpcutils.InvalidArg("TXT record value is too long")
+		}
+	case *corev1.ClusterConfig_Spec_DNS_Record_Srv:
+		srv := record.GetSrv()
+		if srv == nil || !govalidator.IsDNSName(srv.Target) {
+			return grpcutils.InvalidArg("Invalid SRV record target")
+		}
+		if srv.Port == 0 || srv.Port > 65535 || srv.Priority > 65535 || srv.Weight > 65535 {
+			return grpcutils.InvalidArg("Invalid SRV record values")
+		}
+	default:
+		return grpcutils.InvalidArg("DNS record type must be set")
 	}
 
 	return nil
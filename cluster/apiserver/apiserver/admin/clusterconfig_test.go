@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
 	"github.com/octelium/octelium/cluster/common/tests"
 	"github.com/octelium/octelium/pkg/utils/utilrand"
 	"github.com/stretchr/testify/assert"
@@ -73,3 +74,274 @@ func TestClusterConfig(t *testing.T) {
 		}
 	*/
 }
+
+func TestValidateDNS(t *testing.T) {
+	genCC := func(dnsCfg *corev1.ClusterConfig_Spec_DNS) *corev1.ClusterConfig {
+		return &corev1.ClusterConfig{
+			Spec: &corev1.ClusterConfig_Spec{
+				Dns: dnsCfg,
+			},
+		}
+	}
+
+	assert.Nil(t, validateDNS(genCC(nil)))
+
+	assert.Nil(t, validateDNS(genCC(&corev1.ClusterConfig_Spec_DNS{
+		FallbackZone: &corev1.ClusterConfig_Spec_DNS_Zone{
+			Servers: []string{"https://cloudflare-dns.com/dns-query", "tls://1.1.1.1"},
+		},
+		ConditionalZones: []*corev1.ClusterConfig_Spec_DNS_ConditionalZone{
+			{
+				Domain: "corp.internal",
+				Zone: &corev1.ClusterConfig_Spec_DNS_Zone{
+					Servers: []string{"10.0.0.53"},
+				},
+			},
+		},
+		Records: []*corev1.ClusterConfig_Spec_DNS_Record{
+			{
+				Name: "db.corp.internal",
+				Type: &corev1.ClusterConfig_Spec_DNS_Record_A{A: "10.0.0.1"},
+			},
+			{
+				Name: "_ldap._tcp.corp.internal",
+				Type: &corev1.ClusterConfig_Spec_DNS_Record_Srv{
+					Srv: &corev1.ClusterConfig_Spec_DNS_Record_SRV{
+						Port:   389,
+						Target: "ldap.corp.internal",
+					},
+				},
+			},
+		},
+	})))
+
+	invalids := []*corev1.ClusterConfig_Spec_DNS{
+		{
+			ConditionalZones: []*corev1.ClusterConfig_Spec_DNS_ConditionalZone{
+				{
+					Domain: "corp.internal",
+				},
+			},
+		},
+		{
+			ConditionalZones: []*corev1.ClusterConfig_Spec_DNS_ConditionalZone{
+				{
+					Domain: "corp.internal",
+					Zone: &corev1.ClusterConfig_Spec_DNS_Zone{
+						Servers: []string{"10.0.0.53"},
+					},
+				},
+				{
+					Domain: "CORP.internal.",
+					Zone: &corev1.ClusterConfig_Spec_DNS_Zone{
+						Servers: []string{"10.0.0.54"},
+					},
+				},
+			},
+		},
+		{
+			Records: []*corev1.ClusterConfig_Spec_DNS_Record{
+				{
+					Name: "db.corp.internal",
+				},
+			},
+		},
+		{
+			Records: []*corev1.ClusterConfig_Spec_DNS_Record{
+				{
+					Name: "db.corp.internal",
+					Type: &corev1.ClusterConfig_Spec_DNS_Record_A{A: "fd00::1"},
+				},
+			},
+		},
+		{
+			Records: []*corev1.ClusterConfig_Spec_DNS_Record{
+				{
+					Name: "_ldap._tcp.corp.internal",
+					Type: &corev1.ClusterConfig_Spec_DNS_Record_Srv{
+						Srv: &corev1.ClusterConfig_Spec_DNS_Record_SRV{
+							Target: "ldap.corp.internal",
+						},
+					},
+				},
+			},
+		},
+		{
+			ServiceDiscovery: &corev1.ClusterConfig_Spec_DNS_ServiceDiscovery{
+				TxtAttrs: []string{"team=payments"},
+			},
+		},
+	}
+
+	for _, invalid := range invalids {
+		assert.NotNil(t, validateDNS(genCC(invalid)), "%+v", invalid)
+	}
+}
+
+func TestValidateCertificate(t *testing.T) {
+	genCC := func(acme *corev1.ClusterConfig_Spec_Certificate_ACME) *corev1.ClusterConfig {
+		return &corev1.ClusterConfig{
+			Spec: &corev1.ClusterConfig_Spec{
+				Certificate: &corev1.ClusterConfig_Spec_Certificate{
+					Type: &corev1.ClusterConfig_Spec_Certificate_Acme{
+						Acme: acme,
+					},
+				},
+			},
+		}
+	}
+
+	genRFC2136 := func(rfc2136 *corev1.ClusterConfig_Spec_Certificate_ACME_DNS01_RFC2136) *corev1.ClusterConfig_Spec_Certificate_ACME_Dns01 {
+		return &corev1.ClusterConfig_Spec_Certificate_ACME_Dns01{
+			Dns01: &corev1.ClusterConfig_Spec_Certificate_ACME_DNS01{
+				Type: &corev1.ClusterConfig_Spec_Certificate_ACME_DNS01_Rfc2136{
+					Rfc2136: rfc2136,
+				},
+			},
+		}
+	}
+
+	assert.Nil(t, validateCertificate(&corev1.ClusterConfig{Spec: &corev1.ClusterConfig_Spec{}}))
+
+	valids := []*corev1.ClusterConfig_Spec_Certificate_ACME{
+		{
+			Challenge: &corev1.ClusterConfig_Spec_Certificate_ACME_Http01{
+				Http01: &corev1.ClusterConfig_Spec_Certificate_ACME_HTTP01{},
+			},
+		},
+		{
+			DirectoryURL: "https://localhost:14000/dir",
+			Email:        "admin@example.com",
+			RenewBefore: &metav1.Duration{
+				Type: &metav1.Duration_Days{
+					Days: 20,
+				},
+			},
+			Challenge: genRFC2136(&corev1.ClusterConfig_Spec_Certificate_ACME_DNS01_RFC2136{
+				Server: "ns1.example.com:53",
+				Zone:   "example.com",
+				Tsig: &corev1.ClusterConfig_Spec_Certificate_ACME_DNS01_RFC2136_TSIG{
+					KeyName:   "acme-key",
+					Algorithm: "hmac-sha512",
+					Type: &corev1.ClusterConfig_Spec_Certificate_ACME_DNS01_RFC2136_TSIG_FromSecret{
+						FromSecret: "tsig-key",
+					},
+				},
+			}),
+		},
+	}
+
+	for _, valid := range valids {
+		assert.Nil(t, validateCertificate(genCC(valid)), "%+v", valid)
+	}
+
+	invalids := []*corev1.ClusterConfig_Spec_Certificate_ACME{
+		{},
+		{
+			DirectoryURL: "http://localhost:14000/dir",
+			Challenge: &corev1.ClusterConfig_Spec_Certificate_ACME_Http01{
+				Http01: &corev1.ClusterConfig_Spec_Certificate_ACME_HTTP01{},
+			},
+		},
+		{
+			Email: "invalid",
+			Challenge: &corev1.ClusterConfig_Spec_Certificate_ACME_Http01{
+				Http01: &corev1.ClusterConfig_Spec_Certificate_ACME_HTTP01{},
+			},
+		},
+		{
+			RenewBefore: &metav1.Duration{
+				Type: &metav1.Duration_Minutes{
+					Minutes: 10,
+				},
+			},
+			Challenge: &corev1.ClusterConfig_Spec_Certificate_ACME_Http01{
+				Http01: &corev1.ClusterConfig_Spec_Certificate_ACME_HTTP01{},
+			},
+		},
+		{
+			TrustedCAs: []string{"invalid"},
+			Challenge: &corev1.ClusterConfig_Spec_Certificate_ACME_Http01{
+				Http01: &corev1.ClusterConfig_Spec_Certificate_ACME_HTTP01{},
+			},
+		},
+		{
+			Challenge: &corev1.ClusterConfig_Spec_Certificate_ACME_Dns01{
+				Dns01: &corev1.ClusterConfig_Spec_Certificate_ACME_DNS01{},
+			},
+		},
+		{
+			Challenge: genRFC2136(&corev1.ClusterConfig_Spec_Certificate_ACME_DNS01_RFC2136{
+				Server: "ns1.example.com",
+			}),
+		},
+		{
+			Challenge: genRFC2136(&corev1.ClusterConfig_Spec_Certificate_ACME_DNS01_RFC2136{
+				Server: "ns1.example.com:53",
+				Tsig: &corev1.ClusterConfig_Spec_Certificate_ACME_DNS01_RFC2136_TSIG{
+					KeyName:   "acme-key",
+					Algorithm: "hmac-md5",
+					Type: &corev1.ClusterConfig_Spec_Certificate_ACME_DNS01_RFC2136_TSIG_FromSecret{
+						FromSecret: "tsig-key",
+					},
+				},
+			}),
+		},
+		{
+			Challenge: genRFC2136(&corev1.ClusterConfig_Spec_Certificate_ACME_DNS01_RFC2136{
+				Server: "ns1.example.com:53",
+				Tsig: &corev1.ClusterConfig_Spec_Certificate_ACME_DNS01_RFC2136_TSIG{
+					KeyName: "acme-key",
+				},
+			}),
+		},
+	}
+
+	for _, invalid := range invalids {
+		assert.NotNil(t, validateCertificate(genCC(invalid)), "%+v", invalid)
+	}
+}
+
+func TestValidateSessionExpiration(t *testing.T) {
+	assert.Nil(t, validateSessionExpiration(nil, nil, nil, 0))
+
+	assert.Nil(t, validateSessionExpiration(&metav1.Duration{
+		Type: &metav1.Duration_Minutes{Minutes: 30},
+	}, &metav1.Duration{
+		Type: &metav1.Duration_Hours{Hours: 8},
+	}, &metav1.Duration{
+		Type: &metav1.Duration_Days{Days: 1},
+	}, 4))
+
+	assert.NotNil(t, validateSessionExpiration(&metav1.Duration{
+		Type: &metav1.Duration_Minutes{Minutes: 5},
+	}, nil, nil, 0))
+
+	assert.NotNil(t, validateSessionExpiration(nil, nil, &metav1.Duration{
+		Type: &metav1.Duration_Seconds{Seconds: 30},
+	}, 0))
+
+	assert.NotNil(t, validateSessionExpiration(nil, nil, nil, 100000))
+}
+
+func TestValidateAuditLog(t *testing.T) {
+	genCC := func(retention *metav1.Duration) *corev1.ClusterConfig {
+		return &corev1.ClusterConfig{
+			Spec: &corev1.ClusterConfig_Spec{
+				AuditLog: &corev1.ClusterConfig_Spec_AuditLog{
+					Retention: retention,
+				},
+			},
+		}
+	}
+
+	assert.Nil(t, validateAuditLog(&corev1.ClusterConfig{Spec: &corev1.ClusterConfig_Spec{}}))
+	assert.Nil(t, validateAuditLog(genCC(nil)))
+	assert.Nil(t, validateAuditLog(genCC(&metav1.Duration{
+		Type: &metav1.Duration_Days{Days: 90},
+	})))
+
+	assert.NotNil(t, validateAuditLog(genCC(&metav1.Duration{
+		Type: &metav1.Duration_Hours{Hours: 1},
+	})))
+}
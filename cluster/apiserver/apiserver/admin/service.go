@@ -23,6 +23,7 @@ import (
 	"net/url"
 	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/asaskevich/govalidator"
@@ -339,191 +340,24 @@ func (s *Server) checkAndSetService(ctx context.Context,
 
 		case *corev1.Service_Spec_Config_Upstream_Container_:
 
-			typ := spec.Config.GetUpstream().GetContainer()
-
-			if typ.Image == "" {
-				return grpcutils.InvalidArg("You must provide a managedContainer image")
-			}
-			if len(typ.Image) > 256 {
-				return grpcutils.InvalidArg("Image address is too long: %s", typ.Image)
-			}
-
-			if err := apivalidation.ValidatePort(int(typ.Port)); err != nil {
+			if err := s.validateUpstreamContainer(ctx, spec.Config.GetUpstream().GetContainer()); err != nil {
 				return err
 			}
 
-			if len(typ.Args) > 64 {
-				return grpcutils.InvalidArg("Too many managedContainer args")
-			}
-
-			if len(typ.Command) > 64 {
-				return grpcutils.InvalidArg("Too many command items")
-			}
-
-			for _, arg := range typ.Args {
-				if len(arg) > 1024 {
-					return grpcutils.InvalidArg("Too long managedContainer arg: %s", arg)
-				}
-			}
-
-			for _, arg := range typ.Command {
-				if len(arg) > 1024 {
-					return grpcutils.InvalidArg("Too long managedContainer command: %s", arg)
-				}
-			}
-
-			if typ.Replicas > 100 {
-				return grpcutils.InvalidArg("Too many managedContainer replicas: %d", typ.Replicas)
-			}
-
-			if len(typ.Env) > 32 {
-				return grpcutils.InvalidArg("Too many environment variable")
-			}
-			for _, itm := range typ.Env {
-				if err := apivalidation.ValidateEnvVarKey(itm.Name); err != nil {
-					return err
-				}
-
-				switch itm.Type.(type) {
-				case *corev1.Service_Spec_Config_Upstream_Container_Env_Value:
-					if err := apivalidation.ValidateEnvVarKey(itm.GetValue()); err != nil {
-						return err
-					}
-				case *corev1.Service_Spec_Config_Upstream_Container_Env_FromSecret:
-					if err := s.validateSecretOwner(ctx, itm); err != nil {
-						return err
-					}
-				case *corev1.Service_Spec_Config_Upstream_Container_Env_KubernetesSecretRef_:
-					if itm.GetKubernetesSecretRef().Name == "" {
-						return grpcutils.InvalidArg("KubernetesSecretRef name is empty")
-					}
-					if itm.GetKubernetesSecretRef().Key == "" {
-						return grpcutils.InvalidArg("KubernetesSecretRef key is empty")
-					}
-					if err := apivalidation.ValidateGenASCII(itm.GetKubernetesSecretRef().Name); err != nil {
-						return err
-					}
-					if err := apivalidation.ValidateGenASCII(itm.GetKubernetesSecretRef().Key); err != nil {
-						return err
-					}
-				default:
-					return grpcutils.InvalidArg("either value, fromSecret or kubernetesSecretRef must be set")
-				}
-
-			}
-
-			if typ.ResourceLimit != nil {
-				if len(typ.ResourceLimit.Ext) > 100 {
-					return grpcutils.InvalidArg("Too many extend resources")
-				}
-
-				for k, v := range typ.ResourceLimit.Ext {
-
-					if err := apivalidation.ValidateGenASCII(k); err != nil {
-						return err
-					}
-
-					if err := apivalidation.ValidateGenASCII(v); err != nil {
-						return err
-					}
-				}
-			}
-
-			if typ.GetCredentials() != nil && typ.GetCredentials().GetUsernamePassword() != nil {
-				uP := typ.GetCredentials().GetUsernamePassword()
-				if uP.Username == "" || len(uP.Username) > 256 {
-					return grpcutils.InvalidArg("Invalid credentials username")
-				}
-				if uP.GetPassword() == nil {
-					return grpcutils.InvalidArg("Password must be supplied")
-				}
-				if err := s.validateSecretOwner(ctx, uP.GetPassword()); err != nil {
-					return err
-				}
-				if uP.Server != "" && !govalidator.IsDNSName(uP.Server) {
-					return grpcutils.InvalidArg("Invalid server: %s", uP.Server)
-				}
-			}
-
-			if len(typ.Volumes) > 32 {
-				return grpcutils.InvalidArg("Too many volumes")
-			}
-
-			for _, vol := range typ.Volumes {
-				if err := apivalidation.ValidateName(vol.Name, 0, 0); err != nil {
-					return err
-				}
-
-				switch vol.Type.(type) {
-				case *corev1.Service_Spec_Config_Upstream_Container_Volume_PersistentVolumeClaim_:
-					if err := apivalidation.ValidateName(vol.GetPersistentVolumeClaim().Name, 0, 0); err != nil {
-						return err
-					}
-				default:
-					return grpcutils.InvalidArg("Volume type must be set")
-				}
-			}
-
-			if len(typ.VolumeMounts) > 32 {
-				return grpcutils.InvalidArg("Too many volumeMounts")
-			}
-
-			for _, mount := range typ.VolumeMounts {
-				if err := apivalidation.ValidateName(mount.Name, 0, 0); err != nil {
-					return err
-				}
-
-				if !govalidator.IsUnixFilePath(mount.MountPath) {
-					return grpcutils.InvalidArg("Invalid mountPath: %s", mount.MountPath)
-				}
-
-				if err := s.validateGenStr(mount.SubPath, false, "subPath"); err != nil {
-					return err
-				}
-			}
-
-			validateProbe := func(p *corev1.Service_Spec_Config_Upstream_Container_Probe) error {
-				if p == nil {
-					return nil
-				}
-
-				switch p.Type.(type) {
-				case *corev1.Service_Spec_Config_Upstream_Container_Probe_Grpc:
-					if err := apivalidation.ValidatePort(int(p.GetGrpc().Port)); err != nil {
-						return err
-					}
-				case *corev1.Service_Spec_Config_Upstream_Container_Probe_HttpGet:
-					if len(p.GetHttpGet().Path) > 512 {
-						return grpcutils.InvalidArg("Path is too long: %s", p.GetHttpGet().Path)
-					}
-					if !govalidator.IsRequestURI(p.GetHttpGet().Path) {
-						return grpcutils.InvalidArg("Invalid path: %s", p.GetHttpGet().Path)
-					}
-
-					if err := apivalidation.ValidatePort(int(p.GetHttpGet().Port)); err != nil {
-						return err
-					}
-				case *corev1.Service_Spec_Config_Upstream_Container_Probe_TcpSocket:
-					if err := apivalidation.ValidatePort(int(p.GetTcpSocket().Port)); err != nil {
-						return err
-					}
-				default:
-					return grpcutils.InvalidArg("Invalid Probe type")
-				}
-
-				return nil
-			}
+		default:
+			return serr.InvalidArg("Invalid upstream type")
+		}
+	}
 
-			if err := validateProbe(typ.ReadinessProbe); err != nil {
-				return err
+	if spec.DynamicConfig != nil {
+		for _, cfg := range spec.DynamicConfig.Configs {
+			if cfg.GetUpstream().GetContainer() == nil {
+				continue
 			}
 
-			if err := validateProbe(typ.LivenessProbe); err != nil {
+			if err := s.validateUpstreamContainer(ctx, cfg.GetUpstream().GetContainer()); err != nil {
 				return err
 			}
-
-		default:
-			return serr.InvalidArg("Invalid upstream type")
 		}
 	}
 
@@ -800,6 +634,10 @@ func (s *Server) checkAndSetService(ctx context.Context,
 				}
 			}
 
+			if err := validateHTTPResilience(cfg.GetHttp()); err != nil {
+				return err
+			}
+
 			if len(cfg.GetHttp().Plugins) > 0 {
 				if len(cfg.GetHttp().Plugins) > 256 {
 					return grpcutils.InvalidArg("Too many plugins")
@@ -909,6 +747,55 @@ func (s *Server) checkAndSetService(ctx context.Context,
 							return grpcutils.InvalidArg("Invalid maxSize value: %d", conf.MaxSize)
 						}
 
+					case *corev1.Service_Spec_Config_HTTP_Plugin_Mirror_:
+						conf := plugin.GetMirror()
+						switch conf.Type.(type) {
+						case *corev1.Service_Spec_Config_HTTP_Plugin_Mirror_Url:
+							if len(conf.GetUrl()) > 1024 {
+								return grpcutils.InvalidArg("Mirror URL is too long")
+							}
+							mirrorURL, err := url.Parse(conf.GetUrl())
+							if err != nil || mirrorURL.Host == "" {
+								return grpcutils.InvalidArg("Invalid mirror URL: %s", conf.GetUrl())
+							}
+							switch mirrorURL.Scheme {
+							case "http", "https":
+							default:
+								return grpcutils.InvalidArg("Mirror URL scheme must be either http or https")
+							}
+						case *corev1.Service_Spec_Config_HTTP_Plugin_Mirror_ConfigName:
+							if conf.GetConfigName() == cfg.Name {
+								return grpcutils.InvalidArg("A Config cannot mirror to itself: %s", cfg.Name)
+							}
+							if !slices.Contains(cfgNames, conf.GetConfigName()) {
+								return grpcutils.InvalidArg("Mirror Config does not exist: %s", conf.GetConfigName())
+							}
+						default:
+							return grpcutils.InvalidArg("Mirror URL or configName must be set")
+						}
+
+						if conf.Percentage > 100 {
+							return grpcutils.InvalidArg("Invalid mirror percentage: %d", conf.Percentage)
+						}
+
+						if conf.MaxBodySize > 32_000_000 {
+							return grpcutils.InvalidArg("Invalid mirror maxBodySize value: %d", conf.MaxBodySize)
+						}
+
+						if conf.MaxConcurrentRequests > 10000 {
+							return grpcutils.InvalidArg("Invalid mirror maxConcurrentRequests value: %d",
+								conf.MaxConcurrentRequests)
+						}
+
+						if conf.Timeout != nil {
+							if err := apivalidation.ValidateDuration(conf.Timeout); err != nil {
+								return err
+							}
+							if umetav1.ToDuration(conf.Timeout).ToGo() > 60*time.Second {
+								return grpcutils.InvalidArg("Mirror timeout upper limit is exceeded")
+							}
+						}
+
 					case *corev1.Service_Spec_Config_HTTP_Plugin_JsonSchema:
 						conf := plugin.GetJsonSchema()
 						switch conf.Type.(type) {
@@ -1176,6 +1063,20 @@ func (s *Server) checkAndSetService(ctx context.Context,
 					return err
 				}
 			}
+		case *corev1.Service_Spec_Config_Tcp:
+			if spec.Mode != corev1.Service_Spec_TCP {
+				return grpcutils.InvalidArg("TCP mode must be set for TCP config to be used")
+			}
+
+			if pp := cfg.GetTcp().ProxyProtocol; pp != nil && pp.Token != nil {
+				if !pp.IsEnabled {
+					return grpcutils.InvalidArg("PROXY protocol must be enabled for its token to be used")
+				}
+				if err := s.validateSecretOwner(ctx, pp.Token); err != nil {
+					return err
+				}
+			}
+
 		case *corev1.Service_Spec_Config_Mysql:
 			if spec.Mode != corev1.Service_Spec_MYSQL {
 				return grpcutils.InvalidArg("MYSQL mode must be set for MySQL config to be used")
@@ -1256,10 +1157,19 @@ func (s *Server) checkAndSetService(ctx context.Context,
 				return err
 			}
 		}
+
+		if err := validateDynamicConfigSplit(ctx, spec.DynamicConfig); err != nil {
+			return err
+		}
 	}
 
 	if svc.Spec.IsPublic && !ucorev1.ToService(svc).IsHTTP() {
-		return serr.InvalidArg("The Service: %s is not an HTTP-based Service to be exposed publicly.", svc.Metadata.Name)
+		if svc.Spec.Mode != corev1.Service_Spec_TCP {
+			return serr.InvalidArg("The Service: %s is not an HTTP-based or a TCP Service to be exposed publicly.", svc.Metadata.Name)
+		}
+		if !svc.Spec.IsTLS {
+			return serr.InvalidArg("The TCP Service: %s must enable isTLS to be exposed publicly.", svc.Metadata.Name)
+		}
 	}
 
 	svc.Status.PrimaryHostname = func() string {
@@ -1487,6 +1397,16 @@ func (s *Server) validateService(ctx context.Context, itm *corev1.Service) error
 		return err
 	}
 
+	if spec.Deployment != nil {
+		if spec.Deployment.Replicas > 100 {
+			return grpcutils.InvalidArg("Too many replicas: %d", spec.Deployment.Replicas)
+		}
+
+		if err := validateAutoscale(spec.Deployment.Autoscale); err != nil {
+			return err
+		}
+	}
+
 	if itm.Spec.IsAnonymous {
 		if !itm.Spec.IsPublic {
 			return grpcutils.InvalidArg("Anonymous access mode requires isPublic to be enabled")
@@ -1503,3 +1423,404 @@ func (s *Server) validateService(ctx context.Context, itm *corev1.Service) error
 
 	return nil
 }
+
+func validateHTTPResilience(httpCfg *corev1.Service_Spec_Config_HTTP) error {
+	validateDuration := func(d *metav1.Duration, max time.Duration, name string) error {
+		if d == nil {
+			return nil
+		}
+		if err := apivalidation.ValidateDuration(d); err != nil {
+			return err
+		}
+		if umetav1.ToDuration(d).ToGo() > max {
+			return grpcutils.InvalidArg("%s upper limit is exceeded", name)
+		}
+		return nil
+	}
+
+	if timeout := httpCfg.Timeout; timeout != nil {
+		if err := validateDuration(timeout.Request, 24*time.Hour, "Request timeout"); err != nil {
+			return err
+		}
+		if err := validateDuration(timeout.IdleStream, 24*time.Hour, "Idle stream timeout"); err != nil {
+			return err
+		}
+	}
+
+	if limit := httpCfg.Limit; limit != nil {
+		if limit.MaxConcurrentRequests > 100_000 {
+			return grpcutils.InvalidArg("Invalid maxConcurrentRequests: %d", limit.MaxConcurrentRequests)
+		}
+		if limit.MaxPendingRequests > 100_000 {
+			return grpcutils.InvalidArg("Invalid maxPendingRequests: %d", limit.MaxPendingRequests)
+		}
+		if limit.MaxPendingRequests > 0 && limit.MaxConcurrentRequests == 0 {
+			return grpcutils.InvalidArg("maxPendingRequests requires maxConcurrentRequests to be set")
+		}
+		if err := validateDuration(limit.MaxPendingDuration, 10*time.Minute, "Max pending duration"); err != nil {
+			return err
+		}
+	}
+
+	if cb := httpCfg.CircuitBreaker; cb != nil {
+		if cb.ErrorRatePercentage > 100 {
+			return grpcutils.InvalidArg("Invalid errorRatePercentage: %d", cb.ErrorRatePercentage)
+		}
+		if cb.SlowRatePercentage > 100 {
+			return grpcutils.InvalidArg("Invalid slowRatePercentage: %d", cb.SlowRatePercentage)
+		}
+		if cb.MinRequests > 1_000_000 {
+			return grpcutils.InvalidArg("Invalid minRequests: %d", cb.MinRequests)
+		}
+		if cb.HalfOpenMaxRequests > 10_000 {
+			return grpcutils.InvalidArg("Invalid halfOpenMaxRequests: %d", cb.HalfOpenMaxRequests)
+		}
+		if err := validateDuration(cb.Interval, 1*time.Hour, "Circuit breaker interval"); err != nil {
+			return err
+		}
+		if err := validateDuration(cb.OpenDuration, 1*time.Hour, "Circuit breaker openDuration"); err != nil {
+			return err
+		}
+		if err := validateDuration(cb.SlowRequestThreshold, 10*time.Minute, "Circuit breaker slowRequestThreshold"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) validateUpstreamContainer(ctx context.Context,
+	typ *corev1.Service_Spec_Config_Upstream_Container) error {
+
+	if err := apivalidation.ValidateContainerImage(typ.Image); err != nil {
+		return err
+	}
+
+	if err := apivalidation.ValidatePort(int(typ.Port)); err != nil {
+		return err
+	}
+
+	if err := apivalidation.ValidateContainerCommand(typ.Command, typ.Args); err != nil {
+		return err
+	}
+
+	if typ.Replicas > 100 {
+		return grpcutils.InvalidArg("Too many managedContainer replicas: %d", typ.Replicas)
+	}
+
+	if err := validateAutoscale(typ.Autoscale); err != nil {
+		return err
+	}
+
+	if err := s.validateContainerEnv(ctx, typ.Env); err != nil {
+		return err
+	}
+
+	if err := validateContainerResourceLimit(typ.ResourceLimit); err != nil {
+		return err
+	}
+
+	if typ.GetCredentials() != nil && typ.GetCredentials().GetUsernamePassword() != nil {
+		uP := typ.GetCredentials().GetUsernamePassword()
+		if uP.Username == "" || len(uP.Username) > 256 {
+			return grpcutils.InvalidArg("Invalid credentials username")
+		}
+		if uP.GetPassword() == nil {
+			return grpcutils.InvalidArg("Password must be supplied")
+		}
+		if err := s.validateSecretOwner(ctx, uP.GetPassword()); err != nil {
+			return err
+		}
+		if uP.Server != "" && !govalidator.IsDNSName(uP.Server) {
+			return grpcutils.InvalidArg("Invalid server: %s", uP.Server)
+		}
+	}
+
+	if len(typ.Volumes) > 32 {
+		return grpcutils.InvalidArg("Too many volumes")
+	}
+
+	var volNames []string
+	for _, vol := range typ.Volumes {
+		if err := apivalidation.ValidateContainerVolume(vol); err != nil {
+			return err
+		}
+
+		if slices.Contains(volNames, vol.Name) {
+			return grpcutils.InvalidArg("Duplicate volume name: %s", vol.Name)
+		}
+		volNames = append(volNames, vol.Name)
+
+		switch vol.Type.(type) {
+		case *corev1.Service_Spec_Config_Upstream_Container_Volume_Secret_:
+			if _, err := s.octeliumC.CoreC().GetSecret(ctx,
+				&rmetav1.GetOptions{Name: vol.GetSecret().Name}); err != nil {
+				if grpcerr.IsNotFound(err) {
+					return grpcutils.InvalidArg("The Secret %s is not found", vol.GetSecret().Name)
+				}
+				return grpcutils.InternalWithErr(err)
+			}
+		case *corev1.Service_Spec_Config_Upstream_Container_Volume_Config_:
+			if _, err := s.octeliumC.CoreC().GetConfig(ctx,
+				&rmetav1.GetOptions{Name: vol.GetConfig().Name}); err != nil {
+				if grpcerr.IsNotFound(err) {
+					return grpcutils.InvalidArg("The Config %s is not found", vol.GetConfig().Name)
+				}
+				return grpcutils.InternalWithErr(err)
+			}
+		}
+	}
+
+	if err := apivalidation.ValidateContainerVolumeMounts(typ.VolumeMounts, volNames); err != nil {
+		return err
+	}
+
+	if err := validateContainerProbe(typ.ReadinessProbe); err != nil {
+		return err
+	}
+
+	if err := validateContainerProbe(typ.LivenessProbe); err != nil {
+		return err
+	}
+
+	if err := apivalidation.ValidateNodeSelector(typ.NodeSelector); err != nil {
+		return err
+	}
+
+	if len(typ.InitContainers)+len(typ.Sidecars) > 16 {
+		return grpcutils.InvalidArg("Too many initContainers and sidecars")
+	}
+
+	var containerNames []string
+	for _, sidecar := range append(slices.Clone(typ.InitContainers), typ.Sidecars...) {
+		if err := apivalidation.ValidateContainerName(sidecar.Name); err != nil {
+			return err
+		}
+
+		if slices.Contains(containerNames, sidecar.Name) {
+			return grpcutils.InvalidArg("Duplicate container name: %s", sidecar.Name)
+		}
+		containerNames = append(containerNames, sidecar.Name)
+
+		if err := apivalidation.ValidateContainerImage(sidecar.Image); err != nil {
+			return err
+		}
+
+		if err := apivalidation.ValidateContainerCommand(sidecar.Command, sidecar.Args); err != nil {
+			return err
+		}
+
+		if err := s.validateContainerEnv(ctx, sidecar.Env); err != nil {
+			return err
+		}
+
+		if err := validateContainerResourceLimit(sidecar.ResourceLimit); err != nil {
+			return err
+		}
+
+		if err := apivalidation.ValidateContainerVolumeMounts(sidecar.VolumeMounts, volNames); err != nil {
+			return err
+		}
+
+		if err := validateContainerProbe(sidecar.ReadinessProbe); err != nil {
+			return err
+		}
+
+		if err := validateContainerProbe(sidecar.LivenessProbe); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) validateContainerEnv(ctx context.Context,
+	envList []*corev1.Service_Spec_Config_Upstream_Container_Env) error {
+	if len(envList) > 32 {
+		return grpcutils.InvalidArg("Too many environment variable")
+	}
+
+	for _, itm := range envList {
+		if err := apivalidation.ValidateEnvVarKey(itm.Name); err != nil {
+			return err
+		}
+
+		switch itm.Type.(type) {
+		case *corev1.Service_Spec_Config_Upstream_Container_Env_Value:
+			if err := apivalidation.ValidateEnvVarKey(itm.GetValue()); err != nil {
+				return err
+			}
+		case *corev1.Service_Spec_Config_Upstream_Container_Env_FromSecret:
+			if err := s.validateSecretOwner(ctx, itm); err != nil {
+				return err
+			}
+		case *corev1.Service_Spec_Config_Upstream_Container_Env_KubernetesSecretRef_:
+			if itm.GetKubernetesSecretRef().Name == "" {
+				return grpcutils.InvalidArg("KubernetesSecretRef name is empty")
+			}
+			if itm.GetKubernetesSecretRef().Key == "" {
+				return grpcutils.InvalidArg("KubernetesSecretRef key is empty")
+			}
+			if err := apivalidation.ValidateGenASCII(itm.GetKubernetesSecretRef().Name); err != nil {
+				return err
+			}
+			if err := apivalidation.ValidateGenASCII(itm.GetKubernetesSecretRef().Key); err != nil {
+				return err
+			}
+		default:
+			return grpcutils.InvalidArg("either value, fromSecret or kubernetesSecretRef must be set")
+		}
+	}
+
+	return nil
+}
+
+func validateContainerResourceLimit(limit *corev1.Service_Spec_Config_Upstream_Container_ResourceLimit) error {
+	if limit == nil {
+		return nil
+	}
+
+	if len(limit.Ext) > 100 {
+		return grpcutils.InvalidArg("Too many extend resources")
+	}
+
+	for k, v := range limit.Ext {
+		if err := apivalidation.ValidateGenASCII(k); err != nil {
+			return err
+		}
+
+		if err := apivalidation.ValidateGenASCII(v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateContainerProbe(p *corev1.Service_Spec_Config_Upstream_Container_Probe) error {
+	if p == nil {
+		return nil
+	}
+
+	switch p.Type.(type) {
+	case *corev1.Service_Spec_Config_Upstream_Container_Probe_Grpc:
+		if err := apivalidation.ValidatePort(int(p.GetGrpc().Port)); err != nil {
+			return err
+		}
+	case *corev1.Service_Spec_Config_Upstream_Container_Probe_HttpGet:
+		if len(p.GetHttpGet().Path) > 512 {
+			return grpcutils.InvalidArg("Path is too long: %s", p.GetHttpGet().Path)
+		}
+		if !govalidator.IsRequestURI(p.GetHttpGet().Path) {
+			return grpcutils.InvalidArg("Invalid path: %s", p.GetHttpGet().Path)
+		}
+
+		if err := apivalidation.ValidatePort(int(p.GetHttpGet().Port)); err != nil {
+			return err
+		}
+	case *corev1.Service_Spec_Config_Upstream_Container_Probe_TcpSocket:
+		if err := apivalidation.ValidatePort(int(p.GetTcpSocket().Port)); err != nil {
+			return err
+		}
+	default:
+		return grpcutils.InvalidArg("Invalid Probe type")
+	}
+
+	return nil
+}
+
+func validateAutoscale(autoscale *corev1.Service_Spec_Autoscale) error {
+	if autoscale == nil {
+		return nil
+	}
+
+	if autoscale.MaxReplicas < 1 || autoscale.MaxReplicas > 100 {
+		return grpcutils.InvalidArg("Invalid autoscale maxReplicas: %d", autoscale.MaxReplicas)
+	}
+
+	if autoscale.MinReplicas > autoscale.MaxReplicas {
+		return grpcutils.InvalidArg("autoscale minReplicas cannot be greater than maxReplicas")
+	}
+
+	if len(autoscale.Metrics) > 8 {
+		return grpcutils.InvalidArg("Too many autoscale metrics")
+	}
+
+	for _, metric := range autoscale.Metrics {
+		switch metric.Type.(type) {
+		case *corev1.Service_Spec_Autoscale_Metric_CpuUtilization:
+			if metric.GetCpuUtilization() < 1 || metric.GetCpuUtilization() > 100 {
+				return grpcutils.InvalidArg("Invalid autoscale cpuUtilization: %d", metric.GetCpuUtilization())
+			}
+		case *corev1.Service_Spec_Autoscale_Metric_MemoryUtilization:
+			if metric.GetMemoryUtilization() < 1 || metric.GetMemoryUtilization() > 100 {
+				return grpcutils.InvalidArg("Invalid autoscale memoryUtilization: %d", metric.GetMemoryUtilization())
+			}
+		default:
+			return grpcutils.InvalidArg("Autoscale metric type must be set")
+		}
+	}
+
+	return nil
+}
+
+func validateDynamicConfigSplit(ctx context.Context, dynamicConfig *corev1.Service_Spec_DynamicConfig) error {
+	split := dynamicConfig.Split
+	if split == nil {
+		return nil
+	}
+
+	if len(split.Targets) == 0 {
+		return grpcutils.InvalidArg("DynamicConfig split must have at least one target")
+	}
+
+	if len(split.Targets) > 32 {
+		return grpcutils.InvalidArg("Too many DynamicConfig split targets")
+	}
+
+	var totalWeight uint32
+	var targetNames []string
+	for _, target := range split.Targets {
+		if target.ConfigName != "" && !slices.ContainsFunc(dynamicConfig.Configs,
+			func(cfg *corev1.Service_Spec_Config) bool {
+				return cfg.Name == target.ConfigName
+			}) {
+			return grpcutils.InvalidArg("DynamicConfig split target Config does not exist: %s", target.ConfigName)
+		}
+
+		if slices.Contains(targetNames, target.ConfigName) {
+			return grpcutils.InvalidArg("Duplicate DynamicConfig split target: %s", target.ConfigName)
+		}
+		targetNames = append(targetNames, target.ConfigName)
+
+		if target.Weight > 10000 {
+			return grpcutils.InvalidArg("DynamicConfig split target weight is too high: %d", target.Weight)
+		}
+		totalWeight += target.Weight
+	}
+
+	if totalWeight == 0 {
+		return grpcutils.InvalidArg("DynamicConfig split targets must have a positive total weight")
+	}
+
+	if split.OverrideHeader != "" {
+		if err := apivalidation.ValidateGenASCII(split.OverrideHeader); err != nil {
+			return err
+		}
+		if len(split.OverrideHeader) > 128 || strings.ContainsAny(split.OverrideHeader, " :") {
+			return grpcutils.InvalidArg("Invalid DynamicConfig split overrideHeader: %s", split.OverrideHeader)
+		}
+	}
+
+	if split.Stickiness != nil &&
+		split.Stickiness.Type == corev1.Service_Spec_DynamicConfig_Split_Stickiness_EVAL {
+		if split.Stickiness.Eval == "" {
+			return grpcutils.InvalidArg("DynamicConfig split stickiness eval must be set")
+		}
+		if err := checkCELStringExpression(ctx, split.Stickiness.Eval); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
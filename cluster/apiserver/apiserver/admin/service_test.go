@@ -452,6 +452,37 @@ func TestServiceMode(t *testing.T) {
 		assert.Equal(t, corev1.Service_Spec_SSH, ucorev1.ToService(svc).GetMode())
 	}
 
+	{
+		genPublicSvc := func(mode corev1.Service_Spec_Mode, isTLS bool) *corev1.Service {
+			return &corev1.Service{
+				Metadata: &metav1.Metadata{
+					Name: utilrand.GetRandomStringCanonical(8),
+				},
+				Spec: &corev1.Service_Spec{
+					Mode:     mode,
+					IsPublic: true,
+					IsTLS:    isTLS,
+					Config: &corev1.Service_Spec_Config{
+						Upstream: &corev1.Service_Spec_Config_Upstream{
+							Type: &corev1.Service_Spec_Config_Upstream_Url{
+								Url: "tcp://localhost:9000",
+							},
+						},
+					},
+				},
+			}
+		}
+
+		_, err := srv.CreateService(ctx, genPublicSvc(corev1.Service_Spec_TCP, true))
+		assert.Nil(t, err, "%+v", err)
+
+		_, err = srv.CreateService(ctx, genPublicSvc(corev1.Service_Spec_TCP, false))
+		assert.NotNil(t, err)
+
+		_, err = srv.CreateService(ctx, genPublicSvc(corev1.Service_Spec_UDP, true))
+		assert.NotNil(t, err)
+	}
+
 	{
 		svc, err := srv.CreateService(ctx, &corev1.Service{
 			Metadata: &metav1.Metadata{
@@ -1325,3 +1356,193 @@ func TestMergedServiceConfig(t *testing.T) {
 		assert.True(t, pbutils.IsEqual(req.Spec, svc.Spec))
 	}
 }
+
+func TestValidateAutoscale(t *testing.T) {
+	valids := []*corev1.Service_Spec_Autoscale{
+		nil,
+		{
+			MaxReplicas: 4,
+		},
+		{
+			MinReplicas: 2,
+			MaxReplicas: 10,
+			Metrics: []*corev1.Service_Spec_Autoscale_Metric{
+				{
+					Type: &corev1.Service_Spec_Autoscale_Metric_CpuUtilization{CpuUtilization: 70},
+				},
+				{
+					Type: &corev1.Service_Spec_Autoscale_Metric_MemoryUtilization{MemoryUtilization: 60},
+				},
+			},
+		},
+	}
+
+	for _, valid := range valids {
+		assert.Nil(t, validateAutoscale(valid), "%+v", valid)
+	}
+
+	invalids := []*corev1.Service_Spec_Autoscale{
+		{},
+		{
+			MaxReplicas: 1000,
+		},
+		{
+			MinReplicas: 5,
+			MaxReplicas: 2,
+		},
+		{
+			MaxReplicas: 4,
+			Metrics: []*corev1.Service_Spec_Autoscale_Metric{
+				{
+					Type: &corev1.Service_Spec_Autoscale_Metric_MemoryUtilization{MemoryUtilization: 150},
+				},
+			},
+		},
+		{
+			MaxReplicas: 4,
+			Metrics: []*corev1.Service_Spec_Autoscale_Metric{
+				{},
+			},
+		},
+	}
+
+	for _, invalid := range invalids {
+		assert.NotNil(t, validateAutoscale(invalid), "%+v", invalid)
+	}
+}
+
+func TestValidateDynamicConfigSplit(t *testing.T) {
+	ctx := context.Background()
+
+	genDynamicConfig := func(split *corev1.Service_Spec_DynamicConfig_Split) *corev1.Service_Spec_DynamicConfig {
+		return &corev1.Service_Spec_DynamicConfig{
+			Configs: []*corev1.Service_Spec_Config{
+				{
+					Name: "canary",
+				},
+			},
+			Split: split,
+		}
+	}
+
+	valids := []*corev1.Service_Spec_DynamicConfig_Split{
+		nil,
+		{
+			Targets: []*corev1.Service_Spec_DynamicConfig_Split_Target{
+				{Weight: 95},
+				{ConfigName: "canary", Weight: 5},
+			},
+			OverrideHeader: "X-Octelium-Config",
+		},
+		{
+			Targets: []*corev1.Service_Spec_DynamicConfig_Split_Target{
+				{ConfigName: "canary", Weight: 1},
+			},
+			Stickiness: &corev1.Service_Spec_DynamicConfig_Split_Stickiness{
+				Type: corev1.Service_Spec_DynamicConfig_Split_Stickiness_EVAL,
+				Eval: `ctx.user.metadata.name`,
+			},
+		},
+	}
+
+	for _, valid := range valids {
+		assert.Nil(t, validateDynamicConfigSplit(ctx, genDynamicConfig(valid)), "%+v", valid)
+	}
+
+	invalids := []*corev1.Service_Spec_DynamicConfig_Split{
+		{},
+		{
+			Targets: []*corev1.Service_Spec_DynamicConfig_Split_Target{
+				{ConfigName: "unknown", Weight: 5},
+			},
+		},
+		{
+			Targets: []*corev1.Service_Spec_DynamicConfig_Split_Target{
+				{Weight: 0},
+				{ConfigName: "canary", Weight: 0},
+			},
+		},
+		{
+			Targets: []*corev1.Service_Spec_DynamicConfig_Split_Target{
+				{ConfigName: "canary", Weight: 1},
+				{ConfigName: "canary", Weight: 1},
+			},
+		},
+		{
+			Targets: []*corev1.Service_Spec_DynamicConfig_Split_Target{
+				{ConfigName: "canary", Weight: 1},
+			},
+			Stickiness: &corev1.Service_Spec_DynamicConfig_Split_Stickiness{
+				Type: corev1.Service_Spec_DynamicConfig_Split_Stickiness_EVAL,
+			},
+		},
+		{
+			Targets: []*corev1.Service_Spec_DynamicConfig_Split_Target{
+				{ConfigName: "canary", Weight: 1},
+			},
+			OverrideHeader: "X Octelium",
+		},
+	}
+
+	for _, invalid := range invalids {
+		assert.NotNil(t, validateDynamicConfigSplit(ctx, genDynamicConfig(invalid)), "%+v", invalid)
+	}
+}
+
+func TestValidateHTTPResilience(t *testing.T) {
+	valids := []*corev1.Service_Spec_Config_HTTP{
+		{},
+		{
+			Timeout: &corev1.Service_Spec_Config_HTTP_Timeout{
+				Request: &metav1.Duration{
+					Type: &metav1.Duration_Seconds{Seconds: 30},
+				},
+			},
+			Limit: &corev1.Service_Spec_Config_HTTP_Limit{
+				MaxConcurrentRequests: 100,
+				MaxPendingRequests:    50,
+			},
+			CircuitBreaker: &corev1.Service_Spec_Config_HTTP_CircuitBreaker{
+				ErrorRatePercentage: 40,
+				SlowRequestThreshold: &metav1.Duration{
+					Type: &metav1.Duration_Seconds{Seconds: 2},
+				},
+			},
+		},
+	}
+
+	for _, valid := range valids {
+		assert.Nil(t, validateHTTPResilience(valid), "%+v", valid)
+	}
+
+	invalids := []*corev1.Service_Spec_Config_HTTP{
+		{
+			Timeout: &corev1.Service_Spec_Config_HTTP_Timeout{
+				IdleStream: &metav1.Duration{
+					Type: &metav1.Duration_Days{Days: 3},
+				},
+			},
+		},
+		{
+			Limit: &corev1.Service_Spec_Config_HTTP_Limit{
+				MaxPendingRequests: 50,
+			},
+		},
+		{
+			CircuitBreaker: &corev1.Service_Spec_Config_HTTP_CircuitBreaker{
+				ErrorRatePercentage: 101,
+			},
+		},
+		{
+			CircuitBreaker: &corev1.Service_Spec_Config_HTTP_CircuitBreaker{
+				OpenDuration: &metav1.Duration{
+					Type: &metav1.Duration_Hours{Hours: 2},
+				},
+			},
+		},
+	}
+
+	for _, invalid := range invalids {
+		assert.NotNil(t, validateHTTPResilience(invalid), "%+v", invalid)
+	}
+}
@@ -318,6 +318,13 @@ func (s *Server) validateUser(ctx context.Context, itm *corev1.User) error {
 		if err := apivalidation.ValidateDuration(itm.Spec.Session.ClientlessDuration); err != nil {
 			return err
 		}
+
+		if err := validateSessionExpiration(itm.Spec.Session.ClientIdleTimeout,
+			itm.Spec.Session.ClientlessIdleTimeout,
+			itm.Spec.Session.ReauthenticationInterval,
+			itm.Spec.Session.MaxPerDevice); err != nil {
+			return err
+		}
 	}
 
 	if err := s.validatePolicyOwner(ctx, itm.Spec.Authorization); err != nil {
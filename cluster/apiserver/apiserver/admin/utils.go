@@ -40,6 +40,18 @@ func checkCELExpression(ctx context.Context, arg string) error {
 	return nil
 }
 
+func checkCELStringExpression(ctx context.Context, arg string) error {
+	engine, err := celengine.New(ctx, &celengine.Opts{})
+	if err != nil {
+		return grpcutils.InternalWithErr(err)
+	}
+	if err := engine.AddPolicyString(ctx, arg); err != nil {
+		return grpcutils.InvalidArgWithErr(err)
+	}
+
+	return nil
+}
+
 func getNamespace(name string) (string, error) {
 
 	args := strings.Split(name, ".")
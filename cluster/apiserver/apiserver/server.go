@@ -39,20 +39,40 @@ import (
 	sesscontroller "github.com/octelium/octelium/cluster/apiserver/apiserver/controllers/sessions"
 )
 
+type Opts struct {
+	// Port sets the local port on which the API Server listens. The default
+	// managed Service port is used if not set.
+	Port int
+	// IsEmbedded is set when the API Server runs within another process,
+	// such as the standalone runtime, which already initializes the common
+	// telemetry and serves its own health checks.
+	IsEmbedded bool
+}
+
 func Run(ctx context.Context) error {
+	return RunWithOpts(ctx, nil)
+}
+
+func RunWithOpts(ctx context.Context, o *Opts) error {
 
 	zap.L().Debug("Starting octelium API server...")
 
+	if o == nil {
+		o = &Opts{}
+	}
+
 	octeliumC, err := octeliumc.NewClient(ctx)
 	if err != nil {
 		return err
 	}
 
-	if err := commoninit.Run(ctx, nil); err != nil {
-		return err
+	if !o.IsEmbedded {
+		if err := commoninit.Run(ctx, nil); err != nil {
+			return err
+		}
 	}
 
-	lis, err := net.Listen("tcp", vutils.ManagedServiceAddr)
+	lis, err := net.Listen("tcp", vutils.GetManagedServiceAddr(o.Port))
 	if err != nil {
 		return err
 	}
@@ -107,7 +127,9 @@ func Run(ctx context.Context) error {
 		}
 	}()
 
-	healthcheck.Run(vutils.HealthCheckPortManagedService)
+	if !o.IsEmbedded {
+		healthcheck.Run(vutils.HealthCheckPortManagedService)
+	}
 	zap.L().Info("API Server is now running")
 	<-ctx.Done()
 	zap.L().Debug("Shutting down gRPC server")
@@ -71,7 +71,8 @@ func getConnectionState(ctx context.Context, octeliumC octeliumc.ClientInterface
 		Ed25519Key: ed25519Priv[:],
 		Mtu: func() int32 {
 			switch conn.Type {
-			case corev1.Session_Status_Connection_QUICV0:
+			case corev1.Session_Status_Connection_QUICV0, corev1.Session_Status_Connection_MASQUE,
+				corev1.Session_Status_Connection_TLS:
 				return int32(ucorev1.ToClusterConfig(cc).GetDevMTUQUIV0())
 			case corev1.Session_Status_Connection_WIREGUARD:
 				return int32(ucorev1.ToClusterConfig(cc).GetDevMTUWireGuard())
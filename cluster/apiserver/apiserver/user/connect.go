@@ -96,6 +96,8 @@ func (s *Server) Connect(stream userv1.MainService_ConnectServer) error {
 	s.connServer.addConnectedSess(i.Session, stream)
 	defer s.connServer.removeConnectedSess(i.Session.Metadata.Uid)
 
+	go s.doRecvLoop(ctx, i, stream)
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -284,6 +286,10 @@ func (s *Server) DoInitConnect(ctx context.Context, req *userv1.ConnectRequest_I
 			switch req.ConnectionType {
 			case userv1.ConnectRequest_Initialize_QUICV0:
 				return corev1.Session_Status_Connection_QUICV0
+			case userv1.ConnectRequest_Initialize_MASQUE:
+				return corev1.Session_Status_Connection_MASQUE
+			case userv1.ConnectRequest_Initialize_TLS:
+				return corev1.Session_Status_Connection_TLS
 			default:
 				return corev1.Session_Status_Connection_WIREGUARD
 			}
@@ -0,0 +1,150 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package user
+
+import (
+	"context"
+	"crypto/ed25519"
+	"time"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/userv1"
+	"github.com/octelium/octelium/apis/rsc/rmetav1"
+	"github.com/octelium/octelium/cluster/common/userctx"
+	"github.com/octelium/octelium/pkg/apiutils/umetav1"
+	"github.com/octelium/octelium/pkg/common/pbutils"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	postureMaxClockSkew  = 5 * time.Minute
+	postureMinInterval   = 30 * time.Second
+	postureMaxStringSize = 256
+)
+
+func (s *Server) doRecvLoop(ctx context.Context, i *userctx.UserCtx, stream userv1.MainService_ConnectServer) {
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			zap.L().Debug("Stopping the Connect recv loop", zap.Error(err))
+			return
+		}
+
+		switch req.Type.(type) {
+		case *userv1.ConnectRequest_Posture_:
+			if err := s.setDevicePosture(ctx, i, req.GetPosture()); err != nil {
+				zap.L().Debug("Could not set Device posture",
+					zap.String("sessName", i.Session.Metadata.Name), zap.Error(err))
+			}
+		default:
+			zap.L().Debug("Ignoring unexpected Connect request",
+				zap.String("sessName", i.Session.Metadata.Name))
+		}
+	}
+}
+
+func (s *Server) setDevicePosture(ctx context.Context, i *userctx.UserCtx, req *userv1.ConnectRequest_Posture) error {
+
+	sess, err := s.octeliumC.CoreC().GetSession(ctx, &rmetav1.GetOptions{Uid: i.Session.Metadata.Uid})
+	if err != nil {
+		return err
+	}
+
+	if sess.Status.DeviceRef == nil {
+		return nil
+	}
+
+	if sess.Status.Connection == nil {
+		return errors.Errorf("Session has no active Connection")
+	}
+
+	if err := verifyPosture(req, sess.Status.Connection.Ed25519PublicKey, time.Now()); err != nil {
+		return err
+	}
+
+	dev, err := s.octeliumC.CoreC().GetDevice(ctx, &rmetav1.GetOptions{Uid: sess.Status.DeviceRef.Uid})
+	if err != nil {
+		return err
+	}
+
+	if dev.Status.UserRef == nil || dev.Status.UserRef.Uid != sess.Status.UserRef.Uid {
+		return errors.Errorf("Device does not belong to the Session's User")
+	}
+
+	if cur := dev.Status.Posture; cur != nil {
+		if cur.ReportedAt != nil && !req.Info.Timestamp.AsTime().After(cur.ReportedAt.AsTime()) {
+			return errors.Errorf("Posture report is not newer than the current one")
+		}
+
+		if cur.ReceivedAt != nil && cur.SessionRef != nil && cur.SessionRef.Uid == sess.Metadata.Uid &&
+			time.Since(cur.ReceivedAt.AsTime()) < postureMinInterval {
+			return errors.Errorf("Posture reported too frequently")
+		}
+	}
+
+	dev.Status.Posture = &corev1.Device_Status_Posture{
+		OsVersion:           req.Info.OsVersion,
+		IsDiskEncrypted:     req.Info.IsDiskEncrypted,
+		IsScreenLockEnabled: req.Info.IsScreenLockEnabled,
+		IsFirewallEnabled:   req.Info.IsFirewallEnabled,
+		ClientVersion:       req.Info.ClientVersion,
+		ReportedAt:          req.Info.Timestamp,
+		ReceivedAt:          pbutils.Now(),
+		SessionRef:          umetav1.GetObjectReference(sess),
+	}
+
+	if _, err := s.octeliumC.CoreC().UpdateDevice(ctx, dev); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func verifyPosture(req *userv1.ConnectRequest_Posture, pubKey []byte, now time.Time) error {
+	if req == nil || req.Info == nil {
+		return errors.Errorf("Empty posture")
+	}
+
+	if len(pubKey) != ed25519.PublicKeySize {
+		return errors.Errorf("Invalid Connection public key")
+	}
+
+	if len(req.Info.OsVersion) > postureMaxStringSize || len(req.Info.ClientVersion) > postureMaxStringSize {
+		return errors.Errorf("Posture info is too long")
+	}
+
+	if req.Info.Timestamp == nil || !req.Info.Timestamp.IsValid() {
+		return errors.Errorf("Invalid posture timestamp")
+	}
+
+	if skew := now.Sub(req.Info.Timestamp.AsTime()); skew > postureMaxClockSkew || skew < -postureMaxClockSkew {
+		return errors.Errorf("Posture timestamp is out of the allowed clock skew")
+	}
+
+	infoBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(req.Info)
+	if err != nil {
+		return err
+	}
+
+	if !ed25519.Verify(ed25519.PublicKey(pubKey), infoBytes, req.Signature) {
+		return errors.Errorf("Invalid posture signature")
+	}
+
+	return nil
+}
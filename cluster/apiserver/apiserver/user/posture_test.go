@@ -0,0 +1,94 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package user
+
+import (
+	"crypto/ed25519"
+	"testing"
+	"time"
+
+	"github.com/octelium/octelium/apis/main/userv1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestVerifyPosture(t *testing.T) {
+
+	pub, priv, err := ed25519.GenerateKey(nil)
+	assert.Nil(t, err)
+
+	now := time.Now()
+
+	sign := func(info *userv1.ConnectRequest_Posture_Info, key ed25519.PrivateKey) *userv1.ConnectRequest_Posture {
+		infoBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(info)
+		assert.Nil(t, err)
+		return &userv1.ConnectRequest_Posture{
+			Info:      info,
+			Signature: ed25519.Sign(key, infoBytes),
+		}
+	}
+
+	{
+		req := sign(&userv1.ConnectRequest_Posture_Info{
+			OsVersion:       "Ubuntu 24.04",
+			IsDiskEncrypted: true,
+			Timestamp:       timestamppb.New(now),
+		}, priv)
+		err := verifyPosture(req, pub, now)
+		assert.Nil(t, err)
+	}
+
+	{
+		req := sign(&userv1.ConnectRequest_Posture_Info{
+			IsDiskEncrypted: true,
+			Timestamp:       timestamppb.New(now),
+		}, priv)
+		req.Info.IsFirewallEnabled = true
+		err := verifyPosture(req, pub, now)
+		assert.NotNil(t, err)
+	}
+
+	{
+		_, otherPriv, err := ed25519.GenerateKey(nil)
+		assert.Nil(t, err)
+		req := sign(&userv1.ConnectRequest_Posture_Info{
+			Timestamp: timestamppb.New(now),
+		}, otherPriv)
+		err = verifyPosture(req, pub, now)
+		assert.NotNil(t, err)
+	}
+
+	{
+		req := sign(&userv1.ConnectRequest_Posture_Info{
+			Timestamp: timestamppb.New(now.Add(-1 * time.Hour)),
+		}, priv)
+		err := verifyPosture(req, pub, now)
+		assert.NotNil(t, err)
+	}
+
+	{
+		req := sign(&userv1.ConnectRequest_Posture_Info{}, priv)
+		err := verifyPosture(req, pub, now)
+		assert.NotNil(t, err)
+	}
+
+	{
+		err := verifyPosture(&userv1.ConnectRequest_Posture{}, pub, now)
+		assert.NotNil(t, err)
+	}
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package authserver
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+
+	"github.com/octelium/octelium/apis/main/authv1"
+	"github.com/pkg/errors"
+)
+
+// doIssueClientCertificate issues a client certificate for the Session, which
+// public TLS passthrough Services accept in place of the access token.
+func (s *server) doIssueClientCertificate(ctx context.Context,
+	req *authv1.IssueClientCertificateRequest) (*authv1.IssueClientCertificateResponse, error) {
+
+	sess, err := s.getSessionFromGRPCCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pub, err := parseClientCertificatePublicKey(req.PublicKey)
+	if err != nil {
+		return nil, s.errInvalidArgErr(err)
+	}
+
+	crt, err := s.jwkCtl.CreateClientCertificate(sess, pub)
+	if err != nil {
+		return nil, s.errInternalErr(err)
+	}
+
+	return &authv1.IssueClientCertificateResponse{
+		Certificate: crt,
+	}, nil
+}
+
+func parseClientCertificatePublicKey(der []byte) (any, error) {
+	if len(der) == 0 {
+		return nil, errors.Errorf("Empty public key")
+	}
+
+	if len(der) > 2048 {
+		return nil, errors.Errorf("Public key is too large")
+	}
+
+	pub, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, errors.Errorf("Invalid public key")
+	}
+
+	switch key := pub.(type) {
+	case ed25519.PublicKey:
+	case *ecdsa.PublicKey:
+		if key.Curve != elliptic.P256() && key.Curve != elliptic.P384() {
+			return nil, errors.Errorf("Unsupported ECDSA curve")
+		}
+	case *rsa.PublicKey:
+		if key.N.BitLen() < 2048 {
+			return nil, errors.Errorf("RSA public key is too short")
+		}
+	default:
+		return nil, errors.Errorf("Unsupported public key type")
+	}
+
+	return pub, nil
+}
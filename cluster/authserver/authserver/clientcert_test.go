@@ -0,0 +1,125 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package authserver
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"testing"
+
+	"github.com/octelium/octelium/apis/main/authv1"
+	"github.com/octelium/octelium/cluster/apiserver/apiserver/admin"
+	"github.com/octelium/octelium/cluster/common/tests"
+	"github.com/octelium/octelium/cluster/common/tests/tstuser"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseClientCertificatePublicKey(t *testing.T) {
+	marshal := func(pub any) []byte {
+		ret, err := x509.MarshalPKIXPublicKey(pub)
+		assert.Nil(t, err)
+		return ret
+	}
+
+	{
+		pub, _, err := ed25519.GenerateKey(rand.Reader)
+		assert.Nil(t, err)
+		_, err = parseClientCertificatePublicKey(marshal(pub))
+		assert.Nil(t, err)
+	}
+
+	{
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		assert.Nil(t, err)
+		_, err = parseClientCertificatePublicKey(marshal(key.Public()))
+		assert.Nil(t, err)
+	}
+
+	{
+		key, err := ecdsa.GenerateKey(elliptic.P224(), rand.Reader)
+		assert.Nil(t, err)
+		_, err = parseClientCertificatePublicKey(marshal(key.Public()))
+		assert.NotNil(t, err)
+	}
+
+	{
+		key, err := rsa.GenerateKey(rand.Reader, 1024)
+		assert.Nil(t, err)
+		_, err = parseClientCertificatePublicKey(marshal(key.Public()))
+		assert.NotNil(t, err)
+	}
+
+	{
+		_, err := parseClientCertificatePublicKey(nil)
+		assert.NotNil(t, err)
+
+		_, err = parseClientCertificatePublicKey([]byte("invalid"))
+		assert.NotNil(t, err)
+	}
+}
+
+func TestIssueClientCertificate(t *testing.T) {
+	ctx := context.Background()
+
+	tst, err := tests.Initialize(nil)
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		tst.Destroy()
+	})
+	fakeC := tst.C
+	cc, err := tst.C.OcteliumC.CoreV1Utils().GetClusterConfig(ctx)
+	assert.Nil(t, err)
+
+	srv, err := initServer(ctx, fakeC.OcteliumC, cc)
+	assert.Nil(t, err)
+
+	adminSrv := admin.NewServer(&admin.Opts{
+		OcteliumC:  fakeC.OcteliumC,
+		IsEmbedded: true,
+	})
+
+	usrT, err := tstuser.NewUserWeb(srv.octeliumC, adminSrv, nil, nil)
+	assert.Nil(t, err)
+
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	assert.Nil(t, err)
+	pubDER, err := x509.MarshalPKIXPublicKey(pub)
+	assert.Nil(t, err)
+
+	{
+		_, err := srv.doIssueClientCertificate(ctx, &authv1.IssueClientCertificateRequest{
+			PublicKey: pubDER,
+		})
+		assert.NotNil(t, err)
+	}
+
+	{
+		resp, err := srv.doIssueClientCertificate(getCtxRT(usrT), &authv1.IssueClientCertificateRequest{
+			PublicKey: pubDER,
+		})
+		assert.Nil(t, err)
+
+		claims, err := srv.jwkCtl.VerifyClientCertificate(resp.Certificate)
+		assert.Nil(t, err)
+		assert.Equal(t, usrT.Session.Metadata.Uid, claims.SessionUID)
+	}
+}
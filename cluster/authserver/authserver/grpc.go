@@ -128,3 +128,9 @@ func (s *authMainSvc) AuthenticateWithPasskey(ctx context.Context,
 
 	return s.s.doAuthenticateWithPasskey(ctx, req)
 }
+
+func (s *authMainSvc) IssueClientCertificate(ctx context.Context,
+	req *authv1.IssueClientCertificateRequest) (*authv1.IssueClientCertificateResponse, error) {
+
+	return s.s.doIssueClientCertificate(ctx, req)
+}
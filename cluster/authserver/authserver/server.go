@@ -315,7 +315,7 @@ func (s *server) setIdentityProviders(ctx context.Context) error {
 	return nil
 }
 
-func (s *server) run(ctx context.Context, grpcMode bool) error {
+func (s *server) run(ctx context.Context, grpcMode bool, addr string) error {
 	if err := s.jwkCtl.Run(ctx); err != nil {
 		return err
 	}
@@ -350,7 +350,7 @@ func (s *server) run(ctx context.Context, grpcMode bool) error {
 		)
 		authv1.RegisterMainServiceServer(grpcSrv, authSrv)
 
-		lisGRPC, err := net.Listen("tcp", vutils.ManagedServiceAddr)
+		lisGRPC, err := net.Listen("tcp", addr)
 		if err != nil {
 			return err
 		}
@@ -393,7 +393,7 @@ func (s *server) run(ctx context.Context, grpcMode bool) error {
 		go func() error {
 			srv := &http.Server{
 				Handler:      r,
-				Addr:         vutils.ManagedServiceAddr,
+				Addr:         addr,
 				WriteTimeout: 15 * time.Second,
 				ReadTimeout:  15 * time.Second,
 			}
@@ -405,14 +405,34 @@ func (s *server) run(ctx context.Context, grpcMode bool) error {
 	return nil
 }
 
+type Opts struct {
+	// Port sets the local port on which the server listens. The default
+	// managed Service port is used if not set.
+	Port int
+	// IsEmbedded is set when the server runs within another process, such as
+	// the standalone runtime, which already initializes the common telemetry
+	// and serves its own health checks.
+	IsEmbedded bool
+}
+
 func Run(ctx context.Context, grpcMode bool) error {
+	return RunWithOpts(ctx, grpcMode, nil)
+}
+
+func RunWithOpts(ctx context.Context, grpcMode bool, o *Opts) error {
+	if o == nil {
+		o = &Opts{}
+	}
+
 	octeliumC, err := octeliumc.NewClient(ctx)
 	if err != nil {
 		return err
 	}
 
-	if err := commoninit.Run(ctx, nil); err != nil {
-		return err
+	if !o.IsEmbedded {
+		if err := commoninit.Run(ctx, nil); err != nil {
+			return err
+		}
 	}
 
 	clusterCfg, err := octeliumC.CoreV1Utils().GetClusterConfig(ctx)
@@ -425,11 +445,13 @@ func Run(ctx context.Context, grpcMode bool) error {
 		return err
 	}
 
-	if err := s.run(ctx, grpcMode); err != nil {
+	if err := s.run(ctx, grpcMode, vutils.GetManagedServiceAddr(o.Port)); err != nil {
 		return err
 	}
 
-	healthcheck.Run(vutils.HealthCheckPortManagedService)
+	if !o.IsEmbedded {
+		healthcheck.Run(vutils.HealthCheckPortManagedService)
+	}
 	zap.L().Info("AuthServer is now running...")
 	<-ctx.Done()
 
@@ -0,0 +1,183 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package apivalidation
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/cluster/common/grpcutils"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+)
+
+var rgxContainerFileName = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,128}$`)
+
+// ValidateContainerName validates the name of a managed container's init
+// container or sidecar. The name of the main container is reserved.
+func ValidateContainerName(arg string) error {
+	if errs := k8svalidation.IsDNS1123Label(arg); len(errs) > 0 {
+		return grpcutils.InvalidArg("Invalid container name: %s", arg)
+	}
+
+	if arg == "backend" {
+		return grpcutils.InvalidArg("The container name %s is reserved", arg)
+	}
+
+	return nil
+}
+
+func ValidateContainerImage(arg string) error {
+	if arg == "" {
+		return grpcutils.InvalidArg("You must provide a managedContainer image")
+	}
+	if len(arg) > 256 {
+		return grpcutils.InvalidArg("Image address is too long: %s", arg)
+	}
+
+	return nil
+}
+
+func ValidateContainerCommand(command, args []string) error {
+	if len(args) > 64 {
+		return grpcutils.InvalidArg("Too many managedContainer args")
+	}
+
+	if len(command) > 64 {
+		return grpcutils.InvalidArg("Too many command items")
+	}
+
+	for _, arg := range args {
+		if len(arg) > 1024 {
+			return grpcutils.InvalidArg("Too long managedContainer arg: %s", arg)
+		}
+	}
+
+	for _, arg := range command {
+		if len(arg) > 1024 {
+			return grpcutils.InvalidArg("Too long managedContainer command: %s", arg)
+		}
+	}
+
+	return nil
+}
+
+// ValidateContainerVolume validates a managed container volume. It does not
+// check whether the referenced Secrets and Configs exist.
+func ValidateContainerVolume(vol *corev1.Service_Spec_Config_Upstream_Container_Volume) error {
+	if err := ValidateName(vol.Name, 0, 0); err != nil {
+		return err
+	}
+
+	validateFile := func(fileName string, mode uint32) error {
+		if fileName != "" && (!rgxContainerFileName.MatchString(fileName) ||
+			fileName == "." || fileName == "..") {
+			return grpcutils.InvalidArg("Invalid volume fileName: %s", fileName)
+		}
+
+		if mode > 0777 {
+			return grpcutils.InvalidArg("Invalid volume file mode: %o", mode)
+		}
+
+		return nil
+	}
+
+	switch vol.Type.(type) {
+	case *corev1.Service_Spec_Config_Upstream_Container_Volume_PersistentVolumeClaim_:
+		if err := ValidateName(vol.GetPersistentVolumeClaim().Name, 0, 0); err != nil {
+			return err
+		}
+	case *corev1.Service_Spec_Config_Upstream_Container_Volume_EmptyDir_:
+		if vol.GetEmptyDir().SizeLimitMegabytes > 1024*1024 {
+			return grpcutils.InvalidArg("emptyDir sizeLimitMegabytes is too large")
+		}
+	case *corev1.Service_Spec_Config_Upstream_Container_Volume_Secret_:
+		if err := ValidateName(vol.GetSecret().Name, 0, 0); err != nil {
+			return err
+		}
+		if err := validateFile(vol.GetSecret().FileName, vol.GetSecret().Mode); err != nil {
+			return err
+		}
+	case *corev1.Service_Spec_Config_Upstream_Container_Volume_Config_:
+		if err := ValidateName(vol.GetConfig().Name, 0, 0); err != nil {
+			return err
+		}
+		if err := validateFile(vol.GetConfig().FileName, vol.GetConfig().Mode); err != nil {
+			return err
+		}
+	default:
+		return grpcutils.InvalidArg("Volume type must be set")
+	}
+
+	return nil
+}
+
+// ValidateContainerVolumeMounts validates the volume mounts of a container
+// against the names of the volumes declared by the managed container.
+func ValidateContainerVolumeMounts(mounts []*corev1.Service_Spec_Config_Upstream_Container_VolumeMount,
+	volNames []string) error {
+	if len(mounts) > 32 {
+		return grpcutils.InvalidArg("Too many volumeMounts")
+	}
+
+	var mountPaths []string
+	for _, mount := range mounts {
+		if err := ValidateName(mount.Name, 0, 0); err != nil {
+			return err
+		}
+
+		if !govalidator.IsUnixFilePath(mount.MountPath) {
+			return grpcutils.InvalidArg("Invalid mountPath: %s", mount.MountPath)
+		}
+
+		if len(mount.SubPath) > 256 || strings.Contains(mount.SubPath, "..") ||
+			(mount.SubPath != "" && !govalidator.IsASCII(mount.SubPath)) {
+			return grpcutils.InvalidArg("Invalid subPath: %s", mount.SubPath)
+		}
+
+		if !slices.Contains(volNames, mount.Name) {
+			return grpcutils.InvalidArg("volumeMount %s does not match any volume", mount.Name)
+		}
+
+		if slices.Contains(mountPaths, mount.MountPath) {
+			return grpcutils.InvalidArg("Duplicate mountPath: %s", mount.MountPath)
+		}
+		mountPaths = append(mountPaths, mount.MountPath)
+	}
+
+	return nil
+}
+
+func ValidateNodeSelector(arg map[string]string) error {
+	if len(arg) > 32 {
+		return grpcutils.InvalidArg("Too many nodeSelector labels")
+	}
+
+	for k, v := range arg {
+		if errs := k8svalidation.IsQualifiedName(k); len(errs) > 0 {
+			return grpcutils.InvalidArg("Invalid nodeSelector key: %s", k)
+		}
+		if errs := k8svalidation.IsValidLabelValue(v); len(errs) > 0 {
+			return grpcutils.InvalidArg("Invalid nodeSelector value: %s", fmt.Sprintf("%s=%s", k, v))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package apivalidation
+
+import (
+	"testing"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/pkg/grpcerr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateContainerName(t *testing.T) {
+	for _, valid := range []string{"migrate", "sidecar-1", "a"} {
+		assert.Nil(t, ValidateContainerName(valid))
+	}
+
+	for _, invalid := range []string{"", "backend", "Sidecar", "side_car", "-sidecar"} {
+		err := ValidateContainerName(invalid)
+		assert.NotNil(t, err, "%s", invalid)
+		assert.True(t, grpcerr.IsInvalidArg(err))
+	}
+}
+
+func TestValidateContainerVolume(t *testing.T) {
+	valids := []*corev1.Service_Spec_Config_Upstream_Container_Volume{
+		{
+			Name: "data",
+			Type: &corev1.Service_Spec_Config_Upstream_Container_Volume_PersistentVolumeClaim_{
+				PersistentVolumeClaim: &corev1.Service_Spec_Config_Upstream_Container_Volume_PersistentVolumeClaim{
+					Name: "pvc-1",
+				},
+			},
+		},
+		{
+			Name: "tmp",
+			Type: &corev1.Service_Spec_Config_Upstream_Container_Volume_EmptyDir_{
+				EmptyDir: &corev1.Service_Spec_Config_Upstream_Container_Volume_EmptyDir{
+					IsMemory:           true,
+					SizeLimitMegabytes: 64,
+				},
+			},
+		},
+		{
+			Name: "cert",
+			Type: &corev1.Service_Spec_Config_Upstream_Container_Volume_Secret_{
+				Secret: &corev1.Service_Spec_Config_Upstream_Container_Volume_Secret{
+					Name:     "tls-cert",
+					FileName: "tls.crt",
+					Mode:     0440,
+				},
+			},
+		},
+		{
+			Name: "cfg",
+			Type: &corev1.Service_Spec_Config_Upstream_Container_Volume_Config_{
+				Config: &corev1.Service_Spec_Config_Upstream_Container_Volume_Config{
+					Name: "app-config",
+				},
+			},
+		},
+	}
+
+	for _, valid := range valids {
+		assert.Nil(t, ValidateContainerVolume(valid), "%+v", valid)
+	}
+
+	invalids := []*corev1.Service_Spec_Config_Upstream_Container_Volume{
+		{
+			Name: "data",
+		},
+		{
+			Name: "tmp",
+			Type: &corev1.Service_Spec_Config_Upstream_Container_Volume_EmptyDir_{
+				EmptyDir: &corev1.Service_Spec_Config_Upstream_Container_Volume_EmptyDir{
+					SizeLimitMegabytes: 1024*1024 + 1,
+				},
+			},
+		},
+		{
+			Name: "cert",
+			Type: &corev1.Service_Spec_Config_Upstream_Container_Volume_Secret_{
+				Secret: &corev1.Service_Spec_Config_Upstream_Container_Volume_Secret{
+					Name:     "tls-cert",
+					FileName: "../tls.crt",
+				},
+			},
+		},
+		{
+			Name: "cert",
+			Type: &corev1.Service_Spec_Config_Upstream_Container_Volume_Secret_{
+				Secret: &corev1.Service_Spec_Config_Upstream_Container_Volume_Secret{
+					Name: "tls-cert",
+					Mode: 01777,
+				},
+			},
+		},
+		{
+			Name: "cfg",
+			Type: &corev1.Service_Spec_Config_Upstream_Container_Volume_Config_{
+				Config: &corev1.Service_Spec_Config_Upstream_Container_Volume_Config{
+					Name:     "app-config",
+					FileName: "..",
+				},
+			},
+		},
+	}
+
+	for _, invalid := range invalids {
+		err := ValidateContainerVolume(invalid)
+		assert.NotNil(t, err, "%+v", invalid)
+		assert.True(t, grpcerr.IsInvalidArg(err))
+	}
+}
+
+func TestValidateContainerVolumeMounts(t *testing.T) {
+	volNames := []string{"data", "cert"}
+
+	assert.Nil(t, ValidateContainerVolumeMounts([]*corev1.Service_Spec_Config_Upstream_Container_VolumeMount{
+		{
+			Name:      "data",
+			MountPath: "/var/lib/data",
+		},
+		{
+			Name:      "cert",
+			MountPath: "/etc/tls",
+			ReadOnly:  true,
+		},
+	}, volNames))
+
+	invalids := [][]*corev1.Service_Spec_Config_Upstream_Container_VolumeMount{
+		{
+			{
+				Name:      "unknown",
+				MountPath: "/var/lib/data",
+			},
+		},
+		{
+			{
+				Name:      "data",
+				MountPath: "/var/lib/data",
+				SubPath:   "../etc",
+			},
+		},
+		{
+			{
+				Name:      "data",
+				MountPath: "/var/lib/data",
+			},
+			{
+				Name:      "cert",
+				MountPath: "/var/lib/data",
+			},
+		},
+	}
+
+	for _, invalid := range invalids {
+		err := ValidateContainerVolumeMounts(invalid, volNames)
+		assert.NotNil(t, err)
+		assert.True(t, grpcerr.IsInvalidArg(err))
+	}
+}
+
+func TestValidateNodeSelector(t *testing.T) {
+	assert.Nil(t, ValidateNodeSelector(nil))
+	assert.Nil(t, ValidateNodeSelector(map[string]string{
+		"kubernetes.io/arch": "amd64",
+		"gpu":                "",
+	}))
+
+	for _, invalid := range []map[string]string{
+		{"invalid key": "value"},
+		{"key": "invalid value"},
+	} {
+		err := ValidateNodeSelector(invalid)
+		assert.NotNil(t, err)
+		assert.True(t, grpcerr.IsInvalidArg(err))
+	}
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package celengine
+
+import (
+	celast "github.com/google/cel-go/common/ast"
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/pkg/errors"
+)
+
+var ErrNotAnalyzable = errors.New("The condition input cannot be statically analyzed")
+
+// GetConditionInputPaths returns the paths of the `ctx` fields read by the
+// condition, e.g. ["request", "http", "path"] for `ctx.request.http.path`.
+// An empty path means that the whole `ctx` is read. ErrNotAnalyzable is
+// returned if the read fields cannot be statically determined, such as in
+// OPA conditions, or if the condition depends on the current time.
+func (e *CELEngine) GetConditionInputPaths(condition *corev1.Condition) ([][]string, error) {
+	if condition == nil {
+		return nil, nil
+	}
+
+	switch condition.Type.(type) {
+	case *corev1.Condition_MatchAny:
+		return nil, nil
+	case *corev1.Condition_Match:
+		return e.GetExpressionInputPaths(condition.GetMatch())
+	case *corev1.Condition_Not:
+		return e.GetExpressionInputPaths(condition.GetNot())
+	case *corev1.Condition_All_:
+		return e.getConditionListInputPaths(condition.GetAll().GetOf())
+	case *corev1.Condition_Any_:
+		return e.getConditionListInputPaths(condition.GetAny().GetOf())
+	case *corev1.Condition_None_:
+		return e.getConditionListInputPaths(condition.GetNone().GetOf())
+	default:
+		return nil, ErrNotAnalyzable
+	}
+}
+
+func (e *CELEngine) getConditionListInputPaths(conditions []*corev1.Condition) ([][]string, error) {
+	var ret [][]string
+	for _, condition := range conditions {
+		paths, err := e.GetConditionInputPaths(condition)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, paths...)
+	}
+
+	return ret, nil
+}
+
+// GetExpressionInputPaths returns the paths of the `ctx` fields read by the
+// CEL expression. See GetConditionInputPaths.
+func (e *CELEngine) GetExpressionInputPaths(exp string) ([][]string, error) {
+	if len(exp) == 0 {
+		return nil, nil
+	}
+
+	if len(exp) > 10000 {
+		return nil, errors.Errorf("Expression is too long")
+	}
+
+	parsed, iss := e.env.Parse(exp)
+	if iss.Err() != nil {
+		return nil, errors.Errorf("Could not parse CEL expression: %s: %s", exp, iss.Err())
+	}
+
+	root := celast.NavigateAST(parsed.NativeRep())
+
+	if len(celast.MatchDescendants(root, celast.FunctionMatcher("now"))) > 0 {
+		return nil, ErrNotAnalyzable
+	}
+
+	var ret [][]string
+	for _, ident := range celast.MatchDescendants(root, celast.KindMatcher(celast.IdentKind)) {
+		if ident.AsIdent() != "ctx" {
+			continue
+		}
+
+		path := []string{}
+		cur := ident
+		for {
+			parent, ok := cur.Parent()
+			if !ok || parent.Kind() != celast.SelectKind {
+				break
+			}
+			path = append(path, parent.AsSelect().FieldName())
+			cur = parent
+		}
+
+		ret = append(ret, path)
+	}
+
+	return ret, nil
+}
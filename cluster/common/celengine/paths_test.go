@@ -0,0 +1,120 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package celengine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetConditionInputPaths(t *testing.T) {
+
+	ctx := context.Background()
+
+	srv, err := New(ctx, &Opts{})
+	assert.Nil(t, err)
+
+	{
+		paths, err := srv.GetExpressionInputPaths(`ctx.user.metadata.name == "john" && ctx.request.http.path.startsWith("/api")`)
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, [][]string{
+			{"user", "metadata", "name"},
+			{"request", "http", "path"},
+		}, paths)
+	}
+
+	{
+		paths, err := srv.GetExpressionInputPaths(`ctx.request.http.headers["x-custom"] == "abc"`)
+		assert.Nil(t, err)
+		assert.Equal(t, [][]string{{"request", "http", "headers"}}, paths)
+	}
+
+	{
+		paths, err := srv.GetExpressionInputPaths(`has(ctx.request.http) && attrs.enabled`)
+		assert.Nil(t, err)
+		assert.Equal(t, [][]string{{"request", "http"}}, paths)
+	}
+
+	{
+		paths, err := srv.GetExpressionInputPaths(`ctx["request"].http.path == "/"`)
+		assert.Nil(t, err)
+		assert.Equal(t, [][]string{{}}, paths)
+	}
+
+	{
+		paths, err := srv.GetExpressionInputPaths(`ctx.user.spec.groups.exists(g, g == "admins")`)
+		assert.Nil(t, err)
+		assert.Equal(t, [][]string{{"user", "spec", "groups"}}, paths)
+	}
+
+	{
+		_, err := srv.GetExpressionInputPaths(`now() > timestamp("2024-01-01T00:00:00Z")`)
+		assert.ErrorIs(t, err, ErrNotAnalyzable)
+	}
+
+	{
+		_, err := srv.GetExpressionInputPaths(`ctx.user.`)
+		assert.NotNil(t, err)
+	}
+
+	{
+		paths, err := srv.GetConditionInputPaths(&corev1.Condition{
+			Type: &corev1.Condition_All_{
+				All: &corev1.Condition_All{
+					Of: []*corev1.Condition{
+						{
+							Type: &corev1.Condition_Match{
+								Match: `ctx.session.metadata.name == "sess"`,
+							},
+						},
+						{
+							Type: &corev1.Condition_Not{
+								Not: `ctx.request.http.method == "DELETE"`,
+							},
+						},
+						{
+							Type: &corev1.Condition_MatchAny{
+								MatchAny: true,
+							},
+						},
+					},
+				},
+			},
+		})
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, [][]string{
+			{"session", "metadata", "name"},
+			{"request", "http", "method"},
+		}, paths)
+	}
+
+	{
+		_, err := srv.GetConditionInputPaths(&corev1.Condition{
+			Type: &corev1.Condition_Opa{
+				Opa: &corev1.Condition_OPA{
+					Type: &corev1.Condition_OPA_Inline{
+						Inline: "package octelium.condition\nmatch := true",
+					},
+				},
+			},
+		})
+		assert.ErrorIs(t, err, ErrNotAnalyzable)
+	}
+}
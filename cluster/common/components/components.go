@@ -41,6 +41,8 @@ const NodeInit ComponentType = "nodeinit"
 const Genesis ComponentType = "genesis"
 const Octovigil ComponentType = "octovigil"
 const Portal ComponentType = "portal"
+const SecretMan ComponentType = "secretman"
+const Standalone ComponentType = "standalone"
 
 var myComponentType ComponentType
 var myComponentNS ComponentNamespace
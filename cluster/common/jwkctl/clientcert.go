@@ -0,0 +1,169 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package jwkctl
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/pkg/apiutils/umetav1"
+	"github.com/pkg/errors"
+)
+
+const maxClientCertificateSize = 8192
+
+// CreateClientCertificate issues a client certificate of the public key that
+// is bound to the Session's current access token. The certificate is signed
+// by the same root key as the tokens and expires along with the access token.
+func (c *Controller) CreateClientCertificate(sess *corev1.Session, pub crypto.PublicKey) ([]byte, error) {
+	if sess == nil || sess.Status.Authentication == nil || sess.Status.Authentication.TokenID == "" ||
+		!sess.Status.Authentication.SetAt.IsValid() {
+		return nil, errors.Errorf("Session authentication field is not set")
+	}
+
+	expiresAt := sess.Status.Authentication.SetAt.AsTime().
+		Add(umetav1.ToDuration(sess.Status.Authentication.AccessTokenDuration).ToGo())
+	if time.Now().After(expiresAt) {
+		return nil, errors.Errorf("The Session access token is already expired")
+	}
+
+	key, err := c.chooseJWK()
+	if err != nil {
+		return nil, err
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			CommonName: sess.Metadata.Name,
+		},
+		NotBefore:             time.Now().Add(-1 * time.Minute),
+		NotAfter:              expiresAt,
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		URIs: []*url.URL{
+			c.getClientCertificateURI(sess.Metadata.Uid, sess.Status.Authentication.TokenID),
+		},
+	}
+
+	parent := &x509.Certificate{
+		Subject: pkix.Name{
+			CommonName: c.domain,
+		},
+		SubjectKeyId: c.uidToBytes(key.uid),
+	}
+
+	return x509.CreateCertificate(rand.Reader, tmpl, parent, pub, key.key)
+}
+
+// VerifyClientCertificate verifies the DER-encoded client certificate issued
+// by CreateClientCertificate and returns the claims of the access token that
+// it is bound to.
+func (c *Controller) VerifyClientCertificate(der []byte) (*AccessTokenClaims, error) {
+	if len(der) == 0 {
+		return nil, errors.Errorf("Empty certificate")
+	}
+
+	if len(der) > maxClientCertificateSize {
+		return nil, errors.Errorf("Certificate is too large")
+	}
+
+	crt, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+
+	if crt.SignatureAlgorithm != x509.PureEd25519 {
+		return nil, errors.Errorf("Invalid certificate signature algorithm")
+	}
+
+	keyUID, err := c.getUID(crt.AuthorityKeyId)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := c.findKeyByUID(keyUID)
+	if err != nil {
+		return nil, err
+	}
+
+	issuer := &x509.Certificate{
+		PublicKey: key.key.Public(),
+	}
+	if err := issuer.CheckSignature(crt.SignatureAlgorithm, crt.RawTBSCertificate, crt.Signature); err != nil {
+		return nil, errors.Errorf("Invalid signature")
+	}
+
+	now := time.Now()
+	if now.Before(crt.NotBefore) || now.After(crt.NotAfter) {
+		return nil, errors.Errorf("Certificate is expired or not yet valid")
+	}
+
+	if !slices.Contains(crt.ExtKeyUsage, x509.ExtKeyUsageClientAuth) {
+		return nil, errors.Errorf("Not a client certificate")
+	}
+
+	if len(crt.URIs) != 1 {
+		return nil, errors.Errorf("Invalid certificate URIs")
+	}
+
+	return c.parseClientCertificateURI(crt.URIs[0])
+}
+
+func (c *Controller) getClientCertificateURI(sessUID, tokenID string) *url.URL {
+	return &url.URL{
+		Scheme: "octelium",
+		Host:   c.domain,
+		Path:   fmt.Sprintf("/sessions/%s/tokens/%s", sessUID, tokenID),
+	}
+}
+
+func (c *Controller) parseClientCertificateURI(u *url.URL) (*AccessTokenClaims, error) {
+	if u.Scheme != "octelium" || u.Host != c.domain {
+		return nil, errors.Errorf("Invalid certificate URI")
+	}
+
+	parts := strings.Split(strings.TrimPrefix(u.Path, "/"), "/")
+	if len(parts) != 4 || parts[0] != "sessions" || parts[2] != "tokens" {
+		return nil, errors.Errorf("Invalid certificate URI path")
+	}
+
+	if !govalidator.IsUUIDv4(parts[1]) || !govalidator.IsUUIDv4(parts[3]) {
+		return nil, errors.Errorf("Invalid certificate URI uid")
+	}
+
+	return &AccessTokenClaims{
+		SessionUID: parts[1],
+		TokenID:    parts[3],
+	}, nil
+}
@@ -0,0 +1,132 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package jwkctl
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/pkg/common/pbutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientCertificate(t *testing.T) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	assert.Nil(t, err)
+
+	keyUID := uuid.New().String()
+	c := &Controller{
+		domain: "example.com",
+		ctl: &ctl{
+			keyMap: map[string]*jwtKey{
+				keyUID: {
+					key:       priv,
+					uid:       keyUID,
+					createdAt: time.Now(),
+				},
+			},
+		},
+	}
+
+	sess := &corev1.Session{
+		Metadata: &metav1.Metadata{
+			Name: "usr-abcdef",
+			Uid:  uuid.New().String(),
+		},
+		Status: &corev1.Session_Status{
+			Authentication: &corev1.Session_Status_Authentication{
+				TokenID: uuid.New().String(),
+				SetAt:   pbutils.Now(),
+				AccessTokenDuration: &metav1.Duration{
+					Type: &metav1.Duration_Hours{Hours: 1},
+				},
+			},
+		},
+	}
+
+	clientKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+
+	der, err := c.CreateClientCertificate(sess, clientKey.Public())
+	assert.Nil(t, err)
+
+	claims, err := c.VerifyClientCertificate(der)
+	assert.Nil(t, err)
+	assert.Equal(t, sess.Metadata.Uid, claims.SessionUID)
+	assert.Equal(t, sess.Status.Authentication.TokenID, claims.TokenID)
+
+	crt, err := x509.ParseCertificate(der)
+	assert.Nil(t, err)
+	assert.Equal(t, "usr-abcdef", crt.Subject.CommonName)
+	assert.Equal(t, "example.com", crt.Issuer.CommonName)
+
+	{
+		// Certificates signed by unknown keys are rejected
+		otherC := &Controller{
+			domain: "example.com",
+			ctl: &ctl{
+				keyMap: map[string]*jwtKey{},
+			},
+		}
+		_, err := otherC.VerifyClientCertificate(der)
+		assert.NotNil(t, err)
+	}
+
+	{
+		// Tampered certificates are rejected
+		tampered := append([]byte{}, der...)
+		tampered[len(tampered)-1] ^= 0xff
+		_, err := c.VerifyClientCertificate(tampered)
+		assert.NotNil(t, err)
+	}
+
+	{
+		// Certificates of other Clusters are rejected
+		otherC := &Controller{
+			domain: "example.org",
+			ctl:    c.ctl,
+		}
+		_, err := otherC.VerifyClientCertificate(der)
+		assert.NotNil(t, err)
+	}
+
+	{
+		_, err := c.VerifyClientCertificate(nil)
+		assert.NotNil(t, err)
+	}
+
+	{
+		sess.Status.Authentication.SetAt = pbutils.Timestamp(time.Now().Add(-2 * time.Hour))
+		_, err := c.CreateClientCertificate(sess, clientKey.Public())
+		assert.NotNil(t, err)
+	}
+
+	{
+		_, err := c.CreateClientCertificate(&corev1.Session{
+			Status: &corev1.Session_Status{},
+		}, clientKey.Public())
+		assert.NotNil(t, err)
+	}
+}
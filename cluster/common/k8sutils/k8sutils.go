@@ -24,7 +24,9 @@ import (
 
 	"github.com/octelium/octelium/apis/main/corev1"
 	"github.com/octelium/octelium/cluster/common/vutils"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
 	k8scorev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func normalizeSvcName(svc *corev1.Service) string {
@@ -64,3 +66,33 @@ func GetGatewayName(node *k8scorev1.Node) string {
 func GetImagePullPolicy() k8scorev1.PullPolicy {
 	return k8scorev1.PullIfNotPresent
 }
+
+// GetIngressDataPlaneServicePorts returns the ports of the ingress data plane
+// Service. The plain HTTP port is only exposed for ACME HTTP-01 challenges.
+func GetIngressDataPlaneServicePorts(c *corev1.ClusterConfig) []k8scorev1.ServicePort {
+	ret := []k8scorev1.ServicePort{
+		{
+			Name:     "https",
+			Protocol: k8scorev1.ProtocolTCP,
+			Port:     443,
+			TargetPort: intstr.IntOrString{
+				Type:   intstr.Int,
+				IntVal: 8080,
+			},
+		},
+	}
+
+	if ucorev1.ToClusterConfig(c).IsACMEHTTP01Enabled() {
+		ret = append(ret, k8scorev1.ServicePort{
+			Name:     "http",
+			Protocol: k8scorev1.ProtocolTCP,
+			Port:     80,
+			TargetPort: intstr.IntOrString{
+				Type:   intstr.Int,
+				IntVal: 8081,
+			},
+		})
+	}
+
+	return ret
+}
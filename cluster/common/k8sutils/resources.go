@@ -21,6 +21,7 @@ import (
 
 	"github.com/octelium/octelium/cluster/common/vutils"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -56,7 +57,14 @@ func CreateOrUpdateDeployment(ctx context.Context, c kubernetes.Interface, itm *
 
 	oldItem.Annotations["octelium.com/last-upgrade"] = vutils.NowRFC3339Nano()
 	oldItem.ObjectMeta.OwnerReferences = itm.OwnerReferences
+
+	// A nil Replicas means that the replicas are managed elsewhere
+	// (e.g. by a HorizontalPodAutoscaler) and must be kept as they are.
+	replicas := oldItem.Spec.Replicas
 	oldItem.Spec = itm.Spec
+	if oldItem.Spec.Replicas == nil {
+		oldItem.Spec.Replicas = replicas
+	}
 
 	return c.AppsV1().Deployments(itm.Namespace).Update(ctx, oldItem, k8smetav1.UpdateOptions{})
 }
@@ -334,3 +342,38 @@ func CreateOrUpdateIngress(ctx context.Context, c kubernetes.Interface, itm *net
 
 	return c.NetworkingV1().Ingresses(itm.Namespace).Update(ctx, oldItem, k8smetav1.UpdateOptions{})
 }
+
+func CreateOrUpdateHorizontalPodAutoscaler(ctx context.Context,
+	c kubernetes.Interface, itm *autoscalingv2.HorizontalPodAutoscaler) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	ret, err := c.AutoscalingV2().HorizontalPodAutoscalers(itm.Namespace).Create(ctx, itm, k8smetav1.CreateOptions{})
+	if err == nil {
+		return ret, nil
+	}
+
+	if !k8serr.IsAlreadyExists(err) {
+		return nil, err
+	}
+
+	oldItem, err := c.AutoscalingV2().HorizontalPodAutoscalers(itm.Namespace).Get(ctx, itm.Name, k8smetav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if oldItem.Labels == nil {
+		oldItem.Labels = make(map[string]string)
+	}
+	for k, v := range itm.Labels {
+		oldItem.Labels[k] = v
+	}
+	if oldItem.Annotations == nil {
+		oldItem.Annotations = make(map[string]string)
+	}
+	for k, v := range itm.Annotations {
+		oldItem.Annotations[k] = v
+	}
+
+	oldItem.ObjectMeta.OwnerReferences = itm.OwnerReferences
+	oldItem.Spec = itm.Spec
+
+	return c.AutoscalingV2().HorizontalPodAutoscalers(itm.Namespace).Update(ctx, oldItem, k8smetav1.UpdateOptions{})
+}
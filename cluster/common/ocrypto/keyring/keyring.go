@@ -0,0 +1,289 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package keyring implements the key-encryption-key (KEK) keyring used by
+// the rscserver to envelope-encrypt Secret data at rest. The keyring itself
+// lives outside of the primary storage, as a k8s Secret that is mounted into
+// the rscserver, so that a dump of the database alone cannot reveal the
+// Secrets' data.
+package keyring
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/octelium/octelium/pkg/utils/utilrand"
+	"github.com/pkg/errors"
+)
+
+const (
+	// K8sSecretName is the name of the k8s Secret that holds the KEKs.
+	K8sSecretName = "octelium-rscserver-kek"
+	// DefaultDir is where K8sSecretName is mounted in the rscserver.
+	DefaultDir = "/etc/octelium/rscserver/kek"
+	// CurrentKey is the k8s Secret data key holding the ID of the KEK that
+	// is used to wrap newly generated DEKs.
+	CurrentKey = "current"
+
+	keySize = 32
+)
+
+var rgxKeyID = regexp.MustCompile(`^kek-[a-z0-9-]{1,60}$`)
+
+type Keyring struct {
+	currentID string
+	keys      map[string][]byte
+}
+
+// New creates a Keyring from the data of the KEK k8s Secret.
+func New(data map[string][]byte) (*Keyring, error) {
+	ret := &Keyring{
+		currentID: strings.TrimSpace(string(data[CurrentKey])),
+		keys:      make(map[string][]byte),
+	}
+
+	for k, v := range data {
+		if k == CurrentKey {
+			continue
+		}
+		if !rgxKeyID.MatchString(k) {
+			return nil, errors.Errorf("Invalid KEK ID: %s", k)
+		}
+		if len(v) != keySize {
+			return nil, errors.Errorf("Invalid KEK %s size: %d", k, len(v))
+		}
+		ret.keys[k] = v
+	}
+
+	if ret.currentID == "" {
+		return nil, errors.Errorf("No current KEK is set")
+	}
+
+	if _, ok := ret.keys[ret.currentID]; !ok {
+		return nil, errors.Errorf("Current KEK %s does not exist", ret.currentID)
+	}
+
+	return ret, nil
+}
+
+// LoadFromDir creates a Keyring from a mounted KEK k8s Secret directory.
+func LoadFromDir(dir string) (*Keyring, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string][]byte)
+	for _, entry := range entries {
+		// k8s Secret volumes contain hidden "..data" and timestamped directories
+		if strings.HasPrefix(entry.Name(), ".") || entry.IsDir() {
+			continue
+		}
+
+		val, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		data[entry.Name()] = val
+	}
+
+	return New(data)
+}
+
+// AddKey generates a new KEK, adds it to the KEK k8s Secret data and sets it
+// as the current KEK. Previous KEKs are retained since they are still needed
+// to unwrap the DEKs of the records that have not been re-wrapped yet.
+func AddKey(data map[string][]byte) (string, error) {
+	if data == nil {
+		return "", errors.Errorf("Nil data")
+	}
+
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+
+	keyID := fmt.Sprintf("kek-%d-%s", time.Now().Unix(), utilrand.GetRandomStringLowercase(6))
+
+	data[keyID] = key
+	data[CurrentKey] = []byte(keyID)
+
+	return keyID, nil
+}
+
+func (k *Keyring) CurrentID() string {
+	return k.currentID
+}
+
+func (k *Keyring) KeyIDs() []string {
+	ret := make([]string, 0, len(k.keys))
+	for id := range k.keys {
+		ret = append(ret, id)
+	}
+	return ret
+}
+
+func (k *Keyring) getKey(id string) ([]byte, error) {
+	ret, ok := k.keys[id]
+	if !ok {
+		return nil, errors.Errorf("KEK %s does not exist", id)
+	}
+	return ret, nil
+}
+
+// Envelope is the at-rest representation of an encrypted record. The record is
+// encrypted by a random per-record data encryption key (DEK) which is in turn
+// wrapped by a KEK identified by KEKID.
+type Envelope struct {
+	KEKID      string `json:"kekID"`
+	WrappedDEK []byte `json:"wrappedDEK"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// Seal encrypts plaintext by a new DEK wrapped by the current KEK. The aad binds
+// the Envelope to its record so that it cannot be swapped with the Envelope of
+// another record.
+func (k *Keyring) Seal(plaintext, aad []byte) (*Envelope, error) {
+	dek := make([]byte, keySize)
+	if _, err := rand.Read(dek); err != nil {
+		return nil, err
+	}
+
+	ciphertext, err := encrypt(dek, plaintext, aad)
+	if err != nil {
+		return nil, err
+	}
+
+	wrappedDEK, err := k.wrap(k.currentID, dek, aad)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Envelope{
+		KEKID:      k.currentID,
+		WrappedDEK: wrappedDEK,
+		Ciphertext: ciphertext,
+	}, nil
+}
+
+func (k *Keyring) Open(env *Envelope, aad []byte) ([]byte, error) {
+	if env == nil {
+		return nil, errors.Errorf("Nil envelope")
+	}
+
+	dek, err := k.unwrap(env, aad)
+	if err != nil {
+		return nil, err
+	}
+
+	return decrypt(dek, env.Ciphertext, aad)
+}
+
+// Rewrap re-wraps the DEK of the Envelope by the current KEK without touching
+// the ciphertext. It returns false if the Envelope is already wrapped by the
+// current KEK.
+func (k *Keyring) Rewrap(env *Envelope, aad []byte) (*Envelope, bool, error) {
+	if env == nil {
+		return nil, false, errors.Errorf("Nil envelope")
+	}
+
+	if env.KEKID == k.currentID {
+		return env, false, nil
+	}
+
+	dek, err := k.unwrap(env, aad)
+	if err != nil {
+		return nil, false, err
+	}
+
+	wrappedDEK, err := k.wrap(k.currentID, dek, aad)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &Envelope{
+		KEKID:      k.currentID,
+		WrappedDEK: wrappedDEK,
+		Ciphertext: env.Ciphertext,
+	}, true, nil
+}
+
+func (k *Keyring) wrap(kekID string, dek, aad []byte) ([]byte, error) {
+	kek, err := k.getKey(kekID)
+	if err != nil {
+		return nil, err
+	}
+
+	return encrypt(kek, dek, getWrapAAD(kekID, aad))
+}
+
+func (k *Keyring) unwrap(env *Envelope, aad []byte) ([]byte, error) {
+	kek, err := k.getKey(env.KEKID)
+	if err != nil {
+		return nil, err
+	}
+
+	return decrypt(kek, env.WrappedDEK, getWrapAAD(env.KEKID, aad))
+}
+
+func getWrapAAD(kekID string, aad []byte) []byte {
+	return append([]byte(kekID+":"), aad...)
+}
+
+func encrypt(key, plaintext, aad []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+func decrypt(key, ciphertext, aad []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.Errorf("Ciphertext is too short")
+	}
+
+	nonce := ciphertext[:aead.NonceSize()]
+
+	return aead.Open(nil, nonce, ciphertext[aead.NonceSize():], aad)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keyring
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyring(t *testing.T) {
+
+	{
+		_, err := New(map[string][]byte{})
+		assert.NotNil(t, err)
+	}
+
+	{
+		_, err := New(map[string][]byte{
+			CurrentKey: []byte("kek-1"),
+			"kek-1":    []byte("short"),
+		})
+		assert.NotNil(t, err)
+	}
+
+	data := map[string][]byte{}
+	id1, err := AddKey(data)
+	assert.Nil(t, err)
+
+	kr1, err := New(data)
+	assert.Nil(t, err)
+	assert.Equal(t, id1, kr1.CurrentID())
+
+	aad := []byte("uid-1")
+	plaintext := []byte(`{"value":"secret"}`)
+
+	env, err := kr1.Seal(plaintext, aad)
+	assert.Nil(t, err)
+	assert.Equal(t, id1, env.KEKID)
+	assert.NotContains(t, string(env.Ciphertext), "secret")
+
+	out, err := kr1.Open(env, aad)
+	assert.Nil(t, err)
+	assert.Equal(t, plaintext, out)
+
+	_, err = kr1.Open(env, []byte("uid-2"))
+	assert.NotNil(t, err, "envelope must be bound to its record")
+
+	{
+		_, changed, err := kr1.Rewrap(env, aad)
+		assert.Nil(t, err)
+		assert.False(t, changed)
+	}
+
+	id2, err := AddKey(data)
+	assert.Nil(t, err)
+	assert.NotEqual(t, id1, id2)
+
+	kr2, err := New(data)
+	assert.Nil(t, err)
+	assert.Equal(t, id2, kr2.CurrentID())
+	assert.Len(t, kr2.KeyIDs(), 2)
+
+	rewrapped, changed, err := kr2.Rewrap(env, aad)
+	assert.Nil(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, id2, rewrapped.KEKID)
+	assert.Equal(t, env.Ciphertext, rewrapped.Ciphertext)
+
+	out, err = kr2.Open(rewrapped, aad)
+	assert.Nil(t, err)
+	assert.Equal(t, plaintext, out)
+
+	_, err = kr1.Open(rewrapped, aad)
+	assert.NotNil(t, err)
+
+	delete(data, id1)
+	kr3, err := New(data)
+	assert.Nil(t, err)
+	_, err = kr3.Open(env, aad)
+	assert.NotNil(t, err)
+}
+
+func TestLoadFromDir(t *testing.T) {
+	dir := t.TempDir()
+
+	data := map[string][]byte{}
+	id, err := AddKey(data)
+	assert.Nil(t, err)
+
+	for k, v := range data {
+		assert.Nil(t, os.WriteFile(filepath.Join(dir, k), v, 0600))
+	}
+	assert.Nil(t, os.Mkdir(filepath.Join(dir, "..2026_01_01"), 0700))
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "..data"), []byte("x"), 0600))
+
+	kr, err := LoadFromDir(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, id, kr.CurrentID())
+
+	_, err = LoadFromDir(filepath.Join(dir, "none"))
+	assert.True(t, os.IsNotExist(err))
+}
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
+	"github.com/octelium/octelium/cluster/common/otelutils"
 	"github.com/octelium/octelium/pkg/grpcerr"
 	"github.com/octelium/octelium/pkg/utils/ldflags"
 	"go.uber.org/zap"
@@ -125,6 +126,7 @@ func GetUnaryInterceptors() []grpc.UnaryClientInterceptor {
 	}
 
 	return []grpc.UnaryClientInterceptor{
+		otelutils.UnaryClientInterceptor(),
 		grpc_retry.UnaryClientInterceptor(
 			grpc_retry.WithMax(unaryTries),
 			grpc_retry.WithBackoff(grpc_retry.BackoffLinear(1000*time.Millisecond)),
@@ -135,6 +137,7 @@ func GetUnaryInterceptors() []grpc.UnaryClientInterceptor {
 
 func GetStreamInterceptors() []grpc.StreamClientInterceptor {
 	return []grpc.StreamClientInterceptor{
+		otelutils.StreamClientInterceptor(),
 		grpc_retry.StreamClientInterceptor(
 			grpc_retry.WithMax(math.MaxUint32),
 			grpc_retry.WithBackoff(grpc_retry.BackoffLinear(1000*time.Millisecond)),
@@ -23,6 +23,7 @@ import (
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/rsc/rauditv1"
 	"github.com/octelium/octelium/apis/rsc/rcachev1"
 	"github.com/octelium/octelium/apis/rsc/rcorev1"
 	"github.com/octelium/octelium/apis/rsc/rmetav1"
@@ -38,6 +39,7 @@ type Client struct {
 	coreC           rcorev1.ResourceServiceClient
 	cacheC          rcachev1.MainServiceClient
 	rateLimitC      rratelimitv1.MainServiceClient
+	auditC          rauditv1.MainServiceClient
 	clusterV1UtilsC *clusterV1UtilsC
 }
 
@@ -45,7 +47,18 @@ type CoreV1Utils interface {
 	GetClusterConfig(ctx context.Context) (*corev1.ClusterConfig, error)
 }
 
+// DefaultAddr returns the rscserver address. It can be overridden by the
+// OCTELIUM_RSCSERVER_ADDR env var, including in tests, when the components
+// do not run inside k8s.
 func DefaultAddr() string {
+	if addr := os.Getenv("OCTELIUM_RSCSERVER_ADDR"); addr != "" {
+		return addr
+	}
+
+	if ldflags.IsTest() {
+		return fmt.Sprintf("localhost:%s", os.Getenv("OCTELIUM_TEST_RSCSERVER_PORT"))
+	}
+
 	return fmt.Sprintf("%s.octelium.svc:8080", components.OcteliumComponent(components.RscServer))
 }
 
@@ -59,13 +72,7 @@ func DefaultDialOpts(ctx context.Context) ([]grpc.DialOption, error) {
 
 func NewClient(ctx context.Context) (*Client, error) {
 
-	var host string
-
-	if ldflags.IsTest() {
-		host = fmt.Sprintf("localhost:%s", os.Getenv("OCTELIUM_TEST_RSCSERVER_PORT"))
-	} else {
-		host = DefaultAddr()
-	}
+	host := DefaultAddr()
 
 	opts, err := DefaultDialOpts(ctx)
 	if err != nil {
@@ -81,6 +88,7 @@ func NewClient(ctx context.Context) (*Client, error) {
 		coreC:           rcorev1.NewResourceServiceClient(grpcConn),
 		cacheC:          rcachev1.NewMainServiceClient(grpcConn),
 		rateLimitC:      rratelimitv1.NewMainServiceClient(grpcConn),
+		auditC:          rauditv1.NewMainServiceClient(grpcConn),
 		clusterV1UtilsC: &clusterV1UtilsC{},
 	}
 
@@ -101,6 +109,10 @@ func (c *Client) RateLimitC() rratelimitv1.MainServiceClient {
 	return c.rateLimitC
 }
 
+func (c *Client) AuditC() rauditv1.MainServiceClient {
+	return c.auditC
+}
+
 func (c *Client) CoreV1Utils() CoreV1Utils {
 	return c.clusterV1UtilsC
 }
@@ -110,6 +122,7 @@ type ClientInterface interface {
 	CoreV1Utils() CoreV1Utils
 	CacheC() rcachev1.MainServiceClient
 	RateLimitC() rratelimitv1.MainServiceClient
+	AuditC() rauditv1.MainServiceClient
 }
 
 type clusterV1UtilsC struct {
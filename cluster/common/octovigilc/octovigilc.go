@@ -20,11 +20,14 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"os"
+	"strconv"
 	"time"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	"github.com/octelium/octelium/apis/cluster/coctovigilv1"
+	"github.com/octelium/octelium/cluster/common/otelutils"
 	"github.com/octelium/octelium/pkg/utils/ldflags"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -39,10 +42,7 @@ func NewClient(ctx context.Context) (*Client, error) {
 
 	unaryTries := uint(32)
 
-	host := fmt.Sprintf("octelium-octovigil.octelium.svc:%d", GetPort())
-	if ldflags.IsTest() {
-		host = fmt.Sprintf("localhost:%d", GetPort())
-	}
+	host := DefaultAddr()
 
 	retryCodes := []codes.Code{
 		codes.Unavailable,
@@ -55,6 +55,7 @@ func NewClient(ctx context.Context) (*Client, error) {
 	}
 
 	unaryMiddlewares := []grpc.UnaryClientInterceptor{
+		otelutils.UnaryClientInterceptor(),
 		grpc_retry.UnaryClientInterceptor(
 			grpc_retry.WithMax(unaryTries),
 			grpc_retry.WithBackoff(grpc_retry.BackoffLinear(1000*time.Millisecond)),
@@ -97,9 +98,27 @@ type ClientInterface interface {
 	InternalC() coctovigilv1.InternalServiceClient
 }
 
+// DefaultAddr returns the octovigil address. Like the rscserver address, it
+// can be overridden by the OCTELIUM_OCTOVIGIL_ADDR env var, including in
+// tests, when the components do not run inside k8s.
+func DefaultAddr() string {
+	if addr := os.Getenv("OCTELIUM_OCTOVIGIL_ADDR"); addr != "" {
+		return addr
+	}
+
+	if ldflags.IsTest() {
+		return fmt.Sprintf("localhost:%d", GetPort())
+	}
+
+	return fmt.Sprintf("octelium-octovigil.octelium.svc:%d", GetPort())
+}
+
 func GetPort() int {
 	if ldflags.IsTest() {
 		return 48234
 	}
+	if port, _ := strconv.Atoi(os.Getenv("OCTELIUM_OCTOVIGIL_PORT")); port > 0 {
+		return port
+	}
 	return 8080
 }
@@ -0,0 +1,45 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package octovigilc
+
+import (
+	"testing"
+
+	"github.com/octelium/octelium/pkg/utils/ldflags"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultAddr(t *testing.T) {
+	testMode := ldflags.TestMode
+	t.Cleanup(func() {
+		ldflags.TestMode = testMode
+	})
+
+	t.Setenv("OCTELIUM_OCTOVIGIL_ADDR", "")
+
+	ldflags.TestMode = "false"
+	assert.Equal(t, "octelium-octovigil.octelium.svc:8080", DefaultAddr())
+
+	ldflags.TestMode = "true"
+	assert.Equal(t, "localhost:48234", DefaultAddr())
+
+	t.Setenv("OCTELIUM_OCTOVIGIL_ADDR", "localhost:9000")
+	assert.Equal(t, "localhost:9000", DefaultAddr())
+
+	ldflags.TestMode = "false"
+	assert.Equal(t, "localhost:9000", DefaultAddr())
+}
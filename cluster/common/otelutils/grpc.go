@@ -0,0 +1,139 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package otelutils
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type metadataCarrier struct {
+	md metadata.MD
+}
+
+func (c *metadataCarrier) Get(key string) string {
+	vals := c.md.Get(key)
+	if len(vals) == 0 {
+		return ""
+	}
+	return vals[0]
+}
+
+func (c *metadataCarrier) Set(key, value string) {
+	c.md.Set(key, value)
+}
+
+func (c *metadataCarrier) Keys() []string {
+	ret := make([]string, 0, len(c.md))
+	for k := range c.md {
+		ret = append(ret, k)
+	}
+	return ret
+}
+
+func injectOutgoing(ctx context.Context) context.Context {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+
+	otel.GetTextMapPropagator().Inject(ctx, &metadataCarrier{md: md})
+
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+func extractIncoming(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	return otel.GetTextMapPropagator().Extract(ctx, &metadataCarrier{md: md})
+}
+
+func setSpanGRPCStatus(span trace.Span, err error) {
+	st, _ := status.FromError(err)
+	span.SetAttributes(attribute.String("rpc.grpc.status_code", st.Code().String()))
+	if err != nil {
+		span.SetStatus(codes.Error, st.Message())
+	}
+}
+
+// UnaryClientInterceptor starts a client span for each unary call made as a
+// part of an existing trace and propagates its context to the server via the
+// gRPC metadata. Calls made outside of a trace (e.g. by controllers) are left
+// untouched so that they do not flood the collector with root spans.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any,
+		cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !trace.SpanContextFromContext(ctx).IsValid() {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		ctx, span := GetTracer().Start(ctx, method,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(attribute.String("rpc.system", "grpc")))
+		defer span.End()
+
+		err := invoker(injectOutgoing(ctx), method, req, reply, cc, opts...)
+		setSpanGRPCStatus(span, err)
+		return err
+	}
+}
+
+// StreamClientInterceptor only propagates the trace context for streams
+// without creating a span since streams are typically long-lived.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc,
+		cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if !trace.SpanContextFromContext(ctx).IsValid() {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+		return streamer(injectOutgoing(ctx), desc, cc, method, opts...)
+	}
+}
+
+// UnaryServerInterceptor extracts the trace context sent by the client and
+// starts a server span for each unary call. Calls that do not carry a trace
+// context are served without a span.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any,
+		info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx = extractIncoming(ctx)
+		if !trace.SpanContextFromContext(ctx).IsValid() {
+			return handler(ctx, req)
+		}
+
+		ctx, span := GetTracer().Start(ctx, info.FullMethod,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(attribute.String("rpc.system", "grpc")))
+		defer span.End()
+
+		resp, err := handler(ctx, req)
+		setSpanGRPCStatus(span, err)
+		return resp, err
+	}
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package otelutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func setTestTracerProvider(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	prevTP := otel.GetTracerProvider()
+	prevProp := otel.GetTextMapPropagator()
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(GetPropagator())
+
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevTP)
+		otel.SetTextMapPropagator(prevProp)
+	})
+
+	return recorder
+}
+
+func TestGRPCInterceptors(t *testing.T) {
+	recorder := setTestTracerProvider(t)
+
+	clientInt := UnaryClientInterceptor()
+	serverInt := UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+
+	callServer := func(ctx context.Context, method string, req, reply any,
+		cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		ctx = metadata.NewIncomingContext(context.Background(), md)
+
+		_, err := serverInt(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+			if !trace.SpanContextFromContext(ctx).IsValid() {
+				return nil, nil
+			}
+			return nil, status.Error(codes.NotFound, "not found")
+		})
+		return err
+	}
+
+	{
+		err := clientInt(context.Background(), info.FullMethod, nil, nil, nil, callServer)
+		assert.Nil(t, err)
+		assert.Len(t, recorder.Ended(), 0)
+	}
+
+	{
+		ctx, parent := GetTracer().Start(context.Background(), "parent")
+		err := clientInt(ctx, info.FullMethod, nil, nil, nil, callServer)
+		parent.End()
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		spans := recorder.Ended()
+		assert.Len(t, spans, 3)
+
+		serverSpan, clientSpan := spans[0], spans[1]
+		assert.Equal(t, trace.SpanKindServer, serverSpan.SpanKind())
+		assert.Equal(t, trace.SpanKindClient, clientSpan.SpanKind())
+		assert.Equal(t, parent.SpanContext().TraceID(), serverSpan.SpanContext().TraceID())
+		assert.Equal(t, clientSpan.SpanContext().SpanID(), serverSpan.Parent().SpanID())
+		assert.True(t, serverSpan.Parent().IsRemote())
+		assert.Equal(t, parent.SpanContext().SpanID(), clientSpan.Parent().SpanID())
+	}
+}
+
+func TestGetTraceIDs(t *testing.T) {
+	setTestTracerProvider(t)
+
+	traceID, spanID := GetTraceIDs(context.Background())
+	assert.Equal(t, "", traceID)
+	assert.Equal(t, "", spanID)
+
+	ctx, span := GetTracer().Start(context.Background(), "test")
+	defer span.End()
+
+	traceID, spanID = GetTraceIDs(ctx)
+	assert.Equal(t, span.SpanContext().TraceID().String(), traceID)
+	assert.Equal(t, span.SpanContext().SpanID().String(), spanID)
+}
+
+func TestGetSampleRatio(t *testing.T) {
+	t.Setenv(envTracesSampleRatio, "")
+	assert.Equal(t, float64(1), getSampleRatio())
+
+	t.Setenv(envTracesSampleRatio, "0.25")
+	assert.Equal(t, 0.25, getSampleRatio())
+
+	t.Setenv(envTracesSampleRatio, "2")
+	assert.Equal(t, float64(1), getSampleRatio())
+
+	t.Setenv(envTracesSampleRatio, "invalid")
+	assert.Equal(t, float64(1), getSampleRatio())
+}
@@ -96,3 +96,16 @@ func EmitAccessLog(in *corev1.AccessLog) {
 
 	GetLogger().Emit(context.Background(), ret)
 }
+
+func EmitAuditLog(in *corev1.AuditLog) {
+	inMap := pbutils.MustConvertToMap(in)
+
+	ret := log.Record{}
+	ret.SetTimestamp((in.GetMetadata().CreatedAt.AsTime()))
+	ret.SetObservedTimestamp(pbutils.Now().AsTime())
+	ret.SetSeverity(log.SeverityInfo)
+
+	ret.SetBody(convertValue(inMap))
+
+	GetLogger().Emit(context.Background(), ret)
+}
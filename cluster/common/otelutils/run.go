@@ -48,8 +48,14 @@ func RunOTEL(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	tracerProvider, err := CreateTracerProvider(ctx, "")
+	if err != nil {
+		return err
+	}
 	global.SetLoggerProvider(logProvider)
 	otel.SetMeterProvider(metricProvider)
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(GetPropagator())
 
 	zapLogger := otelcore.NewOTELCore(GetLogger())
 
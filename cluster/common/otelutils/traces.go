@@ -0,0 +1,97 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package otelutils
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const envTracesSampleRatio = "OCTELIUM_OTEL_TRACES_SAMPLE_RATIO"
+
+func CreateTracerProvider(ctx context.Context, addr string) (*sdktrace.TracerProvider, error) {
+	if addr == "" {
+		addr = defaultAddr
+	}
+
+	opts := []otlptracegrpc.Option{
+		otlptracegrpc.WithEndpoint(addr),
+		otlptracegrpc.WithInsecure(),
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := getResource(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithResource(resource),
+		sdktrace.WithSampler(getSampler()),
+		sdktrace.WithBatcher(exporter),
+	)
+
+	return tracerProvider, nil
+}
+
+// getSampler respects the sampling decision of the remote parent, if any,
+// and otherwise samples root spans according to the configured ratio.
+func getSampler() sdktrace.Sampler {
+	return sdktrace.ParentBased(sdktrace.TraceIDRatioBased(getSampleRatio()))
+}
+
+func getSampleRatio() float64 {
+	ratio, err := strconv.ParseFloat(os.Getenv(envTracesSampleRatio), 64)
+	if err != nil || ratio < 0 || ratio > 1 {
+		return 1
+	}
+
+	return ratio
+}
+
+func GetPropagator() propagation.TextMapPropagator {
+	return propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	)
+}
+
+func GetTracer() trace.Tracer {
+	return otel.GetTracerProvider().Tracer("default")
+}
+
+// GetTraceIDs returns the hex-encoded trace and span IDs of the span stored
+// in ctx, or empty strings if there is no valid span context.
+func GetTraceIDs(ctx context.Context) (string, string) {
+	spanCtx := trace.SpanContextFromContext(ctx)
+	if !spanCtx.IsValid() {
+		return "", ""
+	}
+
+	return spanCtx.TraceID().String(), spanCtx.SpanID().String()
+}
@@ -47,16 +47,51 @@ CREATE INDEX IF NOT EXISTS idx_{{$val}}_name ON {{$val}}((resource->'metadata'->
 CREATE INDEX IF NOT EXISTS idx_{{$val}}_uid ON {{$val}}((resource->'metadata'->>'uid'));
 CREATE INDEX IF NOT EXISTS idx_{{$val}}_created_at_ts ON {{$val}}(created_at);
 {{ end }}
+
+CREATE TABLE IF NOT EXISTS {{ .AuditTable }} (
+    id BIGSERIAL PRIMARY KEY,
+		uid TEXT UNIQUE NOT NULL,
+		created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+		api TEXT,
+		version TEXT,
+		kind TEXT,
+		resource_uid TEXT,
+		resource_name TEXT,
+		actor_uid TEXT,
+		actor_name TEXT,
+    audit_log JSONB
+);
+
+CREATE INDEX IF NOT EXISTS idx_{{ .AuditTable }}_resource_uid ON {{ .AuditTable }}(resource_uid);
+CREATE INDEX IF NOT EXISTS idx_{{ .AuditTable }}_resource_name ON {{ .AuditTable }}(kind, resource_name);
+CREATE INDEX IF NOT EXISTS idx_{{ .AuditTable }}_actor_uid ON {{ .AuditTable }}(actor_uid);
+CREATE INDEX IF NOT EXISTS idx_{{ .AuditTable }}_created_at_ts ON {{ .AuditTable }}(created_at);
+
+CREATE OR REPLACE FUNCTION {{ .AuditTable }}_immutable() RETURNS trigger AS $$
+BEGIN
+	RAISE EXCEPTION '{{ .AuditTable }} records are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS {{ .AuditTable }}_immutable ON {{ .AuditTable }};
+CREATE TRIGGER {{ .AuditTable }}_immutable BEFORE UPDATE ON {{ .AuditTable }}
+	FOR EACH ROW EXECUTE FUNCTION {{ .AuditTable }}_immutable();
 `
 
 type migrationTmplArgs struct {
-	Tables []string
+	Tables     []string
+	AuditTable string
 }
 
 var Tables = []string{
 	"octelium_resources",
 }
 
+// AuditTable is the append-only table of the resource AuditLogs. Its rows can
+// never be updated but are deleted by the rscserver once they exceed the
+// ClusterConfig's AuditLog retention.
+const AuditTable = "octelium_audit_logs"
+
 func Migrate(ctx context.Context, db *sql.DB) error {
 	t, err := template.New("migration-tmpl").Parse(migrationTmpl)
 	if err != nil {
@@ -66,7 +101,8 @@ func Migrate(ctx context.Context, db *sql.DB) error {
 	var tpl bytes.Buffer
 
 	args := migrationTmplArgs{
-		Tables: Tables,
+		Tables:     Tables,
+		AuditTable: AuditTable,
 	}
 
 	if err := t.Execute(&tpl, args); err != nil {
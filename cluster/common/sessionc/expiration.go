@@ -0,0 +1,244 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package sessionc
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/apis/rsc/rmetav1"
+	"github.com/octelium/octelium/cluster/common/octeliumc"
+	"github.com/octelium/octelium/pkg/apiutils/umetav1"
+	"github.com/octelium/octelium/pkg/common/pbutils"
+	"github.com/octelium/octelium/pkg/grpcerr"
+)
+
+// ExpirationOpts is the set of expiration options of a Session resolved from
+// its User and the ClusterConfig. Zero values disable the corresponding check.
+type ExpirationOpts struct {
+	IdleTimeout              time.Duration
+	ReauthenticationInterval time.Duration
+	MaxPerDevice             uint32
+}
+
+type expirationOpts struct {
+	clientIdleTimeout        *metav1.Duration
+	clientlessIdleTimeout    *metav1.Duration
+	reauthenticationInterval *metav1.Duration
+	maxPerDevice             uint32
+}
+
+func GetExpirationOpts(sess *corev1.Session, usr *corev1.User, cc *corev1.ClusterConfig) *ExpirationOpts {
+	var optsList []*expirationOpts
+
+	if usr.Spec.Session != nil {
+		optsList = append(optsList, &expirationOpts{
+			clientIdleTimeout:        usr.Spec.Session.ClientIdleTimeout,
+			clientlessIdleTimeout:    usr.Spec.Session.ClientlessIdleTimeout,
+			reauthenticationInterval: usr.Spec.Session.ReauthenticationInterval,
+			maxPerDevice:             usr.Spec.Session.MaxPerDevice,
+		})
+	}
+
+	if cc.Spec.Session != nil {
+		switch usr.Spec.Type {
+		case corev1.User_Spec_HUMAN:
+			if opts := cc.Spec.Session.Human; opts != nil {
+				optsList = append(optsList, &expirationOpts{
+					clientIdleTimeout:        opts.ClientIdleTimeout,
+					clientlessIdleTimeout:    opts.ClientlessIdleTimeout,
+					reauthenticationInterval: opts.ReauthenticationInterval,
+					maxPerDevice:             opts.MaxPerDevice,
+				})
+			}
+		case corev1.User_Spec_WORKLOAD:
+			if opts := cc.Spec.Session.Workload; opts != nil {
+				optsList = append(optsList, &expirationOpts{
+					clientIdleTimeout:        opts.ClientIdleTimeout,
+					clientlessIdleTimeout:    opts.ClientlessIdleTimeout,
+					reauthenticationInterval: opts.ReauthenticationInterval,
+					maxPerDevice:             opts.MaxPerDevice,
+				})
+			}
+		}
+	}
+
+	ret := &ExpirationOpts{}
+
+	for _, opts := range optsList {
+		if ret.IdleTimeout == 0 {
+			switch sess.Status.Type {
+			case corev1.Session_Status_CLIENT:
+				ret.IdleTimeout = umetav1.ToDuration(opts.clientIdleTimeout).ToGo()
+			case corev1.Session_Status_CLIENTLESS:
+				ret.IdleTimeout = umetav1.ToDuration(opts.clientlessIdleTimeout).ToGo()
+			}
+		}
+
+		if ret.ReauthenticationInterval == 0 {
+			ret.ReauthenticationInterval = umetav1.ToDuration(opts.reauthenticationInterval).ToGo()
+		}
+
+		if ret.MaxPerDevice == 0 {
+			ret.MaxPerDevice = opts.maxPerDevice
+		}
+	}
+
+	return ret
+}
+
+// GetExpiration returns the time at which the Session expires and the reason
+// of the expiration. The earliest of the Session expiresAt, its refresh token
+// expiration, its idle timeout and its reauthentication interval wins.
+func GetExpiration(sess *corev1.Session, opts *ExpirationOpts) (time.Time, corev1.Session_Status_Expiration_Reason) {
+	ret := sess.Spec.ExpiresAt.AsTime()
+	reason := corev1.Session_Status_Expiration_EXPIRED
+
+	setIfEarlier := func(t time.Time, r corev1.Session_Status_Expiration_Reason) {
+		if t.Before(ret) {
+			ret = t
+			reason = r
+		}
+	}
+
+	if auth := sess.Status.Authentication; auth != nil {
+		if d := umetav1.ToDuration(auth.RefreshTokenDuration).ToGo(); d > 0 {
+			setIfEarlier(auth.SetAt.AsTime().Add(d), corev1.Session_Status_Expiration_REFRESH_TOKEN_EXPIRED)
+		}
+	}
+
+	if opts != nil && opts.IdleTimeout > 0 {
+		setIfEarlier(GetLastActivityAt(sess).Add(opts.IdleTimeout),
+			corev1.Session_Status_Expiration_IDLE_TIMEOUT)
+	}
+
+	if opts != nil && opts.ReauthenticationInterval > 0 {
+		setIfEarlier(GetLastAuthenticatedAt(sess).Add(opts.ReauthenticationInterval),
+			corev1.Session_Status_Expiration_REAUTHENTICATION_REQUIRED)
+	}
+
+	return ret, reason
+}
+
+// GetLastActivityAt returns the last time the Session was seen active. For
+// CLIENT Sessions, the connection heartbeats are taken into account while for
+// CLIENTLESS Sessions, the authenticated requests recorded by Octovigil are.
+func GetLastActivityAt(sess *corev1.Session) time.Time {
+	ret := sess.Metadata.CreatedAt.AsTime()
+
+	setIfLater := func(t time.Time) {
+		if t.After(ret) {
+			ret = t
+		}
+	}
+
+	if sess.Status.Authentication != nil {
+		setIfLater(sess.Status.Authentication.SetAt.AsTime())
+	}
+
+	setIfLater(sess.Status.LastActivityAt.AsTime())
+
+	if sess.Status.Type == corev1.Session_Status_CLIENT {
+		if conn := sess.Status.Connection; conn != nil {
+			setIfLater(conn.StartedAt.AsTime())
+			setIfLater(conn.LastSeenAt.AsTime())
+		}
+
+		if len(sess.Status.LastConnections) > 0 {
+			setIfLater(sess.Status.LastConnections[0].EndedAt.AsTime())
+		}
+	}
+
+	return ret
+}
+
+// GetLastAuthenticatedAt returns the time of the last authentication of the
+// Session that is not a mere refresh of its tokens.
+func GetLastAuthenticatedAt(sess *corev1.Session) time.Time {
+	auths := append([]*corev1.Session_Status_Authentication{
+		sess.Status.Authentication,
+	}, sess.Status.LastAuthentications...)
+
+	for _, auth := range auths {
+		if auth == nil {
+			continue
+		}
+		if auth.Info != nil && auth.Info.Type == corev1.Session_Status_Authentication_Info_REFRESH_TOKEN {
+			continue
+		}
+		return auth.SetAt.AsTime()
+	}
+
+	if sess.Status.InitialAuthentication != nil {
+		return sess.Status.InitialAuthentication.SetAt.AsTime()
+	}
+
+	return sess.Metadata.CreatedAt.AsTime()
+}
+
+// GetDeviceSessionsToExpire returns the oldest Sessions of a Device that
+// exceed the maxPerDevice limit.
+func GetDeviceSessionsToExpire(sessList []*corev1.Session, maxPerDevice uint32) []*corev1.Session {
+	if maxPerDevice == 0 {
+		return nil
+	}
+
+	var activeList []*corev1.Session
+	for _, sess := range sessList {
+		if sess.Status.Expiration == nil {
+			activeList = append(activeList, sess)
+		}
+	}
+
+	if uint32(len(activeList)) <= maxPerDevice {
+		return nil
+	}
+
+	slices.SortStableFunc(activeList, func(a, b *corev1.Session) int {
+		return a.Metadata.CreatedAt.AsTime().Compare(b.Metadata.CreatedAt.AsTime())
+	})
+
+	return activeList[:uint32(len(activeList))-maxPerDevice]
+}
+
+// ExpireSession records the expiration of the Session and then deletes it.
+func ExpireSession(ctx context.Context, octeliumC octeliumc.ClientInterface,
+	sess *corev1.Session, reason corev1.Session_Status_Expiration_Reason) error {
+	if sess.Status.Expiration == nil {
+		sess.Status.Expiration = &corev1.Session_Status_Expiration{
+			ExpiredAt: pbutils.Now(),
+			Reason:    reason,
+		}
+
+		if _, err := octeliumC.CoreC().UpdateSession(ctx, sess); err != nil {
+			if grpcerr.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+	}
+
+	if _, err := octeliumC.CoreC().DeleteSession(ctx,
+		&rmetav1.DeleteOptions{Uid: sess.Metadata.Uid}); err != nil && !grpcerr.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
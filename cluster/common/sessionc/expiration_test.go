@@ -0,0 +1,203 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package sessionc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/pkg/common/pbutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetExpirationOpts(t *testing.T) {
+	cc := &corev1.ClusterConfig{
+		Spec: &corev1.ClusterConfig_Spec{
+			Session: &corev1.ClusterConfig_Spec_Session{
+				Human: &corev1.ClusterConfig_Spec_Session_Human{
+					ClientIdleTimeout: &metav1.Duration{
+						Type: &metav1.Duration_Hours{Hours: 1},
+					},
+					ClientlessIdleTimeout: &metav1.Duration{
+						Type: &metav1.Duration_Hours{Hours: 2},
+					},
+					ReauthenticationInterval: &metav1.Duration{
+						Type: &metav1.Duration_Hours{Hours: 12},
+					},
+					MaxPerDevice: 2,
+				},
+			},
+		},
+	}
+
+	usr := &corev1.User{
+		Spec: &corev1.User_Spec{
+			Type: corev1.User_Spec_HUMAN,
+			Session: &corev1.User_Spec_Session{
+				ReauthenticationInterval: &metav1.Duration{
+					Type: &metav1.Duration_Hours{Hours: 4},
+				},
+			},
+		},
+	}
+
+	{
+		opts := GetExpirationOpts(&corev1.Session{
+			Status: &corev1.Session_Status{Type: corev1.Session_Status_CLIENT},
+		}, usr, cc)
+		assert.Equal(t, time.Hour, opts.IdleTimeout)
+		assert.Equal(t, 4*time.Hour, opts.ReauthenticationInterval)
+		assert.Equal(t, uint32(2), opts.MaxPerDevice)
+	}
+
+	{
+		opts := GetExpirationOpts(&corev1.Session{
+			Status: &corev1.Session_Status{Type: corev1.Session_Status_CLIENTLESS},
+		}, usr, cc)
+		assert.Equal(t, 2*time.Hour, opts.IdleTimeout)
+	}
+
+	{
+		usr.Spec.Type = corev1.User_Spec_WORKLOAD
+		opts := GetExpirationOpts(&corev1.Session{
+			Status: &corev1.Session_Status{Type: corev1.Session_Status_CLIENT},
+		}, usr, cc)
+		assert.Equal(t, time.Duration(0), opts.IdleTimeout)
+		assert.Equal(t, 4*time.Hour, opts.ReauthenticationInterval)
+		assert.Equal(t, uint32(0), opts.MaxPerDevice)
+	}
+}
+
+func TestGetExpiration(t *testing.T) {
+	now := time.Now()
+
+	newSess := func() *corev1.Session {
+		return &corev1.Session{
+			Metadata: &metav1.Metadata{
+				CreatedAt: pbutils.Timestamp(now.Add(-10 * time.Hour)),
+			},
+			Spec: &corev1.Session_Spec{
+				ExpiresAt: pbutils.Timestamp(now.Add(10 * time.Hour)),
+			},
+			Status: &corev1.Session_Status{
+				Type: corev1.Session_Status_CLIENT,
+				Authentication: &corev1.Session_Status_Authentication{
+					SetAt: pbutils.Timestamp(now.Add(-1 * time.Hour)),
+					Info: &corev1.Session_Status_Authentication_Info{
+						Type: corev1.Session_Status_Authentication_Info_REFRESH_TOKEN,
+					},
+					RefreshTokenDuration: &metav1.Duration{
+						Type: &metav1.Duration_Hours{Hours: 18},
+					},
+				},
+				LastAuthentications: []*corev1.Session_Status_Authentication{
+					{
+						SetAt: pbutils.Timestamp(now.Add(-5 * time.Hour)),
+						Info: &corev1.Session_Status_Authentication_Info{
+							Type: corev1.Session_Status_Authentication_Info_REFRESH_TOKEN,
+						},
+					},
+					{
+						SetAt: pbutils.Timestamp(now.Add(-8 * time.Hour)),
+						Info: &corev1.Session_Status_Authentication_Info{
+							Type: corev1.Session_Status_Authentication_Info_IDENTITY_PROVIDER,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	{
+		expiresAt, reason := GetExpiration(newSess(), &ExpirationOpts{})
+		assert.Equal(t, corev1.Session_Status_Expiration_EXPIRED, reason)
+		assert.True(t, expiresAt.Sub(now.Add(10*time.Hour)).Abs() < time.Millisecond)
+	}
+
+	{
+		sess := newSess()
+		sess.Status.Authentication.RefreshTokenDuration = &metav1.Duration{
+			Type: &metav1.Duration_Minutes{Minutes: 30},
+		}
+		_, reason := GetExpiration(sess, &ExpirationOpts{})
+		assert.Equal(t, corev1.Session_Status_Expiration_REFRESH_TOKEN_EXPIRED, reason)
+	}
+
+	{
+		sess := newSess()
+		assert.True(t, GetLastAuthenticatedAt(sess).Sub(now.Add(-8*time.Hour)).Abs() < time.Millisecond)
+
+		expiresAt, reason := GetExpiration(sess, &ExpirationOpts{
+			ReauthenticationInterval: 6 * time.Hour,
+		})
+		assert.Equal(t, corev1.Session_Status_Expiration_REAUTHENTICATION_REQUIRED, reason)
+		assert.True(t, expiresAt.Before(now))
+	}
+
+	{
+		sess := newSess()
+		expiresAt, reason := GetExpiration(sess, &ExpirationOpts{
+			IdleTimeout: 2 * time.Hour,
+		})
+		assert.Equal(t, corev1.Session_Status_Expiration_IDLE_TIMEOUT, reason)
+		assert.True(t, expiresAt.After(now))
+
+		sess.Status.Connection = &corev1.Session_Status_Connection{
+			StartedAt:  pbutils.Timestamp(now.Add(-3 * time.Hour)),
+			LastSeenAt: pbutils.Timestamp(now.Add(-1 * time.Minute)),
+		}
+		assert.True(t, GetLastActivityAt(sess).Sub(now.Add(-1*time.Minute)).Abs() < time.Millisecond)
+
+		sess.Status.Type = corev1.Session_Status_CLIENTLESS
+		assert.True(t, GetLastActivityAt(sess).Sub(now.Add(-1*time.Hour)).Abs() < time.Millisecond)
+
+		sess.Status.LastActivityAt = pbutils.Timestamp(now.Add(-2 * time.Minute))
+		assert.True(t, GetLastActivityAt(sess).Sub(now.Add(-2*time.Minute)).Abs() < time.Millisecond)
+	}
+}
+
+func TestGetDeviceSessionsToExpire(t *testing.T) {
+	now := time.Now()
+
+	var sessList []*corev1.Session
+	for i := range 4 {
+		sessList = append(sessList, &corev1.Session{
+			Metadata: &metav1.Metadata{
+				Name:      string(rune('a' + i)),
+				CreatedAt: pbutils.Timestamp(now.Add(-time.Duration(i) * time.Hour)),
+			},
+			Status: &corev1.Session_Status{},
+		})
+	}
+
+	assert.Nil(t, GetDeviceSessionsToExpire(sessList, 0))
+	assert.Nil(t, GetDeviceSessionsToExpire(sessList, 4))
+
+	ret := GetDeviceSessionsToExpire(sessList, 2)
+	assert.Equal(t, 2, len(ret))
+	assert.Equal(t, "d", ret[0].Metadata.Name)
+	assert.Equal(t, "c", ret[1].Metadata.Name)
+
+	sessList[3].Status.Expiration = &corev1.Session_Status_Expiration{
+		Reason: corev1.Session_Status_Expiration_IDLE_TIMEOUT,
+	}
+	ret = GetDeviceSessionsToExpire(sessList, 2)
+	assert.Equal(t, 1, len(ret))
+	assert.Equal(t, "c", ret[0].Metadata.Name)
+}
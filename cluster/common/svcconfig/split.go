@@ -0,0 +1,137 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package svcconfig
+
+import (
+	"context"
+	"hash/fnv"
+	"strings"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/cluster/common/celengine"
+	"github.com/octelium/octelium/pkg/utils/utilrand"
+	"go.uber.org/zap"
+)
+
+// GetSplitConfigName returns the name of the Service configuration chosen by
+// the weighted split of the Service's dynamic configuration. It returns an
+// empty string, i.e. the default configuration, if no split is set.
+func GetSplitConfigName(ctx context.Context,
+	celEngine *celengine.CELEngine, reqCtx *corev1.RequestContext, inputMap map[string]any) string {
+	if reqCtx == nil || reqCtx.Service == nil ||
+		reqCtx.Service.Spec.DynamicConfig == nil || reqCtx.Service.Spec.DynamicConfig.Split == nil {
+		return ""
+	}
+
+	split := reqCtx.Service.Spec.DynamicConfig.Split
+
+	if cfgName, ok := getOverrideConfigName(split, reqCtx); ok {
+		return cfgName
+	}
+
+	key, hasKey := getStickinessKey(ctx, celEngine, split, reqCtx, inputMap)
+
+	return chooseTarget(split.Targets, reqCtx.Service.Metadata.Uid, key, hasKey)
+}
+
+func getOverrideConfigName(split *corev1.Service_Spec_DynamicConfig_Split,
+	reqCtx *corev1.RequestContext) (string, bool) {
+	if split.OverrideHeader == "" || reqCtx.Request == nil || reqCtx.Request.GetHttp() == nil {
+		return "", false
+	}
+
+	val, ok := reqCtx.Request.GetHttp().Headers[strings.ToLower(split.OverrideHeader)]
+	if !ok {
+		return "", false
+	}
+
+	for _, target := range split.Targets {
+		if target.ConfigName == val {
+			return val, true
+		}
+	}
+
+	return "", false
+}
+
+func getStickinessKey(ctx context.Context,
+	celEngine *celengine.CELEngine, split *corev1.Service_Spec_DynamicConfig_Split,
+	reqCtx *corev1.RequestContext, inputMap map[string]any) (string, bool) {
+	if split.Stickiness == nil {
+		return "", false
+	}
+
+	switch split.Stickiness.Type {
+	case corev1.Service_Spec_DynamicConfig_Split_Stickiness_USER:
+		if reqCtx.User == nil || reqCtx.User.Metadata == nil {
+			return "", false
+		}
+		return reqCtx.User.Metadata.Uid, true
+	case corev1.Service_Spec_DynamicConfig_Split_Stickiness_SESSION:
+		if reqCtx.Session == nil || reqCtx.Session.Metadata == nil {
+			return "", false
+		}
+		return reqCtx.Session.Metadata.Uid, true
+	case corev1.Service_Spec_DynamicConfig_Split_Stickiness_EVAL:
+		if celEngine == nil || split.Stickiness.Eval == "" {
+			return "", false
+		}
+		key, err := celEngine.EvalPolicyString(ctx, split.Stickiness.Eval, inputMap)
+		if err != nil {
+			zap.L().Debug("Could not eval split stickiness key", zap.Error(err))
+			return "", false
+		}
+		return key, key != ""
+	default:
+		return "", false
+	}
+}
+
+// chooseTarget chooses a target according to its weight. Requests with the
+// same key are always routed to the same target as long as the targets do
+// not change.
+func chooseTarget(targets []*corev1.Service_Spec_DynamicConfig_Split_Target,
+	seed, key string, hasKey bool) string {
+	var total uint64
+	for _, target := range targets {
+		total += uint64(target.Weight)
+	}
+
+	if total == 0 {
+		return ""
+	}
+
+	var point uint64
+	if hasKey {
+		h := fnv.New64a()
+		h.Write([]byte(seed))
+		h.Write([]byte(":"))
+		h.Write([]byte(key))
+		point = h.Sum64() % total
+	} else {
+		point = uint64(utilrand.GetRandomRangeMath(0, int(total)-1))
+	}
+
+	for _, target := range targets {
+		if point < uint64(target.Weight) {
+			return target.ConfigName
+		}
+		point -= uint64(target.Weight)
+	}
+
+	return ""
+}
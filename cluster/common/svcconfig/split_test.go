@@ -0,0 +1,151 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package svcconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/cluster/common/celengine"
+	"github.com/octelium/octelium/pkg/common/pbutils"
+	"github.com/octelium/octelium/pkg/utils/utilrand"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSplitConfigName(t *testing.T) {
+	ctx := context.Background()
+
+	celEngine, err := celengine.New(ctx, &celengine.Opts{})
+	assert.Nil(t, err)
+
+	svc := &corev1.Service{
+		Metadata: &metav1.Metadata{
+			Uid: utilrand.GetRandomStringCanonical(8),
+		},
+		Spec: &corev1.Service_Spec{
+			DynamicConfig: &corev1.Service_Spec_DynamicConfig{
+				Split: &corev1.Service_Spec_DynamicConfig_Split{
+					Targets: []*corev1.Service_Spec_DynamicConfig_Split_Target{
+						{
+							Weight: 50,
+						},
+						{
+							ConfigName: "canary",
+							Weight:     50,
+						},
+					},
+					OverrideHeader: "X-Octelium-Config",
+				},
+			},
+		},
+	}
+
+	getReqCtx := func(userUID, sessUID string, headers map[string]string) *corev1.RequestContext {
+		return &corev1.RequestContext{
+			Service: svc,
+			User: &corev1.User{
+				Metadata: &metav1.Metadata{Uid: userUID},
+			},
+			Session: &corev1.Session{
+				Metadata: &metav1.Metadata{Uid: sessUID},
+			},
+			Request: &corev1.RequestContext_Request{
+				Type: &corev1.RequestContext_Request_Http{
+					Http: &corev1.RequestContext_Request_HTTP{
+						Headers: headers,
+					},
+				},
+			},
+		}
+	}
+
+	getName := func(reqCtx *corev1.RequestContext) string {
+		return GetSplitConfigName(ctx, celEngine, reqCtx, map[string]any{
+			"ctx": pbutils.MustConvertToMap(reqCtx),
+		})
+	}
+
+	{
+		assert.Equal(t, "canary", getName(getReqCtx("usr", "sess", map[string]string{
+			"x-octelium-config": "canary",
+		})))
+		assert.Contains(t, []string{"", "canary"}, getName(getReqCtx("usr", "sess", map[string]string{
+			"x-octelium-config": "unknown",
+		})))
+	}
+
+	{
+		svc.Spec.DynamicConfig.Split.Stickiness = &corev1.Service_Spec_DynamicConfig_Split_Stickiness{
+			Type: corev1.Service_Spec_DynamicConfig_Split_Stickiness_USER,
+		}
+
+		counts := map[string]int{}
+		for range 200 {
+			userUID := utilrand.GetRandomStringCanonical(8)
+			name := getName(getReqCtx(userUID, utilrand.GetRandomStringCanonical(8), nil))
+			counts[name]++
+
+			for range 5 {
+				assert.Equal(t, name, getName(getReqCtx(userUID, utilrand.GetRandomStringCanonical(8), nil)))
+			}
+		}
+
+		assert.True(t, counts[""] > 0)
+		assert.True(t, counts["canary"] > 0)
+	}
+
+	{
+		svc.Spec.DynamicConfig.Split.Stickiness = &corev1.Service_Spec_DynamicConfig_Split_Stickiness{
+			Type: corev1.Service_Spec_DynamicConfig_Split_Stickiness_EVAL,
+			Eval: `ctx.request.http.headers["x-tenant"]`,
+		}
+
+		name := getName(getReqCtx("usr1", "sess1", map[string]string{"x-tenant": "tenant1"}))
+		for range 10 {
+			assert.Equal(t, name, getName(getReqCtx(utilrand.GetRandomStringCanonical(8),
+				utilrand.GetRandomStringCanonical(8), map[string]string{"x-tenant": "tenant1"})))
+		}
+	}
+
+	{
+		svc.Spec.DynamicConfig.Split.Stickiness = nil
+		svc.Spec.DynamicConfig.Split.Targets[0].Weight = 0
+
+		for range 20 {
+			assert.Equal(t, "canary", getName(getReqCtx("usr", "sess", nil)))
+		}
+	}
+}
+
+func TestChooseTarget(t *testing.T) {
+	assert.Equal(t, "", chooseTarget(nil, "seed", "key", true))
+	assert.Equal(t, "", chooseTarget([]*corev1.Service_Spec_DynamicConfig_Split_Target{
+		{ConfigName: "cfg1"},
+	}, "seed", "key", true))
+
+	targets := []*corev1.Service_Spec_DynamicConfig_Split_Target{
+		{ConfigName: "cfg1", Weight: 1},
+		{ConfigName: "cfg2", Weight: 3},
+	}
+
+	name := chooseTarget(targets, "seed", "key", true)
+	for range 10 {
+		assert.Equal(t, name, chooseTarget(targets, "seed", "key", true))
+	}
+}
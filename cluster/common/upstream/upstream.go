@@ -132,7 +132,10 @@ func AddAddressToConnection(ctx context.Context,
 	}
 
 	switch conn.Type {
-	case corev1.Session_Status_Connection_QUICV0, corev1.Session_Status_Connection_WIREGUARD:
+	case corev1.Session_Status_Connection_QUICV0,
+		corev1.Session_Status_Connection_MASQUE,
+		corev1.Session_Status_Connection_TLS,
+		corev1.Session_Status_Connection_WIREGUARD:
 	default:
 		return errors.Errorf("Cannot adding addresses without knowing Connection type")
 	}
@@ -161,7 +164,8 @@ func AddAddressToConnection(ctx context.Context,
 					return true
 				}
 			}
-		case corev1.Session_Status_Connection_QUICV0:
+		case corev1.Session_Status_Connection_QUICV0, corev1.Session_Status_Connection_MASQUE,
+			corev1.Session_Status_Connection_TLS:
 			for _, itm := range networkInfo.ActiveIndexesQUIC {
 				if itm == arg {
 					return true
@@ -189,7 +193,8 @@ func AddAddressToConnection(ctx context.Context,
 	switch conn.Type {
 	case corev1.Session_Status_Connection_WIREGUARD:
 		networkInfo.ActiveIndexesWG = append(networkInfo.ActiveIndexesWG, idx)
-	case corev1.Session_Status_Connection_QUICV0:
+	case corev1.Session_Status_Connection_QUICV0, corev1.Session_Status_Connection_MASQUE,
+		corev1.Session_Status_Connection_TLS:
 		networkInfo.ActiveIndexesQUIC = append(networkInfo.ActiveIndexesQUIC, idx)
 	}
 
@@ -210,7 +215,8 @@ func AddAddressToConnection(ctx context.Context,
 	var connSubnet *metav1.DualStackNetwork
 
 	switch conn.Type {
-	case corev1.Session_Status_Connection_QUICV0:
+	case corev1.Session_Status_Connection_QUICV0, corev1.Session_Status_Connection_MASQUE,
+		corev1.Session_Status_Connection_TLS:
 		connSubnet = &metav1.DualStackNetwork{
 			V4: cc.Status.Network.QuicConnSubnet.V4,
 			V6: cc.Status.Network.QuicConnSubnet.V6,
@@ -319,7 +325,8 @@ func removeConnIndex(network *cclusterv1.ClusterConnInfo, arg uint32, typ corev1
 				return
 			}
 		}
-	case corev1.Session_Status_Connection_QUICV0:
+	case corev1.Session_Status_Connection_QUICV0, corev1.Session_Status_Connection_MASQUE,
+		corev1.Session_Status_Connection_TLS:
 		for i, itm := range network.ActiveIndexesQUIC {
 			if itm == arg {
 				network.ActiveIndexesQUIC = append(network.ActiveIndexesQUIC[:i], network.ActiveIndexesQUIC[i+1:]...)
@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -76,6 +78,43 @@ func GetServicePublicFQDN(svc *corev1.Service, clusterDomain string) string {
 	return fmt.Sprintf("%s.%s", svc.Metadata.Name, clusterDomain)
 }
 
+// GetServicePublicFQDNs returns the hostnames on which a public Service is
+// served by the ingress. The first hostname is the canonical one.
+func GetServicePublicFQDNs(svc *corev1.Service, domain string) []string {
+	name := ucorev1.ToService(svc).Name()
+	ns := ucorev1.ToService(svc).Namespace()
+	ret := []string{
+		fmt.Sprintf("%s.%s.%s", name, ns, domain),
+	}
+
+	appendIfNotExists := func(arg string) {
+		if !slices.Contains(ret, arg) {
+			ret = append(ret, arg)
+		}
+	}
+	if name == "default" {
+		appendIfNotExists(fmt.Sprintf("%s.%s", ns, domain))
+	}
+
+	if ns == "default" {
+		appendIfNotExists(fmt.Sprintf("%s.%s", name, domain))
+		if name == "default" {
+			appendIfNotExists(domain)
+		}
+	}
+
+	if ucorev1.ToService(svc).IsManagedService() &&
+		svc.Status.ManagedService != nil && svc.Status.ManagedService.HasSubdomain {
+		domains := slices.Clone(ret)
+
+		for _, dmn := range domains {
+			appendIfNotExists(fmt.Sprintf("*.%s", dmn))
+		}
+	}
+
+	return ret
+}
+
 func GetServicePrivateFQDN(svc *corev1.Service, clusterDomain string) string {
 	if svc.Status.NamespaceRef.Name == "default" {
 		return fmt.Sprintf("%s.local.%s", ucorev1.ToService(svc).Name(), clusterDomain)
@@ -228,6 +267,7 @@ func GatewayToUser(gw *corev1.Gateway) *userv1.Gateway {
 		Id:        gw.Status.Id,
 		Hostname:  gw.Status.Hostname,
 		Addresses: gw.Status.PublicIPs,
+		Region:    gw.Status.RegionRef.GetName(),
 
 		Wireguard: &userv1.Gateway_WireGuard{
 			Port:      int32(gw.Status.Wireguard.Port),
@@ -241,6 +281,22 @@ func GatewayToUser(gw *corev1.Gateway) *userv1.Gateway {
 				Port: int32(gw.Status.Quicv0.Port),
 			}
 		}(),
+		Masque: func() *userv1.Gateway_MASQUE {
+			if gw.Status.Masque == nil {
+				return nil
+			}
+			return &userv1.Gateway_MASQUE{
+				Port: int32(gw.Status.Masque.Port),
+			}
+		}(),
+		Tls: func() *userv1.Gateway_TLS {
+			if gw.Status.Tls == nil {
+				return nil
+			}
+			return &userv1.Gateway_TLS{
+				Path: GetGatewayTLSTunnelPath(gw.Status.Id),
+			}
+		}(),
 
 		CIDRs: func() []string {
 			ret := []string{}
@@ -257,6 +313,12 @@ func GatewayToUser(gw *corev1.Gateway) *userv1.Gateway {
 	}
 }
 
+// GetGatewayTLSTunnelPath returns the path under the Cluster API host at
+// which the ingress proxies the TLS fallback tunnel of the given Gateway.
+func GetGatewayTLSTunnelPath(gwID string) string {
+	return fmt.Sprintf("/octelium/tunnel/%s", gwID)
+}
+
 func GetServiceFullNameFromName(arg string) string {
 	if arg == "" {
 		return ""
@@ -359,6 +421,20 @@ const MaxPolicyParents = 5
 const MaxNameSubArgs = 7
 const ManagedServicePort = 49999
 
+// GetManagedServiceAddr returns the local address on which a managed Service
+// listens. The default managed Service port is used if port is not set.
+func GetManagedServiceAddr(port int) string {
+	if port == 0 {
+		return ManagedServiceAddr
+	}
+
+	return net.JoinHostPort("localhost", strconv.Itoa(port))
+}
+
+// DNSSessionUIDOptionCode is the EDNS0 local option code by which the Vigil of
+// the Cluster DNS Service passes the querying Session UID to the DNS server.
+const DNSSessionUIDOptionCode = 65001
+
 const HealthCheckPortVigil = 49094
 const HealthCheckPortManagedService = 49095
 const HealthCheckPortMain = 49090
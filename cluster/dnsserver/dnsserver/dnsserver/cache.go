@@ -109,6 +109,21 @@ func (c *cache) get(arg string, typ uint16) net.IP {
 	}
 }
 
+func (c *cache) getService(arg string) *corev1.Service {
+	if arg == "" {
+		arg = "default.default"
+	}
+	c.RLock()
+	defer c.RUnlock()
+
+	res, ok := c.cMap[arg]
+	if !ok || res == nil {
+		return nil
+	}
+
+	return res.svc
+}
+
 func (c *cache) has(arg string) bool {
 	if arg == "" {
 		arg = "default.default"
@@ -0,0 +1,187 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dnsserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/octelium/octelium/apis/cluster/coctovigilv1"
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/cluster/common/vutils"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
+	"go.uber.org/zap"
+)
+
+const serviceRecordTTL = 60
+
+// getSRVHostname strips the leading "_service._proto" labels of SRV queries
+// so that the remaining domain can be resolved to a Service hostname.
+func getSRVHostname(domain string, qtype uint16) string {
+	if qtype != dns.TypeSRV {
+		return domain
+	}
+
+	labels := dns.SplitDomainName(domain)
+	idx := 0
+	for idx < len(labels) && strings.HasPrefix(labels[idx], "_") {
+		idx++
+	}
+
+	if idx == 0 || idx == len(labels) {
+		return domain
+	}
+
+	return dns.Fqdn(strings.Join(labels[idx:], "."))
+}
+
+func getServiceProtocol(svc *corev1.Service) string {
+	switch svc.Spec.Mode {
+	case corev1.Service_Spec_UDP, corev1.Service_Spec_DNS:
+		return "_udp"
+	default:
+		return "_tcp"
+	}
+}
+
+func getSRVAnswer(domain string, svc *corev1.Service) []dns.RR {
+	labels := dns.SplitDomainName(domain)
+	for _, label := range labels {
+		if !strings.HasPrefix(label, "_") {
+			break
+		}
+		if (label == "_tcp" || label == "_udp") && label != getServiceProtocol(svc) {
+			return nil
+		}
+	}
+
+	return []dns.RR{
+		&dns.SRV{
+			Hdr: dns.RR_Header{
+				Name:   domain,
+				Rrtype: dns.TypeSRV,
+				Class:  dns.ClassINET,
+				Ttl:    serviceRecordTTL,
+			},
+			Port:   uint16(ucorev1.ToService(svc).RealPort()),
+			Target: getSRVHostname(domain, dns.TypeSRV),
+		},
+	}
+}
+
+func (s *DNSServer) getTXTAnswer(domain string, svc *corev1.Service) []dns.RR {
+	txt := []string{
+		fmt.Sprintf("mode=%s", strings.ToLower(svc.Spec.Mode.String())),
+		fmt.Sprintf("port=%d", ucorev1.ToService(svc).RealPort()),
+		fmt.Sprintf("tls=%t", svc.Spec.IsTLS),
+	}
+
+	s.mu.RLock()
+	txtAttrs := s.txtAttrs
+	s.mu.RUnlock()
+
+	if attrs := svc.Spec.GetAttrs(); attrs != nil {
+		for _, key := range txtAttrs {
+			val, ok := attrs.Fields[key]
+			if !ok || val == nil {
+				continue
+			}
+
+			switch v := val.AsInterface().(type) {
+			case string:
+				txt = append(txt, fmt.Sprintf("%s=%s", key, v))
+			default:
+				valBytes, err := json.Marshal(v)
+				if err != nil {
+					continue
+				}
+				txt = append(txt, fmt.Sprintf("%s=%s", key, string(valBytes)))
+			}
+		}
+	}
+
+	return []dns.RR{
+		&dns.TXT{
+			Hdr: dns.RR_Header{
+				Name:   domain,
+				Rrtype: dns.TypeTXT,
+				Class:  dns.ClassINET,
+				Ttl:    serviceRecordTTL,
+			},
+			Txt: splitTXT(strings.Join(txt, " ")),
+		},
+	}
+}
+
+func getSessionUID(r *dns.Msg) string {
+	opt := r.IsEdns0()
+	if opt == nil {
+		return ""
+	}
+
+	for _, o := range opt.Option {
+		if local, ok := o.(*dns.EDNS0_LOCAL); ok && local.Code == vutils.DNSSessionUIDOptionCode {
+			return string(local.Data)
+		}
+	}
+
+	return ""
+}
+
+// isAuthorized checks whether the Session that sent the query is authorized
+// to access the Service. Queries without a Session UID are filtered out
+// when filtering is enabled.
+func (s *DNSServer) isAuthorized(r *dns.Msg, svc *corev1.Service) bool {
+	s.mu.RLock()
+	isFilteringEnabled := s.isFilteringEnabled
+	s.mu.RUnlock()
+
+	if !isFilteringEnabled {
+		return true
+	}
+
+	sessUID := getSessionUID(r)
+	if sessUID == "" || s.authorize == nil {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := s.authorize(ctx, &coctovigilv1.AuthorizeRequest{
+		ServiceUID: svc.Metadata.Uid,
+		SessionUID: sessUID,
+		Request: &corev1.RequestContext_Request{
+			Type: &corev1.RequestContext_Request_Dns{
+				Dns: &corev1.RequestContext_Request_DNS{
+					Name:   r.Question[0].Name,
+					TypeID: int32(r.Question[0].Qtype),
+				},
+			},
+		},
+	})
+	if err != nil {
+		zap.L().Warn("Could not authorize DNS query", zap.Error(err))
+		return false
+	}
+
+	return resp.IsAuthorized
+}
@@ -0,0 +1,125 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dnsserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/octelium/octelium/apis/cluster/coctovigilv1"
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/cluster/common/vutils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestGetSRVHostname(t *testing.T) {
+	assert.Equal(t, "svc1.local.example.com.",
+		getSRVHostname("_http._tcp.svc1.local.example.com.", dns.TypeSRV))
+	assert.Equal(t, "svc1.local.example.com.",
+		getSRVHostname("svc1.local.example.com.", dns.TypeSRV))
+	assert.Equal(t, "_http._tcp.svc1.local.example.com.",
+		getSRVHostname("_http._tcp.svc1.local.example.com.", dns.TypeA))
+	assert.Equal(t, "_http._tcp.",
+		getSRVHostname("_http._tcp.", dns.TypeSRV))
+}
+
+func TestServiceDiscoveryAnswers(t *testing.T) {
+	attrs, err := structpb.NewStruct(map[string]any{
+		"team":    "payments",
+		"version": 2,
+		"secret":  "hidden",
+	})
+	assert.Nil(t, err)
+
+	svc := &corev1.Service{
+		Metadata: &metav1.Metadata{Uid: "svc-uid"},
+		Spec: &corev1.Service_Spec{
+			Mode:  corev1.Service_Spec_HTTP,
+			IsTLS: true,
+			Attrs: attrs,
+		},
+		Status: &corev1.Service_Status{
+			Port: 8080,
+		},
+	}
+
+	{
+		answer := getSRVAnswer("_http._tcp.svc1.local.example.com.", svc)
+		assert.Equal(t, 1, len(answer))
+		srv := answer[0].(*dns.SRV)
+		assert.Equal(t, uint16(8080), srv.Port)
+		assert.Equal(t, "svc1.local.example.com.", srv.Target)
+	}
+
+	{
+		answer := getSRVAnswer("_http._udp.svc1.local.example.com.", svc)
+		assert.Equal(t, 0, len(answer))
+	}
+
+	{
+		s := &DNSServer{
+			txtAttrs: []string{"team", "version", "unknown"},
+		}
+		answer := s.getTXTAnswer("svc1.local.example.com.", svc)
+		assert.Equal(t, 1, len(answer))
+		assert.Equal(t, []string{"mode=http port=8080 tls=true team=payments version=2"},
+			answer[0].(*dns.TXT).Txt)
+	}
+}
+
+func TestIsAuthorized(t *testing.T) {
+	svc := &corev1.Service{
+		Metadata: &metav1.Metadata{Uid: "svc-uid"},
+		Spec:     &corev1.Service_Spec{},
+		Status:   &corev1.Service_Status{},
+	}
+
+	newReq := func(sessUID string) *dns.Msg {
+		r := &dns.Msg{}
+		r.SetQuestion("svc1.local.example.com.", dns.TypeA)
+		if sessUID != "" {
+			r.SetEdns0(4096, false)
+			opt := r.IsEdns0()
+			opt.Option = append(opt.Option, &dns.EDNS0_LOCAL{
+				Code: vutils.DNSSessionUIDOptionCode,
+				Data: []byte(sessUID),
+			})
+		}
+		return r
+	}
+
+	s := &DNSServer{
+		authorize: func(ctx context.Context,
+			req *coctovigilv1.AuthorizeRequest) (*coctovigilv1.AuthorizeResponse, error) {
+			return &coctovigilv1.AuthorizeResponse{
+				IsAuthorized: req.SessionUID == "sess-allowed" && req.ServiceUID == "svc-uid",
+			}, nil
+		},
+	}
+
+	assert.True(t, s.isAuthorized(newReq(""), svc))
+	assert.True(t, s.isAuthorized(newReq("sess-denied"), svc))
+
+	s.isFilteringEnabled = true
+
+	assert.False(t, s.isAuthorized(newReq(""), svc))
+	assert.False(t, s.isAuthorized(newReq("sess-denied"), svc))
+	assert.True(t, s.isAuthorized(newReq("sess-allowed"), svc))
+}
@@ -22,9 +22,7 @@ import (
 	"context"
 	"fmt"
 	"net"
-	"net/url"
 	"slices"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -32,15 +30,16 @@ import (
 	"github.com/asaskevich/govalidator"
 	"github.com/go-resty/resty/v2"
 	"github.com/miekg/dns"
+	"github.com/octelium/octelium/apis/cluster/coctovigilv1"
 	"github.com/octelium/octelium/apis/main/corev1"
 	"github.com/octelium/octelium/cluster/common/apivalidation"
 	"github.com/octelium/octelium/cluster/common/ccctl"
 	"github.com/octelium/octelium/cluster/common/octeliumc"
+	"github.com/octelium/octelium/cluster/common/octovigilc"
 	"github.com/octelium/octelium/cluster/common/vutils"
 	"github.com/octelium/octelium/pkg/apiutils/umetav1"
 	"github.com/octelium/octelium/pkg/common/pbutils"
 	"github.com/octelium/octelium/pkg/utils/ldflags"
-	"github.com/octelium/octelium/pkg/utils/utilrand"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 )
@@ -52,31 +51,41 @@ type DNSServer struct {
 	ccCtl             *ccctl.Controller
 	mu                sync.RWMutex
 	fallbackZoneCache *zoneCache
+	conditionalZones  []*conditionalZone
+	records           *recordCache
+
+	isFilteringEnabled bool
+	txtAttrs           []string
+	authorize          func(ctx context.Context, req *coctovigilv1.AuthorizeRequest) (*coctovigilv1.AuthorizeResponse, error)
 
 	reservedNamespaces []string
+
+	port int
 }
 
 func Initialize(ctx context.Context, octeliumC octeliumc.ClientInterface) (*DNSServer, error) {
 
 	ret := &DNSServer{
-		cache: newCache(),
+		cache:             newCache(),
+		records:           newRecordCache(),
+		fallbackZoneCache: newZoneCache(0),
 	}
 
 	ret.setReservedNamespaces(ctx)
 
-	getDuration := func(cc *corev1.ClusterConfig) time.Duration {
-		if cc.Spec.Dns == nil || cc.Spec.Dns.FallbackZone == nil || cc.Spec.Dns.FallbackZone.CacheDuration == nil {
-			return 0
-		}
-		return umetav1.ToDuration(cc.Spec.Dns.FallbackZone.CacheDuration).ToGo()
+	octovigilC, err := octovigilc.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret.authorize = func(ctx context.Context, req *coctovigilv1.AuthorizeRequest) (*coctovigilv1.AuthorizeResponse, error) {
+		return octovigilC.InternalC().Authorize(ctx, req)
 	}
 
 	ccCtl, err := ccctl.New(ctx, octeliumC, &ccctl.Opts{
 		OnUpdate: func(ctx context.Context, new, old *corev1.ClusterConfig) error {
 			if !pbutils.IsEqual(new.Spec.Dns, old.Spec.Dns) {
-				zap.L().Debug("Updating fallback upstreams", zap.Any("dnsConfig", new.Spec.Dns))
-				ret.setDefaultUpstreams(new)
-				ret.fallbackZoneCache.setDuration(getDuration(new))
+				zap.L().Debug("Updating DNS config", zap.Any("dnsConfig", new.Spec.Dns))
+				ret.setDNSConfig(new)
 			}
 
 			return nil
@@ -87,17 +96,54 @@ func Initialize(ctx context.Context, octeliumC octeliumc.ClientInterface) (*DNSS
 	}
 	ret.ccCtl = ccCtl
 	ret.domain = ccCtl.Get().Status.Domain
-	ret.setDefaultUpstreams(ccCtl.Get())
-
-	ret.fallbackZoneCache = newZoneCache(getDuration(ret.ccCtl.Get()))
+	ret.setDNSConfig(ccCtl.Get())
 
 	return ret, nil
 }
 
+func (s *DNSServer) setDNSConfig(cc *corev1.ClusterConfig) {
+	s.setDefaultUpstreams(cc)
+	s.setConditionalZones(cc)
+
+	var fallbackZone *corev1.ClusterConfig_Spec_DNS_Zone
+	var records []*corev1.ClusterConfig_Spec_DNS_Record
+	if cc != nil && cc.Spec.Dns != nil {
+		fallbackZone = cc.Spec.Dns.FallbackZone
+		records = cc.Spec.Dns.Records
+	}
+
+	s.fallbackZoneCache.setDuration(getZoneCacheDuration(fallbackZone))
+	s.fallbackZoneCache.setNegativeDuration(getZoneNegativeCacheDuration(fallbackZone))
+	s.records.setRecords(records)
+
+	s.mu.Lock()
+	s.isFilteringEnabled = cc != nil && cc.Spec.Dns.GetFiltering().GetIsEnabled()
+	s.txtAttrs = nil
+	if cc != nil {
+		s.txtAttrs = cc.Spec.Dns.GetServiceDiscovery().GetTxtAttrs()
+	}
+	s.mu.Unlock()
+}
+
+func getZoneCacheDuration(zone *corev1.ClusterConfig_Spec_DNS_Zone) time.Duration {
+	if zone == nil || zone.CacheDuration == nil {
+		return 0
+	}
+	return umetav1.ToDuration(zone.CacheDuration).ToGo()
+}
+
+func getZoneNegativeCacheDuration(zone *corev1.ClusterConfig_Spec_DNS_Zone) time.Duration {
+	if zone == nil || zone.NegativeCacheDuration == nil {
+		return 0
+	}
+	return umetav1.ToDuration(zone.NegativeCacheDuration).ToGo()
+}
+
 type upstream struct {
 	host string
 	port int
 	typ  string
+	url  string
 }
 
 func (u *upstream) getAddr() string {
@@ -178,9 +224,18 @@ func (s *DNSServer) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 		return
 	}
 
-	hostname, err := s.getHostname(domain)
+	qtype := r.Question[0].Qtype
+
+	hostname, err := s.getHostname(getSRVHostname(domain, qtype))
 	if err != nil {
-		ret, err := s.getProxiedAnswer(domain, r.Question[0].Qtype)
+		if answer, ok := s.records.get(domain, qtype); ok {
+			msg.Authoritative = true
+			msg.Answer = answer
+			w.WriteMsg(&msg)
+			return
+		}
+
+		ret, err := s.getProxiedAnswer(domain, qtype)
 		if err != nil {
 			msg.SetRcode(r, dns.RcodeNameError)
 			w.WriteMsg(&msg)
@@ -188,6 +243,7 @@ func (s *DNSServer) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 			return
 		}
 
+		msg.Rcode = ret.Rcode
 		msg.Answer = ret.Answer
 		msg.Extra = ret.Extra
 		msg.Ns = ret.Ns
@@ -195,6 +251,21 @@ func (s *DNSServer) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 		return
 	}
 
+	svc := s.cache.getService(hostname)
+	if svc == nil {
+		msg.SetRcode(r, dns.RcodeNameError)
+		w.WriteMsg(&msg)
+		return
+	}
+
+	if !s.isAuthorized(r, svc) {
+		msg.SetRcode(r, dns.RcodeNameError)
+		w.WriteMsg(&msg)
+		zap.L().Debug("Filtered unauthorized Service hostname",
+			zap.String("domain", domain), zap.String("hostname", hostname))
+		return
+	}
+
 	doResolve := func(typ uint16) {
 
 		address := s.cache.get(hostname, typ)
@@ -226,13 +297,23 @@ func (s *DNSServer) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 			zap.String("addr", address.String()))
 	}
 
-	switch r.Question[0].Qtype {
+	switch qtype {
 	case dns.TypeA:
 		doResolve(dns.TypeA)
 		return
 	case dns.TypeAAAA:
 		doResolve(dns.TypeAAAA)
 		return
+	case dns.TypeSRV:
+		msg.Authoritative = true
+		msg.Answer = getSRVAnswer(domain, svc)
+		w.WriteMsg(&msg)
+		return
+	case dns.TypeTXT:
+		msg.Authoritative = true
+		msg.Answer = s.getTXTAnswer(domain, svc)
+		w.WriteMsg(&msg)
+		return
 	default:
 		msg.SetRcode(r, dns.RcodeRefused)
 		w.WriteMsg(&msg)
@@ -366,6 +447,12 @@ func (s *DNSServer) parseSvcNs(arg string) *svcNs {
 }
 */
 
+// SetPort sets the local UDP port on which the DNS server listens instead of
+// the default managed Service port.
+func (s *DNSServer) SetPort(port int) {
+	s.port = port
+}
+
 func (s *DNSServer) Run(ctx context.Context) error {
 
 	if err := s.ccCtl.Run(ctx); err != nil {
@@ -378,8 +465,13 @@ func (s *DNSServer) Run(ctx context.Context) error {
 
 	go s.fallbackZoneCache.startCleanupLoop(ctx)
 
+	port := s.port
+	if port == 0 {
+		port = vutils.ManagedServicePort
+	}
+
 	{
-		srv := &dns.Server{Addr: fmt.Sprintf("[::1]:%d", vutils.ManagedServicePort), Net: "udp"}
+		srv := &dns.Server{Addr: fmt.Sprintf("[::1]:%d", port), Net: "udp"}
 		srv.Handler = s
 
 		go func() {
@@ -390,7 +482,7 @@ func (s *DNSServer) Run(ctx context.Context) error {
 	}
 
 	{
-		srv := &dns.Server{Addr: fmt.Sprintf("127.0.0.1:%d", vutils.ManagedServicePort), Net: "udp"}
+		srv := &dns.Server{Addr: fmt.Sprintf("127.0.0.1:%d", port), Net: "udp"}
 		srv.Handler = s
 
 		go func() {
@@ -407,26 +499,34 @@ func (s *DNSServer) Run(ctx context.Context) error {
 func (s *DNSServer) getProxiedAnswer(domain string, typ uint16) (*dns.Msg, error) {
 
 	zap.L().Debug("Getting proxied answer", zap.String("domain", domain), zap.Uint16("type", typ))
-	if cached := s.fallbackZoneCache.get(domain, typ); cached != nil {
+
+	zoneCache := s.fallbackZoneCache
+	zone := s.getConditionalZone(domain)
+	if zone != nil {
+		zoneCache = zone.cache
+	}
+
+	if cached := zoneCache.get(domain, typ); cached != nil {
 		zap.L().Debug("Found cached proxied answer", zap.String("domain", domain), zap.Any("answer", cached))
 		return cached, nil
 	}
 
-	upstream := s.chooseUpstream()
-	c := dns.Client{
-		Net:     upstream.typ,
-		Timeout: 6 * time.Second,
+	var upstream *upstream
+	if zone != nil {
+		upstream = zone.chooseUpstream()
+	} else {
+		upstream = s.chooseUpstream()
 	}
-	m := dns.Msg{}
 
+	m := &dns.Msg{}
 	m.SetQuestion(domain, typ)
 
-	r, _, err := c.Exchange(&m, upstream.getAddr())
+	r, err := upstream.exchange(m)
 	if err != nil {
 		return nil, err
 	}
 
-	s.fallbackZoneCache.set(domain, typ, r)
+	zoneCache.set(domain, typ, r)
 
 	zap.L().Debug("Found cached proxied answer", zap.String("domain", domain), zap.Any("answer", r))
 	return r, nil
@@ -436,58 +536,17 @@ func (s *DNSServer) chooseUpstream() *upstream {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	return s.upstreams[utilrand.GetRandomRangeMath(0, len(s.upstreams)-1)]
+	return chooseUpstream(s.upstreams)
 }
 
 func (s *DNSServer) setDefaultUpstreams(cc *corev1.ClusterConfig) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	getPort := func(u *url.URL) int {
-		if u.Port() != "" {
-			if port, err := strconv.Atoi(u.Port()); err == nil && port > 0 && port <= 65535 {
-				return port
-			}
-		}
-		return 0
-	}
-
 	s.upstreams = nil
 	if cc != nil && cc.Spec.Dns != nil && cc.Spec.Dns.FallbackZone != nil &&
 		len(cc.Spec.Dns.FallbackZone.Servers) > 0 {
-		for _, server := range cc.Spec.Dns.FallbackZone.Servers {
-			u, err := url.Parse(server)
-			if err != nil {
-				zap.L().Warn("Could not parse fallback server. Skipping...",
-					zap.Error(err), zap.String("server", server))
-				continue
-			}
-
-			switch u.Scheme {
-			case "dns", "", "udp":
-				upstream := &upstream{
-					host: u.Host,
-					port: getPort(u),
-				}
-				if upstream.port == 0 {
-					upstream.port = 53
-				}
-				s.upstreams = append(s.upstreams, upstream)
-			case "tls":
-				upstream := &upstream{
-					host: u.Host,
-					port: getPort(u),
-					typ:  "tcp-tls",
-				}
-				if upstream.port == 0 {
-					upstream.port = 853
-				}
-				s.upstreams = append(s.upstreams, upstream)
-			default:
-				continue
-			}
-
-		}
+		s.upstreams = parseUpstreams(cc.Spec.Dns.FallbackZone.Servers)
 	}
 
 	if len(s.upstreams) > 0 {
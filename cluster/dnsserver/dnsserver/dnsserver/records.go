@@ -0,0 +1,170 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dnsserver
+
+import (
+	"net"
+	"sync"
+
+	"github.com/miekg/dns"
+	"github.com/octelium/octelium/apis/main/corev1"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultRecordTTL   = 60
+	maxCNAMEChainDepth = 8
+)
+
+// recordCache holds the static custom records set by the ClusterConfig.
+type recordCache struct {
+	sync.RWMutex
+	rMap map[string][]dns.RR
+}
+
+func newRecordCache() *recordCache {
+	return &recordCache{
+		rMap: make(map[string][]dns.RR),
+	}
+}
+
+func (c *recordCache) setRecords(records []*corev1.ClusterConfig_Spec_DNS_Record) {
+	rMap := make(map[string][]dns.RR)
+
+	for _, record := range records {
+		rr := getRR(record)
+		if rr == nil {
+			zap.L().Warn("Invalid DNS record. Skipping...", zap.Any("record", record))
+			continue
+		}
+
+		rMap[rr.Header().Name] = append(rMap[rr.Header().Name], rr)
+	}
+
+	c.Lock()
+	c.rMap = rMap
+	c.Unlock()
+}
+
+// get returns the records of the domain that match the type. CNAME records are
+// followed as long as their targets are static records as well. The returned
+// bool is false if the domain has no static records at all.
+func (c *recordCache) get(domain string, typ uint16) ([]dns.RR, bool) {
+	c.RLock()
+	defer c.RUnlock()
+
+	name := dns.CanonicalName(domain)
+	if _, ok := c.rMap[name]; !ok {
+		return nil, false
+	}
+
+	var ret []dns.RR
+	for range maxCNAMEChainDepth {
+		rrs, ok := c.rMap[name]
+		if !ok {
+			break
+		}
+
+		var cname *dns.CNAME
+		found := false
+		for _, rr := range rrs {
+			if rr.Header().Rrtype == typ {
+				ret = append(ret, dns.Copy(rr))
+				found = true
+			} else if rr.Header().Rrtype == dns.TypeCNAME {
+				cname = rr.(*dns.CNAME)
+			}
+		}
+
+		if found || cname == nil {
+			break
+		}
+
+		ret = append(ret, dns.Copy(cname))
+		name = cname.Target
+	}
+
+	return ret, true
+}
+
+func getRR(record *corev1.ClusterConfig_Spec_DNS_Record) dns.RR {
+	if record == nil || record.Name == "" {
+		return nil
+	}
+
+	ttl := record.Ttl
+	if ttl == 0 {
+		ttl = defaultRecordTTL
+	}
+
+	getHdr := func(typ uint16) dns.RR_Header {
+		return dns.RR_Header{
+			Name:   dns.CanonicalName(record.Name),
+			Rrtype: typ,
+			Class:  dns.ClassINET,
+			Ttl:    ttl,
+		}
+	}
+
+	switch record.Type.(type) {
+	case *corev1.ClusterConfig_Spec_DNS_Record_A:
+		ip := net.ParseIP(record.GetA()).To4()
+		if ip == nil {
+			return nil
+		}
+		return &dns.A{Hdr: getHdr(dns.TypeA), A: ip}
+	case *corev1.ClusterConfig_Spec_DNS_Record_Aaaa:
+		ip := net.ParseIP(record.GetAaaa())
+		if ip == nil || ip.To4() != nil {
+			return nil
+		}
+		return &dns.AAAA{Hdr: getHdr(dns.TypeAAAA), AAAA: ip}
+	case *corev1.ClusterConfig_Spec_DNS_Record_Cname:
+		if record.GetCname() == "" {
+			return nil
+		}
+		return &dns.CNAME{Hdr: getHdr(dns.TypeCNAME), Target: dns.CanonicalName(record.GetCname())}
+	case *corev1.ClusterConfig_Spec_DNS_Record_Txt:
+		return &dns.TXT{Hdr: getHdr(dns.TypeTXT), Txt: splitTXT(record.GetTxt())}
+	case *corev1.ClusterConfig_Spec_DNS_Record_Srv:
+		srv := record.GetSrv()
+		if srv.Target == "" || srv.Port == 0 || srv.Port > 65535 ||
+			srv.Priority > 65535 || srv.Weight > 65535 {
+			return nil
+		}
+		return &dns.SRV{
+			Hdr:      getHdr(dns.TypeSRV),
+			Priority: uint16(srv.Priority),
+			Weight:   uint16(srv.Weight),
+			Port:     uint16(srv.Port),
+			Target:   dns.CanonicalName(srv.Target),
+		}
+	default:
+		return nil
+	}
+}
+
+// splitTXT splits the TXT value into 255-byte character strings.
+func splitTXT(arg string) []string {
+	var ret []string
+	for len(arg) > 255 {
+		ret = append(ret, arg[:255])
+		arg = arg[255:]
+	}
+
+	return append(ret, arg)
+}
@@ -0,0 +1,130 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package dnsserver
+
+import (
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordCache(t *testing.T) {
+	c := newRecordCache()
+
+	c.setRecords([]*corev1.ClusterConfig_Spec_DNS_Record{
+		{
+			Name: "db.corp.internal",
+			Type: &corev1.ClusterConfig_Spec_DNS_Record_A{
+				A: "10.0.0.1",
+			},
+		},
+		{
+			Name: "db.corp.internal",
+			Ttl:  300,
+			Type: &corev1.ClusterConfig_Spec_DNS_Record_Aaaa{
+				Aaaa: "fd00::1",
+			},
+		},
+		{
+			Name: "DB2.corp.internal.",
+			Type: &corev1.ClusterConfig_Spec_DNS_Record_Cname{
+				Cname: "db.corp.internal",
+			},
+		},
+		{
+			Name: "_ldap._tcp.corp.internal",
+			Type: &corev1.ClusterConfig_Spec_DNS_Record_Srv{
+				Srv: &corev1.ClusterConfig_Spec_DNS_Record_SRV{
+					Priority: 10,
+					Weight:   5,
+					Port:     389,
+					Target:   "ldap.corp.internal",
+				},
+			},
+		},
+		{
+			Name: "corp.internal",
+			Type: &corev1.ClusterConfig_Spec_DNS_Record_Txt{
+				Txt: "v=spf1 -all",
+			},
+		},
+		{
+			Name: "invalid.corp.internal",
+			Type: &corev1.ClusterConfig_Spec_DNS_Record_A{
+				A: "fd00::1",
+			},
+		},
+	})
+
+	{
+		res, ok := c.get("db.corp.internal.", dns.TypeA)
+		assert.True(t, ok)
+		assert.Equal(t, 1, len(res))
+		assert.Equal(t, "10.0.0.1", res[0].(*dns.A).A.String())
+		assert.Equal(t, uint32(defaultRecordTTL), res[0].Header().Ttl)
+	}
+
+	{
+		res, ok := c.get("DB.corp.internal.", dns.TypeAAAA)
+		assert.True(t, ok)
+		assert.Equal(t, 1, len(res))
+		assert.Equal(t, "fd00::1", res[0].(*dns.AAAA).AAAA.String())
+		assert.Equal(t, uint32(300), res[0].Header().Ttl)
+	}
+
+	{
+		res, ok := c.get("db.corp.internal.", dns.TypeTXT)
+		assert.True(t, ok)
+		assert.Equal(t, 0, len(res))
+	}
+
+	{
+		res, ok := c.get("db2.corp.internal.", dns.TypeA)
+		assert.True(t, ok)
+		assert.Equal(t, 2, len(res))
+		assert.Equal(t, "db.corp.internal.", res[0].(*dns.CNAME).Target)
+		assert.Equal(t, "10.0.0.1", res[1].(*dns.A).A.String())
+	}
+
+	{
+		res, ok := c.get("_ldap._tcp.corp.internal.", dns.TypeSRV)
+		assert.True(t, ok)
+		assert.Equal(t, 1, len(res))
+		assert.Equal(t, uint16(389), res[0].(*dns.SRV).Port)
+		assert.Equal(t, "ldap.corp.internal.", res[0].(*dns.SRV).Target)
+	}
+
+	{
+		res, ok := c.get("corp.internal.", dns.TypeTXT)
+		assert.True(t, ok)
+		assert.Equal(t, []string{"v=spf1 -all"}, res[0].(*dns.TXT).Txt)
+	}
+
+	{
+		_, ok := c.get("invalid.corp.internal.", dns.TypeA)
+		assert.False(t, ok)
+		_, ok = c.get("www.example.com.", dns.TypeA)
+		assert.False(t, ok)
+	}
+
+	c.setRecords(nil)
+	{
+		_, ok := c.get("db.corp.internal.", dns.TypeA)
+		assert.False(t, ok)
+	}
+}
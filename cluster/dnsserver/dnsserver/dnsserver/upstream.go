@@ -0,0 +1,171 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dnsserver
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/octelium/octelium/pkg/utils/utilrand"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const upstreamTimeout = 6 * time.Second
+
+var httpsClient = &http.Client{
+	Timeout: upstreamTimeout,
+}
+
+// parseUpstreams parses the DNS servers URLs. Plain DNS servers are set via
+// IP addresses or `dns://` and `udp://` URLs, DNS-over-TLS servers via `tls://`
+// URLs and DNS-over-HTTPS servers via `https://` URLs.
+func parseUpstreams(servers []string) []*upstream {
+	var ret []*upstream
+
+	getPort := func(u *url.URL) int {
+		if u.Port() != "" {
+			if port, err := strconv.Atoi(u.Port()); err == nil && port > 0 && port <= 65535 {
+				return port
+			}
+		}
+		return 0
+	}
+
+	for _, server := range servers {
+		if !strings.Contains(server, "://") {
+			server = "dns://" + server
+		}
+
+		u, err := url.Parse(server)
+		if err != nil {
+			zap.L().Warn("Could not parse DNS server. Skipping...",
+				zap.Error(err), zap.String("server", server))
+			continue
+		}
+
+		switch u.Scheme {
+		case "dns", "udp":
+			upstream := &upstream{
+				host: u.Hostname(),
+				port: getPort(u),
+			}
+			if upstream.port == 0 {
+				upstream.port = 53
+			}
+			ret = append(ret, upstream)
+		case "tls":
+			upstream := &upstream{
+				host: u.Hostname(),
+				port: getPort(u),
+				typ:  "tcp-tls",
+			}
+			if upstream.port == 0 {
+				upstream.port = 853
+			}
+			ret = append(ret, upstream)
+		case "https":
+			if u.Path == "" {
+				u.Path = "/dns-query"
+			}
+			ret = append(ret, &upstream{
+				host: u.Hostname(),
+				port: getPort(u),
+				typ:  "https",
+				url:  u.String(),
+			})
+		default:
+			zap.L().Warn("Unsupported DNS server scheme. Skipping...", zap.String("server", server))
+			continue
+		}
+	}
+
+	return ret
+}
+
+func (u *upstream) exchange(m *dns.Msg) (*dns.Msg, error) {
+	if u.typ == "https" {
+		ctx, cancel := context.WithTimeout(context.Background(), upstreamTimeout)
+		defer cancel()
+		return u.exchangeHTTPS(ctx, m)
+	}
+
+	c := dns.Client{
+		Net:     u.typ,
+		Timeout: upstreamTimeout,
+	}
+
+	r, _, err := c.Exchange(m, u.getAddr())
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// exchangeHTTPS exchanges the message with a DNS-over-HTTPS server as
+// described in RFC 8484.
+func (u *upstream) exchangeHTTPS(ctx context.Context, m *dns.Msg) (*dns.Msg, error) {
+	req := m.Copy()
+	req.Id = 0
+
+	reqBytes, err := req.Pack()
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, u.url, bytes.NewReader(reqBytes))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/dns-message")
+	httpReq.Header.Set("Accept", "application/dns-message")
+
+	resp, err := httpsClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("DNS-over-HTTPS server returned status code: %d", resp.StatusCode)
+	}
+
+	respBytes, err := io.ReadAll(io.LimitReader(resp.Body, dns.MaxMsgSize))
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &dns.Msg{}
+	if err := ret.Unpack(respBytes); err != nil {
+		return nil, err
+	}
+	ret.Id = m.Id
+
+	return ret, nil
+}
+
+func chooseUpstream(upstreams []*upstream) *upstream {
+	return upstreams[utilrand.GetRandomRangeMath(0, len(upstreams)-1)]
+}
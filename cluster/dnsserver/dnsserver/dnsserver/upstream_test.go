@@ -0,0 +1,92 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package dnsserver
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseUpstreams(t *testing.T) {
+	res := parseUpstreams([]string{
+		"1.2.3.4",
+		"udp://1.2.3.5:5353",
+		"tls://1.1.1.1",
+		"https://dns.example.com",
+		"https://dns.example.com:8443/custom-query",
+		"ftp://1.2.3.6",
+	})
+
+	assert.Equal(t, 5, len(res))
+
+	assert.Equal(t, "1.2.3.4:53", res[0].getAddr())
+	assert.Equal(t, "", res[0].typ)
+
+	assert.Equal(t, "1.2.3.5:5353", res[1].getAddr())
+
+	assert.Equal(t, "1.1.1.1:853", res[2].getAddr())
+	assert.Equal(t, "tcp-tls", res[2].typ)
+
+	assert.Equal(t, "https", res[3].typ)
+	assert.Equal(t, "https://dns.example.com/dns-query", res[3].url)
+	assert.Equal(t, "https://dns.example.com:8443/custom-query", res[4].url)
+}
+
+func TestExchangeHTTPS(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/dns-message" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		reqBytes, _ := io.ReadAll(r.Body)
+		req := &dns.Msg{}
+		if err := req.Unpack(reqBytes); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		resp := &dns.Msg{}
+		resp.SetReply(req)
+		resp.Answer = append(resp.Answer, &dns.A{
+			Hdr: dns.RR_Header{Name: req.Question[0].Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+			A:   []byte{1, 2, 3, 4},
+		})
+		respBytes, _ := resp.Pack()
+		w.Header().Set("Content-Type", "application/dns-message")
+		w.Write(respBytes)
+	}))
+	defer srv.Close()
+
+	u := &upstream{
+		typ: "https",
+		url: srv.URL + "/dns-query",
+	}
+
+	m := &dns.Msg{}
+	m.SetQuestion("www.example.com.", dns.TypeA)
+
+	r, err := u.exchange(m)
+	assert.Nil(t, err, "%+v", err)
+	assert.Equal(t, m.Id, r.Id)
+	assert.Equal(t, dns.RcodeSuccess, r.Rcode)
+	assert.Equal(t, "1.2.3.4", r.Answer[0].(*dns.A).A.String())
+}
@@ -27,8 +27,9 @@ import (
 
 type zoneCache struct {
 	sync.RWMutex
-	cMap     map[string]*zoneCacheVal
-	duration time.Duration
+	cMap             map[string]*zoneCacheVal
+	duration         time.Duration
+	negativeDuration time.Duration
 }
 
 type zoneCacheVal struct {
@@ -36,14 +37,17 @@ type zoneCacheVal struct {
 	exp time.Time
 }
 
+const defaultNegativeCacheDuration = 10 * time.Second
+
 func newZoneCache(duration time.Duration) *zoneCache {
 	if duration == 0 {
 		duration = 30 * time.Second
 	}
 
 	return &zoneCache{
-		cMap:     make(map[string]*zoneCacheVal),
-		duration: duration,
+		cMap:             make(map[string]*zoneCacheVal),
+		duration:         duration,
+		negativeDuration: defaultNegativeCacheDuration,
 	}
 }
 
@@ -67,24 +71,66 @@ func (c *zoneCache) get(domain string, typ uint16) *dns.Msg {
 }
 
 func (c *zoneCache) set(domain string, typ uint16, r *dns.Msg) {
-	if r.Rcode != dns.RcodeSuccess {
-		return
-	}
-
 	switch typ {
 	case dns.TypeA, dns.TypeAAAA:
 	default:
 		return
 	}
 
+	isNegative := isNegativeAnswer(r)
+	if r.Rcode != dns.RcodeSuccess && !isNegative {
+		return
+	}
+
 	c.Lock()
-	c.cMap[c.getCacheKey(domain, typ)] = &zoneCacheVal{
-		r:   r,
-		exp: time.Now().Add(c.duration),
+	duration := c.duration
+	if isNegative {
+		duration = getNegativeCacheDuration(r, c.negativeDuration)
+	}
+
+	if duration > 0 {
+		c.cMap[c.getCacheKey(domain, typ)] = &zoneCacheVal{
+			r:   r,
+			exp: time.Now().Add(duration),
+		}
 	}
 	c.Unlock()
 }
 
+// isNegativeAnswer returns whether the answer is either an NXDOMAIN or a
+// NODATA one (i.e. a successful answer without any records).
+func isNegativeAnswer(r *dns.Msg) bool {
+	switch r.Rcode {
+	case dns.RcodeNameError:
+		return true
+	case dns.RcodeSuccess:
+		return len(r.Answer) == 0
+	default:
+		return false
+	}
+}
+
+// getNegativeCacheDuration returns the negative caching duration of the answer
+// which is bounded by the SOA record of the authority section, if it exists,
+// as described in RFC 2308.
+func getNegativeCacheDuration(r *dns.Msg, maxDuration time.Duration) time.Duration {
+	ret := maxDuration
+
+	for _, rr := range r.Ns {
+		soa, ok := rr.(*dns.SOA)
+		if !ok {
+			continue
+		}
+
+		ttl := min(soa.Hdr.Ttl, soa.Minttl)
+		if soaDuration := time.Duration(ttl) * time.Second; soaDuration < ret {
+			ret = soaDuration
+		}
+	}
+
+	return ret
+}
+
 func (c *zoneCache) startCleanupLoop(ctx context.Context) {
 	tickerCh := time.NewTicker(6 * time.Minute)
 	defer tickerCh.Stop()
@@ -124,3 +170,12 @@ func (c *zoneCache) setDuration(duration time.Duration) {
 	c.duration = duration
 	c.Unlock()
 }
+
+func (c *zoneCache) setNegativeDuration(duration time.Duration) {
+	c.Lock()
+	if duration == 0 {
+		duration = defaultNegativeCacheDuration
+	}
+	c.negativeDuration = duration
+	c.Unlock()
+}
@@ -18,6 +18,7 @@ package dnsserver
 
 import (
 	"testing"
+	"time"
 
 	"github.com/miekg/dns"
 	"github.com/stretchr/testify/assert"
@@ -63,3 +64,56 @@ func TestZoneCache(t *testing.T) {
 		assert.NotNil(t, cr)
 	}
 }
+
+func TestZoneCacheNegative(t *testing.T) {
+	zc := newZoneCache(0)
+
+	domain := "does-not.exist.com."
+	typ := dns.TypeA
+
+	{
+		m := &dns.Msg{}
+		m.SetQuestion(domain, typ)
+		r := &dns.Msg{}
+		r.SetRcode(m, dns.RcodeNameError)
+
+		zc.set(domain, typ, r)
+		cr := zc.get(domain, typ)
+		assert.NotNil(t, cr)
+		assert.Equal(t, dns.RcodeNameError, cr.Rcode)
+	}
+
+	{
+		m := &dns.Msg{}
+		m.SetQuestion("servfail.example.com.", typ)
+		r := &dns.Msg{}
+		r.SetRcode(m, dns.RcodeServerFailure)
+
+		zc.set("servfail.example.com.", typ, r)
+		assert.Nil(t, zc.get("servfail.example.com.", typ))
+	}
+
+	{
+		m := &dns.Msg{}
+		m.SetQuestion("soa.example.com.", typ)
+		r := &dns.Msg{}
+		r.SetRcode(m, dns.RcodeNameError)
+		r.Ns = append(r.Ns, &dns.SOA{
+			Hdr:    dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeSOA, Class: dns.ClassINET, Ttl: 3600},
+			Minttl: 0,
+		})
+
+		zc.set("soa.example.com.", typ, r)
+		assert.Nil(t, zc.get("soa.example.com.", typ))
+	}
+
+	{
+		r := &dns.Msg{}
+		r.Ns = append(r.Ns, &dns.SOA{
+			Hdr:    dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeSOA, Class: dns.ClassINET, Ttl: 3600},
+			Minttl: 2,
+		})
+		assert.Equal(t, 2*time.Second, getNegativeCacheDuration(r, defaultNegativeCacheDuration))
+		assert.Equal(t, defaultNegativeCacheDuration, getNegativeCacheDuration(&dns.Msg{}, defaultNegativeCacheDuration))
+	}
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dnsserver
+
+import (
+	"strings"
+
+	"github.com/miekg/dns"
+	"github.com/octelium/octelium/apis/main/corev1"
+	"go.uber.org/zap"
+)
+
+// conditionalZone is a zone whose queries are forwarded to its own upstreams
+// instead of the fallback upstreams.
+type conditionalZone struct {
+	domain    string
+	upstreams []*upstream
+	cache     *zoneCache
+}
+
+func (z *conditionalZone) chooseUpstream() *upstream {
+	return chooseUpstream(z.upstreams)
+}
+
+func (z *conditionalZone) contains(domain string) bool {
+	return domain == z.domain || strings.HasSuffix(domain, "."+z.domain)
+}
+
+func (s *DNSServer) setConditionalZones(cc *corev1.ClusterConfig) {
+	var zones []*conditionalZone
+
+	if cc != nil && cc.Spec.Dns != nil {
+		for _, cz := range cc.Spec.Dns.ConditionalZones {
+			if cz.Domain == "" || cz.Zone == nil {
+				continue
+			}
+
+			upstreams := parseUpstreams(cz.Zone.Servers)
+			if len(upstreams) == 0 {
+				zap.L().Warn("No valid upstreams for conditional zone. Skipping...",
+					zap.String("domain", cz.Domain))
+				continue
+			}
+
+			zone := &conditionalZone{
+				domain:    dns.CanonicalName(cz.Domain),
+				upstreams: upstreams,
+				cache:     newZoneCache(getZoneCacheDuration(cz.Zone)),
+			}
+			zone.cache.setNegativeDuration(getZoneNegativeCacheDuration(cz.Zone))
+
+			zones = append(zones, zone)
+		}
+	}
+
+	s.mu.Lock()
+	s.conditionalZones = zones
+	s.mu.Unlock()
+}
+
+// getConditionalZone returns the most specific conditional zone of the domain
+// if it exists.
+func (s *DNSServer) getConditionalZone(domain string) *conditionalZone {
+	domain = dns.CanonicalName(domain)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var ret *conditionalZone
+	for _, zone := range s.conditionalZones {
+		if !zone.contains(domain) {
+			continue
+		}
+		if ret == nil || len(zone.domain) > len(ret.domain) {
+			ret = zone
+		}
+	}
+
+	return ret
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dnsserver
+
+import (
+	"testing"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConditionalZones(t *testing.T) {
+	s := &DNSServer{}
+
+	s.setConditionalZones(&corev1.ClusterConfig{
+		Spec: &corev1.ClusterConfig_Spec{
+			Dns: &corev1.ClusterConfig_Spec_DNS{
+				ConditionalZones: []*corev1.ClusterConfig_Spec_DNS_ConditionalZone{
+					{
+						Domain: "corp.internal",
+						Zone: &corev1.ClusterConfig_Spec_DNS_Zone{
+							Servers: []string{"10.0.0.53"},
+						},
+					},
+					{
+						Domain: "eu.corp.internal",
+						Zone: &corev1.ClusterConfig_Spec_DNS_Zone{
+							Servers: []string{"tls://10.1.0.53"},
+						},
+					},
+					{
+						Domain: "invalid.internal",
+						Zone:   &corev1.ClusterConfig_Spec_DNS_Zone{},
+					},
+				},
+			},
+		},
+	})
+
+	assert.Equal(t, 2, len(s.conditionalZones))
+
+	assert.Equal(t, "corp.internal.", s.getConditionalZone("corp.internal.").domain)
+	assert.Equal(t, "corp.internal.", s.getConditionalZone("db.CORP.internal.").domain)
+	assert.Equal(t, "eu.corp.internal.", s.getConditionalZone("db.eu.corp.internal.").domain)
+	assert.Equal(t, "10.1.0.53:853", s.getConditionalZone("db.eu.corp.internal.").chooseUpstream().getAddr())
+	assert.Nil(t, s.getConditionalZone("xcorp.internal."))
+	assert.Nil(t, s.getConditionalZone("invalid.internal."))
+	assert.Nil(t, s.getConditionalZone("www.example.com."))
+}
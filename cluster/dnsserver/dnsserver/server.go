@@ -29,10 +29,30 @@ import (
 	"go.uber.org/zap"
 )
 
+type Opts struct {
+	// Port sets the local UDP port on which the DNS server listens. The
+	// default managed Service port is used if not set.
+	Port int
+	// IsEmbedded is set when the DNS server runs within another process,
+	// such as the standalone runtime, which already initializes the common
+	// telemetry and serves its own health checks.
+	IsEmbedded bool
+}
+
 func Run(ctx context.Context) error {
+	return RunWithOpts(ctx, nil)
+}
 
-	if err := commoninit.Run(ctx, nil); err != nil {
-		return err
+func RunWithOpts(ctx context.Context, o *Opts) error {
+
+	if o == nil {
+		o = &Opts{}
+	}
+
+	if !o.IsEmbedded {
+		if err := commoninit.Run(ctx, nil); err != nil {
+			return err
+		}
 	}
 
 	octeliumC, err := octeliumc.NewClient(ctx)
@@ -45,6 +65,8 @@ func Run(ctx context.Context) error {
 		return err
 	}
 
+	dnsServer.SetPort(o.Port)
+
 	if err := dnsServer.Run(ctx); err != nil {
 		return err
 	}
@@ -55,7 +77,9 @@ func Run(ctx context.Context) error {
 		return err
 	}
 
-	healthcheck.Run(vutils.HealthCheckPortManagedService)
+	if !o.IsEmbedded {
+		healthcheck.Run(vutils.HealthCheckPortManagedService)
+	}
 	zap.S().Info("DNS server is running")
 
 	<-ctx.Done()
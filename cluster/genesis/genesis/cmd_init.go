@@ -107,40 +107,9 @@ func (g *Genesis) RunInit(ctx context.Context) error {
 			return err
 		}
 
-		if _, err := rscSrv.GetDB().ExecContext(ctx, `TRUNCATE TABLE octelium_resources`); err != nil {
-			zap.L().Warn("Could not truncate the octelium_resources table", zap.Error(err))
-		}
-
-		redisC := rscSrv.GetRedisC()
-		if err := redisC.FlushDB(ctx).Err(); err != nil {
-			zap.L().Debug("Could not do Redis flushDB. Trying to manually delete keys", zap.Error(err))
-
-			keys, err := redisC.Keys(ctx, "*").Result()
-			if err == nil {
-				if len(keys) > 0 {
-					if err := redisC.Del(ctx, keys...).Err(); err != nil {
-						zap.L().Warn("Could not delete Redis database keys", zap.Error(err))
-					}
-				}
-			} else {
-				zap.L().Warn("Could not fetch current Redis keys", zap.Error(err))
-			}
-		}
-
-		clusterCfgI, err := rscSrv.CreateResource(ctx,
-			clusterCfg, ucorev1.API, ucorev1.Version, ucorev1.KindClusterConfig)
-		if err != nil {
-			return err
-		}
-
-		iCtx.ClusterConfig = clusterCfgI.(*corev1.ClusterConfig)
-
-		regionI, err := rscSrv.CreateResource(ctx,
-			region, ucorev1.API, ucorev1.Version, ucorev1.KindRegion)
-		if err != nil {
+		if err := initClusterResources(ctx, rscSrv, iCtx); err != nil {
 			return err
 		}
-		iCtx.Region = regionI.(*corev1.Region)
 	}
 
 	zap.L().Debug("creating rscServer")
@@ -159,6 +128,12 @@ func (g *Genesis) RunInit(ctx context.Context) error {
 			return err
 		}
 	} else {
+		if bootstrap.Spec.SecretManager != nil {
+			if err := g.installSecretMan(ctx, clusterCfg, bootstrap); err != nil {
+				return err
+			}
+		}
+
 		if err := components.CreateRscServer(ctx, g.k8sC, clusterCfg); err != nil {
 			return err
 		}
@@ -207,6 +182,10 @@ func (g *Genesis) RunInit(ctx context.Context) error {
 		return err
 	}
 
+	if err := k8sutils.WaitReadinessDeployment(ctx, g.k8sC, "octelium-nocturne"); err != nil {
+		return err
+	}
+
 	if err := g.installOcteliumResources(ctx, clusterCfg, iCtx.Region); err != nil {
 		return err
 	}
@@ -242,6 +221,60 @@ func (g *Genesis) RunInit(ctx context.Context) error {
 	return nil
 }
 
+// initClusterResources resets the storage from any previous installation and then
+// creates the ClusterConfig and the Region directly via the Resource server
+// since they cannot be created via the API.
+func initClusterResources(ctx context.Context, rscSrv *rscserver.Server, iCtx *genesisutils.InstallCtx) error {
+	if _, err := rscSrv.GetDB().ExecContext(ctx, `TRUNCATE TABLE octelium_resources`); err != nil {
+		zap.L().Warn("Could not truncate the octelium_resources table", zap.Error(err))
+	}
+
+	redisC := rscSrv.GetRedisC()
+	if err := redisC.FlushDB(ctx).Err(); err != nil {
+		zap.L().Debug("Could not do Redis flushDB. Trying to manually delete keys", zap.Error(err))
+
+		keys, err := redisC.Keys(ctx, "*").Result()
+		if err == nil {
+			if len(keys) > 0 {
+				if err := redisC.Del(ctx, keys...).Err(); err != nil {
+					zap.L().Warn("Could not delete Redis database keys", zap.Error(err))
+				}
+			}
+		} else {
+			zap.L().Warn("Could not fetch current Redis keys", zap.Error(err))
+		}
+	}
+
+	clusterCfgI, err := rscSrv.CreateResource(ctx,
+		iCtx.ClusterConfig, ucorev1.API, ucorev1.Version, ucorev1.KindClusterConfig)
+	if err != nil {
+		return err
+	}
+
+	iCtx.ClusterConfig = clusterCfgI.(*corev1.ClusterConfig)
+
+	regionI, err := rscSrv.CreateResource(ctx,
+		iCtx.Region, ucorev1.API, ucorev1.Version, ucorev1.KindRegion)
+	if err != nil {
+		return err
+	}
+	iCtx.Region = regionI.(*corev1.Region)
+
+	return nil
+}
+
+func (g *Genesis) installSecretMan(ctx context.Context, clusterCfg *corev1.ClusterConfig, bootstrap *cbootstrapv1.Config) error {
+	zap.L().Debug("Installing the secret manager")
+
+	if err := components.CreateSecretMan(ctx, g.k8sC, clusterCfg, bootstrap.Spec.SecretManager); err != nil {
+		return err
+	}
+
+	zap.L().Debug("Waiting for readiness of the secret manager")
+
+	return k8sutils.WaitReadinessDeployment(ctx, g.k8sC, "octelium-secretman")
+}
+
 func (g *Genesis) setInitClusterCertificate(ctx context.Context, clusterCfg *corev1.ClusterConfig) error {
 
 	now := time.Now()
@@ -380,10 +413,6 @@ func (g *Genesis) setBootstrapSecret(ctx context.Context, bs *cbootstrapv1.Confi
 func (g *Genesis) installOcteliumResources(ctx context.Context, clusterCfg *corev1.ClusterConfig, region *corev1.Region) error {
 	zap.L().Debug("Installing Octelium resources")
 
-	if err := k8sutils.WaitReadinessDeployment(ctx, g.k8sC, "octelium-nocturne"); err != nil {
-		return err
-	}
-
 	zap.L().Debug("Creating system Namespaces and Services")
 
 	if err := genesisutils.CreateOrUpdateNamespace(ctx, g.octeliumC, &corev1.Namespace{
@@ -656,6 +685,12 @@ func (g *Genesis) installOcteliumResources(ctx context.Context, clusterCfg *core
 		}
 	}
 
+	if g.isStandalone {
+		// The demo Service is upstreamed by a managed container which
+		// requires Kubernetes.
+		return nil
+	}
+
 	{
 		svc := &corev1.Service{
 			Metadata: &metav1.Metadata{
@@ -789,6 +824,16 @@ func (g *Genesis) initClusterConfig(ctx context.Context, bootstrap *cbootstrapv1
 		}
 	}
 
+	if bootstrap.Spec.SecretManager != nil {
+		if vault := bootstrap.Spec.SecretManager.GetVault(); vault != nil && (vault.Address == "" || vault.Token == "") {
+			return nil, errors.Errorf("Vault secret manager address and token must be set")
+		}
+
+		clusterCfg.Status.SecretManager = &corev1.ClusterConfig_Status_SecretManager{
+			Address: components.GetSecretManAddress(),
+		}
+	}
+
 	clusterCfg.Status.Network.V6RangePrefix = v6Prefix
 	if err := clusterconfig.SetClusterSubnets(clusterCfg); err != nil {
 		return nil, err
@@ -924,6 +969,30 @@ func (g *Genesis) createAESKey(ctx context.Context) error {
 }
 
 func (g *Genesis) createInitAuthenticationToken(ctx context.Context) error {
+	tkn, err := g.generateInitAuthenticationToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = g.k8sC.CoreV1().Secrets(vutils.K8sNS).Create(ctx, &k8scorev1.Secret{
+		ObjectMeta: k8smetav1.ObjectMeta{
+			Name:      "init-token",
+			Namespace: vutils.K8sNS,
+		},
+		Data: map[string][]byte{
+			"data": []byte(tkn),
+		},
+	}, k8smetav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+
+	zap.L().Debug("Successfully created the root User initial authentication token")
+
+	return nil
+}
+
+func (g *Genesis) generateInitAuthenticationToken(ctx context.Context) (string, error) {
 	zap.L().Debug("Creating the initial root authentication token")
 
 	adminSrv := admin.NewServer(&admin.Opts{
@@ -963,32 +1032,17 @@ func (g *Genesis) createInitAuthenticationToken(ctx context.Context) error {
 		},
 	})
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	tkn, err := adminSrv.GenerateCredentialToken(ctx, &corev1.GenerateCredentialTokenRequest{
 		CredentialRef: umetav1.GetObjectReference(cred),
 	})
 	if err != nil {
-		return err
-	}
-
-	_, err = g.k8sC.CoreV1().Secrets(vutils.K8sNS).Create(ctx, &k8scorev1.Secret{
-		ObjectMeta: k8smetav1.ObjectMeta{
-			Name:      "init-token",
-			Namespace: vutils.K8sNS,
-		},
-		Data: map[string][]byte{
-			"data": []byte(tkn.GetAuthenticationToken().AuthenticationToken),
-		},
-	}, k8smetav1.CreateOptions{})
-	if err != nil {
-		return err
+		return "", err
 	}
 
-	zap.L().Debug("Successfully created the root User initial authentication token")
-
-	return nil
+	return tkn.GetAuthenticationToken().AuthenticationToken, nil
 }
 
 func (g *Genesis) setNamespace(ctx context.Context) error {
@@ -68,6 +68,12 @@ func (g *Genesis) RunUpgrade(ctx context.Context) error {
 		return err
 	}
 
+	if clusterCfg.Status.SecretManager != nil && initResources.Bootstrap.Spec.SecretManager != nil {
+		if err := g.installSecretMan(ctx, clusterCfg, initResources.Bootstrap); err != nil {
+			return err
+		}
+	}
+
 	zap.L().Debug("upgrading rscServer")
 
 	if err := components.CreateRscServer(ctx, g.k8sC, clusterCfg); err != nil {
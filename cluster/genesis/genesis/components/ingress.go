@@ -121,16 +121,7 @@ func getIngressDataPlaneService(c *corev1.ClusterConfig, r *corev1.Region) *k8sc
 				}
 				return externalIPs
 			}(),
-			Ports: []k8scorev1.ServicePort{
-				{
-					Protocol: k8scorev1.ProtocolTCP,
-					Port:     443,
-					TargetPort: intstr.IntOrString{
-						Type:   intstr.Int,
-						IntVal: 8080,
-					},
-				},
-			},
+			Ports: k8sutils.GetIngressDataPlaneServicePorts(c),
 		},
 	}
 
@@ -22,10 +22,12 @@ import (
 	"github.com/octelium/octelium/apis/main/corev1"
 	"github.com/octelium/octelium/cluster/common/components"
 	"github.com/octelium/octelium/cluster/common/k8sutils"
+	"github.com/octelium/octelium/cluster/common/ocrypto/keyring"
 	utils_types "github.com/octelium/octelium/pkg/utils/types"
 	appsv1 "k8s.io/api/apps/v1"
 	k8scorev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -119,6 +121,14 @@ func getRscServerDeployment(c *corev1.ClusterConfig) *appsv1.Deployment {
 							Image:           components.GetImage(components.RscServer, ""),
 							ImagePullPolicy: k8sutils.GetImagePullPolicy(),
 
+							VolumeMounts: []k8scorev1.VolumeMount{
+								{
+									Name:      "kek",
+									MountPath: keyring.DefaultDir,
+									ReadOnly:  true,
+								},
+							},
+
 							Resources: k8scorev1.ResourceRequirements{
 								Requests: getDefaultRequests(),
 								Limits: k8scorev1.ResourceList{
@@ -128,6 +138,16 @@ func getRscServerDeployment(c *corev1.ClusterConfig) *appsv1.Deployment {
 							},
 						},
 					},
+					Volumes: []k8scorev1.Volume{
+						{
+							Name: "kek",
+							VolumeSource: k8scorev1.VolumeSource{
+								Secret: &k8scorev1.SecretVolumeSource{
+									SecretName: keyring.K8sSecretName,
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -135,6 +155,38 @@ func getRscServerDeployment(c *corev1.ClusterConfig) *appsv1.Deployment {
 	return deployment
 }
 
+// createRscServerKEKSecret creates the KEK keyring k8s Secret used to encrypt
+// Secrets at rest if it does not already exist. An existing keyring is never
+// overwritten since it is required to decrypt the existing Secrets. The keyring
+// is rotated via "octops rotate-kek".
+func createRscServerKEKSecret(ctx context.Context, c kubernetes.Interface) error {
+	_, err := c.CoreV1().Secrets(ns).Get(ctx, keyring.K8sSecretName, metav1.GetOptions{})
+	if err == nil {
+		return nil
+	}
+	if !k8serr.IsNotFound(err) {
+		return err
+	}
+
+	data := make(map[string][]byte)
+	if _, err := keyring.AddKey(data); err != nil {
+		return err
+	}
+
+	_, err = c.CoreV1().Secrets(ns).Create(ctx, &k8scorev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      keyring.K8sSecretName,
+			Namespace: ns,
+		},
+		Data: data,
+	}, metav1.CreateOptions{})
+	if err != nil && !k8serr.IsAlreadyExists(err) {
+		return err
+	}
+
+	return nil
+}
+
 func getRscServerNetworkPolicy(c *corev1.ClusterConfig) *networkingv1.NetworkPolicy {
 	return &networkingv1.NetworkPolicy{
 		ObjectMeta: metav1.ObjectMeta{
@@ -191,6 +243,10 @@ func getRscServerNetworkPolicy(c *corev1.ClusterConfig) *networkingv1.NetworkPol
 
 func CreateRscServer(ctx context.Context, c kubernetes.Interface, clusterCfg *corev1.ClusterConfig) error {
 
+	if err := createRscServerKEKSecret(ctx, c); err != nil {
+		return err
+	}
+
 	if _, err := k8sutils.CreateOrUpdateDeployment(ctx, c, getRscServerDeployment(clusterCfg)); err != nil {
 		return err
 	}
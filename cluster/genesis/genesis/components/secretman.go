@@ -0,0 +1,391 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package components
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/octelium/octelium/apis/cluster/cbootstrapv1"
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/cluster/common/components"
+	"github.com/octelium/octelium/cluster/common/k8sutils"
+	"github.com/octelium/octelium/cluster/common/ocrypto/keyring"
+	utils_types "github.com/octelium/octelium/pkg/utils/types"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	k8scorev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+)
+
+const componentSecretMan = "secretman"
+
+const secretManVaultSecretName = "octelium-secretman-vault"
+const secretManFileKEKSecretName = "octelium-secretman-kek"
+
+// GetSecretManAddress returns the address of the secret manager component to be
+// set in the ClusterConfig status.
+func GetSecretManAddress() string {
+	return fmt.Sprintf("%s.%s.svc:8080", getComponentName(componentSecretMan), ns)
+}
+
+func getSecretManService() *k8scorev1.Service {
+	return &k8scorev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getComponentName(componentSecretMan),
+			Namespace: ns,
+			Labels:    getComponentLabels(componentSecretMan),
+		},
+		Spec: k8scorev1.ServiceSpec{
+			Type:     k8scorev1.ServiceTypeClusterIP,
+			Selector: getComponentLabels(componentSecretMan),
+			Ports: []k8scorev1.ServicePort{
+				{
+					Protocol: k8scorev1.ProtocolTCP,
+					Port:     8080,
+					TargetPort: intstr.IntOrString{
+						Type:   intstr.Int,
+						IntVal: 8080,
+					},
+				},
+			},
+		},
+	}
+}
+
+func getSecretManEnv(cfg *cbootstrapv1.Config_Spec_SecretManager) []k8scorev1.EnvVar {
+	switch cfg.Type.(type) {
+	case *cbootstrapv1.Config_Spec_SecretManager_Vault_:
+		vault := cfg.GetVault()
+		return []k8scorev1.EnvVar{
+			{
+				Name:  "OCTELIUM_SECRETMAN_BACKEND",
+				Value: "vault",
+			},
+			{
+				Name:  "OCTELIUM_SECRETMAN_VAULT_ADDRESS",
+				Value: vault.Address,
+			},
+			{
+				Name:  "OCTELIUM_SECRETMAN_VAULT_MOUNT",
+				Value: vault.Mount,
+			},
+			{
+				Name:  "OCTELIUM_SECRETMAN_VAULT_PATH_PREFIX",
+				Value: vault.PathPrefix,
+			},
+			{
+				Name:  "OCTELIUM_SECRETMAN_VAULT_NAMESPACE",
+				Value: vault.Namespace,
+			},
+			{
+				Name: "OCTELIUM_SECRETMAN_VAULT_TOKEN",
+				ValueFrom: &k8scorev1.EnvVarSource{
+					SecretKeyRef: &k8scorev1.SecretKeySelector{
+						LocalObjectReference: k8scorev1.LocalObjectReference{
+							Name: secretManVaultSecretName,
+						},
+						Key: "token",
+					},
+				},
+			},
+		}
+	default:
+		return []k8scorev1.EnvVar{
+			{
+				Name:  "OCTELIUM_SECRETMAN_BACKEND",
+				Value: "file",
+			},
+		}
+	}
+}
+
+func getSecretManDeployment(c *corev1.ClusterConfig, cfg *cbootstrapv1.Config_Spec_SecretManager) *appsv1.Deployment {
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getComponentName(componentSecretMan),
+			Namespace: ns,
+			Labels:    getComponentLabels(componentSecretMan),
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: nil,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: getComponentLabels(componentSecretMan),
+			},
+			Template: k8scorev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      getComponentLabels(componentSecretMan),
+					Annotations: getAnnotations(),
+				},
+				Spec: k8scorev1.PodSpec{
+					AutomountServiceAccountToken: utils_types.BoolToPtr(false),
+					NodeSelector:                 getNodeSelectorControlPlane(c),
+
+					Containers: []k8scorev1.Container{
+						{
+							Name:            componentSecretMan,
+							Image:           components.GetImage(components.SecretMan, ""),
+							ImagePullPolicy: k8sutils.GetImagePullPolicy(),
+							Env:             getSecretManEnv(cfg),
+
+							ReadinessProbe: &k8scorev1.Probe{
+								InitialDelaySeconds: 5,
+								TimeoutSeconds:      4,
+								PeriodSeconds:       20,
+								FailureThreshold:    3,
+								ProbeHandler: k8scorev1.ProbeHandler{
+									GRPC: &k8scorev1.GRPCAction{
+										Port: int32(8080),
+									},
+								},
+							},
+
+							LivenessProbe: &k8scorev1.Probe{
+								InitialDelaySeconds: 60,
+								TimeoutSeconds:      4,
+								PeriodSeconds:       30,
+								FailureThreshold:    3,
+								ProbeHandler: k8scorev1.ProbeHandler{
+									GRPC: &k8scorev1.GRPCAction{
+										Port: int32(8080),
+									},
+								},
+							},
+
+							Resources: getDefaultResourceRequirements(),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if cfg.GetFile() != nil {
+		// The file backend's persistent volume can only be mounted by a single Pod
+		deployment.Spec.Replicas = utils_types.Int32ToPtr(1)
+		deployment.Spec.Strategy = appsv1.DeploymentStrategy{
+			Type: appsv1.RecreateDeploymentStrategyType,
+		}
+
+		podSpec := &deployment.Spec.Template.Spec
+		podSpec.Containers[0].VolumeMounts = []k8scorev1.VolumeMount{
+			{
+				Name:      "data",
+				MountPath: "/var/lib/octelium/secretman",
+			},
+			{
+				Name:      "kek",
+				MountPath: "/etc/octelium/secretman/kek",
+				ReadOnly:  true,
+			},
+		}
+		podSpec.Volumes = []k8scorev1.Volume{
+			{
+				Name: "data",
+				VolumeSource: k8scorev1.VolumeSource{
+					PersistentVolumeClaim: &k8scorev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: getComponentName(componentSecretMan),
+					},
+				},
+			},
+			{
+				Name: "kek",
+				VolumeSource: k8scorev1.VolumeSource{
+					Secret: &k8scorev1.SecretVolumeSource{
+						SecretName: secretManFileKEKSecretName,
+					},
+				},
+			},
+		}
+		podSpec.SecurityContext = &k8scorev1.PodSecurityContext{
+			FSGroup: utils_types.Int64ToPtr(3454),
+		}
+	}
+
+	return deployment
+}
+
+func getSecretManPVC(cfg *cbootstrapv1.Config_Spec_SecretManager_File) (*k8scorev1.PersistentVolumeClaim, error) {
+	size := cfg.Size
+	if size == "" {
+		size = "1Gi"
+	}
+
+	quantity, err := resource.ParseQuantity(size)
+	if err != nil {
+		return nil, errors.Errorf("Invalid secret manager volume size: %s", size)
+	}
+
+	ret := &k8scorev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getComponentName(componentSecretMan),
+			Namespace: ns,
+			Labels:    getComponentLabels(componentSecretMan),
+		},
+		Spec: k8scorev1.PersistentVolumeClaimSpec{
+			AccessModes: []k8scorev1.PersistentVolumeAccessMode{
+				k8scorev1.ReadWriteOnce,
+			},
+			Resources: k8scorev1.VolumeResourceRequirements{
+				Requests: k8scorev1.ResourceList{
+					k8scorev1.ResourceStorage: quantity,
+				},
+			},
+		},
+	}
+
+	if cfg.StorageClassName != "" {
+		ret.Spec.StorageClassName = utils_types.StrToPtr(cfg.StorageClassName)
+	}
+
+	return ret, nil
+}
+
+func getSecretManNetworkPolicy() *networkingv1.NetworkPolicy {
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getComponentName(componentSecretMan),
+			Namespace: ns,
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: getComponentLabels(componentSecretMan),
+			},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{
+				{
+					Ports: []networkingv1.NetworkPolicyPort{
+						{
+							Protocol: &tcpProtocol,
+							Port: &intstr.IntOrString{
+								IntVal: 8080,
+							},
+						},
+					},
+					From: []networkingv1.NetworkPolicyPeer{
+						{
+							// Only the rscserver talks to the secret manager
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: getComponentLabels(componentRscServer),
+							},
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{
+									"kubernetes.io/metadata.name": ns,
+								},
+							},
+						},
+					},
+				},
+			},
+			PolicyTypes: []networkingv1.PolicyType{
+				networkingv1.PolicyTypeIngress,
+			},
+		},
+	}
+}
+
+// createSecretManSecrets creates the k8s Secrets required by the backend. The
+// file backend keyring is never overwritten since it is required to decrypt the
+// existing data.
+func createSecretManSecrets(ctx context.Context, c kubernetes.Interface, cfg *cbootstrapv1.Config_Spec_SecretManager) error {
+	switch cfg.Type.(type) {
+	case *cbootstrapv1.Config_Spec_SecretManager_Vault_:
+		if cfg.GetVault().Token == "" {
+			return nil
+		}
+
+		_, err := k8sutils.CreateOrUpdateSecret(ctx, c, &k8scorev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      secretManVaultSecretName,
+				Namespace: ns,
+			},
+			Data: map[string][]byte{
+				"token": []byte(cfg.GetVault().Token),
+			},
+		})
+		return err
+	case *cbootstrapv1.Config_Spec_SecretManager_File_:
+		_, err := c.CoreV1().Secrets(ns).Get(ctx, secretManFileKEKSecretName, metav1.GetOptions{})
+		if err == nil {
+			return nil
+		}
+		if !k8serr.IsNotFound(err) {
+			return err
+		}
+
+		data := make(map[string][]byte)
+		if _, err := keyring.AddKey(data); err != nil {
+			return err
+		}
+
+		_, err = c.CoreV1().Secrets(ns).Create(ctx, &k8scorev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      secretManFileKEKSecretName,
+				Namespace: ns,
+			},
+			Data: data,
+		}, metav1.CreateOptions{})
+		if err != nil && !k8serr.IsAlreadyExists(err) {
+			return err
+		}
+		return nil
+	default:
+		return errors.Errorf("Unknown secret manager type")
+	}
+}
+
+func CreateSecretMan(ctx context.Context, c kubernetes.Interface,
+	clusterCfg *corev1.ClusterConfig, cfg *cbootstrapv1.Config_Spec_SecretManager) error {
+	if cfg == nil {
+		return errors.Errorf("Nil secret manager config")
+	}
+
+	if err := createSecretManSecrets(ctx, c, cfg); err != nil {
+		return err
+	}
+
+	if cfg.GetFile() != nil {
+		pvc, err := getSecretManPVC(cfg.GetFile())
+		if err != nil {
+			return err
+		}
+
+		if _, err := c.CoreV1().PersistentVolumeClaims(ns).Create(ctx, pvc, metav1.CreateOptions{}); err != nil &&
+			!k8serr.IsAlreadyExists(err) {
+			return err
+		}
+	}
+
+	if _, err := k8sutils.CreateOrUpdateDeployment(ctx, c, getSecretManDeployment(clusterCfg, cfg)); err != nil {
+		return err
+	}
+
+	if _, err := k8sutils.CreateOrUpdateService(ctx, c, getSecretManService()); err != nil {
+		return err
+	}
+
+	if _, err := k8sutils.CreateOrUpdateNetworkPolicy(ctx, c, getSecretManNetworkPolicy()); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package components
+
+import (
+	"context"
+	"testing"
+
+	"github.com/octelium/octelium/apis/cluster/cbootstrapv1"
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/cluster/common/ocrypto/keyring"
+	"github.com/stretchr/testify/assert"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCreateSecretMan(t *testing.T) {
+	ctx := context.Background()
+
+	clusterCfg := &corev1.ClusterConfig{
+		Metadata: &metav1.Metadata{},
+		Spec:     &corev1.ClusterConfig_Spec{},
+		Status:   &corev1.ClusterConfig_Status{},
+	}
+
+	{
+		k8sC := fake.NewClientset()
+		cfg := &cbootstrapv1.Config_Spec_SecretManager{
+			Type: &cbootstrapv1.Config_Spec_SecretManager_File_{
+				File: &cbootstrapv1.Config_Spec_SecretManager_File{
+					Size: "2Gi",
+				},
+			},
+		}
+
+		assert.Nil(t, CreateSecretMan(ctx, k8sC, clusterCfg, cfg))
+
+		pvc, err := k8sC.CoreV1().PersistentVolumeClaims(ns).Get(ctx, "octelium-secretman", k8smetav1.GetOptions{})
+		assert.Nil(t, err)
+		assert.Equal(t, "2Gi", pvc.Spec.Resources.Requests.Storage().String())
+
+		sec, err := k8sC.CoreV1().Secrets(ns).Get(ctx, secretManFileKEKSecretName, k8smetav1.GetOptions{})
+		assert.Nil(t, err)
+		kr, err := keyring.New(sec.Data)
+		assert.Nil(t, err)
+
+		assert.Nil(t, CreateSecretMan(ctx, k8sC, clusterCfg, cfg))
+		sec, err = k8sC.CoreV1().Secrets(ns).Get(ctx, secretManFileKEKSecretName, k8smetav1.GetOptions{})
+		assert.Nil(t, err)
+		kr2, err := keyring.New(sec.Data)
+		assert.Nil(t, err)
+		assert.Equal(t, kr.CurrentID(), kr2.CurrentID(), "the keyring must not be overwritten")
+
+		dep, err := k8sC.AppsV1().Deployments(ns).Get(ctx, "octelium-secretman", k8smetav1.GetOptions{})
+		assert.Nil(t, err)
+		assert.Equal(t, int32(1), *dep.Spec.Replicas)
+		assert.Len(t, dep.Spec.Template.Spec.Volumes, 2)
+	}
+
+	{
+		k8sC := fake.NewClientset()
+		cfg := &cbootstrapv1.Config_Spec_SecretManager{
+			Type: &cbootstrapv1.Config_Spec_SecretManager_Vault_{
+				Vault: &cbootstrapv1.Config_Spec_SecretManager_Vault{
+					Address: "https://vault.example.com:8200",
+					Token:   "token",
+				},
+			},
+		}
+
+		assert.Nil(t, CreateSecretMan(ctx, k8sC, clusterCfg, cfg))
+
+		sec, err := k8sC.CoreV1().Secrets(ns).Get(ctx, secretManVaultSecretName, k8smetav1.GetOptions{})
+		assert.Nil(t, err)
+		assert.Equal(t, "token", string(sec.Data["token"]))
+
+		_, err = k8sC.CoreV1().PersistentVolumeClaims(ns).Get(ctx, "octelium-secretman", k8smetav1.GetOptions{})
+		assert.NotNil(t, err)
+
+		dep, err := k8sC.AppsV1().Deployments(ns).Get(ctx, "octelium-secretman", k8smetav1.GetOptions{})
+		assert.Nil(t, err)
+		assert.Len(t, dep.Spec.Template.Spec.Volumes, 0)
+
+		envMap := make(map[string]string)
+		for _, env := range dep.Spec.Template.Spec.Containers[0].Env {
+			envMap[env.Name] = env.Value
+		}
+		assert.Equal(t, "vault", envMap["OCTELIUM_SECRETMAN_BACKEND"])
+		assert.Equal(t, "https://vault.example.com:8200", envMap["OCTELIUM_SECRETMAN_VAULT_ADDRESS"])
+	}
+}
@@ -38,6 +38,8 @@ type Genesis struct {
 	k8sC      kubernetes.Interface
 	octeliumC octeliumc.ClientInterface
 	nadC      nadclientset.Interface
+
+	isStandalone bool
 }
 
 func NewGenesis() (*Genesis, error) {
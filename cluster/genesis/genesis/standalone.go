@@ -0,0 +1,132 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package genesis
+
+import (
+	"context"
+
+	"github.com/octelium/octelium/apis/cluster/cbootstrapv1"
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/cluster/common/jwkctl/jwkutils"
+	"github.com/octelium/octelium/cluster/common/octeliumc"
+	"github.com/octelium/octelium/cluster/genesis/genesis/genesisutils"
+	"github.com/octelium/octelium/cluster/rscserver/rscserver"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// NewStandalone creates a Genesis for the standalone runtime where the
+// Cluster runs without Kubernetes. The Resource server must already be
+// running and reachable by the provided client.
+func NewStandalone(octeliumC octeliumc.ClientInterface) *Genesis {
+	return &Genesis{
+		octeliumC:    octeliumC,
+		isStandalone: true,
+	}
+}
+
+type StandaloneInitOpts struct {
+	Bootstrap *cbootstrapv1.Config
+	// Region is the initial Region. A "default" Region is used if not set.
+	Region *corev1.Region
+	Domain string
+}
+
+// RunInitStandalone initializes the Cluster resources in the standalone
+// runtime and returns the initial root authentication token. The components
+// are not installed since the standalone runtime runs them in-process.
+func (g *Genesis) RunInitStandalone(ctx context.Context,
+	rscSrv *rscserver.Server, o *StandaloneInitOpts) (string, error) {
+	zap.L().Info("Starting initializing the standalone Cluster")
+
+	if o == nil || o.Bootstrap == nil || o.Bootstrap.Spec == nil {
+		return "", errors.Errorf("Nil Bootstrap")
+	}
+
+	if o.Domain == "" {
+		return "", errors.Errorf("No Cluster domain")
+	}
+
+	region, err := g.initRegion(&LoadedClusterResource{
+		Bootstrap: o.Bootstrap,
+		Region:    o.Region,
+		Domain:    o.Domain,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	clusterCfg, err := g.initClusterConfig(ctx, o.Bootstrap, o.Domain)
+	if err != nil {
+		return "", err
+	}
+
+	iCtx := &genesisutils.InstallCtx{
+		ClusterConfig: clusterCfg,
+		Region:        region,
+		Bootstrap:     o.Bootstrap,
+	}
+
+	if err := initClusterResources(ctx, rscSrv, iCtx); err != nil {
+		return "", err
+	}
+
+	if err := g.createUsersGroups(ctx, iCtx.ClusterConfig); err != nil {
+		return "", err
+	}
+
+	if err := g.createSSHCA(ctx); err != nil {
+		return "", err
+	}
+
+	if err := g.createAESKey(ctx); err != nil {
+		return "", err
+	}
+
+	if _, err := jwkutils.CreateJWKSecret(ctx, g.octeliumC); err != nil {
+		return "", err
+	}
+
+	if err := g.setConnInfoConfig(ctx); err != nil {
+		return "", err
+	}
+
+	if err := g.installOcteliumResources(ctx, iCtx.ClusterConfig, iCtx.Region); err != nil {
+		return "", err
+	}
+
+	if err := g.setInitClusterCertificate(ctx, iCtx.ClusterConfig); err != nil {
+		return "", err
+	}
+
+	if err := g.installBuiltinPolicies(ctx); err != nil {
+		zap.L().Warn("Could not install builtin Policies", zap.Error(err))
+	}
+
+	tkn, err := g.generateInitAuthenticationToken(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if err := g.setBootstrapSecret(ctx, o.Bootstrap); err != nil {
+		return "", err
+	}
+
+	zap.L().Info("Successfully initialized the standalone Cluster")
+
+	return tkn, nil
+}
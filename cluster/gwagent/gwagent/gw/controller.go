@@ -43,7 +43,7 @@ func InitGateway(ctx context.Context,
 	node *k8scorev1.Node,
 
 	octeliumC octeliumc.ClientInterface,
-	regionIdx int, regionRef *metav1.ObjectReference, privateKey wgtypes.Key) error {
+	regionIdx int, regionRef *metav1.ObjectReference, privateKey wgtypes.Key, hasCNI bool) error {
 
 	cc, err := octeliumC.CoreV1Utils().GetClusterConfig(ctx)
 	if err != nil {
@@ -61,6 +61,14 @@ func InitGateway(ctx context.Context,
 			gw.Status.Wireguard.PublicKey = privateKey.PublicKey().String()
 			gw.Status.Wireguard.KeyRotatedAt = pbutils.Now()
 
+			if gw.Status.Masque == nil {
+				gw.Status.Masque = &corev1.Gateway_Status_MASQUE{
+					Port: int32(ucorev1.ToClusterConfig(cc).GetGatewayPortMASQUE()),
+				}
+			}
+
+			gw.Status.Tls = getGatewayTLS(node, cc)
+
 			if len(publicIPs) > 0 {
 				gw.Status.PublicIPs = publicIPs
 			}
@@ -97,8 +105,10 @@ func InitGateway(ctx context.Context,
 		return err
 	}
 
-	if err := addCNI(gw, cc); err != nil {
-		return err
+	if hasCNI {
+		if err := addCNI(gw, cc); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -169,6 +179,10 @@ func getGateway(nodeIdx int, publicIPs []string, node *k8scorev1.Node, privateKe
 			Quicv0: &corev1.Gateway_Status_QUICV0{
 				Port: int32(ucorev1.ToClusterConfig(cc).GetGatewayPortQUICv0()),
 			},
+			Masque: &corev1.Gateway_Status_MASQUE{
+				Port: int32(ucorev1.ToClusterConfig(cc).GetGatewayPortMASQUE()),
+			},
+			Tls: getGatewayTLS(node, cc),
 
 			PublicIPs: publicIPs,
 			Id:        gwID,
@@ -181,6 +195,24 @@ func getGateway(nodeIdx int, publicIPs []string, node *k8scorev1.Node, privateKe
 	return ret, nil
 }
 
+// getGatewayTLS returns the TLS fallback tunnel listener information. The
+// listener is reached by the ingress over the node's internal address since
+// the gwagent runs in the host network namespace.
+func getGatewayTLS(node *k8scorev1.Node, cc *corev1.ClusterConfig) *corev1.Gateway_Status_TLS {
+	ret := &corev1.Gateway_Status_TLS{
+		Port: int32(ucorev1.ToClusterConfig(cc).GetGatewayPortTLS()),
+	}
+
+	for _, addr := range node.Status.Addresses {
+		if addr.Type == k8scorev1.NodeInternalIP {
+			ret.Address = addr.Address
+			break
+		}
+	}
+
+	return ret
+}
+
 type subnet struct {
 	V4 *net.IPNet
 	V6 *net.IPNet
@@ -34,6 +34,11 @@ import (
 
 func (s *Server) setNodePublicIPs(ctx context.Context) error {
 
+	if len(s.publicIPs) > 0 {
+		zap.L().Debug("Using the preset node public IP addresses", zap.Strings("addrs", s.publicIPs))
+		return nil
+	}
+
 	node := s.node
 
 	if nIP, ok := node.Annotations["octelium.com/public-ip-test"]; ok {
@@ -103,6 +108,10 @@ func (s *Server) doAppendPublicIPAddr(addr string) {
 }
 
 func (s *Server) setExternalIPFromNode(ctx context.Context) error {
+	if s.k8sC == nil {
+		return errors.Errorf("No k8s client")
+	}
+
 	node, err := s.k8sC.CoreV1().Nodes().Get(ctx, s.nodeName, k8smetav1.GetOptions{})
 	if err != nil {
 		return err
@@ -21,6 +21,7 @@ import (
 	"crypto/tls"
 	"encoding/binary"
 	"fmt"
+	"net/http"
 	"net/netip"
 	"sync"
 	"time"
@@ -37,6 +38,7 @@ import (
 	"github.com/octelium/octelium/pkg/grpcerr"
 	"github.com/pkg/errors"
 	"github.com/quic-go/quic-go"
+	"github.com/quic-go/quic-go/http3"
 	"go.uber.org/zap"
 	"golang.zx2c4.com/wireguard/tun"
 )
@@ -45,6 +47,11 @@ type QUICController struct {
 	octeliumC octeliumc.ClientInterface
 	lis       *quic.Listener
 
+	masqueLis *quic.EarlyListener
+	masqueSrv *http3.Server
+
+	tlsSrv *http.Server
+
 	cancelFn context.CancelFunc
 	jwkCtl   *jwkctl.Controller
 
@@ -117,15 +124,29 @@ func (c *QUICController) Run(ctx context.Context) error {
 		return err
 	}
 
-	if gw.Status.Quicv0 == nil {
-		return errors.Errorf("status.quicv0 is not defined")
-	}
-
 	cc, err := c.octeliumC.CoreV1Utils().GetClusterConfig(ctx)
 	if err != nil {
 		return err
 	}
 
+	// The QUICv0 listener is always run unless the Cluster only enables
+	// MASQUE and/or the TLS fallback tunnel
+	hasMASQUE := ucorev1.ToClusterConfig(cc).IsMASQUEEnabled()
+	hasTLS := ucorev1.ToClusterConfig(cc).IsTLSEnabled()
+	hasQUICV0 := ucorev1.ToClusterConfig(cc).IsQUICV0Enabled() || (!hasMASQUE && !hasTLS)
+
+	if hasQUICV0 && gw.Status.Quicv0 == nil {
+		return errors.Errorf("status.quicv0 is not defined")
+	}
+
+	if hasMASQUE && gw.Status.Masque == nil {
+		return errors.Errorf("status.masque is not defined")
+	}
+
+	if hasTLS && gw.Status.Tls == nil {
+		return errors.Errorf("status.tls is not defined")
+	}
+
 	zap.L().Debug("dev MTU", zap.Int("mtu", ucorev1.ToClusterConfig(cc).GetDevMTUQUIV0()))
 
 	c.mtu = ucorev1.ToClusterConfig(cc).GetDevMTUQUIV0()
@@ -159,14 +180,28 @@ func (c *QUICController) Run(ctx context.Context) error {
 		return err
 	}
 
-	addr := fmt.Sprintf(":%d", gw.Status.Quicv0.Port)
-	zap.L().Debug("Starting a QUIC server", zap.String("addr", addr))
-	c.lis, err = quic.ListenAddr(addr, tlsCfg, quicCfg)
-	if err != nil {
-		return err
+	if hasQUICV0 {
+		addr := fmt.Sprintf(":%d", gw.Status.Quicv0.Port)
+		zap.L().Debug("Starting a QUIC server", zap.String("addr", addr))
+		c.lis, err = quic.ListenAddr(addr, tlsCfg, quicCfg)
+		if err != nil {
+			return err
+		}
+
+		go c.startLoop(ctx)
+	}
+
+	if hasMASQUE {
+		if err := c.runMASQUE(ctx, gw.Status.Masque.Port, tlsCfg, quicCfg); err != nil {
+			return errors.Errorf("Could not run MASQUE server: %+v", err)
+		}
 	}
 
-	go c.startLoop(ctx)
+	if hasTLS {
+		if err := c.runTLS(ctx, gw, tlsCfg); err != nil {
+			return errors.Errorf("Could not run TLS tunnel server: %+v", err)
+		}
+	}
 
 	zap.L().Debug("QUIC controller is now running")
 
@@ -276,7 +311,24 @@ func (c *QUICController) doInit(ctx context.Context, stream *quic.Stream) (*core
 		return nil, err
 	}
 
-	claims, err := c.jwkCtl.VerifyAccessToken(req.AccessToken)
+	sess, err := c.getSession(ctx, req.AccessToken, corev1.Session_Status_Connection_QUICV0)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &quicv0.InitResponse{
+		Type: quicv0.InitResponse_OK,
+	}
+
+	if err := c.writeResponse(ctx, stream, resp, 1); err != nil {
+		return nil, err
+	}
+	return sess, nil
+}
+
+func (c *QUICController) getSession(ctx context.Context,
+	accessToken string, connType corev1.Session_Status_Connection_Type) (*corev1.Session, error) {
+	claims, err := c.jwkCtl.VerifyAccessToken(accessToken)
 	if err != nil {
 		return nil, err
 	}
@@ -308,21 +360,14 @@ func (c *QUICController) doInit(ctx context.Context, stream *quic.Stream) (*core
 		return nil, errors.Errorf("This Session is not Connected")
 	}
 
-	if sess.Status.Connection.Type != corev1.Session_Status_Connection_QUICV0 {
-		return nil, errors.Errorf("Not a QUIC connection")
+	if sess.Status.Connection.Type != connType {
+		return nil, errors.Errorf("Invalid Connection type: %s", sess.Status.Connection.Type.String())
 	}
 
 	if sess.Status.IsLocked {
 		return nil, errors.Errorf("Session is locked")
 	}
 
-	resp := &quicv0.InitResponse{
-		Type: quicv0.InitResponse_OK,
-	}
-
-	if err := c.writeResponse(ctx, stream, resp, 1); err != nil {
-		return nil, err
-	}
 	return sess, nil
 }
 
@@ -477,8 +522,12 @@ func (c *QUICController) doHandleConnection(ctx context.Context, conn *quic.Conn
 		return err
 	}
 
-	dctx := newDctx(sess, conn, c.tunWriteCh, c.svcCIDRs, c.mtu)
+	c.runDctx(ctx, newDctx(sess, &quicv0Conn{conn}, c.tunWriteCh, c.svcCIDRs, c.mtu))
+
+	return nil
+}
 
+func (c *QUICController) runDctx(ctx context.Context, dctx *dctx) {
 	c.dctxMap.Lock()
 	c.dctxMap.dctxMap[dctx.id] = dctx
 	c.dctxMap.Unlock()
@@ -502,8 +551,6 @@ func (c *QUICController) doHandleConnection(ctx context.Context, conn *quic.Conn
 		delete(c.lookupMap.lookupMap, addr.Addr().String())
 	}
 	c.lookupMap.Unlock()
-
-	return nil
 }
 
 func (c *QUICController) hasActiveSessionUID(sessUID string) bool {
@@ -526,7 +573,18 @@ func (c *QUICController) Close() error {
 	}
 
 	c.cancelFn()
-	c.lis.Close()
+	if c.lis != nil {
+		c.lis.Close()
+	}
+	if c.masqueSrv != nil {
+		c.masqueSrv.Close()
+	}
+	if c.masqueLis != nil {
+		c.masqueLis.Close()
+	}
+	if c.tlsSrv != nil {
+		c.tlsSrv.Close()
+	}
 	if c.tundev != nil {
 		c.tundev.Close()
 	}
@@ -592,7 +650,10 @@ func (c *QUICController) RemoveConnection(sess *corev1.Session) error {
 		return nil
 	}
 
-	if conn.Type != corev1.Session_Status_Connection_QUICV0 {
+	switch conn.Type {
+	case corev1.Session_Status_Connection_QUICV0, corev1.Session_Status_Connection_MASQUE,
+		corev1.Session_Status_Connection_TLS:
+	default:
 		return nil
 	}
 
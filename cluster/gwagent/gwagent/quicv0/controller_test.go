@@ -92,7 +92,7 @@ func TestController(t *testing.T) {
 	err = gw.InitGateway(context.Background(), []string{"1.2.3.4"}, node, fakeC.OcteliumC, 0, &metav1.ObjectReference{
 		Name: "default",
 		Uid:  vutils.UUIDv4(),
-	}, privateKey)
+	}, privateKey, true)
 	assert.NotNil(t, err, "%+v", err)
 
 	ctl, err := New(ctx, tst.C.OcteliumC, k8sutils.GetGatewayName(node))
@@ -31,6 +31,24 @@ import (
 	"golang.org/x/net/ipv6"
 )
 
+// datagramConn is the transport carrying the IP packets of a dctx. It is
+// implemented by QUICv0 connections, MASQUE CONNECT-IP streams and TLS
+// fallback WebSocket connections.
+type datagramConn interface {
+	SendDatagram(b []byte) error
+	ReceiveDatagram(ctx context.Context) ([]byte, error)
+	Context() context.Context
+	Close() error
+}
+
+type quicv0Conn struct {
+	*quic.Conn
+}
+
+func (c *quicv0Conn) Close() error {
+	return c.CloseWithError(12, "")
+}
+
 type dctx struct {
 	id string
 
@@ -38,7 +56,7 @@ type dctx struct {
 
 	mu       sync.Mutex
 	isClosed bool
-	conn     *quic.Conn
+	conn     datagramConn
 
 	sendCh    chan []byte
 	processCh chan []byte
@@ -53,7 +71,7 @@ type dctx struct {
 }
 
 func newDctx(sess *corev1.Session,
-	conn *quic.Conn, tunCh chan<- []byte, svcCIDRs []netip.Prefix, mtu int) *dctx {
+	conn datagramConn, tunCh chan<- []byte, svcCIDRs []netip.Prefix, mtu int) *dctx {
 
 	zap.L().Debug("Creating a new dctx", zap.String("sessionUID", sess.Metadata.Uid), zap.Int("mtu", mtu))
 
@@ -98,7 +116,7 @@ func (d *dctx) close() error {
 	zap.L().Debug("Closing dctx", zap.String("id", d.id))
 	d.isClosed = true
 	d.cancelFn()
-	d.conn.CloseWithError(12, "")
+	d.conn.Close()
 	zap.L().Debug("dctx is now closed", zap.String("id", d.id))
 	return nil
 }
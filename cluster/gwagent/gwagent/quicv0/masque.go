@@ -0,0 +1,263 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package quicv0
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/pkg/utils/masque"
+	"github.com/pkg/errors"
+	"github.com/quic-go/quic-go"
+	"github.com/quic-go/quic-go/http3"
+	"github.com/quic-go/quic-go/quicvarint"
+	"go.uber.org/zap"
+)
+
+// masqueConn is a MASQUE CONNECT-IP (RFC 9484) request stream whose HTTP
+// datagrams carry the IP packets of the Session.
+type masqueConn struct {
+	str      *http3.Stream
+	ctx      context.Context
+	cancelFn context.CancelFunc
+
+	mu sync.Mutex
+}
+
+func newMASQUEConn(str *http3.Stream) *masqueConn {
+	ctx, cancelFn := context.WithCancel(str.Context())
+	return &masqueConn{
+		str:      str,
+		ctx:      ctx,
+		cancelFn: cancelFn,
+	}
+}
+
+func (c *masqueConn) SendDatagram(b []byte) error {
+	return c.str.SendDatagram(masque.EncodeDatagram(b))
+}
+
+func (c *masqueConn) ReceiveDatagram(ctx context.Context) ([]byte, error) {
+	for {
+		msg, err := c.str.ReceiveDatagram(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		pkt, err := masque.DecodeDatagram(msg)
+		if err != nil {
+			continue
+		}
+
+		return pkt, nil
+	}
+}
+
+func (c *masqueConn) Context() context.Context {
+	return c.ctx
+}
+
+func (c *masqueConn) Close() error {
+	c.cancelFn()
+	c.str.CancelRead(quic.StreamErrorCode(http3.ErrCodeNoError))
+	return c.str.Close()
+}
+
+func (c *masqueConn) writeCapsules(addrs []masque.AssignedAddress, routes []masque.IPRange) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if addrs != nil {
+		if err := masque.WriteAddressAssign(c.str, addrs); err != nil {
+			return err
+		}
+	}
+
+	if routes != nil {
+		if err := masque.WriteRouteAdvertisement(c.str, routes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *QUICController) runMASQUE(ctx context.Context, port int32, tlsCfg *tls.Config, quicCfg *quic.Config) error {
+	var err error
+
+	quicCfg = quicCfg.Clone()
+	// HTTP/3 requires the control and QPACK unidirectional streams
+	quicCfg.MaxIncomingUniStreams = 3
+
+	addr := fmt.Sprintf(":%d", port)
+	zap.L().Debug("Starting a MASQUE server", zap.String("addr", addr))
+	c.masqueLis, err = quic.ListenAddrEarly(addr, http3.ConfigureTLSConfig(tlsCfg), quicCfg)
+	if err != nil {
+		return err
+	}
+
+	c.masqueSrv = &http3.Server{
+		EnableDatagrams: true,
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			c.handleMASQUE(ctx, w, r)
+		}),
+	}
+
+	go func() {
+		if err := c.masqueSrv.ServeListener(c.masqueLis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			zap.L().Warn("MASQUE server exited", zap.Error(err))
+		}
+	}()
+
+	return nil
+}
+
+func (c *QUICController) handleMASQUE(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	if err := validateMASQUERequest(r); err != nil {
+		zap.L().Debug("Invalid MASQUE request", zap.Error(err))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	authHdr := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authHdr, "Bearer ") {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	sess, err := c.getSession(ctx, strings.TrimPrefix(authHdr, "Bearer "), corev1.Session_Status_Connection_MASQUE)
+	if err != nil {
+		zap.L().Debug("Could not get MASQUE Session", zap.Error(err))
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	streamer, ok := w.(http3.HTTPStreamer)
+	if !ok {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(http3.CapsuleProtocolHeader, "?1")
+	w.WriteHeader(http.StatusOK)
+
+	conn := newMASQUEConn(streamer.HTTPStream())
+	dctx := newDctx(sess, conn, c.tunWriteCh, c.svcCIDRs, c.mtu)
+
+	if err := conn.writeCapsules(getAssignedAddresses(dctx, nil), getRoutes(dctx)); err != nil {
+		zap.L().Debug("Could not write MASQUE capsules", zap.Error(err))
+		conn.Close()
+		return
+	}
+
+	go c.startMASQUECapsuleLoop(conn, dctx)
+
+	c.runDctx(ctx, dctx)
+}
+
+func validateMASQUERequest(r *http.Request) error {
+	if r.Method != http.MethodConnect {
+		return errors.Errorf("Invalid method: %s", r.Method)
+	}
+
+	if r.Proto != masque.Protocol {
+		return errors.Errorf("Invalid protocol: %s", r.Proto)
+	}
+
+	if !masque.IsValidPath(r.URL.Path) {
+		return errors.Errorf("Invalid path: %s", r.URL.Path)
+	}
+
+	if r.Header.Get(http3.CapsuleProtocolHeader) != "?1" {
+		return errors.Errorf("Capsule protocol is not enabled")
+	}
+
+	return nil
+}
+
+// getAssignedAddresses returns the Session addresses to be assigned. When
+// replying to an ADDRESS_REQUEST capsule, only the addresses matching the
+// requested IP versions are returned using their request IDs.
+func getAssignedAddresses(d *dctx, reqs []masque.AssignedAddress) []masque.AssignedAddress {
+	var ret []masque.AssignedAddress
+
+	if reqs == nil {
+		for _, addr := range d.addrs {
+			ret = append(ret, masque.AssignedAddress{
+				Prefix: addr,
+			})
+		}
+		return ret
+	}
+
+	for _, req := range reqs {
+		for _, addr := range d.addrs {
+			if addr.Addr().Is4() == req.Prefix.Addr().Is4() {
+				ret = append(ret, masque.AssignedAddress{
+					RequestID: req.RequestID,
+					Prefix:    addr,
+				})
+				break
+			}
+		}
+	}
+
+	return ret
+}
+
+func getRoutes(d *dctx) []masque.IPRange {
+	ret := []masque.IPRange{}
+	for _, cidr := range d.svcCIDRs {
+		ret = append(ret, masque.PrefixToRange(cidr))
+	}
+	return ret
+}
+
+func (c *QUICController) startMASQUECapsuleLoop(conn *masqueConn, d *dctx) {
+	defer conn.cancelFn()
+
+	r := quicvarint.NewReader(conn.str)
+	for {
+		typ, value, err := masque.ReadCapsule(r)
+		if err != nil {
+			zap.L().Debug("MASQUE capsule loop exited", zap.String("id", d.id), zap.Error(err))
+			return
+		}
+
+		switch typ {
+		case masque.CapsuleTypeAddressRequest:
+			reqs, err := masque.ParseAddressAssign(bytes.NewReader(value))
+			if err != nil {
+				zap.L().Debug("Invalid ADDRESS_REQUEST capsule", zap.String("id", d.id), zap.Error(err))
+				return
+			}
+
+			if err := conn.writeCapsules(getAssignedAddresses(d, reqs), nil); err != nil {
+				return
+			}
+		default:
+			// Unknown capsules as well as the client's own ADDRESS_ASSIGN and
+			// ROUTE_ADVERTISEMENT capsules are ignored
+		}
+	}
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package quicv0
+
+import (
+	"net/http"
+	"net/netip"
+	"net/url"
+	"testing"
+
+	"github.com/octelium/octelium/pkg/utils/masque"
+	"github.com/quic-go/quic-go/http3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateMASQUERequest(t *testing.T) {
+	newReq := func() *http.Request {
+		return &http.Request{
+			Method: http.MethodConnect,
+			Proto:  masque.Protocol,
+			URL: &url.URL{
+				Path: masque.Path,
+			},
+			Header: http.Header{
+				http3.CapsuleProtocolHeader: []string{"?1"},
+			},
+		}
+	}
+
+	assert.Nil(t, validateMASQUERequest(newReq()))
+
+	{
+		req := newReq()
+		req.Method = http.MethodGet
+		assert.NotNil(t, validateMASQUERequest(req))
+	}
+	{
+		req := newReq()
+		req.Proto = "connect-udp"
+		assert.NotNil(t, validateMASQUERequest(req))
+	}
+	{
+		req := newReq()
+		req.URL.Path = "/.well-known/masque/ip/10.0.0.1/6/"
+		assert.NotNil(t, validateMASQUERequest(req))
+	}
+	{
+		req := newReq()
+		req.Header.Del(http3.CapsuleProtocolHeader)
+		assert.NotNil(t, validateMASQUERequest(req))
+	}
+}
+
+func TestGetAssignedAddresses(t *testing.T) {
+	d := &dctx{
+		addrs: []netip.Prefix{
+			netip.MustParsePrefix("10.0.1.1/32"),
+			netip.MustParsePrefix("fd00::1/128"),
+		},
+		svcCIDRs: []netip.Prefix{
+			netip.MustParsePrefix("10.0.0.0/24"),
+		},
+	}
+
+	assert.Equal(t, []masque.AssignedAddress{
+		{Prefix: d.addrs[0]},
+		{Prefix: d.addrs[1]},
+	}, getAssignedAddresses(d, nil))
+
+	assert.Equal(t, []masque.AssignedAddress{
+		{RequestID: 7, Prefix: d.addrs[1]},
+	}, getAssignedAddresses(d, []masque.AssignedAddress{
+		{RequestID: 7, Prefix: netip.MustParsePrefix("::/128")},
+	}))
+
+	assert.Equal(t, []masque.IPRange{
+		{
+			Start: netip.MustParseAddr("10.0.0.0"),
+			End:   netip.MustParseAddr("10.0.0.255"),
+		},
+	}, getRoutes(d))
+}
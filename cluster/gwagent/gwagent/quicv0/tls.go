@@ -0,0 +1,183 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package quicv0
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/cluster/common/vutils"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// tlsReadTimeout is the time after which a TLS tunnel connection is closed
+// if neither a packet nor a ping has been received from the client.
+const tlsReadTimeout = 45 * time.Second
+
+// wsConn is a WebSocket connection proxied by the ingress for the TLS
+// fallback tunnel. Each binary message carries exactly one IP packet.
+type wsConn struct {
+	conn     *websocket.Conn
+	ctx      context.Context
+	cancelFn context.CancelFunc
+
+	mu sync.Mutex
+}
+
+func newWSConn(ctx context.Context, conn *websocket.Conn, mtu int) *wsConn {
+	ctx, cancelFn := context.WithCancel(ctx)
+	ret := &wsConn{
+		conn:     conn,
+		ctx:      ctx,
+		cancelFn: cancelFn,
+	}
+
+	conn.SetReadLimit(int64(mtu))
+	conn.SetReadDeadline(time.Now().Add(tlsReadTimeout))
+	conn.SetPingHandler(func(appData string) error {
+		conn.SetReadDeadline(time.Now().Add(tlsReadTimeout))
+
+		ret.mu.Lock()
+		defer ret.mu.Unlock()
+		err := conn.WriteControl(websocket.PongMessage, []byte(appData), time.Now().Add(5*time.Second))
+		if err != nil && !errors.Is(err, websocket.ErrCloseSent) {
+			return err
+		}
+		return nil
+	})
+
+	return ret
+}
+
+func (c *wsConn) SendDatagram(b []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+	return c.conn.WriteMessage(websocket.BinaryMessage, b)
+}
+
+func (c *wsConn) ReceiveDatagram(ctx context.Context) ([]byte, error) {
+	for {
+		typ, msg, err := c.conn.ReadMessage()
+		if err != nil {
+			// Read errors are permanent for WebSocket connections
+			c.cancelFn()
+			return nil, err
+		}
+
+		if typ != websocket.BinaryMessage {
+			continue
+		}
+
+		c.conn.SetReadDeadline(time.Now().Add(tlsReadTimeout))
+
+		return msg, nil
+	}
+}
+
+func (c *wsConn) Context() context.Context {
+	return c.ctx
+}
+
+func (c *wsConn) Close() error {
+	c.cancelFn()
+
+	c.mu.Lock()
+	c.conn.WriteControl(websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+	c.mu.Unlock()
+
+	return c.conn.Close()
+}
+
+func (c *QUICController) runTLS(ctx context.Context, gw *corev1.Gateway, tlsCfg *tls.Config) error {
+	tlsCfg = tlsCfg.Clone()
+	// The ingress proxies WebSocket upgrades over HTTP/1.1
+	tlsCfg.NextProtos = []string{"http/1.1"}
+	tlsCfg.MinVersion = tls.VersionTLS12
+
+	addr := fmt.Sprintf(":%d", gw.Status.Tls.Port)
+	zap.L().Debug("Starting a TLS tunnel server", zap.String("addr", addr))
+
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	upgrader := &websocket.Upgrader{
+		HandshakeTimeout: 5 * time.Second,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(vutils.GetGatewayTLSTunnelPath(gw.Status.Id), func(w http.ResponseWriter, r *http.Request) {
+		c.handleTLS(ctx, upgrader, w, r)
+	})
+
+	c.tlsSrv = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func() {
+		if err := c.tlsSrv.Serve(tls.NewListener(lis, tlsCfg)); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			zap.L().Warn("TLS tunnel server exited", zap.Error(err))
+		}
+	}()
+
+	return nil
+}
+
+func (c *QUICController) handleTLS(ctx context.Context,
+	upgrader *websocket.Upgrader, w http.ResponseWriter, r *http.Request) {
+	if !websocket.IsWebSocketUpgrade(r) {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	authHdr := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authHdr, "Bearer ") {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	sess, err := c.getSession(ctx, strings.TrimPrefix(authHdr, "Bearer "), corev1.Session_Status_Connection_TLS)
+	if err != nil {
+		zap.L().Debug("Could not get TLS tunnel Session", zap.Error(err))
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	wsc, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		zap.L().Debug("Could not upgrade TLS tunnel connection", zap.Error(err))
+		return
+	}
+
+	conn := newWSConn(ctx, wsc, c.mtu)
+	defer conn.Close()
+
+	c.runDctx(ctx, newDctx(sess, conn, c.tunWriteCh, c.svcCIDRs, c.mtu))
+}
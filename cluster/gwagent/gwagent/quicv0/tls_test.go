@@ -0,0 +1,84 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package quicv0
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWSConn(t *testing.T) {
+	ctx := context.Background()
+
+	connCh := make(chan *wsConn, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upgrader := &websocket.Upgrader{}
+		c, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		connCh <- newWSConn(ctx, c, 1280)
+	}))
+	defer srv.Close()
+
+	clientConn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	assert.Nil(t, err)
+
+	conn := <-connCh
+
+	pkt := []byte("0123456789abcdefghijklmnopqrstuvwxyz")
+
+	{
+		err = clientConn.WriteMessage(websocket.TextMessage, []byte("ignored"))
+		assert.Nil(t, err)
+		err = clientConn.WriteMessage(websocket.BinaryMessage, pkt)
+		assert.Nil(t, err)
+
+		msg, err := conn.ReceiveDatagram(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, pkt, msg)
+	}
+
+	{
+		err = conn.SendDatagram(pkt)
+		assert.Nil(t, err)
+
+		typ, msg, err := clientConn.ReadMessage()
+		assert.Nil(t, err)
+		assert.Equal(t, websocket.BinaryMessage, typ)
+		assert.Equal(t, pkt, msg)
+	}
+
+	clientConn.Close()
+
+	_, err = conn.ReceiveDatagram(ctx)
+	assert.NotNil(t, err)
+
+	select {
+	case <-conn.Context().Done():
+	case <-time.After(3 * time.Second):
+		t.Fatal("conn context is not done")
+	}
+
+	conn.Close()
+}
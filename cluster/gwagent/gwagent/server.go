@@ -35,6 +35,7 @@ import (
 	"github.com/octelium/octelium/cluster/gwagent/gwagent/gw"
 	"github.com/octelium/octelium/cluster/gwagent/gwagent/quicv0"
 	"github.com/octelium/octelium/cluster/gwagent/gwagent/wg"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
 	"github.com/octelium/octelium/pkg/apiutils/umetav1"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -84,6 +85,19 @@ func NewServer(ctx context.Context) (*Server, error) {
 	return ret, nil
 }
 
+// NewStandaloneServer creates a Gateway agent for the standalone runtime
+// where there is no Kubernetes cluster. The node is provided by the caller
+// and no CNI config is installed since Services are run in-process.
+func NewStandaloneServer(octeliumC octeliumc.ClientInterface,
+	node *k8scorev1.Node, publicIPs []string) *Server {
+	return &Server{
+		octeliumC: octeliumC,
+		nodeName:  node.Name,
+		node:      node,
+		publicIPs: publicIPs,
+	}
+}
+
 func (s *Server) Run(ctx context.Context) error {
 
 	zap.L().Debug("Starting running Gateway agent", zap.String("node", s.nodeName))
@@ -101,11 +115,14 @@ func (s *Server) Run(ctx context.Context) error {
 	s.regionIndex = int(region.Status.Index)
 	s.regionRef = umetav1.GetObjectReference(region)
 
-	node, err := s.k8sC.CoreV1().Nodes().Get(ctx, s.nodeName, k8smetav1.GetOptions{})
-	if err != nil {
-		return err
+	if s.k8sC != nil {
+		node, err := s.k8sC.CoreV1().Nodes().Get(ctx, s.nodeName, k8smetav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		s.node = node
 	}
-	s.node = node
+	node := s.node
 
 	/*
 		if err := s.setNodeIndex(ctx); err != nil {
@@ -113,8 +130,10 @@ func (s *Server) Run(ctx context.Context) error {
 		}
 	*/
 
-	if err := untaintNode(ctx, s.k8sC, node); err != nil {
-		zap.L().Warn("Could not untaint node", zap.Error(err))
+	if s.k8sC != nil {
+		if err := untaintNode(ctx, s.k8sC, node); err != nil {
+			zap.L().Warn("Could not untaint node", zap.Error(err))
+		}
 	}
 
 	if _, err := os.Stat("/dev/net/tun"); err != nil && os.IsNotExist(err) {
@@ -160,7 +179,7 @@ func (s *Server) Run(ctx context.Context) error {
 	}
 
 	if err := gw.InitGateway(ctx,
-		s.publicIPs, node, s.octeliumC, s.regionIndex, s.regionRef, initWGPrivateKey); err != nil {
+		s.publicIPs, node, s.octeliumC, s.regionIndex, s.regionRef, initWGPrivateKey, s.k8sC != nil); err != nil {
 		return errors.Errorf("Could not init Gateway: %+v", err)
 	}
 
@@ -174,9 +193,8 @@ func (s *Server) Run(ctx context.Context) error {
 		return err
 	}
 
-	if cc.Status.NetworkConfig != nil &&
-		cc.Status.NetworkConfig.Quicv0 != nil &&
-		cc.Status.NetworkConfig.Quicv0.Enable {
+	if ucorev1.ToClusterConfig(cc).IsQUICV0Enabled() || ucorev1.ToClusterConfig(cc).IsMASQUEEnabled() ||
+		ucorev1.ToClusterConfig(cc).IsTLSEnabled() {
 		zap.L().Debug("QUICv0 controller is enabled")
 		s.hasQUICV0 = true
 		s.quicCtl, err = quicv0.New(ctx, s.octeliumC, k8sutils.GetGatewayName(s.node))
@@ -191,7 +209,7 @@ func (s *Server) Run(ctx context.Context) error {
 		}
 
 	} else {
-		zap.L().Debug("QUICv0 mode is NOT enabled.")
+		zap.L().Debug("QUICv0, MASQUE and TLS modes are NOT enabled.")
 	}
 
 	sessCtl := sesscontroller.NewController(&sesscontroller.Opts{
@@ -323,15 +341,20 @@ func Run(ctx context.Context) error {
 
 	<-ctx.Done()
 
-	if srv.hasQUICV0 && srv.quicCtl != nil {
-		srv.quicCtl.Close()
-	}
-
-	srv.cleanup()
+	srv.Close()
 
 	return nil
 }
 
+// Close stops the QUIC controller, if any, and removes the WireGuard device.
+func (s *Server) Close() {
+	if s.hasQUICV0 && s.quicCtl != nil {
+		s.quicCtl.Close()
+	}
+
+	s.cleanup()
+}
+
 func (s *Server) cleanup() {
 	if s.wgC != nil {
 		zap.L().Debug("Cleaning up wg devices")
@@ -431,11 +431,17 @@ func (i *iptablesCtl) OnAdd(gw *corev1.Gateway, cc *corev1.ClusterConfig, defaul
 	}
 
 	// Allow Connections to access Services
+	// Services are also allowed in the input chain since the standalone runtime
+	// assigns the Service addresses to the host itself instead of pods
 	if hasV4 {
 		if err := i.iptv4.Append(tableFilter, fwChain,
 			ruleAllowSvc(connSubnet.V4, gwSubnet.V4)...); err != nil {
 			return err
 		}
+		if err := i.iptv4.Append(tableFilter, inputChain,
+			ruleAllowSvc(connSubnet.V4, gwSubnet.V4)...); err != nil {
+			return err
+		}
 	}
 
 	if hasV6 {
@@ -443,6 +449,10 @@ func (i *iptablesCtl) OnAdd(gw *corev1.Gateway, cc *corev1.ClusterConfig, defaul
 			ruleAllowSvc(connSubnet.V6, gwSubnet.V6)...); err != nil {
 			return err
 		}
+		if err := i.iptv6.Append(tableFilter, inputChain,
+			ruleAllowSvc(connSubnet.V6, gwSubnet.V6)...); err != nil {
+			return err
+		}
 	}
 
 	// Drop everything coming from Connection subnet
@@ -85,7 +85,7 @@ func TestWg(t *testing.T) {
 	err = gw.InitGateway(ctx, []string{"1.2.3.4"}, node, fakeC.OcteliumC, 0, &metav1.ObjectReference{
 		Name: "default",
 		Uid:  vutils.UUIDv4(),
-	}, privateKey)
+	}, privateKey, true)
 	assert.NotNil(t, err, "%+v", err)
 
 	wgC, err := New(ctx, node, fakeC.OcteliumC, privateKey)
@@ -71,5 +71,7 @@ func (c *Controller) OnDelete(ctx context.Context, crt *corev1.Secret) error {
 }
 
 func (c *Controller) shouldDoSnapshot(crt *corev1.Secret) bool {
-	return crt.Metadata.SystemLabels != nil && crt.Metadata.SystemLabels["octelium-cert"] == "true"
+	return crt.Metadata.SystemLabels != nil &&
+		(crt.Metadata.SystemLabels["octelium-cert"] == "true" ||
+			crt.Metadata.SystemLabels["octelium-acme-http01"] == "true")
 }
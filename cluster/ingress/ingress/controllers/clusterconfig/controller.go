@@ -0,0 +1,49 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cccontroller
+
+import (
+	"context"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	envoyserver "github.com/octelium/octelium/cluster/ingress/ingress/envoy"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
+	"go.uber.org/zap"
+)
+
+type Controller struct {
+	envoyServer *envoyserver.Server
+}
+
+func NewController(
+	envoyServer *envoyserver.Server,
+) *Controller {
+	return &Controller{
+		envoyServer: envoyServer,
+	}
+}
+
+func (c *Controller) OnUpdate(ctx context.Context, new, old *corev1.ClusterConfig) error {
+	if ucorev1.ToClusterConfig(new).IsACMEHTTP01Enabled() ==
+		ucorev1.ToClusterConfig(old).IsACMEHTTP01Enabled() {
+		return nil
+	}
+
+	zap.L().Debug("ACME HTTP-01 config changed")
+
+	return c.envoyServer.DoSnapshot(ctx)
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package gwcontroller
+
+import (
+	"context"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/cluster/common/octeliumc"
+	"github.com/octelium/octelium/cluster/common/vutils"
+	envoyserver "github.com/octelium/octelium/cluster/ingress/ingress/envoy"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+type Controller struct {
+	octeliumC   octeliumc.ClientInterface
+	envoyServer *envoyserver.Server
+}
+
+func NewController(
+	octeliumC octeliumc.ClientInterface,
+	envoyServer *envoyserver.Server,
+) *Controller {
+	return &Controller{
+		octeliumC:   octeliumC,
+		envoyServer: envoyServer,
+	}
+}
+
+func (c *Controller) OnAdd(ctx context.Context, gw *corev1.Gateway) error {
+	if !isMyRegion(gw) || gw.Status.Tls == nil {
+		return nil
+	}
+
+	zap.L().Debug("Adding Gateway", zap.String("gw", gw.Metadata.Name))
+
+	return c.envoyServer.DoSnapshot(ctx)
+}
+
+func (c *Controller) OnUpdate(ctx context.Context, new, old *corev1.Gateway) error {
+	if !isMyRegion(new) {
+		return nil
+	}
+
+	if new.Status.Id == old.Status.Id && proto.Equal(new.Status.Tls, old.Status.Tls) {
+		return nil
+	}
+
+	zap.L().Debug("Updating Gateway", zap.String("gw", new.Metadata.Name))
+
+	return c.envoyServer.DoSnapshot(ctx)
+}
+
+func (c *Controller) OnDelete(ctx context.Context, gw *corev1.Gateway) error {
+	if !isMyRegion(gw) || gw.Status.Tls == nil {
+		return nil
+	}
+
+	zap.L().Debug("Deleting Gateway", zap.String("gw", gw.Metadata.Name))
+
+	return c.envoyServer.DoSnapshot(ctx)
+}
+
+func isMyRegion(gw *corev1.Gateway) bool {
+	return gw.Status.RegionRef != nil && gw.Status.RegionRef.Name == vutils.GetMyRegionName()
+}
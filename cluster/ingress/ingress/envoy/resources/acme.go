@@ -0,0 +1,201 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package resources
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	routerv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
+	envoyhcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	wellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const acmeHTTP01PathPrefix = "/.well-known/acme-challenge/"
+
+// getListenerHTTP returns the plain HTTP listener. It answers the pending
+// ACME HTTP-01 challenges stored in the challengeList Secrets and redirects
+// every other request to HTTPS.
+func getListenerHTTP(challengeList []*corev1.Secret) (*listenerv3.Listener, error) {
+
+	ret := &listenerv3.Listener{
+		Name: "http-listener",
+	}
+
+	ret.Address = &core.Address{Address: &core.Address_SocketAddress{
+		SocketAddress: &core.SocketAddress{
+			Address:  "0.0.0.0",
+			Protocol: core.SocketAddress_TCP,
+			PortSpecifier: &core.SocketAddress_PortValue{
+				PortValue: 8081,
+			},
+		},
+	}}
+
+	httpConnMan, err := getHttpConnManagerFilterHTTP(challengeList)
+	if err != nil {
+		return nil, err
+	}
+
+	ret.FilterChains = []*listenerv3.FilterChain{
+		{
+			Filters: []*listenerv3.Filter{
+				httpConnMan,
+			},
+		},
+	}
+
+	return ret, nil
+}
+
+func getHttpConnManagerFilterHTTP(challengeList []*corev1.Secret) (*listenerv3.Filter, error) {
+
+	filter := &envoyhcm.HttpConnectionManager{
+		CodecType:             envoyhcm.HttpConnectionManager_HTTP1,
+		StatPrefix:            "hcm-http",
+		ServerName:            "octelium",
+		StripMatchingHostPort: true,
+		RouteSpecifier: &envoyhcm.HttpConnectionManager_RouteConfig{
+			RouteConfig: getRouteConfigHTTP(challengeList),
+		},
+
+		StreamIdleTimeout: &durationpb.Duration{
+			Seconds: 30,
+		},
+		RequestTimeout: &durationpb.Duration{
+			Seconds: 10,
+		},
+		RequestHeadersTimeout: &durationpb.Duration{
+			Seconds: 5,
+		},
+	}
+
+	{
+		routerFilter := &routerv3.Router{
+			SuppressEnvoyHeaders: true,
+		}
+		pbFilter, err := anypb.New(routerFilter)
+		if err != nil {
+			return nil, err
+		}
+
+		filter.HttpFilters = []*envoyhcm.HttpFilter{
+			{
+				Name: "envoy.filters.http.router",
+				ConfigType: &envoyhcm.HttpFilter_TypedConfig{
+					TypedConfig: pbFilter,
+				},
+			},
+		}
+	}
+
+	pbFilter, err := anypb.New(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &listenerv3.Filter{
+		Name: wellknown.HTTPConnectionManager,
+		ConfigType: &listenerv3.Filter_TypedConfig{
+			TypedConfig: pbFilter,
+		},
+	}, nil
+}
+
+func getRouteConfigHTTP(challengeList []*corev1.Secret) *routev3.RouteConfiguration {
+
+	return &routev3.RouteConfiguration{
+		Name: "http",
+		VirtualHosts: []*routev3.VirtualHost{
+			{
+				Name:    "vh-http",
+				Domains: []string{"*"},
+				Routes: append(getRoutesACMEHTTP01(challengeList), &routev3.Route{
+					Match: &routev3.RouteMatch{
+						PathSpecifier: &routev3.RouteMatch_Prefix{
+							Prefix: "/",
+						},
+					},
+					Action: &routev3.Route_Redirect{
+						Redirect: &routev3.RedirectAction{
+							SchemeRewriteSpecifier: &routev3.RedirectAction_HttpsRedirect{
+								HttpsRedirect: true,
+							},
+						},
+					},
+				}),
+			},
+		},
+	}
+}
+
+// getRoutesACMEHTTP01 returns a direct response route for every challenge
+// Secret. The Secret value is the challenge key authorization whose prefix
+// before the "." is the challenge token.
+func getRoutesACMEHTTP01(challengeList []*corev1.Secret) []*routev3.Route {
+	var ret []*routev3.Route
+
+	keyAuths := make(map[string]string)
+	for _, sec := range challengeList {
+		keyAuth := ucorev1.ToSecret(sec).GetValueStr()
+		token, _, ok := strings.Cut(keyAuth, ".")
+		if !ok || token == "" || strings.ContainsAny(token, "/?#") {
+			zap.L().Warn("Skipping invalid ACME HTTP-01 challenge Secret",
+				zap.String("name", sec.Metadata.Name))
+			continue
+		}
+		keyAuths[token] = keyAuth
+	}
+
+	tokens := make([]string, 0, len(keyAuths))
+	for token := range keyAuths {
+		tokens = append(tokens, token)
+	}
+	sort.Strings(tokens)
+
+	for _, token := range tokens {
+		ret = append(ret, &routev3.Route{
+			Name: fmt.Sprintf("acme-http01-%s", token),
+			Match: &routev3.RouteMatch{
+				PathSpecifier: &routev3.RouteMatch_Path{
+					Path: fmt.Sprintf("%s%s", acmeHTTP01PathPrefix, token),
+				},
+			},
+			Action: &routev3.Route_DirectResponse{
+				DirectResponse: &routev3.DirectResponseAction{
+					Status: 200,
+					Body: &core.DataSource{
+						Specifier: &core.DataSource_InlineString{
+							InlineString: keyAuths[token],
+						},
+					},
+				},
+			},
+		})
+	}
+
+	return ret
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package resources
+
+import (
+	"testing"
+
+	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRoutesACMEHTTP01(t *testing.T) {
+	genSecret := func(name, value string) *corev1.Secret {
+		return &corev1.Secret{
+			Metadata: &metav1.Metadata{
+				Name: name,
+			},
+			Spec:   &corev1.Secret_Spec{},
+			Status: &corev1.Secret_Status{},
+			Data: &corev1.Secret_Data{
+				Type: &corev1.Secret_Data_Value{
+					Value: value,
+				},
+			},
+		}
+	}
+
+	routes := getRoutesACMEHTTP01([]*corev1.Secret{
+		genSecret("acme-http01-2", "tok2.thumb"),
+		genSecret("acme-http01-1", "tok1.thumb"),
+		genSecret("acme-http01-3", "invalid"),
+		genSecret("acme-http01-4", "a/b.thumb"),
+	})
+	assert.Equal(t, 2, len(routes))
+	assert.Equal(t, "/.well-known/acme-challenge/tok1", routes[0].Match.GetPath())
+	assert.Equal(t, "/.well-known/acme-challenge/tok2", routes[1].Match.GetPath())
+	assert.Equal(t, "tok1.thumb", routes[0].GetDirectResponse().Body.GetInlineString())
+	assert.Equal(t, uint32(200), routes[0].GetDirectResponse().Status)
+
+	routeConfig := getRouteConfigHTTP(nil)
+	assert.Equal(t, 1, len(routeConfig.VirtualHosts[0].Routes))
+	redirect, ok := routeConfig.VirtualHosts[0].Routes[0].Action.(*routev3.Route_Redirect)
+	assert.True(t, ok)
+	assert.True(t, redirect.Redirect.GetHttpsRedirect())
+
+	_, err := getListenerHTTP(nil)
+	assert.Nil(t, err)
+}
+
+func TestGetListenersHTTP01(t *testing.T) {
+	hasHTTPListener := func(isHTTP01 bool) bool {
+		lisList, err := GetListeners("example.com", nil, nil, nil, nil, isHTTP01)
+		assert.Nil(t, err)
+		for _, lis := range lisList {
+			if lis.(*listenerv3.Listener).Name == "http-listener" {
+				return true
+			}
+		}
+		return false
+	}
+
+	assert.False(t, hasHTTPListener(false))
+	assert.True(t, hasHTTPListener(true))
+}
@@ -18,12 +18,12 @@ package resources
 
 import (
 	"fmt"
-	"slices"
 
 	clusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	"github.com/octelium/octelium/apis/main/corev1"
 	"github.com/octelium/octelium/cluster/common/k8sutils"
+	"github.com/octelium/octelium/cluster/common/vutils"
 	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
 	"github.com/octelium/octelium/pkg/utils/ldflags"
 	"go.uber.org/zap"
@@ -38,51 +38,16 @@ import (
 
 const healthCheckCluster = "octelium-health-check"
 
-func getSvcFQDNs(svc *corev1.Service, domain string) []string {
-	name := ucorev1.ToService(svc).Name()
-	ns := ucorev1.ToService(svc).Namespace()
-	ret := []string{
-		fmt.Sprintf("%s.%s.%s", name, ns, domain),
-	}
-
-	appendIfNotExists := func(arg string) {
-		if !slices.Contains(ret, arg) {
-			ret = append(ret, arg)
-		}
-	}
-	if name == "default" {
-		appendIfNotExists(fmt.Sprintf("%s.%s", ns, domain))
-	}
-
-	if ns == "default" {
-		appendIfNotExists(fmt.Sprintf("%s.%s", name, domain))
-		if name == "default" {
-			appendIfNotExists(domain)
-		}
-	}
-
-	if ucorev1.ToService(svc).IsManagedService() &&
-		svc.Status.ManagedService != nil && svc.Status.ManagedService.HasSubdomain {
-		domains := slices.Clone(ret)
-
-		for _, dmn := range domains {
-			appendIfNotExists(fmt.Sprintf("*.%s", dmn))
-		}
-	}
-
-	return ret
-}
-
-func GetClusters(domain string, svcList []*corev1.Service) ([]types.Resource, error) {
+func GetClusters(domain string, svcList []*corev1.Service, gwList []*corev1.Gateway) ([]types.Resource, error) {
 	ret := []types.Resource{}
 
 	for _, svc := range svcList {
-		isTLS := svc.Spec.IsTLS
+		isTLS := svc.Spec.IsTLS && !isTLSPassthrough(svc)
 		port := ucorev1.ToService(svc).RealPort()
 
-		isHTTP2 := ucorev1.ToService(svc).IsListenerHTTP2()
+		isHTTP2 := ucorev1.ToService(svc).IsListenerHTTP2() && !isTLSPassthrough(svc)
 		clstr, err := getCluster(getClusterNameFromService(svc),
-			isHTTP2, k8sutils.GetSvcFQDN(svc), int(port), isTLS, getSvcFQDNs(svc, domain)[0])
+			isHTTP2, k8sutils.GetSvcFQDN(svc), int(port), isTLS, vutils.GetServicePublicFQDNs(svc, domain)[0])
 		if err != nil {
 			return nil, err
 		}
@@ -90,6 +55,20 @@ func GetClusters(domain string, svcList []*corev1.Service) ([]types.Resource, er
 		ret = append(ret, clstr)
 	}
 
+	for _, gw := range gwList {
+		if !hasGatewayTLS(gw) {
+			continue
+		}
+
+		clstr, err := getCluster(getClusterNameFromGateway(gw),
+			false, gw.Status.Tls.Address, int(gw.Status.Tls.Port), true, fmt.Sprintf("octelium-api.%s", domain))
+		if err != nil {
+			return nil, err
+		}
+		zap.L().Debug("Adding Envoy cluster for Gateway", zap.String("name", gw.Metadata.Name))
+		ret = append(ret, clstr)
+	}
+
 	{
 		healthCheckCluster, err := getCluster(healthCheckCluster, false, "127.0.0.1", 11011, false, "")
 		if err != nil {
@@ -235,3 +214,12 @@ func getTransportSocket(hostname string) (*core.TransportSocket, error) {
 func getClusterNameFromService(svc *corev1.Service) string {
 	return fmt.Sprintf("svc-%s", k8sutils.GetSvcHostname(svc))
 }
+
+func getClusterNameFromGateway(gw *corev1.Gateway) string {
+	return fmt.Sprintf("gw-tls-%s", gw.Status.Id)
+}
+
+func hasGatewayTLS(gw *corev1.Gateway) bool {
+	return gw.Status != nil && gw.Status.Tls != nil &&
+		gw.Status.Tls.Address != "" && gw.Status.Tls.Port != 0
+}
@@ -67,18 +67,18 @@ func TestGetClusters(t *testing.T) {
 
 	svcList = append(svcList, apiSrvSvc)
 
-	_, err = GetClusters("example.com", nil)
+	_, err = GetClusters("example.com", nil, nil)
 	assert.Nil(t, err)
 	//os.Setenv("OCTELIUM_REHION_NAME", "default")
-	_, err = GetClusters("example.com", svcList)
+	_, err = GetClusters("example.com", svcList, nil)
 	assert.Nil(t, err)
 
 	for i := 0; i < 100; i++ {
 		svcList = append(svcList, doCreateSvc())
 	}
 
-	_, err = GetClusters("example.com", svcList)
+	_, err = GetClusters("example.com", svcList, nil)
 	assert.Nil(t, err)
-	_, err = GetClusters("example.com", svcList)
+	_, err = GetClusters("example.com", svcList, nil)
 	assert.Nil(t, err)
 }
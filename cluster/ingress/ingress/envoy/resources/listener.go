@@ -24,6 +24,7 @@ import (
 	grpcweb "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_web/v3"
 	routerv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
 	envoyhcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	tcpproxyv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
 	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	types "github.com/envoyproxy/go-control-plane/pkg/cache/types"
 	wellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
@@ -42,16 +43,26 @@ import (
 	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
 )
 
-func GetListeners(domain string, svcList []*corev1.Service, crtList []*corev1.Secret) ([]types.Resource, error) {
+func GetListeners(domain string, svcList []*corev1.Service, crtList []*corev1.Secret,
+	gwList []*corev1.Gateway, challengeList []*corev1.Secret, isHTTP01 bool) ([]types.Resource, error) {
 	ret := []types.Resource{}
 
-	mainListener, err := getListener(domain, svcList, crtList)
+	mainListener, err := getListener(domain, svcList, crtList, gwList)
 	if err != nil {
 		return nil, err
 	}
 
 	ret = append(ret, mainListener)
 
+	if isHTTP01 {
+		httpListener, err := getListenerHTTP(challengeList)
+		if err != nil {
+			return nil, err
+		}
+
+		ret = append(ret, httpListener)
+	}
+
 	healthCheckListener, err := getListenerHealthCheck()
 	if err != nil {
 		return nil, err
@@ -63,7 +74,7 @@ func GetListeners(domain string, svcList []*corev1.Service, crtList []*corev1.Se
 }
 
 func getListener(domain string, svcList []*corev1.Service,
-	crtList []*corev1.Secret) (*listenerv3.Listener, error) {
+	crtList []*corev1.Secret, gwList []*corev1.Gateway) (*listenerv3.Listener, error) {
 
 	ret := &listenerv3.Listener{
 		Name: "https-listener",
@@ -86,15 +97,83 @@ func getListener(domain string, svcList []*corev1.Service,
 		},
 	}}
 
-	filterChain, err := getFilterChainsMain(domain, crtList, svcList)
+	filterChain, err := getFilterChainsMain(domain, crtList, svcList, gwList)
 	if err != nil {
 		return nil, err
 	}
 	ret.FilterChains = append(ret.FilterChains, filterChain)
 
+	for _, svc := range svcList {
+		if !isTLSPassthrough(svc) {
+			continue
+		}
+
+		filterChain, err := getFilterChainTLSPassthrough(domain, svc)
+		if err != nil {
+			return nil, err
+		}
+		if filterChain == nil {
+			continue
+		}
+
+		zap.L().Debug("Adding TLS passthrough filter chain for Service", zap.String("name", svc.Metadata.Name))
+		ret.FilterChains = append(ret.FilterChains, filterChain)
+	}
+
 	return ret, nil
 }
 
+// isTLSPassthrough returns whether the public Service TLS connections are
+// routed via SNI as-is to its Vigil which terminates the TLS itself.
+func isTLSPassthrough(svc *corev1.Service) bool {
+	return svc.Spec.IsPublic && svc.Spec.IsTLS && svc.Spec.Mode == corev1.Service_Spec_TCP
+}
+
+func getFilterChainTLSPassthrough(domain string, svc *corev1.Service) (*listenerv3.FilterChain, error) {
+	var serverNames []string
+	for _, fqdn := range vutils.GetServicePublicFQDNs(svc, domain) {
+		// The Cluster domain itself is always served by the main filter chain
+		if fqdn == domain {
+			continue
+		}
+		serverNames = append(serverNames, fqdn)
+	}
+
+	if len(serverNames) == 0 {
+		return nil, nil
+	}
+
+	clusterName := getClusterNameFromService(svc)
+
+	filter := &tcpproxyv3.TcpProxy{
+		StatPrefix: fmt.Sprintf("tcp-%s", clusterName),
+		ClusterSpecifier: &tcpproxyv3.TcpProxy_Cluster{
+			Cluster: clusterName,
+		},
+	}
+
+	pbFilter, err := anypb.New(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &listenerv3.FilterChain{
+		Name: fmt.Sprintf("tls-passthrough-%s", clusterName),
+		FilterChainMatch: &listenerv3.FilterChainMatch{
+			ServerNames:       serverNames,
+			TransportProtocol: "tls",
+		},
+		Filters: []*listenerv3.Filter{
+			{
+				Name: wellknown.TCPProxy,
+				ConfigType: &listenerv3.Filter_TypedConfig{
+					TypedConfig: pbFilter,
+				},
+			},
+		},
+	}, nil
+}
+
 func getTLSInspector() (*listenerv3.ListenerFilter, error) {
 	filter := &tls_inspector.TlsInspector{}
 
@@ -111,7 +190,8 @@ func getTLSInspector() (*listenerv3.ListenerFilter, error) {
 	}, nil
 }
 
-func getFilterChainsMain(domain string, crtList []*corev1.Secret, svcList []*corev1.Service) (*listenerv3.FilterChain, error) {
+func getFilterChainsMain(domain string, crtList []*corev1.Secret,
+	svcList []*corev1.Service, gwList []*corev1.Gateway) (*listenerv3.FilterChain, error) {
 
 	ret := &listenerv3.FilterChain{
 		FilterChainMatch: &listenerv3.FilterChainMatch{
@@ -129,7 +209,7 @@ func getFilterChainsMain(domain string, crtList []*corev1.Secret, svcList []*cor
 	}
 	ret.TransportSocket = ts
 
-	httpConnMan, err := getHttpConnManagerFilterMain(domain, svcList)
+	httpConnMan, err := getHttpConnManagerFilterMain(domain, svcList, gwList)
 	if err != nil {
 		return nil, err
 	}
@@ -209,9 +289,10 @@ func getListenerTransportSocket(crtList []*corev1.Secret, alpnProtocols []string
 	}, nil
 }
 
-func getHttpConnManagerFilterMain(domain string, svcList []*corev1.Service) (*listenerv3.Filter, error) {
+func getHttpConnManagerFilterMain(domain string, svcList []*corev1.Service,
+	gwList []*corev1.Gateway) (*listenerv3.Filter, error) {
 
-	routeConfig, err := getRouteConfigMain(domain, svcList)
+	routeConfig, err := getRouteConfigMain(domain, svcList, gwList)
 	if err != nil {
 		return nil, err
 	}
@@ -18,6 +18,7 @@ package resources
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/octelium/octelium/apis/main/corev1"
@@ -61,9 +62,9 @@ func TestGetListeners(t *testing.T) {
 	usr, err = adminSrv.CreateUser(ctx, usr)
 	assert.Nil(t, err)
 
-	_, err = GetListeners("example.com", nil, nil)
+	_, err = GetListeners("example.com", nil, nil, nil, nil, false)
 	assert.Nil(t, err)
-	_, err = GetListeners("example.com", nil, nil)
+	_, err = GetListeners("example.com", nil, nil, nil, nil, false)
 	assert.Nil(t, err)
 
 	var svcList []*corev1.Service
@@ -71,9 +72,9 @@ func TestGetListeners(t *testing.T) {
 		svcList = append(svcList, doCreateSvc())
 	}
 
-	_, err = GetListeners("example.com", svcList, nil)
+	_, err = GetListeners("example.com", svcList, nil, nil, nil, false)
 	assert.Nil(t, err)
-	_, err = GetListeners("example.com", svcList, nil)
+	_, err = GetListeners("example.com", svcList, nil, nil, nil, false)
 	assert.Nil(t, err)
 
 	doCreateCrt := func(name string) *corev1.Secret {
@@ -98,10 +99,62 @@ func TestGetListeners(t *testing.T) {
 		return ret
 	}
 
-	_, err = GetListeners("example.com", svcList, nil)
+	_, err = GetListeners("example.com", svcList, nil, nil, nil, false)
 	assert.Nil(t, err)
 	_, err = GetListeners("example.com", svcList, []*corev1.Secret{
 		doCreateCrt("cluster"),
-	})
+	}, nil, nil, true)
 	assert.Nil(t, err)
 }
+
+func TestGetFilterChainTLSPassthrough(t *testing.T) {
+
+	genSvc := func(name string, mode corev1.Service_Spec_Mode, isTLS bool) *corev1.Service {
+		return &corev1.Service{
+			Metadata: &metav1.Metadata{
+				Name: name,
+			},
+			Spec: &corev1.Service_Spec{
+				Mode:     mode,
+				IsPublic: true,
+				IsTLS:    isTLS,
+			},
+			Status: &corev1.Service_Status{
+				NamespaceRef: &metav1.ObjectReference{
+					Name: strings.Split(name, ".")[1],
+				},
+			},
+		}
+	}
+
+	assert.True(t, isTLSPassthrough(genSvc("svc1.default", corev1.Service_Spec_TCP, true)))
+	assert.False(t, isTLSPassthrough(genSvc("svc1.default", corev1.Service_Spec_TCP, false)))
+	assert.False(t, isTLSPassthrough(genSvc("svc1.default", corev1.Service_Spec_HTTP, true)))
+
+	{
+		svc := genSvc("svc1.ns1", corev1.Service_Spec_TCP, true)
+		chain, err := getFilterChainTLSPassthrough("example.com", svc)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"svc1.ns1.example.com"}, chain.FilterChainMatch.ServerNames)
+		assert.Equal(t, "tls", chain.FilterChainMatch.TransportProtocol)
+		assert.Nil(t, chain.TransportSocket)
+		assert.Equal(t, 1, len(chain.Filters))
+	}
+
+	{
+		svc := genSvc("default.default", corev1.Service_Spec_TCP, true)
+		chain, err := getFilterChainTLSPassthrough("example.com", svc)
+		assert.Nil(t, err)
+		assert.NotContains(t, chain.FilterChainMatch.ServerNames, "example.com")
+	}
+
+	{
+		svcList := []*corev1.Service{
+			genSvc("svc1.ns1", corev1.Service_Spec_TCP, true),
+			genSvc("svc2.ns1", corev1.Service_Spec_TCP, false),
+		}
+		lis, err := getListener("example.com", svcList, nil, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(lis.FilterChains))
+	}
+}
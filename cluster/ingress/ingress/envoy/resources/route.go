@@ -26,6 +26,7 @@ import (
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/octelium/octelium/apis/main/corev1"
 	"github.com/octelium/octelium/cluster/common/k8sutils"
+	"github.com/octelium/octelium/cluster/common/vutils"
 	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -33,12 +34,13 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
-func getRouteConfigMain(domain string, svcList []*corev1.Service) (*routev3.RouteConfiguration, error) {
+func getRouteConfigMain(domain string, svcList []*corev1.Service,
+	gwList []*corev1.Gateway) (*routev3.RouteConfiguration, error) {
 
 	routeConfig := &routev3.RouteConfiguration{}
 
 	{
-		vh, err := getVirtualHostAPI(domain, svcList)
+		vh, err := getVirtualHostAPI(domain, svcList, gwList)
 		if err != nil {
 			return nil, err
 		}
@@ -48,7 +50,7 @@ func getRouteConfigMain(domain string, svcList []*corev1.Service) (*routev3.Rout
 	}
 
 	for _, svc := range svcList {
-		if isAPIServer(svc) {
+		if isAPIServer(svc) || isTLSPassthrough(svc) {
 			continue
 		}
 		vh, err := getVirtualHostService(svc, domain)
@@ -147,13 +149,20 @@ func getVirtualHostMain(domain string, svcList []*corev1.Service) (*routev3.Virt
 }
 */
 
-func getVirtualHostAPI(domain string, svcList []*corev1.Service) (*routev3.VirtualHost, error) {
+func getVirtualHostAPI(domain string, svcList []*corev1.Service,
+	gwList []*corev1.Gateway) (*routev3.VirtualHost, error) {
 
 	routes, err := getRoutesMain(domain, svcList)
 	if err != nil {
 		return nil, err
 	}
 
+	if len(routes) > 0 {
+		// The Gateway tunnel paths are more specific than the API Server
+		// prefixes and must therefore be matched first
+		routes = append(getRoutesGatewayTLS(gwList), routes...)
+	}
+
 	if len(routes) < 1 {
 		return nil, nil
 	}
@@ -251,6 +260,45 @@ func getRoutesMain(domain string, svcList []*corev1.Service) ([]*routev3.Route,
 	return routes, nil
 }
 
+// getRoutesGatewayTLS returns the routes proxying the TLS fallback tunnel
+// WebSocket connections to their Gateways.
+func getRoutesGatewayTLS(gwList []*corev1.Gateway) []*routev3.Route {
+	var ret []*routev3.Route
+
+	for _, gw := range gwList {
+		if !hasGatewayTLS(gw) {
+			continue
+		}
+
+		ret = append(ret, &routev3.Route{
+			Match: &routev3.RouteMatch{
+				PathSpecifier: &routev3.RouteMatch_Path{
+					Path: vutils.GetGatewayTLSTunnelPath(gw.Status.Id),
+				},
+			},
+			Action: &routev3.Route_Route{
+				Route: &routev3.RouteAction{
+					Timeout: &durationpb.Duration{
+						Seconds: 0,
+						Nanos:   0,
+					},
+
+					IdleTimeout: &durationpb.Duration{
+						Seconds: idleTimeoutSeconds,
+						Nanos:   0,
+					},
+
+					ClusterSpecifier: &routev3.RouteAction_Cluster{
+						Cluster: getClusterNameFromGateway(gw),
+					},
+				},
+			},
+		})
+	}
+
+	return ret
+}
+
 func isAPIServer(svc *corev1.Service) bool {
 	return svc.Metadata.SystemLabels != nil &&
 		svc.Metadata.SystemLabels["octelium-apiserver"] == "true" &&
@@ -345,7 +393,7 @@ func getVirtualHostService(svc *corev1.Service, domain string) (*routev3.Virtual
 
 	vh := &routev3.VirtualHost{
 		Name:       fmt.Sprintf("vh-%s", k8sutils.GetSvcHostname(svc)),
-		Domains:    getSvcFQDNs(svc, domain),
+		Domains:    vutils.GetServicePublicFQDNs(svc, domain),
 		Routes:     routes,
 		RequireTls: routev3.VirtualHost_ALL,
 	}
@@ -433,7 +481,7 @@ func getRoutesService(svc *corev1.Service, domain string) ([]*routev3.Route, err
 				},
 
 				HostRewriteSpecifier: &routev3.RouteAction_HostRewriteLiteral{
-					HostRewriteLiteral: getSvcFQDNs(svc, domain)[0],
+					HostRewriteLiteral: vutils.GetServicePublicFQDNs(svc, domain)[0],
 				},
 
 				ClusterSpecifier: &routev3.RouteAction_Cluster{
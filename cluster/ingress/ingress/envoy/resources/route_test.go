@@ -0,0 +1,71 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package resources
+
+import (
+	"testing"
+
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/cluster/common/vutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRoutesGatewayTLS(t *testing.T) {
+	gwList := []*corev1.Gateway{
+		{
+			Metadata: &metav1.Metadata{Name: "gw-1"},
+			Status: &corev1.Gateway_Status{
+				Id: "abcd",
+				Tls: &corev1.Gateway_Status_TLS{
+					Port:    8445,
+					Address: "10.0.0.1",
+				},
+			},
+		},
+		{
+			Metadata: &metav1.Metadata{Name: "gw-2"},
+			Status: &corev1.Gateway_Status{
+				Id: "efgh",
+			},
+		},
+		{
+			Metadata: &metav1.Metadata{Name: "gw-3"},
+			Status: &corev1.Gateway_Status{
+				Id: "ijkl",
+				Tls: &corev1.Gateway_Status_TLS{
+					Port: 8445,
+				},
+			},
+		},
+	}
+
+	routes := getRoutesGatewayTLS(gwList)
+	assert.Equal(t, 1, len(routes))
+	assert.Equal(t, vutils.GetGatewayTLSTunnelPath("abcd"), routes[0].Match.GetPath())
+	assert.Equal(t, getClusterNameFromGateway(gwList[0]),
+		routes[0].Action.(*routev3.Route_Route).Route.GetCluster())
+
+	clusters, err := GetClusters("example.com", nil, gwList)
+	assert.Nil(t, err)
+	// The Gateway cluster and the health check cluster
+	assert.Equal(t, 2, len(clusters))
+
+	vh, err := getVirtualHostAPI("example.com", nil, gwList)
+	assert.Nil(t, err)
+	assert.Nil(t, vh)
+}
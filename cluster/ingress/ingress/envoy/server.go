@@ -25,12 +25,14 @@ import (
 	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	"go.uber.org/zap"
 
+	"github.com/octelium/octelium/apis/main/corev1"
 	"github.com/octelium/octelium/apis/rsc/rmetav1"
 	"github.com/octelium/octelium/cluster/common/octeliumc"
 	"github.com/octelium/octelium/cluster/common/urscsrv"
 	"github.com/octelium/octelium/cluster/common/vutils"
 	"github.com/octelium/octelium/cluster/ingress/ingress/envoy/resources"
 	"github.com/octelium/octelium/cluster/ingress/ingress/xdscb"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
 	"github.com/octelium/octelium/pkg/utils/utilrand"
 
 	"github.com/envoyproxy/go-control-plane/pkg/cache/types"
@@ -157,12 +159,38 @@ func (s *Server) DoSnapshot(ctx context.Context) error {
 		return err
 	}
 
-	rscListeners, err := resources.GetListeners(s.domain, svcList.Items, crtList.Items)
+	cc, err := s.octeliumC.CoreV1Utils().GetClusterConfig(ctx)
 	if err != nil {
 		return err
 	}
 
-	rscClusters, err := resources.GetClusters(s.domain, svcList.Items)
+	isHTTP01 := ucorev1.ToClusterConfig(cc).IsACMEHTTP01Enabled()
+
+	var challengeList []*corev1.Secret
+	if isHTTP01 {
+		secretList, err := s.octeliumC.CoreC().ListSecret(ctx, &rmetav1.ListOptions{
+			SystemLabels: map[string]string{
+				"octelium-acme-http01": "true",
+			},
+		})
+		if err != nil {
+			return err
+		}
+		challengeList = secretList.Items
+	}
+
+	gwList, err := s.getGatewayListTLS(ctx, rgn)
+	if err != nil {
+		return err
+	}
+
+	rscListeners, err := resources.GetListeners(s.domain, svcList.Items, crtList.Items, gwList,
+		challengeList, isHTTP01)
+	if err != nil {
+		return err
+	}
+
+	rscClusters, err := resources.GetClusters(s.domain, svcList.Items, gwList)
 	if err != nil {
 		return err
 	}
@@ -182,3 +210,28 @@ func (s *Server) DoSnapshot(ctx context.Context) error {
 
 	return nil
 }
+
+// getGatewayListTLS returns the region Gateways whose TLS fallback tunnels
+// are proxied by the ingress. No Gateway is returned unless the mode is
+// enabled by the ClusterConfig.
+func (s *Server) getGatewayListTLS(ctx context.Context, rgn *corev1.Region) ([]*corev1.Gateway, error) {
+	cc, err := s.octeliumC.CoreV1Utils().GetClusterConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ucorev1.ToClusterConfig(cc).IsTLSEnabled() {
+		return nil, nil
+	}
+
+	gwList, err := s.octeliumC.CoreC().ListGateway(ctx, &rmetav1.ListOptions{
+		Filters: []*rmetav1.ListOptions_Filter{
+			urscsrv.FilterFieldEQValStr("status.regionRef.uid", rgn.Metadata.Uid),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return gwList.Items, nil
+}
@@ -25,6 +25,8 @@ import (
 	"github.com/octelium/octelium/cluster/common/vutils"
 	"github.com/octelium/octelium/cluster/common/watchers"
 	certcontroller "github.com/octelium/octelium/cluster/ingress/ingress/controllers/certificates"
+	cccontroller "github.com/octelium/octelium/cluster/ingress/ingress/controllers/clusterconfig"
+	gwcontroller "github.com/octelium/octelium/cluster/ingress/ingress/controllers/gateways"
 	svccontroller "github.com/octelium/octelium/cluster/ingress/ingress/controllers/services"
 	"github.com/octelium/octelium/cluster/ingress/ingress/envoy"
 	"go.uber.org/zap"
@@ -53,6 +55,7 @@ func Run(ctx context.Context) error {
 
 	secretCtl := certcontroller.NewController(octeliumC, envoyServer)
 	svcCtl := svccontroller.NewController(octeliumC, envoyServer)
+	gwCtl := gwcontroller.NewController(octeliumC, envoyServer)
 
 	watcher := watchers.NewCoreV1(octeliumC)
 	if err := watcher.Secret(ctx, nil, secretCtl.OnAdd, secretCtl.OnUpdate, secretCtl.OnDelete); err != nil {
@@ -63,6 +66,14 @@ func Run(ctx context.Context) error {
 		return err
 	}
 
+	if err := watcher.Gateway(ctx, nil, gwCtl.OnAdd, gwCtl.OnUpdate, gwCtl.OnDelete); err != nil {
+		return err
+	}
+
+	if err := watcher.ClusterConfig(ctx, nil, cccontroller.NewController(envoyServer).OnUpdate); err != nil {
+		return err
+	}
+
 	healthcheck.Run(vutils.HealthCheckPortMain)
 	zap.L().Info("Ingress controller is now running")
 
@@ -0,0 +1,544 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package acme
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/apis/rsc/rmetav1"
+	"github.com/octelium/octelium/cluster/common/octeliumc"
+	"github.com/octelium/octelium/cluster/common/urscsrv"
+	"github.com/octelium/octelium/cluster/common/vutils"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
+	"github.com/octelium/octelium/pkg/apiutils/umetav1"
+	"github.com/octelium/octelium/pkg/grpcerr"
+	utils_cert "github.com/octelium/octelium/pkg/utils/cert"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/acme"
+)
+
+const accountKeySecretName = "acme-account"
+const defaultRenewBefore = 30 * 24 * time.Hour
+const maxNamesPerCert = 100
+
+// Manager obtains and renews the Cluster and Namespace certificates from the
+// ACME server set by the ClusterConfig and stores them in the same
+// certificate Secrets used by the ingress and the Vigil instances.
+type Manager struct {
+	octeliumC octeliumc.ClientInterface
+
+	interval         time.Duration
+	propagationDelay time.Duration
+
+	mu       sync.Mutex
+	expiries map[string]time.Time
+}
+
+func NewManager(octeliumC octeliumc.ClientInterface) (*Manager, error) {
+	ret := &Manager{
+		octeliumC:        octeliumC,
+		interval:         time.Hour,
+		propagationDelay: 10 * time.Second,
+		expiries:         make(map[string]time.Time),
+	}
+
+	if err := ret.registerMetrics(); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (m *Manager) Run(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+
+		for {
+			if err := m.reconcile(ctx); err != nil {
+				zap.L().Warn("Could not reconcile ACME certificates", zap.Error(err))
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+type certRequest struct {
+	secretName string
+	names      []string
+}
+
+func (m *Manager) reconcile(ctx context.Context) error {
+	cc, err := m.octeliumC.CoreV1Utils().GetClusterConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	acmeCfg := cc.Spec.Certificate.GetAcme()
+	if acmeCfg == nil {
+		return nil
+	}
+
+	svcList, err := m.octeliumC.CoreC().ListService(ctx, &rmetav1.ListOptions{
+		Filters: []*rmetav1.ListOptions_Filter{
+			urscsrv.FilterFieldBooleanTrue("spec.isPublic"),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	renewBefore := umetav1.ToDuration(acmeCfg.RenewBefore).ToGo()
+	if renewBefore == 0 {
+		renewBefore = defaultRenewBefore
+	}
+
+	var client *acme.Client
+	var slv solver
+
+	for _, req := range getCertRequests(cc.Status.Domain, acmeCfg, svcList.Items) {
+		crt, err := m.octeliumC.CoreC().GetSecret(ctx, &rmetav1.GetOptions{Name: req.secretName})
+		if err != nil {
+			if !grpcerr.IsNotFound(err) {
+				return err
+			}
+			crt = nil
+		}
+
+		if leaf := getLeafCertificate(crt); leaf != nil {
+			m.setExpiry(req.secretName, leaf.NotAfter)
+		}
+
+		if !needsRenewal(crt, req.names, renewBefore, time.Now()) {
+			continue
+		}
+
+		if client == nil {
+			client, err = m.getClient(ctx, acmeCfg)
+			if err != nil {
+				return err
+			}
+
+			slv, err = m.getSolver(ctx, cc.Status.Domain, acmeCfg)
+			if err != nil {
+				return err
+			}
+		}
+
+		zap.L().Info("Obtaining ACME certificate",
+			zap.String("secret", req.secretName), zap.Strings("names", req.names))
+
+		chainPEM, keyPEM, err := m.obtain(ctx, client, slv, req.names)
+		if err != nil {
+			zap.L().Warn("Could not obtain ACME certificate",
+				zap.String("secret", req.secretName), zap.Error(err))
+			continue
+		}
+
+		crt, err = m.setCert(ctx, req.secretName, crt, chainPEM, keyPEM)
+		if err != nil {
+			return err
+		}
+
+		if leaf := getLeafCertificate(crt); leaf != nil {
+			m.setExpiry(req.secretName, leaf.NotAfter)
+		}
+
+		zap.L().Info("Successfully set ACME certificate", zap.String("secret", req.secretName))
+	}
+
+	return nil
+}
+
+// getCertRequests returns the certificates required by the Cluster. With
+// DNS-01, the Cluster certificate covers the domain and its wildcard while
+// every Namespace of a public Service gets its own wildcard certificate. With
+// HTTP-01, the certificates instead explicitly list every public Service
+// hostname.
+func getCertRequests(domain string, acmeCfg *corev1.ClusterConfig_Spec_Certificate_ACME,
+	svcList []*corev1.Service) []*certRequest {
+
+	isDNS01 := acmeCfg.GetDns01() != nil
+
+	ret := []*certRequest{
+		{
+			secretName: vutils.ClusterCertSecretName,
+			names: func() []string {
+				if isDNS01 {
+					return []string{domain, fmt.Sprintf("*.%s", domain)}
+				}
+				return []string{domain, fmt.Sprintf("octelium-api.%s", domain)}
+			}(),
+		},
+	}
+
+	getReq := func(ns string) *certRequest {
+		if ns == "default" {
+			return ret[0]
+		}
+
+		secretName := fmt.Sprintf("crt-ns-%s", ns)
+		for _, req := range ret {
+			if req.secretName == secretName {
+				return req
+			}
+		}
+
+		req := &certRequest{
+			secretName: secretName,
+		}
+		if isDNS01 {
+			req.names = []string{fmt.Sprintf("*.%s.%s", ns, domain)}
+		}
+		ret = append(ret, req)
+		return req
+	}
+
+	for _, svc := range svcList {
+		ns := ucorev1.ToService(svc).Namespace()
+		if ns == "" {
+			continue
+		}
+
+		req := getReq(ns)
+		if isDNS01 {
+			continue
+		}
+
+		for _, name := range getSvcHostnames(svc, domain) {
+			if !slices.Contains(req.names, name) {
+				req.names = append(req.names, name)
+			}
+		}
+	}
+
+	for i, req := range ret {
+		if i == 0 {
+			// Keep the Cluster domain first so that it is never truncated
+			slices.Sort(req.names[1:])
+		} else {
+			slices.Sort(req.names)
+		}
+
+		if len(req.names) > maxNamesPerCert {
+			zap.L().Warn("Too many hostnames for a single ACME certificate. Truncating...",
+				zap.String("secret", req.secretName), zap.Int("count", len(req.names)))
+			req.names = req.names[:maxNamesPerCert]
+		}
+	}
+
+	return ret
+}
+
+func getSvcHostnames(svc *corev1.Service, domain string) []string {
+	name := ucorev1.ToService(svc).Name()
+	ns := ucorev1.ToService(svc).Namespace()
+
+	ret := []string{fmt.Sprintf("%s.%s.%s", name, ns, domain)}
+	if name == "default" {
+		ret = append(ret, fmt.Sprintf("%s.%s", ns, domain))
+	}
+	if ns == "default" {
+		ret = append(ret, fmt.Sprintf("%s.%s", name, domain))
+	}
+
+	return ret
+}
+
+func getLeafCertificate(crt *corev1.Secret) *x509.Certificate {
+	if crt == nil || !vutils.IsCertReady(crt) {
+		return nil
+	}
+
+	chain, _, err := ucorev1.ToSecret(crt).GetCertificateChainAndKey()
+	if err != nil {
+		return nil
+	}
+
+	ret, err := utils_cert.ParseX509LeafCertificateChainPEM(chain)
+	if err != nil {
+		return nil
+	}
+
+	return ret
+}
+
+// needsRenewal returns whether the certificate Secret is missing, does not
+// cover all the names or is about to expire within renewBefore.
+func needsRenewal(crt *corev1.Secret, names []string, renewBefore time.Duration, now time.Time) bool {
+	leaf := getLeafCertificate(crt)
+	if leaf == nil {
+		return true
+	}
+
+	if now.Add(renewBefore).After(leaf.NotAfter) {
+		return true
+	}
+
+	for _, name := range names {
+		if !slices.Contains(leaf.DNSNames, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *Manager) getClient(ctx context.Context, acmeCfg *corev1.ClusterConfig_Spec_Certificate_ACME) (*acme.Client, error) {
+	key, err := m.getAccountKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &acme.Client{
+		Key:          key,
+		DirectoryURL: acmeCfg.DirectoryURL,
+		UserAgent:    "octelium",
+	}
+
+	if ret.DirectoryURL == "" {
+		ret.DirectoryURL = acme.LetsEncryptURL
+	}
+
+	if len(acmeCfg.TrustedCAs) > 0 {
+		pool := x509.NewCertPool()
+		for _, ca := range acmeCfg.TrustedCAs {
+			if !pool.AppendCertsFromPEM([]byte(ca)) {
+				return nil, errors.Errorf("Could not parse ACME trusted CA")
+			}
+		}
+
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig.RootCAs = pool
+		ret.HTTPClient = &http.Client{
+			Transport: transport,
+			Timeout:   60 * time.Second,
+		}
+	}
+
+	acct := &acme.Account{}
+	if acmeCfg.Email != "" {
+		acct.Contact = []string{fmt.Sprintf("mailto:%s", acmeCfg.Email)}
+	}
+
+	if _, err := ret.Register(ctx, acct, acme.AcceptTOS); err != nil &&
+		!errors.Is(err, acme.ErrAccountAlreadyExists) {
+		return nil, errors.Errorf("Could not register ACME account: %+v", err)
+	}
+
+	return ret, nil
+}
+
+func (m *Manager) getAccountKey(ctx context.Context) (crypto.Signer, error) {
+	sec, err := m.octeliumC.CoreC().GetSecret(ctx, &rmetav1.GetOptions{Name: accountKeySecretName})
+	if err == nil {
+		return utils_cert.ParsePrivateKeyPEM(ucorev1.ToSecret(sec).GetValueBytes())
+	}
+
+	if !grpcerr.IsNotFound(err) {
+		return nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	keyPEM, err := utils_cert.GetPrivateKeyPEMStr(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := m.octeliumC.CoreC().CreateSecret(ctx, &corev1.Secret{
+		Metadata: &metav1.Metadata{
+			Name:           accountKeySecretName,
+			IsSystem:       true,
+			IsUserHidden:   true,
+			IsSystemHidden: true,
+		},
+		Spec:   &corev1.Secret_Spec{},
+		Status: &corev1.Secret_Status{},
+		Data: &corev1.Secret_Data{
+			Type: &corev1.Secret_Data_Value{
+				Value: keyPEM,
+			},
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+type pendingChallenge struct {
+	authz *acme.Authorization
+	chal  *acme.Challenge
+}
+
+func (m *Manager) obtain(ctx context.Context, client *acme.Client, slv solver, names []string) (string, string, error) {
+	order, err := client.AuthorizeOrder(ctx, acme.DomainIDs(names...))
+	if err != nil {
+		return "", "", err
+	}
+
+	var pendingList []*pendingChallenge
+
+	defer func() {
+		cleanupCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		for _, pending := range pendingList {
+			if err := slv.cleanUp(cleanupCtx, client, pending.authz.Identifier.Value, pending.chal.Token); err != nil {
+				zap.L().Warn("Could not clean up ACME challenge",
+					zap.String("identifier", pending.authz.Identifier.Value), zap.Error(err))
+			}
+		}
+	}()
+
+	for _, authzURL := range order.AuthzURLs {
+		authz, err := client.GetAuthorization(ctx, authzURL)
+		if err != nil {
+			return "", "", err
+		}
+
+		if authz.Status == acme.StatusValid {
+			continue
+		}
+
+		var chal *acme.Challenge
+		for _, c := range authz.Challenges {
+			if c.Type == slv.challengeType() {
+				chal = c
+				break
+			}
+		}
+		if chal == nil {
+			return "", "", errors.Errorf("No %s challenge is offered for %s",
+				slv.challengeType(), authz.Identifier.Value)
+		}
+
+		if err := slv.present(ctx, client, authz.Identifier.Value, chal.Token); err != nil {
+			return "", "", err
+		}
+
+		pendingList = append(pendingList, &pendingChallenge{
+			authz: authz,
+			chal:  chal,
+		})
+	}
+
+	if len(pendingList) > 0 && m.propagationDelay > 0 {
+		select {
+		case <-ctx.Done():
+			return "", "", ctx.Err()
+		case <-time.After(m.propagationDelay):
+		}
+	}
+
+	for _, pending := range pendingList {
+		if _, err := client.Accept(ctx, pending.chal); err != nil {
+			return "", "", err
+		}
+	}
+
+	for _, pending := range pendingList {
+		if _, err := client.WaitAuthorization(ctx, pending.authz.URI); err != nil {
+			return "", "", err
+		}
+	}
+
+	order, err = client.WaitOrder(ctx, order.URI)
+	if err != nil {
+		return "", "", err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		DNSNames: names,
+	}, key)
+	if err != nil {
+		return "", "", err
+	}
+
+	ders, _, err := client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return "", "", err
+	}
+
+	var chainPEM []byte
+	for _, der := range ders {
+		chainPEM = append(chainPEM, pem.EncodeToMemory(&pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: der,
+		})...)
+	}
+
+	keyPEM, err := utils_cert.GetPrivateKeyPEMStr(key)
+	if err != nil {
+		return "", "", err
+	}
+
+	return string(chainPEM), keyPEM, nil
+}
+
+func (m *Manager) setCert(ctx context.Context,
+	secretName string, crt *corev1.Secret, chainPEM, keyPEM string) (*corev1.Secret, error) {
+	if crt != nil {
+		ucorev1.ToSecret(crt).SetCertificate(chainPEM, keyPEM)
+		return m.octeliumC.CoreC().UpdateSecret(ctx, crt)
+	}
+
+	crt = &corev1.Secret{
+		Metadata: &metav1.Metadata{
+			Name: secretName,
+			SystemLabels: map[string]string{
+				"octelium-cert": "true",
+			},
+			IsSystem:       true,
+			IsUserHidden:   true,
+			IsSystemHidden: true,
+		},
+		Spec:   &corev1.Secret_Spec{},
+		Status: &corev1.Secret_Status{},
+	}
+
+	ucorev1.ToSecret(crt).SetCertificate(chainPEM, keyPEM)
+
+	return m.octeliumC.CoreC().CreateSecret(ctx, crt)
+}
@@ -0,0 +1,225 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package acme
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
+	utils_cert "github.com/octelium/octelium/pkg/utils/cert"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/acme"
+)
+
+func TestGetCertRequests(t *testing.T) {
+	genSvc := func(name, ns string) *corev1.Service {
+		return &corev1.Service{
+			Metadata: &metav1.Metadata{
+				Name: fmt.Sprintf("%s.%s", name, ns),
+			},
+			Spec: &corev1.Service_Spec{
+				IsPublic: true,
+			},
+			Status: &corev1.Service_Status{
+				NamespaceRef: &metav1.ObjectReference{
+					Name: ns,
+				},
+			},
+		}
+	}
+
+	svcList := []*corev1.Service{
+		genSvc("svc1", "default"),
+		genSvc("svc2", "ns1"),
+		genSvc("default", "ns1"),
+		genSvc("svc3", "ns2"),
+	}
+
+	{
+		reqs := getCertRequests("example.com", &corev1.ClusterConfig_Spec_Certificate_ACME{
+			Challenge: &corev1.ClusterConfig_Spec_Certificate_ACME_Dns01{
+				Dns01: &corev1.ClusterConfig_Spec_Certificate_ACME_DNS01{},
+			},
+		}, svcList)
+
+		assert.Equal(t, 3, len(reqs))
+		assert.Equal(t, "crt-ns-default", reqs[0].secretName)
+		assert.Equal(t, []string{"example.com", "*.example.com"}, reqs[0].names)
+		assert.Equal(t, "crt-ns-ns1", reqs[1].secretName)
+		assert.Equal(t, []string{"*.ns1.example.com"}, reqs[1].names)
+		assert.Equal(t, "crt-ns-ns2", reqs[2].secretName)
+		assert.Equal(t, []string{"*.ns2.example.com"}, reqs[2].names)
+	}
+
+	{
+		reqs := getCertRequests("example.com", &corev1.ClusterConfig_Spec_Certificate_ACME{
+			Challenge: &corev1.ClusterConfig_Spec_Certificate_ACME_Http01{
+				Http01: &corev1.ClusterConfig_Spec_Certificate_ACME_HTTP01{},
+			},
+		}, svcList)
+
+		assert.Equal(t, 3, len(reqs))
+		assert.Equal(t, []string{
+			"example.com",
+			"octelium-api.example.com",
+			"svc1.default.example.com",
+			"svc1.example.com",
+		}, reqs[0].names)
+		assert.Equal(t, []string{
+			"default.ns1.example.com",
+			"ns1.example.com",
+			"svc2.ns1.example.com",
+		}, reqs[1].names)
+		assert.Equal(t, []string{"svc3.ns2.example.com"}, reqs[2].names)
+	}
+}
+
+func TestNeedsRenewal(t *testing.T) {
+	genCrt := func(names []string, duration time.Duration) *corev1.Secret {
+		crt, err := utils_cert.GenerateSelfSignedCert(names[0], names, duration)
+		assert.Nil(t, err)
+
+		ret := &corev1.Secret{
+			Metadata: &metav1.Metadata{
+				Name: "crt-ns-default",
+			},
+			Spec:   &corev1.Secret_Spec{},
+			Status: &corev1.Secret_Status{},
+		}
+		ucorev1.ToSecret(ret).SetCertificate(string(crt.MustGetCertPEM()), string(crt.MustGetPrivateKeyPEM()))
+		return ret
+	}
+
+	names := []string{"example.com", "*.example.com"}
+	now := time.Now()
+
+	assert.True(t, needsRenewal(nil, names, defaultRenewBefore, now))
+	assert.True(t, needsRenewal(&corev1.Secret{
+		Metadata: &metav1.Metadata{},
+		Spec:     &corev1.Secret_Spec{},
+	}, names, defaultRenewBefore, now))
+
+	crt := genCrt(names, 90*24*time.Hour)
+	assert.False(t, needsRenewal(crt, names, defaultRenewBefore, now))
+	assert.False(t, needsRenewal(crt, names[:1], defaultRenewBefore, now))
+	assert.True(t, needsRenewal(crt, append(names, "*.ns1.example.com"), defaultRenewBefore, now))
+	assert.True(t, needsRenewal(crt, names, defaultRenewBefore, now.Add(61*24*time.Hour)))
+
+	crt = genCrt(names, 10*24*time.Hour)
+	assert.True(t, needsRenewal(crt, names, defaultRenewBefore, now))
+	assert.False(t, needsRenewal(crt, names, 5*24*time.Hour, now))
+}
+
+func TestChallengeNames(t *testing.T) {
+	assert.Equal(t, "_acme-challenge.example.com.", getDNS01FQDN("example.com"))
+	assert.Equal(t, "_acme-challenge.example.com.", getDNS01FQDN("*.example.com"))
+	assert.Equal(t, "_acme-challenge.ns1.example.com.", getDNS01FQDN("*.ns1.example.com."))
+
+	assert.True(t, strings.HasPrefix(getHTTP01SecretName("token"), "acme-http01-"))
+	assert.Equal(t, getHTTP01SecretName("token"), getHTTP01SecretName("token"))
+	assert.NotEqual(t, getHTTP01SecretName("token"), getHTTP01SecretName("token2"))
+}
+
+type challTestSrvProvider struct {
+	addr string
+}
+
+func (p *challTestSrvProvider) Present(ctx context.Context, fqdn, value string) error {
+	return p.do(ctx, "set-txt", fmt.Sprintf(`{"host":"%s","value":"%s"}`, fqdn, value))
+}
+
+func (p *challTestSrvProvider) CleanUp(ctx context.Context, fqdn, value string) error {
+	return p.do(ctx, "clear-txt", fmt.Sprintf(`{"host":"%s"}`, fqdn))
+}
+
+func (p *challTestSrvProvider) do(ctx context.Context, path, body string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		fmt.Sprintf("%s/%s", p.addr, path), strings.NewReader(body))
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// TestObtainPebble runs the whole DNS-01 flow against a local Pebble instance
+// whose DNS resolver is pebble-challtestsrv (e.g. `pebble -dnsserver
+// 127.0.0.1:8053` and `pebble-challtestsrv`).
+func TestObtainPebble(t *testing.T) {
+	directoryURL := os.Getenv("OCTELIUM_TEST_PEBBLE_DIRECTORY_URL")
+	challTestSrvAddr := os.Getenv("OCTELIUM_TEST_PEBBLE_CHALLTESTSRV_ADDR")
+	if directoryURL == "" || challTestSrvAddr == "" {
+		t.Skip("Pebble is not set")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+
+	client := &acme.Client{
+		Key:          key,
+		DirectoryURL: directoryURL,
+		HTTPClient: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: true,
+				},
+			},
+		},
+	}
+
+	_, err = client.Register(ctx, &acme.Account{}, acme.AcceptTOS)
+	assert.Nil(t, err)
+
+	m := &Manager{
+		expiries: make(map[string]time.Time),
+	}
+
+	names := []string{"example.com", "*.example.com"}
+	chainPEM, keyPEM, err := m.obtain(ctx, client, &dns01Solver{
+		provider: &challTestSrvProvider{addr: challTestSrvAddr},
+	}, names)
+	assert.Nil(t, err, "%+v", err)
+
+	leaf, err := utils_cert.ParseX509LeafCertificateChainPEM([]byte(chainPEM))
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, names, leaf.DNSNames)
+
+	_, err = utils_cert.ParsePrivateKeyPEM([]byte(keyPEM))
+	assert.Nil(t, err)
+
+	assert.Contains(t, leaf.ExtKeyUsage, x509.ExtKeyUsageServerAuth)
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package acme
+
+import (
+	"context"
+	"time"
+
+	"github.com/octelium/octelium/cluster/common/otelutils"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+func (m *Manager) registerMetrics() error {
+	meter := otelutils.GetMeter()
+
+	expiry, err := meter.Float64ObservableGauge(
+		otelutils.GetComponentKeyWithPrefix("acme.certificate.expiry"),
+		metric.WithUnit("s"),
+		metric.WithDescription("Remaining seconds until the ACME certificate expires"))
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		now := time.Now()
+		for secretName, notAfter := range m.expiries {
+			observer.ObserveFloat64(expiry, notAfter.Sub(now).Seconds(),
+				metric.WithAttributes(attribute.String("secret", secretName)))
+		}
+
+		return nil
+	}, expiry)
+
+	return err
+}
+
+func (m *Manager) setExpiry(secretName string, notAfter time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expiries[secretName] = notAfter
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package acme
+
+import (
+	"context"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/pkg/errors"
+)
+
+// RFC2136Provider sets the DNS-01 TXT records via dynamic DNS updates sent
+// to an authoritative server, optionally signed with a TSIG key.
+type RFC2136Provider struct {
+	Server string
+	Zone   string
+
+	TSIGKeyName   string
+	TSIGAlgorithm string
+
+	// TSIGSecret is the base64-encoded TSIG key.
+	TSIGSecret string
+}
+
+func (p *RFC2136Provider) Present(ctx context.Context, fqdn, value string) error {
+	return p.update(ctx, fqdn, value, false)
+}
+
+func (p *RFC2136Provider) CleanUp(ctx context.Context, fqdn, value string) error {
+	return p.update(ctx, fqdn, value, true)
+}
+
+func (p *RFC2136Provider) update(ctx context.Context, fqdn, value string, isRemove bool) error {
+	msg, err := p.buildMsg(fqdn, value, isRemove)
+	if err != nil {
+		return err
+	}
+
+	c := &dns.Client{
+		Timeout: 10 * time.Second,
+	}
+
+	if p.TSIGKeyName != "" {
+		c.TsigSecret = map[string]string{
+			dns.Fqdn(p.TSIGKeyName): p.TSIGSecret,
+		}
+	}
+
+	resp, _, err := c.ExchangeContext(ctx, msg, p.Server)
+	if err != nil {
+		return errors.Errorf("Could not send RFC2136 update: %+v", err)
+	}
+
+	if resp.Rcode != dns.RcodeSuccess {
+		return errors.Errorf("RFC2136 update failed: %s", dns.RcodeToString[resp.Rcode])
+	}
+
+	return nil
+}
+
+func (p *RFC2136Provider) buildMsg(fqdn, value string, isRemove bool) (*dns.Msg, error) {
+	rrs := []dns.RR{
+		&dns.TXT{
+			Hdr: dns.RR_Header{
+				Name:   dns.Fqdn(fqdn),
+				Rrtype: dns.TypeTXT,
+				Class:  dns.ClassINET,
+				Ttl:    60,
+			},
+			Txt: []string{value},
+		},
+	}
+
+	ret := &dns.Msg{}
+	ret.SetUpdate(dns.Fqdn(p.Zone))
+	if isRemove {
+		ret.Remove(rrs)
+	} else {
+		ret.Insert(rrs)
+	}
+
+	if p.TSIGKeyName != "" {
+		algorithm, err := getTSIGAlgorithm(p.TSIGAlgorithm)
+		if err != nil {
+			return nil, err
+		}
+
+		ret.SetTsig(dns.Fqdn(p.TSIGKeyName), algorithm, 300, time.Now().Unix())
+	}
+
+	return ret, nil
+}
+
+func getTSIGAlgorithm(arg string) (string, error) {
+	switch arg {
+	case "", "hmac-sha256":
+		return dns.HmacSHA256, nil
+	case "hmac-sha1":
+		return dns.HmacSHA1, nil
+	case "hmac-sha224":
+		return dns.HmacSHA224, nil
+	case "hmac-sha384":
+		return dns.HmacSHA384, nil
+	case "hmac-sha512":
+		return dns.HmacSHA512, nil
+	default:
+		return "", errors.Errorf("Unsupported TSIG algorithm: %s", arg)
+	}
+}
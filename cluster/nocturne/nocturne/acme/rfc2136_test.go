@@ -0,0 +1,132 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package acme
+
+import (
+	"context"
+	"encoding/base64"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRFC2136Provider(t *testing.T) {
+	ctx := context.Background()
+
+	tsigSecret := base64.StdEncoding.EncodeToString([]byte("abcdefghijklmnopqrstuvwxyz012345"))
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.Nil(t, err)
+
+	var mu sync.Mutex
+	records := make(map[string]bool)
+
+	srv := &dns.Server{
+		PacketConn: pc,
+		MsgAcceptFunc: func(dh dns.Header) dns.MsgAcceptAction {
+			return dns.MsgAccept
+		},
+		TsigSecret: map[string]string{
+			"acme-key.": tsigSecret,
+		},
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, req *dns.Msg) {
+			resp := &dns.Msg{}
+			resp.SetReply(req)
+
+			if req.IsTsig() == nil || w.TsigStatus() != nil || req.Question[0].Name != "example.com." {
+				resp.Rcode = dns.RcodeRefused
+			} else {
+				mu.Lock()
+				for _, rr := range req.Ns {
+					txt := rr.(*dns.TXT)
+					key := txt.Hdr.Name + txt.Txt[0]
+					if txt.Hdr.Class == dns.ClassNONE {
+						delete(records, key)
+					} else {
+						records[key] = true
+					}
+				}
+				mu.Unlock()
+				resp.SetTsig("acme-key.", dns.HmacSHA256, 300, int64(req.IsTsig().TimeSigned))
+			}
+
+			w.WriteMsg(resp)
+		}),
+	}
+
+	started := make(chan struct{})
+	srv.NotifyStartedFunc = func() { close(started) }
+	go srv.ActivateAndServe()
+	<-started
+	t.Cleanup(func() {
+		srv.Shutdown()
+	})
+
+	provider := &RFC2136Provider{
+		Server:      pc.LocalAddr().String(),
+		Zone:        "example.com",
+		TSIGKeyName: "acme-key",
+		TSIGSecret:  tsigSecret,
+	}
+
+	err = provider.Present(ctx, "_acme-challenge.example.com.", "value1")
+	assert.Nil(t, err, "%+v", err)
+	err = provider.Present(ctx, "_acme-challenge.example.com.", "value2")
+	assert.Nil(t, err, "%+v", err)
+
+	mu.Lock()
+	assert.Equal(t, map[string]bool{
+		"_acme-challenge.example.com.value1": true,
+		"_acme-challenge.example.com.value2": true,
+	}, records)
+	mu.Unlock()
+
+	err = provider.CleanUp(ctx, "_acme-challenge.example.com.", "value1")
+	assert.Nil(t, err)
+
+	mu.Lock()
+	assert.Equal(t, map[string]bool{
+		"_acme-challenge.example.com.value2": true,
+	}, records)
+	mu.Unlock()
+
+	{
+		provider := &RFC2136Provider{
+			Server:      pc.LocalAddr().String(),
+			Zone:        "example.com",
+			TSIGKeyName: "acme-key",
+			TSIGSecret:  base64.StdEncoding.EncodeToString([]byte("invalid")),
+		}
+		err = provider.Present(ctx, "_acme-challenge.example.com.", "value3")
+		assert.NotNil(t, err)
+	}
+
+	{
+		provider := &RFC2136Provider{
+			Server: pc.LocalAddr().String(),
+			Zone:   "example.com",
+		}
+		err = provider.Present(ctx, "_acme-challenge.example.com.", "value3")
+		assert.NotNil(t, err)
+	}
+
+	_, err = getTSIGAlgorithm("hmac-md5")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,181 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package acme
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/apis/rsc/rmetav1"
+	"github.com/octelium/octelium/cluster/common/octeliumc"
+	"github.com/octelium/octelium/cluster/common/vutils"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
+	"github.com/octelium/octelium/pkg/grpcerr"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/acme"
+)
+
+type solver interface {
+	challengeType() string
+	present(ctx context.Context, client *acme.Client, identifier, token string) error
+	cleanUp(ctx context.Context, client *acme.Client, identifier, token string) error
+}
+
+// DNSProvider sets and removes the TXT records of the DNS-01 challenges.
+type DNSProvider interface {
+	Present(ctx context.Context, fqdn, value string) error
+	CleanUp(ctx context.Context, fqdn, value string) error
+}
+
+func (m *Manager) getSolver(ctx context.Context,
+	domain string, acmeCfg *corev1.ClusterConfig_Spec_Certificate_ACME) (solver, error) {
+	switch acmeCfg.Challenge.(type) {
+	case *corev1.ClusterConfig_Spec_Certificate_ACME_Http01:
+		return &http01Solver{
+			octeliumC: m.octeliumC,
+		}, nil
+	case *corev1.ClusterConfig_Spec_Certificate_ACME_Dns01:
+		provider, err := m.getDNSProvider(ctx, domain, acmeCfg.GetDns01())
+		if err != nil {
+			return nil, err
+		}
+		return &dns01Solver{
+			provider: provider,
+		}, nil
+	default:
+		return nil, errors.Errorf("Unsupported ACME challenge")
+	}
+}
+
+func (m *Manager) getDNSProvider(ctx context.Context,
+	domain string, cfg *corev1.ClusterConfig_Spec_Certificate_ACME_DNS01) (DNSProvider, error) {
+	switch cfg.Type.(type) {
+	case *corev1.ClusterConfig_Spec_Certificate_ACME_DNS01_Rfc2136:
+		rfc2136 := cfg.GetRfc2136()
+		ret := &RFC2136Provider{
+			Server: rfc2136.Server,
+			Zone:   rfc2136.Zone,
+		}
+		if ret.Zone == "" {
+			ret.Zone = domain
+		}
+
+		if tsig := rfc2136.Tsig; tsig != nil {
+			sec, err := m.octeliumC.CoreC().GetSecret(ctx, &rmetav1.GetOptions{Name: tsig.GetFromSecret()})
+			if err != nil {
+				return nil, err
+			}
+
+			ret.TSIGKeyName = tsig.KeyName
+			ret.TSIGAlgorithm = tsig.Algorithm
+			ret.TSIGSecret = strings.TrimSpace(ucorev1.ToSecret(sec).GetValueStr())
+		}
+
+		return ret, nil
+	default:
+		return nil, errors.Errorf("Unsupported DNS-01 provider")
+	}
+}
+
+type dns01Solver struct {
+	provider DNSProvider
+}
+
+func (s *dns01Solver) challengeType() string {
+	return "dns-01"
+}
+
+func (s *dns01Solver) present(ctx context.Context, client *acme.Client, identifier, token string) error {
+	value, err := client.DNS01ChallengeRecord(token)
+	if err != nil {
+		return err
+	}
+
+	return s.provider.Present(ctx, getDNS01FQDN(identifier), value)
+}
+
+func (s *dns01Solver) cleanUp(ctx context.Context, client *acme.Client, identifier, token string) error {
+	value, err := client.DNS01ChallengeRecord(token)
+	if err != nil {
+		return err
+	}
+
+	return s.provider.CleanUp(ctx, getDNS01FQDN(identifier), value)
+}
+
+func getDNS01FQDN(identifier string) string {
+	return fmt.Sprintf("_acme-challenge.%s.", strings.TrimSuffix(strings.TrimPrefix(identifier, "*."), "."))
+}
+
+// http01Solver stores the challenge key authorizations as system Secrets
+// from which the ingress serves the HTTP-01 responses.
+type http01Solver struct {
+	octeliumC octeliumc.ClientInterface
+}
+
+func (s *http01Solver) challengeType() string {
+	return "http-01"
+}
+
+func (s *http01Solver) present(ctx context.Context, client *acme.Client, identifier, token string) error {
+	keyAuth, err := client.HTTP01ChallengeResponse(token)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.octeliumC.CoreC().CreateSecret(ctx, &corev1.Secret{
+		Metadata: &metav1.Metadata{
+			Name: getHTTP01SecretName(token),
+			SystemLabels: map[string]string{
+				"octelium-acme-http01": "true",
+			},
+			IsSystem:       true,
+			IsUserHidden:   true,
+			IsSystemHidden: true,
+		},
+		Spec:   &corev1.Secret_Spec{},
+		Status: &corev1.Secret_Status{},
+		Data: &corev1.Secret_Data{
+			Type: &corev1.Secret_Data_Value{
+				Value: keyAuth,
+			},
+		},
+	})
+	if err != nil && !grpcerr.AlreadyExists(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *http01Solver) cleanUp(ctx context.Context, client *acme.Client, identifier, token string) error {
+	_, err := s.octeliumC.CoreC().DeleteSecret(ctx, &rmetav1.DeleteOptions{
+		Name: getHTTP01SecretName(token),
+	})
+	if err != nil && !grpcerr.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func getHTTP01SecretName(token string) string {
+	return fmt.Sprintf("acme-http01-%s", vutils.Sha256SumHex([]byte(token))[:16])
+}
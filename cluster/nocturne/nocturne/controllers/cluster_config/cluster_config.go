@@ -22,11 +22,17 @@ import (
 	"github.com/octelium/octelium/apis/main/corev1"
 	"github.com/octelium/octelium/apis/main/metav1"
 	"github.com/octelium/octelium/apis/rsc/rmetav1"
+	"github.com/octelium/octelium/cluster/common/components"
+	"github.com/octelium/octelium/cluster/common/k8sutils"
 	"github.com/octelium/octelium/cluster/common/octeliumc"
+	"github.com/octelium/octelium/cluster/common/vutils"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
 	"github.com/octelium/octelium/pkg/apiutils/umetav1"
 	"github.com/octelium/octelium/pkg/common/pbutils"
 	"github.com/octelium/octelium/pkg/grpcerr"
 	"go.uber.org/zap"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -51,6 +57,39 @@ func (c *Controller) OnUpdate(ctx context.Context, new, old *corev1.ClusterConfi
 		return err
 	}
 
+	if err := c.setIngressDataPlaneService(ctx, new, old); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// setIngressDataPlaneService exposes or removes the ingress plain HTTP port
+// whenever ACME HTTP-01 is enabled or disabled.
+func (c *Controller) setIngressDataPlaneService(ctx context.Context, new, old *corev1.ClusterConfig) error {
+	if ucorev1.ToClusterConfig(new).IsACMEHTTP01Enabled() ==
+		ucorev1.ToClusterConfig(old).IsACMEHTTP01Enabled() {
+		return nil
+	}
+
+	svc, err := c.k8sC.CoreV1().Services(vutils.K8sNS).Get(ctx,
+		components.OcteliumComponent(components.IngressDataPlane), k8smetav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	svc.Spec.Ports = k8sutils.GetIngressDataPlaneServicePorts(new)
+
+	if _, err := c.k8sC.CoreV1().Services(vutils.K8sNS).Update(ctx, svc, k8smetav1.UpdateOptions{}); err != nil {
+		return err
+	}
+
+	zap.L().Info("Updated the ingress data plane Service ports",
+		zap.Bool("http01", ucorev1.ToClusterConfig(new).IsACMEHTTP01Enabled()))
+
 	return nil
 }
 
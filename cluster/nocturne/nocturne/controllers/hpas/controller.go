@@ -0,0 +1,173 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package hpacontroller
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	autoscalinginformers "k8s.io/client-go/informers/autoscaling/v2"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/rsc/rmetav1"
+	"github.com/octelium/octelium/cluster/common/octeliumc"
+	"github.com/octelium/octelium/cluster/common/vutils"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+)
+
+const (
+	labelSvcUID         = "octelium.com/svc-uid"
+	labelSvcUpstreamCfg = "octelium.com/svc-upstream-cfg"
+)
+
+// NewController watches the HorizontalPodAutoscalers of autoscaled Services
+// and reports their current and desired replicas in the Service status.
+func NewController(
+	octeliumC octeliumc.ClientInterface,
+	hpaInformer autoscalinginformers.HorizontalPodAutoscalerInformer) {
+
+	hpaInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(old, new any) {
+
+			oldHPA, ok := old.(*autoscalingv2.HorizontalPodAutoscaler)
+			if !ok {
+				return
+			}
+			newHPA, ok := new.(*autoscalingv2.HorizontalPodAutoscaler)
+			if !ok {
+				return
+			}
+
+			if oldHPA.ResourceVersion == newHPA.ResourceVersion {
+				return
+			}
+
+			if err := doHandle(context.Background(), octeliumC, newHPA, false); err != nil {
+				zap.L().Warn("Could not handle HPA update",
+					zap.String("name", newHPA.Name), zap.Error(err))
+			}
+		},
+
+		AddFunc: func(obj any) {
+			hpa, ok := obj.(*autoscalingv2.HorizontalPodAutoscaler)
+			if !ok {
+				return
+			}
+
+			if err := doHandle(context.Background(), octeliumC, hpa, false); err != nil {
+				zap.L().Warn("Could not handle HPA add",
+					zap.String("name", hpa.Name), zap.Error(err))
+			}
+		},
+
+		DeleteFunc: func(obj any) {
+			hpa, ok := obj.(*autoscalingv2.HorizontalPodAutoscaler)
+			if !ok {
+				return
+			}
+
+			if err := doHandle(context.Background(), octeliumC, hpa, true); err != nil {
+				zap.L().Debug("Could not handle HPA delete",
+					zap.String("name", hpa.Name), zap.Error(err))
+			}
+		},
+	})
+}
+
+func doHandle(ctx context.Context, octeliumC octeliumc.ClientInterface,
+	hpa *autoscalingv2.HorizontalPodAutoscaler, isDeleted bool) error {
+	if hpa.Namespace != vutils.K8sNS {
+		return nil
+	}
+
+	svcUID, ok := hpa.Labels[labelSvcUID]
+	if !ok || svcUID == "" {
+		return nil
+	}
+
+	svc, err := octeliumC.CoreC().GetService(ctx, &rmetav1.GetOptions{Uid: svcUID})
+	if err != nil {
+		return err
+	}
+
+	if !setServiceReplicas(svc, hpa, isDeleted) {
+		return nil
+	}
+
+	_, err = octeliumC.CoreC().UpdateService(ctx, svc)
+	return err
+}
+
+// setServiceReplicas sets the replica status of the Service from the HPA
+// and returns whether the status has changed.
+func setServiceReplicas(svc *corev1.Service,
+	hpa *autoscalingv2.HorizontalPodAutoscaler, isDeleted bool) bool {
+	if svc.Status == nil {
+		svc.Status = &corev1.Service_Status{}
+	}
+
+	var replicas *corev1.Service_Status_Replicas
+	if !isDeleted {
+		replicas = &corev1.Service_Status_Replicas{
+			Current: uint32(hpa.Status.CurrentReplicas),
+			Desired: uint32(hpa.Status.DesiredReplicas),
+		}
+	}
+
+	cfgName, isUpstream := hpa.Labels[labelSvcUpstreamCfg]
+	if !isUpstream {
+		if proto.Equal(svc.Status.Replicas, replicas) {
+			return false
+		}
+		svc.Status.Replicas = replicas
+		return true
+	}
+
+	for i, upstream := range svc.Status.UpstreamReplicas {
+		if upstream.ConfigName != cfgName {
+			continue
+		}
+
+		if replicas == nil {
+			svc.Status.UpstreamReplicas = append(svc.Status.UpstreamReplicas[:i],
+				svc.Status.UpstreamReplicas[i+1:]...)
+			return true
+		}
+
+		if proto.Equal(upstream.Replicas, replicas) {
+			return false
+		}
+
+		upstream.Replicas = replicas
+		return true
+	}
+
+	if replicas == nil {
+		return false
+	}
+
+	svc.Status.UpstreamReplicas = append(svc.Status.UpstreamReplicas,
+		&corev1.Service_Status_UpstreamReplicas{
+			ConfigName: cfgName,
+			Replicas:   replicas,
+		})
+
+	return true
+}
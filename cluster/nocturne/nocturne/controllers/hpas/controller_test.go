@@ -0,0 +1,67 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package hpacontroller
+
+import (
+	"testing"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/stretchr/testify/assert"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+)
+
+func TestSetServiceReplicas(t *testing.T) {
+	svc := &corev1.Service{
+		Status: &corev1.Service_Status{},
+	}
+
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	hpa.Labels = map[string]string{
+		labelSvcUID: "svc-uid",
+	}
+	hpa.Status.CurrentReplicas = 2
+	hpa.Status.DesiredReplicas = 4
+
+	assert.True(t, setServiceReplicas(svc, hpa, false))
+	assert.Equal(t, uint32(2), svc.Status.Replicas.Current)
+	assert.Equal(t, uint32(4), svc.Status.Replicas.Desired)
+	assert.False(t, setServiceReplicas(svc, hpa, false))
+
+	upstreamHPA := &autoscalingv2.HorizontalPodAutoscaler{}
+	upstreamHPA.Labels = map[string]string{
+		labelSvcUID:         "svc-uid",
+		labelSvcUpstreamCfg: "",
+	}
+	upstreamHPA.Status.CurrentReplicas = 1
+	upstreamHPA.Status.DesiredReplicas = 3
+
+	assert.True(t, setServiceReplicas(svc, upstreamHPA, false))
+	assert.Equal(t, 1, len(svc.Status.UpstreamReplicas))
+	assert.Equal(t, uint32(3), svc.Status.UpstreamReplicas[0].Replicas.Desired)
+	assert.False(t, setServiceReplicas(svc, upstreamHPA, false))
+
+	upstreamHPA.Status.CurrentReplicas = 3
+	assert.True(t, setServiceReplicas(svc, upstreamHPA, false))
+	assert.Equal(t, uint32(3), svc.Status.UpstreamReplicas[0].Replicas.Current)
+
+	assert.True(t, setServiceReplicas(svc, upstreamHPA, true))
+	assert.Equal(t, 0, len(svc.Status.UpstreamReplicas))
+
+	assert.True(t, setServiceReplicas(svc, hpa, true))
+	assert.Nil(t, svc.Status.Replicas)
+	assert.False(t, setServiceReplicas(svc, hpa, true))
+}
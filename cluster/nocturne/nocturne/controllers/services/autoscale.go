@@ -0,0 +1,129 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package svccontroller
+
+import (
+	"context"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/cluster/common/k8sutils"
+	utils_types "github.com/octelium/octelium/pkg/utils/types"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	k8scorev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const defaultAutoscaleCPUUtilization = 80
+
+// getDeploymentReplicas returns the replicas of a Deployment. A nil value is
+// returned for autoscaled Deployments so that the replicas set by the
+// HorizontalPodAutoscaler are not overridden.
+func getDeploymentReplicas(replicas uint32, autoscale *corev1.Service_Spec_Autoscale) *int32 {
+	if autoscale != nil {
+		return nil
+	}
+
+	if replicas < 1 {
+		return utils_types.Int32ToPtr(1)
+	}
+
+	return utils_types.Int32ToPtr(int32(replicas))
+}
+
+func (c *Controller) setAutoscaler(ctx context.Context, name string,
+	labels map[string]string, autoscale *corev1.Service_Spec_Autoscale, ownerCM *k8scorev1.ConfigMap) error {
+
+	if autoscale == nil {
+		if err := c.k8sC.AutoscalingV2().HorizontalPodAutoscalers(ns).Delete(ctx,
+			name, k8smetav1.DeleteOptions{}); err != nil && !k8serr.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+
+	_, err := k8sutils.CreateOrUpdateHorizontalPodAutoscaler(ctx, c.k8sC,
+		getHorizontalPodAutoscaler(name, labels, autoscale, ownerCM))
+	return err
+}
+
+func getHorizontalPodAutoscaler(name string, labels map[string]string,
+	autoscale *corev1.Service_Spec_Autoscale, ownerCM *k8scorev1.ConfigMap) *autoscalingv2.HorizontalPodAutoscaler {
+
+	minReplicas := int32(autoscale.MinReplicas)
+	if minReplicas < 1 {
+		minReplicas = 1
+	}
+
+	maxReplicas := int32(autoscale.MaxReplicas)
+	if maxReplicas < minReplicas {
+		maxReplicas = minReplicas
+	}
+
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: k8smetav1.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+			Labels:    labels,
+			OwnerReferences: []k8smetav1.OwnerReference{
+				*k8smetav1.NewControllerRef(ownerCM, k8scorev1.SchemeGroupVersion.WithKind("ConfigMap")),
+			},
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       name,
+			},
+			MinReplicas: &minReplicas,
+			MaxReplicas: maxReplicas,
+			Metrics:     getAutoscaleMetrics(autoscale),
+		},
+	}
+}
+
+func getAutoscaleMetrics(autoscale *corev1.Service_Spec_Autoscale) []autoscalingv2.MetricSpec {
+	getUtilization := func(name k8scorev1.ResourceName, val uint32) autoscalingv2.MetricSpec {
+		return autoscalingv2.MetricSpec{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name: name,
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: utils_types.Int32ToPtr(int32(val)),
+				},
+			},
+		}
+	}
+
+	var ret []autoscalingv2.MetricSpec
+
+	for _, metric := range autoscale.Metrics {
+		switch metric.Type.(type) {
+		case *corev1.Service_Spec_Autoscale_Metric_CpuUtilization:
+			ret = append(ret, getUtilization(k8scorev1.ResourceCPU, metric.GetCpuUtilization()))
+		case *corev1.Service_Spec_Autoscale_Metric_MemoryUtilization:
+			ret = append(ret, getUtilization(k8scorev1.ResourceMemory, metric.GetMemoryUtilization()))
+		}
+	}
+
+	if len(ret) == 0 {
+		ret = append(ret, getUtilization(k8scorev1.ResourceCPU, defaultAutoscaleCPUUtilization))
+	}
+
+	return ret
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package svccontroller
+
+import (
+	"testing"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/stretchr/testify/assert"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	k8scorev1 "k8s.io/api/core/v1"
+)
+
+func TestGetDeploymentReplicas(t *testing.T) {
+	assert.Equal(t, int32(1), *getDeploymentReplicas(0, nil))
+	assert.Equal(t, int32(3), *getDeploymentReplicas(3, nil))
+	assert.Nil(t, getDeploymentReplicas(3, &corev1.Service_Spec_Autoscale{
+		MaxReplicas: 5,
+	}))
+}
+
+func TestGetHorizontalPodAutoscaler(t *testing.T) {
+	ownerCM := &k8scorev1.ConfigMap{}
+	ownerCM.Name = "svc1-default"
+
+	{
+		hpa := getHorizontalPodAutoscaler("svc1-default", nil, &corev1.Service_Spec_Autoscale{
+			MaxReplicas: 5,
+		}, ownerCM)
+
+		assert.Equal(t, "svc1-default", hpa.Spec.ScaleTargetRef.Name)
+		assert.Equal(t, "Deployment", hpa.Spec.ScaleTargetRef.Kind)
+		assert.Equal(t, int32(1), *hpa.Spec.MinReplicas)
+		assert.Equal(t, int32(5), hpa.Spec.MaxReplicas)
+		assert.Equal(t, 1, len(hpa.Spec.Metrics))
+		assert.Equal(t, k8scorev1.ResourceCPU, hpa.Spec.Metrics[0].Resource.Name)
+		assert.Equal(t, int32(defaultAutoscaleCPUUtilization),
+			*hpa.Spec.Metrics[0].Resource.Target.AverageUtilization)
+	}
+
+	{
+		hpa := getHorizontalPodAutoscaler("svc1-default", nil, &corev1.Service_Spec_Autoscale{
+			MinReplicas: 2,
+			MaxReplicas: 10,
+			Metrics: []*corev1.Service_Spec_Autoscale_Metric{
+				{
+					Type: &corev1.Service_Spec_Autoscale_Metric_MemoryUtilization{MemoryUtilization: 60},
+				},
+				{
+					Type: &corev1.Service_Spec_Autoscale_Metric_CpuUtilization{CpuUtilization: 70},
+				},
+			},
+		}, ownerCM)
+
+		assert.Equal(t, int32(2), *hpa.Spec.MinReplicas)
+		assert.Equal(t, 2, len(hpa.Spec.Metrics))
+		assert.Equal(t, k8scorev1.ResourceMemory, hpa.Spec.Metrics[0].Resource.Name)
+		assert.Equal(t, autoscalingv2.ResourceMetricSourceType, hpa.Spec.Metrics[1].Type)
+		assert.Equal(t, k8scorev1.ResourceCPU, hpa.Spec.Metrics[1].Resource.Name)
+		assert.Equal(t, int32(70), *hpa.Spec.Metrics[1].Resource.Target.AverageUtilization)
+	}
+}
@@ -45,6 +45,8 @@ type Controller struct {
 
 const ns = vutils.K8sNS
 
+// NewController creates the Service controller. The k8sC can be nil, as in the
+// standalone runtime, where only the Session upstreams are then managed.
 func NewController(octeliumC octeliumc.ClientInterface, k8sC kubernetes.Interface) *Controller {
 	return &Controller{
 		octeliumC: octeliumC,
@@ -68,6 +70,11 @@ func (c *Controller) deployK8sResources(ctx context.Context, svc *corev1.Service
 		return err
 	}
 
+	if err := c.setAutoscaler(ctx, k8sutils.GetSvcHostname(svc),
+		c.getPodLabels(svc), svc.Spec.Deployment.GetAutoscale(), ownerCM); err != nil {
+		return err
+	}
+
 	if _, err := k8sutils.CreateOrUpdateService(ctx, c.k8sC, c.getK8sService(svc, ownerCM)); err != nil {
 		return err
 	}
@@ -83,8 +90,10 @@ func (c *Controller) OnAdd(ctx context.Context, svc *corev1.Service) error {
 		return nil
 	}
 
-	if err := c.deployK8sResources(ctx, svc); err != nil {
-		return err
+	if c.k8sC != nil {
+		if err := c.deployK8sResources(ctx, svc); err != nil {
+			return err
+		}
 	}
 
 	if err := c.handleUpdateSessionUpstream(ctx, svc); err != nil {
@@ -104,6 +113,9 @@ func (c *Controller) OnUpdate(ctx context.Context, newSvc, oldSvc *corev1.Servic
 			zap.String("svc", newSvc.Metadata.Name))
 		return nil
 	case !newSvcInMyRegion && oldSvcInMyRegion:
+		if c.k8sC == nil {
+			return nil
+		}
 		if err := c.k8sC.CoreV1().ConfigMaps(ns).Delete(ctx,
 			k8sutils.GetSvcHostname(oldSvc), k8smetav1.DeleteOptions{}); err != nil {
 			if !k8serr.IsNotFound(err) {
@@ -112,8 +124,10 @@ func (c *Controller) OnUpdate(ctx context.Context, newSvc, oldSvc *corev1.Servic
 		}
 		return nil
 	default:
-		if err := c.deployK8sResources(ctx, newSvc); err != nil {
-			return err
+		if c.k8sC != nil {
+			if err := c.deployK8sResources(ctx, newSvc); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -384,13 +398,8 @@ func (c *Controller) newDeployment(svc *corev1.Service, ownerCM *k8scorev1.Confi
 			},
 		},
 		Spec: appsv1.DeploymentSpec{
-			Replicas: func() *int32 {
-				if svc.Spec.Deployment == nil || svc.Spec.Deployment.Replicas < 1 {
-					return nil
-				}
-
-				return utils_types.Int32ToPtr(int32(svc.Spec.Deployment.Replicas))
-			}(),
+			Replicas: getDeploymentReplicas(svc.Spec.Deployment.GetReplicas(),
+				svc.Spec.Deployment.GetAutoscale()),
 			Selector: &k8smetav1.LabelSelector{
 				MatchLabels: labels,
 			},
@@ -428,11 +437,13 @@ func (c *Controller) OnDelete(ctx context.Context, svc *corev1.Service) error {
 		return nil
 	}
 
-	if err := c.k8sC.CoreV1().ConfigMaps(ns).Delete(ctx,
-		k8sutils.GetSvcHostname(svc), k8smetav1.DeleteOptions{}); err != nil {
-		if !k8serr.IsNotFound(err) {
-			zap.L().Warn("Could not delete svc configMap",
-				zap.String("svc", svc.Metadata.Name), zap.Error(err))
+	if c.k8sC != nil {
+		if err := c.k8sC.CoreV1().ConfigMaps(ns).Delete(ctx,
+			k8sutils.GetSvcHostname(svc), k8smetav1.DeleteOptions{}); err != nil {
+			if !k8serr.IsNotFound(err) {
+				zap.L().Warn("Could not delete svc configMap",
+					zap.String("svc", svc.Metadata.Name), zap.Error(err))
+			}
 		}
 	}
 
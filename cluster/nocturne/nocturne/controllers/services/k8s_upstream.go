@@ -18,16 +18,22 @@ package svccontroller
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
 	"github.com/asaskevich/govalidator"
 	"github.com/octelium/octelium/apis/main/corev1"
 	"github.com/octelium/octelium/apis/rsc/rmetav1"
 	"github.com/octelium/octelium/cluster/common/k8sutils"
+	"github.com/octelium/octelium/cluster/common/vutils"
 	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
+	"github.com/octelium/octelium/pkg/common/pbutils"
 	utils_types "github.com/octelium/octelium/pkg/utils/types"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -36,6 +42,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 )
 
 func (c *Controller) setK8sUpstream(ctx context.Context, svc *corev1.Service, ownerCM *k8scorev1.ConfigMap) error {
@@ -60,6 +67,11 @@ func (c *Controller) setK8sUpstream(ctx context.Context, svc *corev1.Service, ow
 			return err
 		}
 
+		if err := c.setAutoscaler(ctx, dep.Name, dep.Spec.Selector.MatchLabels,
+			cfg.GetUpstream().GetContainer().Autoscale, ownerCM); err != nil {
+			return err
+		}
+
 		if _, err := k8sutils.CreateOrUpdateService(ctx, c.k8sC,
 			c.getK8sUpstreamService(svc, cfg, ownerCM)); err != nil {
 			return err
@@ -88,6 +100,11 @@ func (c *Controller) setK8sUpstream(ctx context.Context, svc *corev1.Service, ow
 					return err
 				}
 
+				if err := c.setAutoscaler(ctx, dep.Name, dep.Spec.Selector.MatchLabels,
+					cfg.GetUpstream().GetContainer().Autoscale, ownerCM); err != nil {
+					return err
+				}
+
 				if _, err := k8sutils.CreateOrUpdateService(ctx, c.k8sC,
 					c.getK8sUpstreamService(svc, cfg, ownerCM)); err != nil {
 					return err
@@ -203,7 +220,12 @@ func (c *Controller) getK8sUpstreamDeployment(ctx context.Context, svc *corev1.S
 	spec := cfg.GetUpstream().GetContainer()
 	labels := c.getK8sUpstreamLabels(svc, cfg.Name)
 
-	podSpec, err := c.getK8sUpstreamPod(ctx, cfg, svc, passwordSec, ownerCM)
+	volumeFiles, err := c.getK8sUpstreamVolumeFiles(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	podSpec, err := c.getK8sUpstreamPod(ctx, cfg, svc, passwordSec, ownerCM, volumeFiles)
 	if err != nil {
 		return nil, err
 	}
@@ -217,25 +239,24 @@ func (c *Controller) getK8sUpstreamDeployment(ctx context.Context, svc *corev1.S
 			},
 		},
 		Spec: appsv1.DeploymentSpec{
-			Replicas: func() *int32 {
-				if spec.Replicas < 1 {
-					return nil
-				}
-
-				return utils_types.Int32ToPtr(int32(spec.Replicas))
-			}(),
+			Replicas: getDeploymentReplicas(spec.Replicas, spec.Autoscale),
 			Selector: &k8smetav1.LabelSelector{
 				MatchLabels: labels,
 			},
 			Template: k8scorev1.PodTemplateSpec{
 				ObjectMeta: k8smetav1.ObjectMeta{
 					Labels: labels,
+					Annotations: func() map[string]string {
+						if len(volumeFiles) == 0 {
+							return nil
+						}
 
-					/*
-						Annotations: map[string]string{
-							"octelium.com/install-uid": utilrand.GetRandomStringLowercase(8),
-						},
-					*/
+						// Changing the hash of the mounted Secrets and Configs
+						// triggers a rolling restart of the pods.
+						return map[string]string{
+							"octelium.com/volumes-hash": getVolumeFilesHash(volumeFiles),
+						}
+					}(),
 				},
 				Spec: *podSpec,
 			},
@@ -243,9 +264,97 @@ func (c *Controller) getK8sUpstreamDeployment(ctx context.Context, svc *corev1.S
 	}, nil
 }
 
+// getK8sUpstreamVolumeFiles returns the files of the Secret and Config
+// volumes keyed by the volume name.
+func (c *Controller) getK8sUpstreamVolumeFiles(ctx context.Context,
+	spec *corev1.Service_Spec_Config_Upstream_Container) (map[string]map[string][]byte, error) {
+	ret := make(map[string]map[string][]byte)
+
+	getFileName := func(arg string) string {
+		if arg == "" {
+			return "value"
+		}
+		return arg
+	}
+
+	for _, vol := range spec.Volumes {
+		switch vol.Type.(type) {
+		case *corev1.Service_Spec_Config_Upstream_Container_Volume_Secret_:
+			sec, err := c.octeliumC.CoreC().GetSecret(ctx, &rmetav1.GetOptions{
+				Name: vol.GetSecret().Name,
+			})
+			if err != nil {
+				return nil, errors.Errorf("Could not get volume Secret %s: %+v", vol.GetSecret().Name, err)
+			}
+
+			ret[vol.Name] = map[string][]byte{
+				getFileName(vol.GetSecret().FileName): ucorev1.ToSecret(sec).GetValueBytes(),
+			}
+		case *corev1.Service_Spec_Config_Upstream_Container_Volume_Config_:
+			cfg, err := c.octeliumC.CoreC().GetConfig(ctx, &rmetav1.GetOptions{
+				Name: vol.GetConfig().Name,
+			})
+			if err != nil {
+				return nil, errors.Errorf("Could not get volume Config %s: %+v", vol.GetConfig().Name, err)
+			}
+
+			files := make(map[string][]byte)
+			fileName := getFileName(vol.GetConfig().FileName)
+
+			if cfg.Data != nil {
+				switch cfg.Data.Type.(type) {
+				case *corev1.Config_Data_Value:
+					files[fileName] = []byte(cfg.Data.GetValue())
+				case *corev1.Config_Data_ValueBytes:
+					files[fileName] = cfg.Data.GetValueBytes()
+				case *corev1.Config_Data_Attrs:
+					attrs, err := pbutils.MarshalJSON(cfg.Data.GetAttrs(), false)
+					if err != nil {
+						return nil, err
+					}
+					files[fileName] = attrs
+				case *corev1.Config_Data_DataMap_:
+					for k, v := range cfg.Data.GetDataMap().Map {
+						if errs := k8svalidation.IsConfigMapKey(k); len(errs) > 0 {
+							zap.L().Warn("Skipping invalid Config dataMap key as a file name",
+								zap.String("config", cfg.Metadata.Name), zap.String("key", k))
+							continue
+						}
+						files[k] = v
+					}
+				}
+			}
+
+			ret[vol.Name] = files
+		}
+	}
+
+	return ret, nil
+}
+
+func getVolumeFilesHash(volumeFiles map[string]map[string][]byte) string {
+	hsh := sha256.New()
+
+	volNames := slices.Sorted(maps.Keys(volumeFiles))
+	for _, volName := range volNames {
+		files := volumeFiles[volName]
+		for _, fileName := range slices.Sorted(maps.Keys(files)) {
+			fmt.Fprintf(hsh, "%s/%s:%d:", volName, fileName, len(files[fileName]))
+			hsh.Write(files[fileName])
+		}
+	}
+
+	return hex.EncodeToString(hsh.Sum(nil))
+}
+
+func getK8sUpstreamVolumeSecretName(svc *corev1.Service, cfg *corev1.Service_Spec_Config, volName string) string {
+	return fmt.Sprintf("svc-vol-%s-%s",
+		svc.Metadata.Uid, vutils.Sha256SumHex([]byte(fmt.Sprintf("%s:%s", cfg.Name, volName)))[:12])
+}
+
 func (c *Controller) getK8sUpstreamPod(ctx context.Context,
 	cfg *corev1.Service_Spec_Config, svc *corev1.Service, passwordSec *corev1.Secret,
-	ownerCM *k8scorev1.ConfigMap) (*k8scorev1.PodSpec, error) {
+	ownerCM *k8scorev1.ConfigMap, volumeFiles map[string]map[string][]byte) (*k8scorev1.PodSpec, error) {
 
 	spec := cfg.GetUpstream().GetContainer()
 
@@ -278,235 +387,306 @@ func (c *Controller) getK8sUpstreamPod(ctx context.Context,
 			}
 		}(),
 		NodeSelector: func() map[string]string {
-			return map[string]string{
+			ret := map[string]string{
 				"octelium.com/node-mode-dataplane": "",
 			}
-		}(),
-		Volumes: func() []k8scorev1.Volume {
-			if len(spec.Volumes) < 1 {
-				return nil
+			for k, v := range spec.NodeSelector {
+				ret[k] = v
 			}
+			return ret
+		}(),
+	}
 
-			var ret []k8scorev1.Volume
+	for _, vol := range spec.Volumes {
+		volume := k8scorev1.Volume{
+			Name: vol.Name,
+		}
 
-			for _, vol := range spec.Volumes {
-				volume := k8scorev1.Volume{
-					Name: vol.Name,
-				}
+		getMode := func(mode uint32) *int32 {
+			if mode == 0 {
+				return utils_types.Int32ToPtr(0400)
+			}
+			return utils_types.Int32ToPtr(int32(mode))
+		}
 
-				switch vol.Type.(type) {
-				case *corev1.Service_Spec_Config_Upstream_Container_Volume_PersistentVolumeClaim_:
-					volume.VolumeSource = k8scorev1.VolumeSource{
-						PersistentVolumeClaim: &k8scorev1.PersistentVolumeClaimVolumeSource{
-							ClaimName: vol.GetPersistentVolumeClaim().Name,
-						},
-					}
-				default:
-					continue
-				}
+		switch vol.Type.(type) {
+		case *corev1.Service_Spec_Config_Upstream_Container_Volume_PersistentVolumeClaim_:
+			volume.VolumeSource = k8scorev1.VolumeSource{
+				PersistentVolumeClaim: &k8scorev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: vol.GetPersistentVolumeClaim().Name,
+				},
+			}
+		case *corev1.Service_Spec_Config_Upstream_Container_Volume_EmptyDir_:
+			emptyDir := &k8scorev1.EmptyDirVolumeSource{}
+			if vol.GetEmptyDir().IsMemory {
+				emptyDir.Medium = k8scorev1.StorageMediumMemory
+			}
+			if vol.GetEmptyDir().SizeLimitMegabytes > 0 {
+				sizeLimit := resource.MustParse(fmt.Sprintf("%dMi", vol.GetEmptyDir().SizeLimitMegabytes))
+				emptyDir.SizeLimit = &sizeLimit
+			}
+			volume.VolumeSource = k8scorev1.VolumeSource{
+				EmptyDir: emptyDir,
+			}
+		case *corev1.Service_Spec_Config_Upstream_Container_Volume_Secret_,
+			*corev1.Service_Spec_Config_Upstream_Container_Volume_Config_:
+
+			k8sSecName := getK8sUpstreamVolumeSecretName(svc, cfg, vol.Name)
+			if _, err := k8sutils.CreateOrUpdateSecret(ctx, c.k8sC, &k8scorev1.Secret{
+				ObjectMeta: k8smetav1.ObjectMeta{
+					Name:      k8sSecName,
+					Namespace: ns,
+					OwnerReferences: []k8smetav1.OwnerReference{
+						*k8smetav1.NewControllerRef(ownerCM, k8scorev1.SchemeGroupVersion.WithKind("ConfigMap")),
+					},
+				},
+				Data: volumeFiles[vol.Name],
+				Type: k8scorev1.SecretTypeOpaque,
+			}); err != nil {
+				return nil, err
+			}
 
-				ret = append(ret, volume)
+			volume.VolumeSource = k8scorev1.VolumeSource{
+				Secret: &k8scorev1.SecretVolumeSource{
+					SecretName: k8sSecName,
+					DefaultMode: func() *int32 {
+						if vol.GetSecret() != nil {
+							return getMode(vol.GetSecret().Mode)
+						}
+						return getMode(vol.GetConfig().Mode)
+					}(),
+				},
 			}
+		default:
+			continue
+		}
 
-			return ret
-		}(),
+		ret.Volumes = append(ret.Volumes, volume)
 	}
 
-	{
+	ret.Containers = append(ret.Containers, c.getK8sUpstreamContainer(ctx, svc, ownerCM,
+		&corev1.Service_Spec_Config_Upstream_Container_Sidecar{
+			Name:            "backend",
+			Image:           spec.Image,
+			Command:         spec.Command,
+			Args:            spec.Args,
+			Env:             spec.Env,
+			ResourceLimit:   spec.ResourceLimit,
+			SecurityContext: spec.SecurityContext,
+			VolumeMounts:    spec.VolumeMounts,
+			LivenessProbe:   spec.LivenessProbe,
+			ReadinessProbe:  spec.ReadinessProbe,
+		}))
+
+	for _, sidecar := range spec.Sidecars {
+		ret.Containers = append(ret.Containers, c.getK8sUpstreamContainer(ctx, svc, ownerCM, sidecar))
+	}
 
-		limits := k8scorev1.ResourceList{
-			k8scorev1.ResourceMemory: resource.MustParse(
-				fmt.Sprintf("%dMi", func() uint32 {
-					if spec.ResourceLimit == nil || spec.ResourceLimit.Memory == nil ||
-						spec.ResourceLimit.Memory.Megabytes == 0 {
-						return 512
-					}
-					return spec.ResourceLimit.Memory.Megabytes
-				}())),
-			k8scorev1.ResourceCPU: resource.MustParse(
-				fmt.Sprintf("%dm", func() uint32 {
-					if spec.ResourceLimit == nil || spec.ResourceLimit.Cpu == nil ||
-						spec.ResourceLimit.Cpu.Millicores == 0 {
-						return 1000
-					}
+	for _, initContainer := range spec.InitContainers {
+		container := c.getK8sUpstreamContainer(ctx, svc, ownerCM, initContainer)
+		container.LivenessProbe = nil
+		container.ReadinessProbe = nil
+		ret.InitContainers = append(ret.InitContainers, container)
+	}
 
-					return spec.ResourceLimit.Cpu.Millicores
-				}())),
-			k8scorev1.ResourceEphemeralStorage: resource.MustParse("5000Mi"),
-		}
+	return ret, nil
+}
+
+func (c *Controller) getK8sUpstreamContainer(ctx context.Context,
+	svc *corev1.Service, ownerCM *k8scorev1.ConfigMap,
+	spec *corev1.Service_Spec_Config_Upstream_Container_Sidecar) k8scorev1.Container {
 
-		if spec.ResourceLimit != nil && len(spec.ResourceLimit.Ext) > 0 {
-			for key, v := range spec.ResourceLimit.Ext {
-				if val, err := resource.ParseQuantity(v); err == nil && key != "" {
-					limits[k8scorev1.ResourceName(key)] = val
+	limits := k8scorev1.ResourceList{
+		k8scorev1.ResourceMemory: resource.MustParse(
+			fmt.Sprintf("%dMi", func() uint32 {
+				if spec.ResourceLimit == nil || spec.ResourceLimit.Memory == nil ||
+					spec.ResourceLimit.Memory.Megabytes == 0 {
+					return 512
+				}
+				return spec.ResourceLimit.Memory.Megabytes
+			}())),
+		k8scorev1.ResourceCPU: resource.MustParse(
+			fmt.Sprintf("%dm", func() uint32 {
+				if spec.ResourceLimit == nil || spec.ResourceLimit.Cpu == nil ||
+					spec.ResourceLimit.Cpu.Millicores == 0 {
+					return 1000
 				}
+
+				return spec.ResourceLimit.Cpu.Millicores
+			}())),
+		k8scorev1.ResourceEphemeralStorage: resource.MustParse("5000Mi"),
+	}
+
+	if spec.ResourceLimit != nil && len(spec.ResourceLimit.Ext) > 0 {
+		for key, v := range spec.ResourceLimit.Ext {
+			if val, err := resource.ParseQuantity(v); err == nil && key != "" {
+				limits[k8scorev1.ResourceName(key)] = val
 			}
 		}
+	}
 
-		container := k8scorev1.Container{
-			Name:    "backend",
-			Image:   spec.Image,
-			Command: spec.Command,
-			Args:    spec.Args,
-			// ImagePullPolicy: k8sutils.GetImagePullPolicy(),
-			Resources: k8scorev1.ResourceRequirements{
-				Requests: k8scorev1.ResourceList{
-					k8scorev1.ResourceMemory:           resource.MustParse("5Mi"),
-					k8scorev1.ResourceCPU:              resource.MustParse("10m"),
-					k8scorev1.ResourceEphemeralStorage: resource.MustParse("50Mi"),
-				},
-				Limits: limits,
+	container := k8scorev1.Container{
+		Name:    spec.Name,
+		Image:   spec.Image,
+		Command: spec.Command,
+		Args:    spec.Args,
+		// ImagePullPolicy: k8sutils.GetImagePullPolicy(),
+		Resources: k8scorev1.ResourceRequirements{
+			Requests: k8scorev1.ResourceList{
+				k8scorev1.ResourceMemory:           resource.MustParse("5Mi"),
+				k8scorev1.ResourceCPU:              resource.MustParse("10m"),
+				k8scorev1.ResourceEphemeralStorage: resource.MustParse("50Mi"),
 			},
-			SecurityContext: &k8scorev1.SecurityContext{
-				Privileged:               utils_types.BoolToPtr(false),
-				AllowPrivilegeEscalation: utils_types.BoolToPtr(false),
-				RunAsUser: func() *int64 {
-					if spec.SecurityContext == nil ||
-						spec.SecurityContext.RunAsUser == 0 ||
-						spec.SecurityContext.RunAsUser > 1000000 {
-						return nil
-					}
-					return utils_types.Int64ToPtr(int64(spec.SecurityContext.RunAsUser))
-				}(),
-				ReadOnlyRootFilesystem: func() *bool {
-					if spec.SecurityContext == nil {
-						return nil
-					}
-					return utils_types.BoolToPtr(spec.SecurityContext.ReadOnlyRootFilesystem)
-				}(),
-			},
-			VolumeMounts: func() []k8scorev1.VolumeMount {
-				if len(spec.VolumeMounts) < 1 {
+			Limits: limits,
+		},
+		SecurityContext: &k8scorev1.SecurityContext{
+			Privileged:               utils_types.BoolToPtr(false),
+			AllowPrivilegeEscalation: utils_types.BoolToPtr(false),
+			RunAsUser: func() *int64 {
+				if spec.SecurityContext == nil ||
+					spec.SecurityContext.RunAsUser == 0 ||
+					spec.SecurityContext.RunAsUser > 1000000 {
 					return nil
 				}
-
-				var ret []k8scorev1.VolumeMount
-
-				for _, mount := range spec.VolumeMounts {
-					ret = append(ret, k8scorev1.VolumeMount{
-						Name:      mount.Name,
-						MountPath: mount.MountPath,
-						SubPath:   mount.SubPath,
-						ReadOnly:  mount.ReadOnly,
-					})
+				return utils_types.Int64ToPtr(int64(spec.SecurityContext.RunAsUser))
+			}(),
+			ReadOnlyRootFilesystem: func() *bool {
+				if spec.SecurityContext == nil {
+					return nil
 				}
-
-				return ret
+				return utils_types.BoolToPtr(spec.SecurityContext.ReadOnlyRootFilesystem)
 			}(),
-		}
-
-		getProbe := func(probe *corev1.Service_Spec_Config_Upstream_Container_Probe) *k8scorev1.Probe {
-			ret := &k8scorev1.Probe{
-				InitialDelaySeconds: probe.InitialDelaySeconds,
-				TimeoutSeconds:      probe.TimeoutSeconds,
-				PeriodSeconds:       probe.PeriodSeconds,
-				SuccessThreshold:    probe.SuccessThreshold,
-				FailureThreshold:    probe.FailureThreshold,
+		},
+		VolumeMounts: func() []k8scorev1.VolumeMount {
+			if len(spec.VolumeMounts) < 1 {
+				return nil
 			}
-			switch spec.LivenessProbe.Type.(type) {
-			case *corev1.Service_Spec_Config_Upstream_Container_Probe_Grpc:
-				ret.ProbeHandler = k8scorev1.ProbeHandler{
-					GRPC: &k8scorev1.GRPCAction{
-						Port: int32(spec.LivenessProbe.GetGrpc().Port),
-					},
-				}
-			case *corev1.Service_Spec_Config_Upstream_Container_Probe_HttpGet:
-				ret.ProbeHandler = k8scorev1.ProbeHandler{
-					HTTPGet: &k8scorev1.HTTPGetAction{
-						Path: spec.LivenessProbe.GetHttpGet().Path,
-						Port: intstr.FromInt32(int32(spec.LivenessProbe.GetHttpGet().Port)),
-					},
-				}
-			case *corev1.Service_Spec_Config_Upstream_Container_Probe_TcpSocket:
-				ret.ProbeHandler = k8scorev1.ProbeHandler{
-					TCPSocket: &k8scorev1.TCPSocketAction{
-						Port: intstr.FromInt32(int32(spec.LivenessProbe.GetTcpSocket().Port)),
-					},
-				}
-			default:
-				zap.L().Debug("Unsupported probe type. Skipping the probe...")
-				ret = nil
+
+			var ret []k8scorev1.VolumeMount
+
+			for _, mount := range spec.VolumeMounts {
+				ret = append(ret, k8scorev1.VolumeMount{
+					Name:      mount.Name,
+					MountPath: mount.MountPath,
+					SubPath:   mount.SubPath,
+					ReadOnly:  mount.ReadOnly,
+				})
 			}
 
 			return ret
-		}
+		}(),
+	}
 
-		if spec.LivenessProbe != nil {
-			container.LivenessProbe = getProbe(spec.LivenessProbe)
+	getProbe := func(probe *corev1.Service_Spec_Config_Upstream_Container_Probe) *k8scorev1.Probe {
+		ret := &k8scorev1.Probe{
+			InitialDelaySeconds: probe.InitialDelaySeconds,
+			TimeoutSeconds:      probe.TimeoutSeconds,
+			PeriodSeconds:       probe.PeriodSeconds,
+			SuccessThreshold:    probe.SuccessThreshold,
+			FailureThreshold:    probe.FailureThreshold,
 		}
-		if spec.ReadinessProbe != nil {
-			container.ReadinessProbe = getProbe(spec.ReadinessProbe)
+		switch probe.Type.(type) {
+		case *corev1.Service_Spec_Config_Upstream_Container_Probe_Grpc:
+			ret.ProbeHandler = k8scorev1.ProbeHandler{
+				GRPC: &k8scorev1.GRPCAction{
+					Port: int32(probe.GetGrpc().Port),
+				},
+			}
+		case *corev1.Service_Spec_Config_Upstream_Container_Probe_HttpGet:
+			ret.ProbeHandler = k8scorev1.ProbeHandler{
+				HTTPGet: &k8scorev1.HTTPGetAction{
+					Path: probe.GetHttpGet().Path,
+					Port: intstr.FromInt32(int32(probe.GetHttpGet().Port)),
+				},
+			}
+		case *corev1.Service_Spec_Config_Upstream_Container_Probe_TcpSocket:
+			ret.ProbeHandler = k8scorev1.ProbeHandler{
+				TCPSocket: &k8scorev1.TCPSocketAction{
+					Port: intstr.FromInt32(int32(probe.GetTcpSocket().Port)),
+				},
+			}
+		default:
+			zap.L().Debug("Unsupported probe type. Skipping the probe...")
+			ret = nil
 		}
 
-		for _, e := range spec.Env {
-			env := k8scorev1.EnvVar{
-				Name: e.Name,
-			}
-			switch e.Type.(type) {
+		return ret
+	}
 
-			case *corev1.Service_Spec_Config_Upstream_Container_Env_Value:
-				env.Value = e.GetValue()
-				if len(env.Name) == 0 || len(env.Name) > 256 ||
-					len(env.Value) == 0 || len(env.Value) > 3000 {
-					continue
-				}
+	if spec.LivenessProbe != nil {
+		container.LivenessProbe = getProbe(spec.LivenessProbe)
+	}
+	if spec.ReadinessProbe != nil {
+		container.ReadinessProbe = getProbe(spec.ReadinessProbe)
+	}
 
-			case *corev1.Service_Spec_Config_Upstream_Container_Env_FromSecret:
+	for _, e := range spec.Env {
+		env := k8scorev1.EnvVar{
+			Name: e.Name,
+		}
+		switch e.Type.(type) {
 
-				sec, err := c.octeliumC.CoreC().GetSecret(ctx, &rmetav1.GetOptions{
-					Name: e.GetFromSecret(),
-				})
-				if err == nil {
-					k8sSecName := fmt.Sprintf("svc-env-%s-%s", svc.Metadata.Uid, sec.Metadata.Uid)
-					req := &k8scorev1.Secret{
-						ObjectMeta: k8smetav1.ObjectMeta{
-							Name:      k8sSecName,
-							Namespace: ns,
-							OwnerReferences: []k8smetav1.OwnerReference{
-								*k8smetav1.NewControllerRef(ownerCM, k8scorev1.SchemeGroupVersion.WithKind("ConfigMap")),
-							},
-						},
-						StringData: map[string]string{
-							"data": ucorev1.ToSecret(sec).GetSpecValueStr(),
-						},
+		case *corev1.Service_Spec_Config_Upstream_Container_Env_Value:
+			env.Value = e.GetValue()
+			if len(env.Name) == 0 || len(env.Name) > 256 ||
+				len(env.Value) == 0 || len(env.Value) > 3000 {
+				continue
+			}
 
-						Type: k8scorev1.SecretTypeDockerConfigJson,
-					}
+		case *corev1.Service_Spec_Config_Upstream_Container_Env_FromSecret:
+
+			sec, err := c.octeliumC.CoreC().GetSecret(ctx, &rmetav1.GetOptions{
+				Name: e.GetFromSecret(),
+			})
+			if err == nil {
+				k8sSecName := fmt.Sprintf("svc-env-%s-%s", svc.Metadata.Uid, sec.Metadata.Uid)
+				req := &k8scorev1.Secret{
+					ObjectMeta: k8smetav1.ObjectMeta{
+						Name:      k8sSecName,
+						Namespace: ns,
+						OwnerReferences: []k8smetav1.OwnerReference{
+							*k8smetav1.NewControllerRef(ownerCM, k8scorev1.SchemeGroupVersion.WithKind("ConfigMap")),
+						},
+					},
+					StringData: map[string]string{
+						"data": ucorev1.ToSecret(sec).GetSpecValueStr(),
+					},
 
-					if _, err := k8sutils.CreateOrUpdateSecret(ctx, c.k8sC, req); err == nil {
-						env.ValueFrom = &k8scorev1.EnvVarSource{
-							SecretKeyRef: &k8scorev1.SecretKeySelector{
-								LocalObjectReference: k8scorev1.LocalObjectReference{
-									Name: k8sSecName,
-								},
-								Key: "data",
-							},
-						}
-					}
+					Type: k8scorev1.SecretTypeDockerConfigJson,
 				}
-			case *corev1.Service_Spec_Config_Upstream_Container_Env_KubernetesSecretRef_:
-				secretRef := e.GetKubernetesSecretRef()
 
-				env.ValueFrom = &k8scorev1.EnvVarSource{
-					SecretKeyRef: &k8scorev1.SecretKeySelector{
-						LocalObjectReference: k8scorev1.LocalObjectReference{
-							Name: secretRef.Name,
+				if _, err := k8sutils.CreateOrUpdateSecret(ctx, c.k8sC, req); err == nil {
+					env.ValueFrom = &k8scorev1.EnvVarSource{
+						SecretKeyRef: &k8scorev1.SecretKeySelector{
+							LocalObjectReference: k8scorev1.LocalObjectReference{
+								Name: k8sSecName,
+							},
+							Key: "data",
 						},
-						Key: secretRef.Key,
-					},
+					}
 				}
 			}
+		case *corev1.Service_Spec_Config_Upstream_Container_Env_KubernetesSecretRef_:
+			secretRef := e.GetKubernetesSecretRef()
 
-			if !govalidator.IsASCII(env.Name) {
-				continue
+			env.ValueFrom = &k8scorev1.EnvVarSource{
+				SecretKeyRef: &k8scorev1.SecretKeySelector{
+					LocalObjectReference: k8scorev1.LocalObjectReference{
+						Name: secretRef.Name,
+					},
+					Key: secretRef.Key,
+				},
 			}
+		}
 
-			container.Env = append(container.Env, env)
+		if !govalidator.IsASCII(env.Name) {
+			continue
 		}
-		ret.Containers = append(ret.Containers, container)
+
+		container.Env = append(container.Env, env)
 	}
 
-	return ret, nil
+	return container
 }
 
 func (c *Controller) getK8sUpstreamService(svc *corev1.Service, cfg *corev1.Service_Spec_Config, ownerCM *k8scorev1.ConfigMap) *k8scorev1.Service {
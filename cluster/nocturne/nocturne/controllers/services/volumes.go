@@ -0,0 +1,92 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package svccontroller
+
+import (
+	"context"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/rsc/rmetav1"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// OnSecretUpdate redeploys the container upstreams of the Services mounting
+// the updated Secret so that their pods are restarted with the new data.
+func (c *Controller) OnSecretUpdate(ctx context.Context, newSec, oldSec *corev1.Secret) error {
+	if proto.Equal(newSec.Data, oldSec.Data) {
+		return nil
+	}
+
+	return c.redeployMountingServices(ctx, func(vol *corev1.Service_Spec_Config_Upstream_Container_Volume) bool {
+		return vol.GetSecret() != nil && vol.GetSecret().Name == newSec.Metadata.Name
+	})
+}
+
+// OnConfigUpdate is the Config counterpart of OnSecretUpdate.
+func (c *Controller) OnConfigUpdate(ctx context.Context, newCfg, oldCfg *corev1.Config) error {
+	if proto.Equal(newCfg.Data, oldCfg.Data) {
+		return nil
+	}
+
+	return c.redeployMountingServices(ctx, func(vol *corev1.Service_Spec_Config_Upstream_Container_Volume) bool {
+		return vol.GetConfig() != nil && vol.GetConfig().Name == newCfg.Metadata.Name
+	})
+}
+
+func (c *Controller) redeployMountingServices(ctx context.Context,
+	isMatch func(vol *corev1.Service_Spec_Config_Upstream_Container_Volume) bool) error {
+	svcList, err := c.octeliumC.CoreC().ListService(ctx, &rmetav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, svc := range svcList.Items {
+		if !ucorev1.ToService(svc).IsInMyRegion() || !hasMatchingVolume(svc, isMatch) {
+			continue
+		}
+
+		zap.L().Debug("Redeploying Service upstream after a mounted volume update",
+			zap.String("svc", svc.Metadata.Name))
+
+		if err := c.deployK8sResources(ctx, svc); err != nil {
+			zap.L().Warn("Could not redeploy Service upstream",
+				zap.String("svc", svc.Metadata.Name), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+func hasMatchingVolume(svc *corev1.Service,
+	isMatch func(vol *corev1.Service_Spec_Config_Upstream_Container_Volume) bool) bool {
+	cfgs := []*corev1.Service_Spec_Config{svc.Spec.Config}
+	if svc.Spec.DynamicConfig != nil {
+		cfgs = append(cfgs, svc.Spec.DynamicConfig.Configs...)
+	}
+
+	for _, cfg := range cfgs {
+		for _, vol := range cfg.GetUpstream().GetContainer().GetVolumes() {
+			if isMatch(vol) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
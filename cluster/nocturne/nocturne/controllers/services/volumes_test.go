@@ -0,0 +1,229 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package svccontroller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/stretchr/testify/assert"
+	k8scorev1 "k8s.io/api/core/v1"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetVolumeFilesHash(t *testing.T) {
+	files := map[string]map[string][]byte{
+		"cert": {
+			"tls.crt": []byte("crt"),
+			"tls.key": []byte("key"),
+		},
+		"cfg": {
+			"value": []byte("cfg"),
+		},
+	}
+
+	hsh := getVolumeFilesHash(files)
+	assert.Equal(t, hsh, getVolumeFilesHash(files))
+
+	files["cert"]["tls.key"] = []byte("key2")
+	assert.NotEqual(t, hsh, getVolumeFilesHash(files))
+
+	assert.NotEqual(t, getVolumeFilesHash(map[string]map[string][]byte{
+		"a": {"bc": []byte("d")},
+	}), getVolumeFilesHash(map[string]map[string][]byte{
+		"ab": {"c": []byte("d")},
+	}))
+}
+
+func TestHasMatchingVolume(t *testing.T) {
+	isSecret := func(name string) func(vol *corev1.Service_Spec_Config_Upstream_Container_Volume) bool {
+		return func(vol *corev1.Service_Spec_Config_Upstream_Container_Volume) bool {
+			return vol.GetSecret() != nil && vol.GetSecret().Name == name
+		}
+	}
+
+	getContainerCfg := func(secName string) *corev1.Service_Spec_Config {
+		return &corev1.Service_Spec_Config{
+			Upstream: &corev1.Service_Spec_Config_Upstream{
+				Type: &corev1.Service_Spec_Config_Upstream_Container_{
+					Container: &corev1.Service_Spec_Config_Upstream_Container{
+						Volumes: []*corev1.Service_Spec_Config_Upstream_Container_Volume{
+							{
+								Name: "vol",
+								Type: &corev1.Service_Spec_Config_Upstream_Container_Volume_Secret_{
+									Secret: &corev1.Service_Spec_Config_Upstream_Container_Volume_Secret{
+										Name: secName,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	assert.False(t, hasMatchingVolume(&corev1.Service{
+		Spec: &corev1.Service_Spec{},
+	}, isSecret("sec1")))
+
+	svc := &corev1.Service{
+		Spec: &corev1.Service_Spec{
+			Config: getContainerCfg("sec1"),
+			DynamicConfig: &corev1.Service_Spec_DynamicConfig{
+				Configs: []*corev1.Service_Spec_Config{
+					getContainerCfg("sec2"),
+				},
+			},
+		},
+	}
+
+	assert.True(t, hasMatchingVolume(svc, isSecret("sec1")))
+	assert.True(t, hasMatchingVolume(svc, isSecret("sec2")))
+	assert.False(t, hasMatchingVolume(svc, isSecret("sec3")))
+}
+
+func TestGetK8sUpstreamPod(t *testing.T) {
+	ctx := context.Background()
+
+	c := &Controller{
+		k8sC: fake.NewSimpleClientset(),
+	}
+
+	svc := &corev1.Service{
+		Metadata: &metav1.Metadata{
+			Name: "svc1.default",
+			Uid:  "svc-uid",
+		},
+		Spec: &corev1.Service_Spec{},
+	}
+
+	ownerCM := &k8scorev1.ConfigMap{}
+	ownerCM.Name = "svc1-default"
+
+	cfg := &corev1.Service_Spec_Config{
+		Upstream: &corev1.Service_Spec_Config_Upstream{
+			Type: &corev1.Service_Spec_Config_Upstream_Container_{
+				Container: &corev1.Service_Spec_Config_Upstream_Container{
+					Image: "nginx",
+					Port:  80,
+					NodeSelector: map[string]string{
+						"kubernetes.io/arch": "arm64",
+					},
+					Volumes: []*corev1.Service_Spec_Config_Upstream_Container_Volume{
+						{
+							Name: "tmp",
+							Type: &corev1.Service_Spec_Config_Upstream_Container_Volume_EmptyDir_{
+								EmptyDir: &corev1.Service_Spec_Config_Upstream_Container_Volume_EmptyDir{
+									IsMemory:           true,
+									SizeLimitMegabytes: 64,
+								},
+							},
+						},
+						{
+							Name: "cert",
+							Type: &corev1.Service_Spec_Config_Upstream_Container_Volume_Secret_{
+								Secret: &corev1.Service_Spec_Config_Upstream_Container_Volume_Secret{
+									Name:     "tls-cert",
+									FileName: "tls.crt",
+								},
+							},
+						},
+					},
+					VolumeMounts: []*corev1.Service_Spec_Config_Upstream_Container_VolumeMount{
+						{
+							Name:      "cert",
+							MountPath: "/etc/tls",
+						},
+					},
+					InitContainers: []*corev1.Service_Spec_Config_Upstream_Container_Sidecar{
+						{
+							Name:  "migrate",
+							Image: "migrate",
+							LivenessProbe: &corev1.Service_Spec_Config_Upstream_Container_Probe{
+								Type: &corev1.Service_Spec_Config_Upstream_Container_Probe_TcpSocket{
+									TcpSocket: &corev1.Service_Spec_Config_Upstream_Container_Probe_TCPSocket{
+										Port: 8080,
+									},
+								},
+							},
+						},
+					},
+					Sidecars: []*corev1.Service_Spec_Config_Upstream_Container_Sidecar{
+						{
+							Name:  "proxy",
+							Image: "envoy",
+							ReadinessProbe: &corev1.Service_Spec_Config_Upstream_Container_Probe{
+								Type: &corev1.Service_Spec_Config_Upstream_Container_Probe_TcpSocket{
+									TcpSocket: &corev1.Service_Spec_Config_Upstream_Container_Probe_TCPSocket{
+										Port: 9090,
+									},
+								},
+							},
+							VolumeMounts: []*corev1.Service_Spec_Config_Upstream_Container_VolumeMount{
+								{
+									Name:      "tmp",
+									MountPath: "/tmp",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	volumeFiles := map[string]map[string][]byte{
+		"cert": {
+			"tls.crt": []byte("crt"),
+		},
+	}
+
+	podSpec, err := c.getK8sUpstreamPod(ctx, cfg, svc, nil, ownerCM, volumeFiles)
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]string{
+		"octelium.com/node-mode-dataplane": "",
+		"kubernetes.io/arch":               "arm64",
+	}, podSpec.NodeSelector)
+
+	assert.Equal(t, 2, len(podSpec.Containers))
+	assert.Equal(t, "backend", podSpec.Containers[0].Name)
+	assert.Equal(t, "nginx", podSpec.Containers[0].Image)
+	assert.Equal(t, "proxy", podSpec.Containers[1].Name)
+	assert.Nil(t, podSpec.Containers[1].LivenessProbe)
+	assert.Equal(t, int32(9090), podSpec.Containers[1].ReadinessProbe.TCPSocket.Port.IntVal)
+
+	assert.Equal(t, 1, len(podSpec.InitContainers))
+	assert.Equal(t, "migrate", podSpec.InitContainers[0].Name)
+	assert.Nil(t, podSpec.InitContainers[0].LivenessProbe)
+
+	assert.Equal(t, 2, len(podSpec.Volumes))
+	assert.Equal(t, k8scorev1.StorageMediumMemory, podSpec.Volumes[0].EmptyDir.Medium)
+	assert.Equal(t, "64Mi", podSpec.Volumes[0].EmptyDir.SizeLimit.String())
+
+	secName := getK8sUpstreamVolumeSecretName(svc, cfg, "cert")
+	assert.Equal(t, secName, podSpec.Volumes[1].Secret.SecretName)
+	assert.Equal(t, int32(0400), *podSpec.Volumes[1].Secret.DefaultMode)
+
+	k8sSec, err := c.k8sC.CoreV1().Secrets(ns).Get(ctx, secName, k8smetav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("crt"), k8sSec.Data["tls.crt"])
+}
@@ -0,0 +1,239 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package sesscontroller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/rsc/rmetav1"
+	"github.com/octelium/octelium/cluster/common/octeliumc"
+	"github.com/octelium/octelium/cluster/common/sessionc"
+	"github.com/octelium/octelium/cluster/common/urscsrv"
+	"github.com/octelium/octelium/pkg/grpcerr"
+	"go.uber.org/zap"
+)
+
+type Controller struct {
+	octeliumC octeliumc.ClientInterface
+
+	mu     sync.Mutex
+	timers map[string]*time.Timer
+}
+
+func NewController(
+	octeliumC octeliumc.ClientInterface,
+) *Controller {
+	return &Controller{
+		octeliumC: octeliumC,
+		timers:    make(map[string]*time.Timer),
+	}
+}
+
+func (c *Controller) OnAdd(ctx context.Context, sess *corev1.Session) error {
+	if err := c.syncSession(ctx, sess); err != nil {
+		return err
+	}
+
+	return c.syncDevice(ctx, sess)
+}
+
+func (c *Controller) OnUpdate(ctx context.Context, new, old *corev1.Session) error {
+	return c.syncSession(ctx, new)
+}
+
+func (c *Controller) OnDelete(ctx context.Context, sess *corev1.Session) error {
+	c.unschedule(sess.Metadata.Uid)
+	return nil
+}
+
+func (c *Controller) OnUserUpdate(ctx context.Context, new, old *corev1.User) error {
+	sessList, err := c.octeliumC.CoreC().ListSession(ctx, urscsrv.FilterByUser(new))
+	if err != nil {
+		return err
+	}
+
+	for _, sess := range sessList.Items {
+		if err := c.syncSession(ctx, sess); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Controller) OnClusterConfigUpdate(ctx context.Context, new, old *corev1.ClusterConfig) error {
+	return c.resync(ctx)
+}
+
+// Run periodically resyncs all the Sessions as a fallback for missed watcher
+// events.
+func (c *Controller) Run(ctx context.Context) {
+	go func() {
+		tickerCh := time.NewTicker(10 * time.Minute)
+		defer tickerCh.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-tickerCh.C:
+				if err := c.resync(ctx); err != nil {
+					zap.L().Error("Could not resync Sessions", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+func (c *Controller) resync(ctx context.Context) error {
+	sessList, err := c.octeliumC.CoreC().ListSession(ctx, &rmetav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, sess := range sessList.Items {
+		if err := c.syncSession(ctx, sess); err != nil {
+			zap.L().Warn("Could not sync Session",
+				zap.String("name", sess.Metadata.Name), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+func (c *Controller) syncSession(ctx context.Context, sess *corev1.Session) error {
+	if sess.Status.Expiration != nil {
+		return c.expire(ctx, sess, sess.Status.Expiration.Reason)
+	}
+
+	opts, err := c.getExpirationOpts(ctx, sess)
+	if err != nil {
+		return err
+	}
+
+	expiresAt, reason := sessionc.GetExpiration(sess, opts)
+	if !time.Now().Before(expiresAt) {
+		return c.expire(ctx, sess, reason)
+	}
+
+	c.schedule(sess.Metadata.Uid, time.Until(expiresAt))
+	return nil
+}
+
+func (c *Controller) syncDevice(ctx context.Context, sess *corev1.Session) error {
+	if sess.Status.DeviceRef == nil {
+		return nil
+	}
+
+	opts, err := c.getExpirationOpts(ctx, sess)
+	if err != nil {
+		return err
+	}
+
+	if opts.MaxPerDevice == 0 {
+		return nil
+	}
+
+	sessList, err := c.octeliumC.CoreC().ListSession(ctx, &rmetav1.ListOptions{
+		Filters: []*rmetav1.ListOptions_Filter{
+			urscsrv.FilterFieldEQValStr("status.deviceRef.uid", sess.Status.DeviceRef.Uid),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, itm := range sessionc.GetDeviceSessionsToExpire(sessList.Items, opts.MaxPerDevice) {
+		if err := c.expire(ctx, itm, corev1.Session_Status_Expiration_MAX_PER_DEVICE_EXCEEDED); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Controller) getExpirationOpts(ctx context.Context, sess *corev1.Session) (*sessionc.ExpirationOpts, error) {
+	cc, err := c.octeliumC.CoreV1Utils().GetClusterConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	usr, err := c.octeliumC.CoreC().GetUser(ctx, &rmetav1.GetOptions{Uid: sess.Status.UserRef.Uid})
+	if err != nil {
+		return nil, err
+	}
+
+	return sessionc.GetExpirationOpts(sess, usr, cc), nil
+}
+
+func (c *Controller) expire(ctx context.Context, sess *corev1.Session,
+	reason corev1.Session_Status_Expiration_Reason) error {
+	c.unschedule(sess.Metadata.Uid)
+
+	zap.L().Info("Expiring Session",
+		zap.String("name", sess.Metadata.Name),
+		zap.String("reason", reason.String()))
+
+	return sessionc.ExpireSession(ctx, c.octeliumC, sess, reason)
+}
+
+func (c *Controller) schedule(uid string, d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if timer, ok := c.timers[uid]; ok {
+		timer.Stop()
+	}
+
+	var timer *time.Timer
+	timer = time.AfterFunc(d, func() {
+		c.mu.Lock()
+		if c.timers[uid] == timer {
+			delete(c.timers, uid)
+		}
+		c.mu.Unlock()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+		defer cancel()
+
+		sess, err := c.octeliumC.CoreC().GetSession(ctx, &rmetav1.GetOptions{Uid: uid})
+		if err != nil {
+			if !grpcerr.IsNotFound(err) {
+				zap.L().Warn("Could not get Session to sync", zap.String("uid", uid), zap.Error(err))
+			}
+			return
+		}
+
+		if err := c.syncSession(ctx, sess); err != nil {
+			zap.L().Warn("Could not sync Session", zap.String("uid", uid), zap.Error(err))
+		}
+	})
+	c.timers[uid] = timer
+}
+
+func (c *Controller) unschedule(uid string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if timer, ok := c.timers[uid]; ok {
+		timer.Stop()
+		delete(c.timers, uid)
+	}
+}
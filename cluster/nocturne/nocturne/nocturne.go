@@ -29,13 +29,16 @@ import (
 	"github.com/octelium/octelium/cluster/common/octeliumc"
 	"github.com/octelium/octelium/cluster/common/vutils"
 	"github.com/octelium/octelium/cluster/common/watchers"
+	"github.com/octelium/octelium/cluster/nocturne/nocturne/acme"
 	cccontroller "github.com/octelium/octelium/cluster/nocturne/nocturne/controllers/cluster_config"
 	devcontroller "github.com/octelium/octelium/cluster/nocturne/nocturne/controllers/devices"
+	hpacontroller "github.com/octelium/octelium/cluster/nocturne/nocturne/controllers/hpas"
 	k8ssecretcontroller "github.com/octelium/octelium/cluster/nocturne/nocturne/controllers/k8ssecrets"
 	k8sservicecontroller "github.com/octelium/octelium/cluster/nocturne/nocturne/controllers/k8sservices"
 	nodecontroller "github.com/octelium/octelium/cluster/nocturne/nocturne/controllers/nodes"
 	podcontroller "github.com/octelium/octelium/cluster/nocturne/nocturne/controllers/pods"
 	svccontroller "github.com/octelium/octelium/cluster/nocturne/nocturne/controllers/services"
+	sesscontroller "github.com/octelium/octelium/cluster/nocturne/nocturne/controllers/sessions"
 	usrcontroller "github.com/octelium/octelium/cluster/nocturne/nocturne/controllers/users"
 	"github.com/octelium/octelium/cluster/nocturne/nocturne/watcher"
 	"github.com/octelium/octelium/pkg/apiutils/umetav1"
@@ -63,7 +66,9 @@ func Run(ctx context.Context) error {
 		return err
 	}
 
-	watcher.InitWatcher(octeliumC).Run(ctx)
+	if err := runControllers(ctx, octeliumC); err != nil {
+		return err
+	}
 
 	podcontroller.NewController(k8sC,
 		octeliumC, kubeInformerFactory.Core().V1().Pods(),
@@ -71,33 +76,25 @@ func Run(ctx context.Context) error {
 	k8ssecretcontroller.NewController(k8sC, octeliumC, kubeInformerFactory.Core().V1().Secrets())
 	k8sservicecontroller.NewController(k8sC, octeliumC, kubeInformerFactory.Core().V1().Services())
 	nodecontroller.NewController(k8sC, octeliumC, kubeInformerFactory.Core().V1().Nodes())
+	hpacontroller.NewController(octeliumC, kubeInformerFactory.Autoscaling().V2().HorizontalPodAutoscalers())
 
-	usrCtl := usrcontroller.NewController(octeliumC)
 	svcCtl := svccontroller.NewController(octeliumC, k8sC)
-	// sessCtl := sesscontroller.NewController(octeliumC)
-	devCtl := devcontroller.NewController(octeliumC)
 
 	{
 		watcher := watchers.NewCoreV1(octeliumC)
 
-		if err := watcher.User(ctx, nil, usrCtl.OnAdd, usrCtl.OnUpdate, usrCtl.OnDelete); err != nil {
+		if err := watcher.Service(ctx, nil, svcCtl.OnAdd, svcCtl.OnUpdate, svcCtl.OnDelete); err != nil {
 			return err
 		}
 
-		if err := watcher.Device(ctx, nil, devCtl.OnAdd, devCtl.OnUpdate, devCtl.OnDelete); err != nil {
+		if err := watcher.Secret(ctx, nil, nil, svcCtl.OnSecretUpdate, nil); err != nil {
 			return err
 		}
 
-		if err := watcher.Service(ctx, nil, svcCtl.OnAdd, svcCtl.OnUpdate, svcCtl.OnDelete); err != nil {
+		if err := watcher.Config(ctx, nil, nil, svcCtl.OnConfigUpdate, nil); err != nil {
 			return err
 		}
 
-		/*
-			if err := watcher.Session(ctx, nil, sessCtl.OnAdd, sessCtl.OnUpdate, sessCtl.OnDelete); err != nil {
-				return err
-			}
-		*/
-
 		if err := watcher.ClusterConfig(ctx, nil, cccontroller.NewController(octeliumC, k8sC).OnUpdate); err != nil {
 			return err
 		}
@@ -115,3 +112,58 @@ func Run(ctx context.Context) error {
 
 	return nil
 }
+
+// RunStandalone runs the controllers that do not depend on Kubernetes. It is
+// used by the standalone runtime where there are no pods to manage. The
+// Service controller then only manages the Session upstreams.
+func RunStandalone(ctx context.Context, octeliumC octeliumc.ClientInterface) error {
+	if err := runControllers(ctx, octeliumC); err != nil {
+		return err
+	}
+
+	svcCtl := svccontroller.NewController(octeliumC, nil)
+
+	return watchers.NewCoreV1(octeliumC).Service(ctx, nil, svcCtl.OnAdd, svcCtl.OnUpdate, svcCtl.OnDelete)
+}
+
+func runControllers(ctx context.Context, octeliumC octeliumc.ClientInterface) error {
+	watcher.InitWatcher(octeliumC).Run(ctx)
+
+	acmeManager, err := acme.NewManager(octeliumC)
+	if err != nil {
+		return err
+	}
+	acmeManager.Run(ctx)
+
+	usrCtl := usrcontroller.NewController(octeliumC)
+	sessCtl := sesscontroller.NewController(octeliumC)
+	devCtl := devcontroller.NewController(octeliumC)
+
+	{
+		watcher := watchers.NewCoreV1(octeliumC)
+
+		if err := watcher.User(ctx, nil, usrCtl.OnAdd, usrCtl.OnUpdate, usrCtl.OnDelete); err != nil {
+			return err
+		}
+
+		if err := watcher.Device(ctx, nil, devCtl.OnAdd, devCtl.OnUpdate, devCtl.OnDelete); err != nil {
+			return err
+		}
+
+		if err := watcher.Session(ctx, nil, sessCtl.OnAdd, sessCtl.OnUpdate, sessCtl.OnDelete); err != nil {
+			return err
+		}
+
+		if err := watcher.User(ctx, nil, nil, sessCtl.OnUserUpdate, nil); err != nil {
+			return err
+		}
+
+		if err := watcher.ClusterConfig(ctx, nil, sessCtl.OnClusterConfigUpdate); err != nil {
+			return err
+		}
+	}
+
+	sessCtl.Run(ctx)
+
+	return nil
+}
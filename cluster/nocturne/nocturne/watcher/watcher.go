@@ -22,7 +22,6 @@ import (
 
 	"github.com/octelium/octelium/apis/rsc/rmetav1"
 	"github.com/octelium/octelium/cluster/common/octeliumc"
-	"github.com/octelium/octelium/pkg/apiutils/umetav1"
 	"go.uber.org/zap"
 )
 
@@ -36,52 +35,6 @@ func InitWatcher(octeliumC octeliumc.ClientInterface) *Watcher {
 	}
 }
 
-func (w *Watcher) runSessions(ctx context.Context) {
-	zap.L().Debug("Starting Session watcher")
-
-	doRun := func() error {
-		sessList, err := w.octeliumC.CoreC().ListSession(ctx, &rmetav1.ListOptions{})
-		if err != nil {
-			return err
-		}
-		for _, sess := range sessList.Items {
-			if time.Now().After(sess.Spec.ExpiresAt.AsTime()) {
-				zap.L().Debug("Deleting expired Session", zap.Any("sess", sess))
-				if _, err := w.octeliumC.CoreC().DeleteSession(ctx,
-					&rmetav1.DeleteOptions{Uid: sess.Metadata.Uid}); err != nil {
-					return err
-				}
-			}
-
-			if sess.Status.Authentication != nil {
-				if expiresAt := sess.Status.Authentication.SetAt.AsTime().
-					Add(umetav1.ToDuration(sess.Status.Authentication.RefreshTokenDuration).ToGo()); !expiresAt.IsZero() && time.Now().After(expiresAt) {
-					zap.L().Debug("Deleting Session with expired refresh token", zap.Any("sess", sess))
-					if _, err := w.octeliumC.CoreC().DeleteSession(ctx,
-						&rmetav1.DeleteOptions{Uid: sess.Metadata.Uid}); err != nil {
-						return err
-					}
-				}
-			}
-		}
-		return nil
-	}
-
-	tickerCh := time.NewTicker(10 * time.Minute)
-	defer tickerCh.Stop()
-
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case <-tickerCh.C:
-			if err := doRun(); err != nil {
-				zap.L().Error("Could not run Session watcher doFn", zap.Error(err))
-			}
-		}
-	}
-}
-
 func (w *Watcher) runCredentials(ctx context.Context) {
 
 	zap.S().Debugf("starting Credential watcher loop")
@@ -121,7 +74,6 @@ func (w *Watcher) runCredentials(ctx context.Context) {
 }
 
 func (w *Watcher) Run(ctx context.Context) {
-	go w.runSessions(ctx)
 	go w.runCredentials(ctx)
 	go w.runJWKSecret(ctx)
 }
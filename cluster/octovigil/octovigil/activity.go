@@ -0,0 +1,113 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package octovigil
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/rsc/rmetav1"
+	"github.com/octelium/octelium/cluster/common/octeliumc"
+	"github.com/octelium/octelium/pkg/common/pbutils"
+	"github.com/octelium/octelium/pkg/grpcerr"
+	"go.uber.org/zap"
+)
+
+// activityRecorder records the lastActivityAt of CLIENTLESS Sessions on
+// their authenticated requests so that nocturne can enforce their idle
+// timeout. CLIENT Sessions already report their activity via their
+// connection heartbeats.
+type activityRecorder struct {
+	octeliumC octeliumc.ClientInterface
+	interval  time.Duration
+
+	mu         sync.Mutex
+	recordedAt map[string]time.Time
+}
+
+func newActivityRecorder(octeliumC octeliumc.ClientInterface) *activityRecorder {
+	return &activityRecorder{
+		octeliumC:  octeliumC,
+		interval:   3 * time.Minute,
+		recordedAt: make(map[string]time.Time),
+	}
+}
+
+func (r *activityRecorder) shouldRecord(sess *corev1.Session, now time.Time) bool {
+	if sess.Status.Type != corev1.Session_Status_CLIENTLESS {
+		return false
+	}
+
+	if sess.Status.LastActivityAt != nil &&
+		now.Sub(sess.Status.LastActivityAt.AsTime()) < r.interval {
+		return false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if at, ok := r.recordedAt[sess.Metadata.Uid]; ok && now.Sub(at) < r.interval {
+		return false
+	}
+
+	if len(r.recordedAt) > 10000 {
+		for uid, at := range r.recordedAt {
+			if now.Sub(at) >= r.interval {
+				delete(r.recordedAt, uid)
+			}
+		}
+	}
+
+	r.recordedAt[sess.Metadata.Uid] = now
+
+	return true
+}
+
+func (r *activityRecorder) record(sess *corev1.Session) {
+	if r == nil || !r.shouldRecord(sess, time.Now()) {
+		return
+	}
+
+	go func(uid string) {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		if err := r.doRecord(ctx, uid); err != nil {
+			zap.L().Warn("Could not record Session activity", zap.String("uid", uid), zap.Error(err))
+		}
+	}(sess.Metadata.Uid)
+}
+
+func (r *activityRecorder) doRecord(ctx context.Context, uid string) error {
+	sess, err := r.octeliumC.CoreC().GetSession(ctx, &rmetav1.GetOptions{Uid: uid})
+	if err != nil {
+		if grpcerr.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	sess.Status.LastActivityAt = pbutils.Now()
+
+	if _, err := r.octeliumC.CoreC().UpdateSession(ctx, sess); err != nil && !grpcerr.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
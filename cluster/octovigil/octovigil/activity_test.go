@@ -0,0 +1,53 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package octovigil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/pkg/common/pbutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActivityRecorderShouldRecord(t *testing.T) {
+	r := newActivityRecorder(nil)
+	now := time.Now()
+
+	sess := &corev1.Session{
+		Metadata: &metav1.Metadata{
+			Uid: "sess-1",
+		},
+		Status: &corev1.Session_Status{
+			Type: corev1.Session_Status_CLIENT,
+		},
+	}
+
+	assert.False(t, r.shouldRecord(sess, now))
+
+	sess.Status.Type = corev1.Session_Status_CLIENTLESS
+	assert.True(t, r.shouldRecord(sess, now))
+	assert.False(t, r.shouldRecord(sess, now.Add(time.Minute)))
+	assert.True(t, r.shouldRecord(sess, now.Add(r.interval)))
+
+	sess.Metadata.Uid = "sess-2"
+	sess.Status.LastActivityAt = pbutils.Timestamp(now)
+	assert.False(t, r.shouldRecord(sess, now.Add(time.Minute)))
+	assert.True(t, r.shouldRecord(sess, now.Add(r.interval)))
+}
@@ -21,26 +21,45 @@ import (
 
 	"github.com/octelium/octelium/apis/main/corev1"
 	"github.com/octelium/octelium/cluster/octovigil/octovigil/acache"
+	"github.com/octelium/octelium/cluster/octovigil/octovigil/dcache"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
 )
 
 type Controller struct {
-	c *acache.Cache
+	c      *acache.Cache
+	dCache *dcache.Cache
 }
 
-func NewController(c *acache.Cache) *Controller {
+func NewController(c *acache.Cache, dCache *dcache.Cache) *Controller {
 	return &Controller{
-		c: c,
+		c:      c,
+		dCache: dCache,
 	}
 }
 
 func (c *Controller) OnAdd(ctx context.Context, device *corev1.Device) error {
-	return c.c.SetDevice(device)
+	if err := c.c.SetDevice(device); err != nil {
+		return err
+	}
+
+	c.dCache.Invalidate(dcache.DepKey(ucorev1.KindDevice, device.Metadata.Uid))
+	return nil
 }
 
 func (c *Controller) OnUpdate(ctx context.Context, new, old *corev1.Device) error {
-	return c.c.SetDevice(new)
+	if err := c.c.SetDevice(new); err != nil {
+		return err
+	}
+
+	c.dCache.Invalidate(dcache.DepKey(ucorev1.KindDevice, new.Metadata.Uid))
+	return nil
 }
 
 func (c *Controller) OnDelete(ctx context.Context, device *corev1.Device) error {
-	return c.c.DeleteDevice(device)
+	if err := c.c.DeleteDevice(device); err != nil {
+		return err
+	}
+
+	c.dCache.Invalidate(dcache.DepKey(ucorev1.KindDevice, device.Metadata.Uid))
+	return nil
 }
@@ -21,26 +21,45 @@ import (
 
 	"github.com/octelium/octelium/apis/main/corev1"
 	"github.com/octelium/octelium/cluster/octovigil/octovigil/acache"
+	"github.com/octelium/octelium/cluster/octovigil/octovigil/dcache"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
 )
 
 type Controller struct {
-	c *acache.Cache
+	c      *acache.Cache
+	dCache *dcache.Cache
 }
 
-func NewController(c *acache.Cache) *Controller {
+func NewController(c *acache.Cache, dCache *dcache.Cache) *Controller {
 	return &Controller{
-		c: c,
+		c:      c,
+		dCache: dCache,
 	}
 }
 
 func (c *Controller) OnAdd(ctx context.Context, grp *corev1.Group) error {
-	return c.c.SetGroup(grp)
+	if err := c.c.SetGroup(grp); err != nil {
+		return err
+	}
+
+	c.dCache.Invalidate(dcache.DepKey(ucorev1.KindGroup, grp.Metadata.Name))
+	return nil
 }
 
 func (c *Controller) OnUpdate(ctx context.Context, new, old *corev1.Group) error {
-	return c.c.SetGroup(new)
+	if err := c.c.SetGroup(new); err != nil {
+		return err
+	}
+
+	c.dCache.Invalidate(dcache.DepKey(ucorev1.KindGroup, new.Metadata.Name))
+	return nil
 }
 
 func (c *Controller) OnDelete(ctx context.Context, grp *corev1.Group) error {
-	return c.c.DeleteGroup(grp)
+	if err := c.c.DeleteGroup(grp); err != nil {
+		return err
+	}
+
+	c.dCache.Invalidate(dcache.DepKey(ucorev1.KindGroup, grp.Metadata.Name))
+	return nil
 }
@@ -21,26 +21,45 @@ import (
 
 	"github.com/octelium/octelium/apis/main/corev1"
 	"github.com/octelium/octelium/cluster/octovigil/octovigil/acache"
+	"github.com/octelium/octelium/cluster/octovigil/octovigil/dcache"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
 )
 
 type Controller struct {
-	c *acache.Cache
+	c      *acache.Cache
+	dCache *dcache.Cache
 }
 
-func NewController(c *acache.Cache) *Controller {
+func NewController(c *acache.Cache, dCache *dcache.Cache) *Controller {
 	return &Controller{
-		c: c,
+		c:      c,
+		dCache: dCache,
 	}
 }
 
 func (c *Controller) OnAdd(ctx context.Context, p *corev1.Namespace) error {
-	return c.c.SetNamespace(p)
+	if err := c.c.SetNamespace(p); err != nil {
+		return err
+	}
+
+	c.dCache.Invalidate(dcache.DepKey(ucorev1.KindNamespace, p.Metadata.Name))
+	return nil
 }
 
 func (c *Controller) OnUpdate(ctx context.Context, new, old *corev1.Namespace) error {
-	return c.c.SetNamespace(new)
+	if err := c.c.SetNamespace(new); err != nil {
+		return err
+	}
+
+	c.dCache.Invalidate(dcache.DepKey(ucorev1.KindNamespace, new.Metadata.Name))
+	return nil
 }
 
 func (c *Controller) OnDelete(ctx context.Context, p *corev1.Namespace) error {
-	return c.c.DeleteNamespace(p)
+	if err := c.c.DeleteNamespace(p); err != nil {
+		return err
+	}
+
+	c.dCache.Invalidate(dcache.DepKey(ucorev1.KindNamespace, p.Metadata.Name))
+	return nil
 }
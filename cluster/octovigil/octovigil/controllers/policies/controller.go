@@ -21,26 +21,45 @@ import (
 
 	"github.com/octelium/octelium/apis/main/corev1"
 	"github.com/octelium/octelium/cluster/octovigil/octovigil/acache"
+	"github.com/octelium/octelium/cluster/octovigil/octovigil/dcache"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
 )
 
 type Controller struct {
-	c *acache.Cache
+	c      *acache.Cache
+	dCache *dcache.Cache
 }
 
-func NewController(c *acache.Cache) *Controller {
+func NewController(c *acache.Cache, dCache *dcache.Cache) *Controller {
 	return &Controller{
-		c: c,
+		c:      c,
+		dCache: dCache,
 	}
 }
 
 func (c *Controller) OnAdd(ctx context.Context, p *corev1.Policy) error {
-	return c.c.SetPolicy(p)
+	if err := c.c.SetPolicy(p); err != nil {
+		return err
+	}
+
+	c.dCache.Invalidate(dcache.DepKey(ucorev1.KindPolicy, p.Metadata.Name))
+	return nil
 }
 
 func (c *Controller) OnUpdate(ctx context.Context, new, old *corev1.Policy) error {
-	return c.c.SetPolicy(new)
+	if err := c.c.SetPolicy(new); err != nil {
+		return err
+	}
+
+	c.dCache.Invalidate(dcache.DepKey(ucorev1.KindPolicy, new.Metadata.Name))
+	return nil
 }
 
 func (c *Controller) OnDelete(ctx context.Context, p *corev1.Policy) error {
-	return c.c.DeletePolicy(p)
+	if err := c.c.DeletePolicy(p); err != nil {
+		return err
+	}
+
+	c.dCache.Invalidate(dcache.DepKey(ucorev1.KindPolicy, p.Metadata.Name))
+	return nil
 }
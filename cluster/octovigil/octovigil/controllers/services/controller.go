@@ -21,27 +21,46 @@ import (
 
 	"github.com/octelium/octelium/apis/main/corev1"
 	"github.com/octelium/octelium/cluster/octovigil/octovigil/acache"
+	"github.com/octelium/octelium/cluster/octovigil/octovigil/dcache"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
 )
 
 type Controller struct {
 	c      *acache.Cache
+	dCache *dcache.Cache
 	svcUID string
 }
 
-func NewController(c *acache.Cache) *Controller {
+func NewController(c *acache.Cache, dCache *dcache.Cache) *Controller {
 	return &Controller{
-		c: c,
+		c:      c,
+		dCache: dCache,
 	}
 }
 
 func (c *Controller) OnAdd(ctx context.Context, svc *corev1.Service) error {
-	return c.c.SetService(svc)
+	if err := c.c.SetService(svc); err != nil {
+		return err
+	}
+
+	c.dCache.Invalidate(dcache.DepKey(ucorev1.KindService, svc.Metadata.Uid))
+	return nil
 }
 
 func (c *Controller) OnUpdate(ctx context.Context, new, old *corev1.Service) error {
-	return c.c.SetService(new)
+	if err := c.c.SetService(new); err != nil {
+		return err
+	}
+
+	c.dCache.Invalidate(dcache.DepKey(ucorev1.KindService, new.Metadata.Uid))
+	return nil
 }
 
 func (c *Controller) OnDelete(ctx context.Context, svc *corev1.Service) error {
-	return c.c.DeleteService(svc)
+	if err := c.c.DeleteService(svc); err != nil {
+		return err
+	}
+
+	c.dCache.Invalidate(dcache.DepKey(ucorev1.KindService, svc.Metadata.Uid))
+	return nil
 }
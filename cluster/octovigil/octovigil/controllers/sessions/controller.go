@@ -21,26 +21,45 @@ import (
 
 	"github.com/octelium/octelium/apis/main/corev1"
 	"github.com/octelium/octelium/cluster/octovigil/octovigil/acache"
+	"github.com/octelium/octelium/cluster/octovigil/octovigil/dcache"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
 )
 
 type Controller struct {
-	c *acache.Cache
+	c      *acache.Cache
+	dCache *dcache.Cache
 }
 
-func NewController(c *acache.Cache) *Controller {
+func NewController(c *acache.Cache, dCache *dcache.Cache) *Controller {
 	return &Controller{
-		c: c,
+		c:      c,
+		dCache: dCache,
 	}
 }
 
 func (c *Controller) OnAdd(ctx context.Context, sess *corev1.Session) error {
-	return c.c.SetSession(sess)
+	if err := c.c.SetSession(sess); err != nil {
+		return err
+	}
+
+	c.dCache.Invalidate(dcache.DepKey(ucorev1.KindSession, sess.Metadata.Uid))
+	return nil
 }
 
 func (c *Controller) OnUpdate(ctx context.Context, new, old *corev1.Session) error {
-	return c.c.SetSession(new)
+	if err := c.c.SetSession(new); err != nil {
+		return err
+	}
+
+	c.dCache.Invalidate(dcache.DepKey(ucorev1.KindSession, new.Metadata.Uid))
+	return nil
 }
 
 func (c *Controller) OnDelete(ctx context.Context, sess *corev1.Session) error {
-	return c.c.DeleteSession(sess)
+	if err := c.c.DeleteSession(sess); err != nil {
+		return err
+	}
+
+	c.dCache.Invalidate(dcache.DepKey(ucorev1.KindSession, sess.Metadata.Uid))
+	return nil
 }
@@ -0,0 +1,323 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dcache
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	defaultTTL        = 1 * time.Minute
+	defaultMaxEntries = 100000
+)
+
+// Cache caches authorization decisions. Every key, typically identifying a
+// Session and a Service, has a Profile describing which request context
+// fields the decision depends on as well as the resources whose changes
+// invalidate all the decisions of that key.
+type Cache struct {
+	mu         sync.Mutex
+	isEnabled  bool
+	ttl        time.Duration
+	maxEntries int
+	numEntries int
+	generation uint64
+	profiles   map[string]*profile
+	deps       map[string]map[string]struct{}
+}
+
+type Opts struct {
+	IsEnabled  bool
+	TTL        time.Duration
+	MaxEntries int
+}
+
+type Profile struct {
+	// Paths are the paths of the request context fields read by the conditions
+	// that the decision might depend on.
+	Paths [][]string
+	// Deps are the keys of the resources whose changes invalidate the decision.
+	Deps []string
+	// IsUncacheable is set when the decision cannot be cached.
+	IsUncacheable bool
+}
+
+type profile struct {
+	*Profile
+	entries map[string]*entry
+}
+
+type entry struct {
+	val       any
+	expiresAt time.Time
+}
+
+func New(o *Opts) *Cache {
+	ret := &Cache{}
+	ret.reset(o)
+	return ret
+}
+
+func (c *Cache) reset(o *Opts) {
+	c.profiles = make(map[string]*profile)
+	c.deps = make(map[string]map[string]struct{})
+	c.numEntries = 0
+	c.generation++
+
+	if o == nil {
+		o = &Opts{}
+	}
+
+	c.isEnabled = o.IsEnabled
+	c.ttl = o.TTL
+	if c.ttl <= 0 {
+		c.ttl = defaultTTL
+	}
+	c.maxEntries = o.MaxEntries
+	if c.maxEntries <= 0 {
+		c.maxEntries = defaultMaxEntries
+	}
+}
+
+// SetOpts updates the Cache options and flushes all of its entries.
+func (c *Cache) SetOpts(o *Opts) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reset(o)
+}
+
+func (c *Cache) IsEnabled() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.isEnabled
+}
+
+// Generation returns the current invalidation generation. It must be obtained
+// before reading the resources a decision depends on and passed to SetProfile
+// and Set so that values computed from resources that have been changed in
+// the meantime are never stored.
+func (c *Cache) Generation() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.generation
+}
+
+func (c *Cache) GetProfile(key string) *Profile {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if p, ok := c.profiles[key]; ok {
+		return p.Profile
+	}
+
+	return nil
+}
+
+func (c *Cache) SetProfile(key string, p *Profile, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.isEnabled || generation != c.generation {
+		return
+	}
+
+	c.deleteProfile(key)
+
+	c.profiles[key] = &profile{
+		Profile: p,
+		entries: make(map[string]*entry),
+	}
+
+	for _, dep := range p.Deps {
+		keys, ok := c.deps[dep]
+		if !ok {
+			keys = make(map[string]struct{})
+			c.deps[dep] = keys
+		}
+		keys[key] = struct{}{}
+	}
+}
+
+func (c *Cache) Get(key string, reqCtxMap map[string]any) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	p, ok := c.profiles[key]
+	if !ok || p.IsUncacheable {
+		return nil, false
+	}
+
+	entryKey, err := getEntryKey(p.Paths, reqCtxMap)
+	if err != nil {
+		return nil, false
+	}
+
+	e, ok := p.entries[entryKey]
+	if !ok {
+		return nil, false
+	}
+
+	if time.Now().After(e.expiresAt) {
+		delete(p.entries, entryKey)
+		c.numEntries--
+		return nil, false
+	}
+
+	return e.val, true
+}
+
+func (c *Cache) Set(key string, reqCtxMap map[string]any, val any, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.isEnabled || generation != c.generation {
+		return
+	}
+
+	p, ok := c.profiles[key]
+	if !ok || p.IsUncacheable {
+		return
+	}
+
+	entryKey, err := getEntryKey(p.Paths, reqCtxMap)
+	if err != nil {
+		return
+	}
+
+	if _, ok := p.entries[entryKey]; !ok {
+		for c.numEntries >= c.maxEntries && c.evictOne() {
+		}
+		c.numEntries++
+	}
+
+	p.entries[entryKey] = &entry{
+		val:       val,
+		expiresAt: time.Now().Add(c.ttl),
+	}
+}
+
+// Invalidate removes all the profiles, and hence their decisions, that
+// depend on any of the given dependency keys.
+func (c *Cache) Invalidate(deps ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+
+	for _, dep := range deps {
+		for key := range c.deps[dep] {
+			c.deleteProfile(key)
+		}
+		delete(c.deps, dep)
+	}
+}
+
+// Flush removes all the profiles and decisions.
+func (c *Cache) Flush() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.profiles = make(map[string]*profile)
+	c.deps = make(map[string]map[string]struct{})
+	c.numEntries = 0
+	c.generation++
+}
+
+// Len returns the number of cached decisions.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.numEntries
+}
+
+func (c *Cache) deleteProfile(key string) {
+	p, ok := c.profiles[key]
+	if !ok {
+		return
+	}
+
+	c.numEntries -= len(p.entries)
+	delete(c.profiles, key)
+
+	for _, dep := range p.Deps {
+		if keys, ok := c.deps[dep]; ok {
+			delete(keys, key)
+			if len(keys) == 0 {
+				delete(c.deps, dep)
+			}
+		}
+	}
+}
+
+func (c *Cache) evictOne() bool {
+	for _, p := range c.profiles {
+		for entryKey := range p.entries {
+			delete(p.entries, entryKey)
+			c.numEntries--
+			return true
+		}
+	}
+
+	return false
+}
+
+func DepKey(kind, id string) string {
+	return fmt.Sprintf("%s/%s", kind, id)
+}
+
+func getEntryKey(paths [][]string, reqCtxMap map[string]any) (string, error) {
+	hsh := sha256.New()
+
+	for _, path := range paths {
+		valBytes, err := json.Marshal(getPathValue(reqCtxMap, path))
+		if err != nil {
+			return "", err
+		}
+
+		pathBytes, err := json.Marshal(path)
+		if err != nil {
+			return "", err
+		}
+
+		hsh.Write(pathBytes)
+		hsh.Write([]byte{0})
+		hsh.Write(valBytes)
+		hsh.Write([]byte{0})
+	}
+
+	return fmt.Sprintf("%x", hsh.Sum(nil)), nil
+}
+
+func getPathValue(in map[string]any, path []string) any {
+	var cur any = in
+	for _, field := range path {
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return nil
+		}
+		cur, ok = m[field]
+		if !ok {
+			return nil
+		}
+	}
+
+	return cur
+}
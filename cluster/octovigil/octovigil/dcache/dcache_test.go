@@ -0,0 +1,135 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dcache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCache(t *testing.T) {
+
+	reqCtxMap := func(path string) map[string]any {
+		return map[string]any{
+			"request": map[string]any{
+				"http": map[string]any{
+					"path":   path,
+					"method": "GET",
+				},
+			},
+		}
+	}
+
+	{
+		c := New(nil)
+		assert.False(t, c.IsEnabled())
+		c.SetProfile("k1", &Profile{}, c.Generation())
+		assert.Nil(t, c.GetProfile("k1"))
+	}
+
+	{
+		c := New(&Opts{IsEnabled: true})
+
+		profile := &Profile{
+			Paths: [][]string{{"request", "http", "path"}},
+			Deps:  []string{DepKey("User", "usr1"), DepKey("Policy", "p1")},
+		}
+		c.SetProfile("k1", profile, c.Generation())
+		assert.Equal(t, profile, c.GetProfile("k1"))
+
+		_, ok := c.Get("k1", reqCtxMap("/a"))
+		assert.False(t, ok)
+
+		c.Set("k1", reqCtxMap("/a"), "allow", c.Generation())
+		val, ok := c.Get("k1", reqCtxMap("/a"))
+		assert.True(t, ok)
+		assert.Equal(t, "allow", val)
+
+		otherMethod := reqCtxMap("/a")
+		otherMethod["request"].(map[string]any)["http"].(map[string]any)["method"] = "POST"
+		_, ok = c.Get("k1", otherMethod)
+		assert.True(t, ok, "fields not read by the profile must not affect the key")
+
+		_, ok = c.Get("k1", reqCtxMap("/b"))
+		assert.False(t, ok)
+		assert.Equal(t, 1, c.Len())
+
+		c.Invalidate(DepKey("User", "usr2"))
+		_, ok = c.Get("k1", reqCtxMap("/a"))
+		assert.True(t, ok)
+
+		c.Invalidate(DepKey("Policy", "p1"))
+		_, ok = c.Get("k1", reqCtxMap("/a"))
+		assert.False(t, ok)
+		assert.Nil(t, c.GetProfile("k1"))
+		assert.Equal(t, 0, c.Len())
+	}
+
+	{
+		c := New(&Opts{IsEnabled: true})
+		generation := c.Generation()
+		c.Invalidate(DepKey("Session", "sess1"))
+
+		c.SetProfile("k1", &Profile{}, generation)
+		assert.Nil(t, c.GetProfile("k1"), "stale generation must be rejected")
+
+		c.SetProfile("k1", &Profile{}, c.Generation())
+		generation = c.Generation()
+		c.Invalidate(DepKey("Session", "sess2"))
+		c.Set("k1", reqCtxMap("/"), "allow", generation)
+		assert.Equal(t, 0, c.Len())
+	}
+
+	{
+		c := New(&Opts{IsEnabled: true})
+		c.SetProfile("k1", &Profile{IsUncacheable: true}, c.Generation())
+		c.Set("k1", reqCtxMap("/"), "allow", c.Generation())
+		_, ok := c.Get("k1", reqCtxMap("/"))
+		assert.False(t, ok)
+	}
+
+	{
+		c := New(&Opts{IsEnabled: true, TTL: 50 * time.Millisecond})
+		c.SetProfile("k1", &Profile{}, c.Generation())
+		c.Set("k1", reqCtxMap("/"), "allow", c.Generation())
+		_, ok := c.Get("k1", reqCtxMap("/"))
+		assert.True(t, ok)
+		time.Sleep(100 * time.Millisecond)
+		_, ok = c.Get("k1", reqCtxMap("/"))
+		assert.False(t, ok)
+		assert.Equal(t, 0, c.Len())
+	}
+
+	{
+		c := New(&Opts{IsEnabled: true, MaxEntries: 2})
+		c.SetProfile("k1", &Profile{
+			Paths: [][]string{{"request", "http", "path"}},
+		}, c.Generation())
+		c.Set("k1", reqCtxMap("/a"), "allow", c.Generation())
+		c.Set("k1", reqCtxMap("/b"), "allow", c.Generation())
+		c.Set("k1", reqCtxMap("/c"), "allow", c.Generation())
+		assert.Equal(t, 2, c.Len())
+		_, ok := c.Get("k1", reqCtxMap("/c"))
+		assert.True(t, ok)
+
+		c.Flush()
+		assert.Equal(t, 0, c.Len())
+		assert.Nil(t, c.GetProfile("k1"))
+	}
+}
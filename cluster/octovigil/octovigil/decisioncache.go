@@ -0,0 +1,279 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package octovigil
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/cluster/common/apivalidation"
+	"github.com/octelium/octelium/cluster/octovigil/octovigil/dcache"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
+	"github.com/octelium/octelium/pkg/apiutils/umetav1"
+	"github.com/octelium/octelium/pkg/common/pbutils"
+	"github.com/octelium/octelium/pkg/grpcerr"
+)
+
+type decisionCacheVal struct {
+	resp         *getDecisionResp
+	evalDuration time.Duration
+}
+
+func (s *Server) getDecisionWithCache(ctx context.Context,
+	req *getDecisionReq, reqCtxMap map[string]any) (*getDecisionResp, error) {
+	startedAt := time.Now()
+	key := getDecisionCacheKey(req.i)
+	generation := s.dCache.Generation()
+
+	profile := s.dCache.GetProfile(key)
+	if profile == nil {
+		profile = s.getDecisionProfile(ctx, req.i)
+		s.dCache.SetProfile(key, profile, generation)
+	}
+
+	if profile.IsUncacheable {
+		s.commonMetrics.atDecisionCacheUncacheable()
+		return s.getDecisionFromRules(ctx, req, reqCtxMap)
+	}
+
+	if val, ok := s.dCache.Get(key, reqCtxMap); ok {
+		cached := val.(*decisionCacheVal)
+		s.commonMetrics.atDecisionCacheHit(cached.evalDuration - time.Since(startedAt))
+		ret := &getDecisionResp{
+			decision: cached.resp.decision,
+			effect:   cached.resp.effect,
+		}
+		if cached.resp.reason != nil {
+			ret.reason = pbutils.Clone(cached.resp.reason).(*corev1.AccessLog_Entry_Common_Reason)
+		}
+		return ret, nil
+	}
+
+	evalStartedAt := time.Now()
+	resp, err := s.getDecisionFromRules(ctx, req, reqCtxMap)
+	if err != nil {
+		return nil, err
+	}
+
+	s.dCache.Set(key, reqCtxMap, &decisionCacheVal{
+		resp:         resp,
+		evalDuration: time.Since(evalStartedAt),
+	}, generation)
+	s.commonMetrics.atDecisionCacheMiss()
+
+	return resp, nil
+}
+
+func getDecisionCacheKey(i *corev1.RequestContext) string {
+	return fmt.Sprintf("%s/%s", i.Session.Metadata.Uid, i.Service.Metadata.Uid)
+}
+
+func (s *Server) setDecisionCacheOpts(cc *corev1.ClusterConfig) {
+	opts := cc.GetSpec().GetAuthorization().GetDecisionCache()
+	s.dCache.SetOpts(&dcache.Opts{
+		IsEnabled:  opts.GetIsEnabled(),
+		TTL:        umetav1.ToDuration(opts.GetTtl()).ToGo(),
+		MaxEntries: int(opts.GetMaxEntries()),
+	})
+}
+
+type decisionProfileBuilder struct {
+	s             *Server
+	paths         map[string][]string
+	deps          map[string]struct{}
+	isUncacheable bool
+}
+
+// getDecisionProfile builds the profile of the decision of the request, i.e.
+// the request context fields read by all the conditions that the decision
+// might depend on, regardless of whether they are evaluated for a specific
+// request, as well as the resources whose changes must invalidate it.
+func (s *Server) getDecisionProfile(ctx context.Context, i *corev1.RequestContext) *dcache.Profile {
+	b := &decisionProfileBuilder{
+		s:     s,
+		paths: make(map[string][]string),
+		deps:  make(map[string]struct{}),
+	}
+
+	b.addDep(ucorev1.KindSession, i.Session.Metadata.Uid)
+	b.addDep(ucorev1.KindUser, i.User.Metadata.Uid)
+	b.addDep(ucorev1.KindService, i.Service.Metadata.Uid)
+	b.addAuthorization(ctx, i.Session.Spec.Authorization)
+	b.addAuthorization(ctx, i.User.Spec.Authorization)
+	b.addAuthorization(ctx, i.Service.Spec.Authorization)
+
+	for _, grp := range i.User.Spec.Groups {
+		b.addDep(ucorev1.KindGroup, grp)
+	}
+
+	for _, grp := range i.Groups {
+		b.addAuthorization(ctx, grp.Spec.Authorization)
+	}
+
+	if i.Device != nil {
+		b.addDep(ucorev1.KindDevice, i.Device.Metadata.Uid)
+		b.addAuthorization(ctx, i.Device.Spec.Authorization)
+	}
+
+	if i.Service.Status.NamespaceRef != nil {
+		b.addDep(ucorev1.KindNamespace, i.Service.Status.NamespaceRef.Name)
+		if ns, err := s.getNamespaceFromName(ctx, i.Service.Status.NamespaceRef.Name); err == nil {
+			b.addAuthorization(ctx, ns.Spec.Authorization)
+		}
+	}
+
+	s.policyTriggerCtl.RLock()
+	for _, pt := range s.policyTriggerCtl.ptMap {
+		if pt.Status.IsDisabled {
+			continue
+		}
+		b.addPreCondition(pt.Status.PreCondition)
+		b.addPolicies(ctx, pt.Status.Policies, pt.Status.InlinePolicies)
+	}
+	s.policyTriggerCtl.RUnlock()
+
+	ret := &dcache.Profile{
+		IsUncacheable: b.isUncacheable,
+	}
+
+	for dep := range b.deps {
+		ret.Deps = append(ret.Deps, dep)
+	}
+
+	for _, path := range b.paths {
+		ret.Paths = append(ret.Paths, path)
+	}
+
+	slices.SortFunc(ret.Paths, func(a, b []string) int {
+		return strings.Compare(strings.Join(a, "."), strings.Join(b, "."))
+	})
+
+	return ret
+}
+
+func (b *decisionProfileBuilder) addDep(kind, id string) {
+	b.deps[dcache.DepKey(kind, id)] = struct{}{}
+}
+
+func (b *decisionProfileBuilder) addAuthorization(ctx context.Context, authz interface {
+	GetPolicies() []string
+	GetInlinePolicies() []*corev1.InlinePolicy
+}) {
+	if authz == nil {
+		return
+	}
+
+	b.addPolicies(ctx, authz.GetPolicies(), authz.GetInlinePolicies())
+}
+
+func (b *decisionProfileBuilder) addPolicies(ctx context.Context,
+	policies []string, inlinePolicies []*corev1.InlinePolicy) {
+
+	for _, name := range policies {
+		allPolicies, err := apivalidation.GetNameAndParents(name)
+		if err != nil {
+			b.isUncacheable = true
+			continue
+		}
+
+		for _, p := range allPolicies {
+			b.addDep(ucorev1.KindPolicy, p)
+
+			policy, err := b.s.getPolicyFromName(ctx, p)
+			if err != nil {
+				if !b.s.cache.IsErrNotFound(err) && !grpcerr.IsNotFound(err) {
+					b.isUncacheable = true
+				}
+				continue
+			}
+
+			b.addPolicySpec(policy.Spec)
+		}
+	}
+
+	for _, p := range inlinePolicies {
+		b.addPolicySpec(p.Spec)
+	}
+}
+
+func (b *decisionProfileBuilder) addPolicySpec(spec *corev1.Policy_Spec) {
+	if spec == nil || spec.IsDisabled {
+		return
+	}
+
+	for _, rule := range spec.EnforcementRules {
+		b.addCondition(rule.Condition)
+	}
+
+	for _, rule := range spec.Rules {
+		b.addCondition(rule.Condition)
+	}
+}
+
+func (b *decisionProfileBuilder) addPreCondition(c *corev1.PolicyTrigger_Status_PreCondition) {
+	if c == nil {
+		return
+	}
+
+	switch c.Type.(type) {
+	case *corev1.PolicyTrigger_Status_PreCondition_Condition:
+		b.addCondition(c.GetCondition())
+	case *corev1.PolicyTrigger_Status_PreCondition_NotAfter,
+		*corev1.PolicyTrigger_Status_PreCondition_NotBefore:
+		b.isUncacheable = true
+	case *corev1.PolicyTrigger_Status_PreCondition_All_:
+		for _, cond := range c.GetAll().Of {
+			b.addPreCondition(cond)
+		}
+	case *corev1.PolicyTrigger_Status_PreCondition_Any_:
+		for _, cond := range c.GetAny().Of {
+			b.addPreCondition(cond)
+		}
+	}
+}
+
+func (b *decisionProfileBuilder) addCondition(c *corev1.Condition) {
+	paths, err := b.s.celEngine.GetConditionInputPaths(c)
+	if err != nil {
+		b.isUncacheable = true
+		return
+	}
+
+	for _, path := range paths {
+		if isTimeDependentPath(path) {
+			b.isUncacheable = true
+			return
+		}
+
+		b.paths[strings.Join(path, ".")] = path
+	}
+}
+
+// isTimeDependentPath returns whether the path reads values computed from the
+// current time, namely the Device posture staleness.
+func isTimeDependentPath(path []string) bool {
+	timeDependent := []string{"device", "posture", "isStale"}
+	if len(path) > len(timeDependent) {
+		return false
+	}
+
+	return slices.Equal(path, timeDependent[:len(path)])
+}
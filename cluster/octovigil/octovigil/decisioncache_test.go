@@ -0,0 +1,175 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package octovigil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/cluster/common/celengine"
+	"github.com/octelium/octelium/cluster/octovigil/octovigil/acache"
+	"github.com/octelium/octelium/cluster/octovigil/octovigil/dcache"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDecisionProfile(t *testing.T) {
+
+	ctx := context.Background()
+
+	aCache, err := acache.NewCache()
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		aCache.Close()
+	})
+
+	celEngine, err := celengine.New(ctx, &celengine.Opts{})
+	assert.Nil(t, err)
+
+	dCache := dcache.New(&dcache.Opts{IsEnabled: true})
+	s := &Server{
+		cache:     aCache,
+		dCache:    dCache,
+		celEngine: celEngine,
+		policyTriggerCtl: &policyTriggerCtl{
+			ptMap:  make(map[string]*corev1.PolicyTrigger),
+			dCache: dCache,
+		},
+	}
+
+	genCondition := func(match string) *corev1.Condition {
+		return &corev1.Condition{
+			Type: &corev1.Condition_Match{
+				Match: match,
+			},
+		}
+	}
+
+	policy := &corev1.Policy{
+		ApiVersion: "core/v1",
+		Kind:       ucorev1.KindPolicy,
+		Metadata: &metav1.Metadata{
+			Name: "allow-api",
+			Uid:  "policy-uid",
+		},
+		Spec: &corev1.Policy_Spec{
+			Rules: []*corev1.Policy_Spec_Rule{
+				{
+					Effect:    corev1.Policy_Spec_Rule_ALLOW,
+					Condition: genCondition(`ctx.request.http.path.startsWith("/api")`),
+				},
+			},
+		},
+	}
+	assert.Nil(t, aCache.SetPolicy(policy))
+
+	reqCtx := &corev1.RequestContext{
+		Session: &corev1.Session{
+			Metadata: &metav1.Metadata{Uid: "sess-uid"},
+			Spec:     &corev1.Session_Spec{},
+		},
+		User: &corev1.User{
+			Metadata: &metav1.Metadata{Uid: "usr-uid"},
+			Spec: &corev1.User_Spec{
+				Groups: []string{"admins"},
+				Authorization: &corev1.User_Spec_Authorization{
+					Policies: []string{"allow-api"},
+					InlinePolicies: []*corev1.InlinePolicy{
+						{
+							Spec: &corev1.Policy_Spec{
+								EnforcementRules: []*corev1.Policy_Spec_EnforcementRule{
+									{
+										Effect:    corev1.Policy_Spec_EnforcementRule_ENFORCE,
+										Condition: genCondition(`ctx.request.http.method == "GET"`),
+									},
+								},
+								Rules: []*corev1.Policy_Spec_Rule{
+									{
+										Effect:    corev1.Policy_Spec_Rule_DENY,
+										Condition: genCondition(`ctx.user.metadata.name == "john"`),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Service: &corev1.Service{
+			Metadata: &metav1.Metadata{Uid: "svc-uid"},
+			Spec:     &corev1.Service_Spec{},
+			Status:   &corev1.Service_Status{},
+		},
+	}
+
+	{
+		profile := s.getDecisionProfile(ctx, reqCtx)
+		assert.False(t, profile.IsUncacheable)
+		assert.Equal(t, [][]string{
+			{"request", "http", "method"},
+			{"request", "http", "path"},
+			{"user", "metadata", "name"},
+		}, profile.Paths)
+		assert.ElementsMatch(t, []string{
+			dcache.DepKey(ucorev1.KindSession, "sess-uid"),
+			dcache.DepKey(ucorev1.KindUser, "usr-uid"),
+			dcache.DepKey(ucorev1.KindService, "svc-uid"),
+			dcache.DepKey(ucorev1.KindGroup, "admins"),
+			dcache.DepKey(ucorev1.KindPolicy, "allow-api"),
+		}, profile.Deps)
+	}
+
+	{
+		reqCtx.Session.Spec.Authorization = &corev1.Session_Spec_Authorization{
+			InlinePolicies: []*corev1.InlinePolicy{
+				{
+					Spec: &corev1.Policy_Spec{
+						Rules: []*corev1.Policy_Spec_Rule{
+							{
+								Effect:    corev1.Policy_Spec_Rule_DENY,
+								Condition: genCondition(`ctx.device.posture.isStale`),
+							},
+						},
+					},
+				},
+			},
+		}
+
+		profile := s.getDecisionProfile(ctx, reqCtx)
+		assert.True(t, profile.IsUncacheable)
+		reqCtx.Session.Spec.Authorization = nil
+	}
+
+	{
+		s.policyTriggerCtl.SetPolicyTrigger(&corev1.PolicyTrigger{
+			Metadata: &metav1.Metadata{Uid: "pt-uid"},
+			Status: &corev1.PolicyTrigger_Status{
+				PreCondition: &corev1.PolicyTrigger_Status_PreCondition{
+					Type: &corev1.PolicyTrigger_Status_PreCondition_Condition{
+						Condition: genCondition(`ctx.request.http.host == "example.com"`),
+					},
+				},
+			},
+		})
+
+		profile := s.getDecisionProfile(ctx, reqCtx)
+		assert.False(t, profile.IsUncacheable)
+		assert.Contains(t, profile.Paths, []string{"request", "http", "host"})
+	}
+}
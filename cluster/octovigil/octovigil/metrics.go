@@ -26,10 +26,12 @@ import (
 )
 
 type commonMetrics struct {
-	TotalRequests      metric.Int64Counter
-	ActiveRequests     metric.Int64UpDownCounter
-	RequestDuration    metric.Float64Histogram
-	CommonAttributeSet attribute.Set
+	TotalRequests              metric.Int64Counter
+	ActiveRequests             metric.Int64UpDownCounter
+	RequestDuration            metric.Float64Histogram
+	DecisionCacheRequests      metric.Int64Counter
+	DecisionCacheSavedDuration metric.Float64Histogram
+	CommonAttributeSet         attribute.Set
 }
 
 func newCommonMetrics(ctx context.Context) (*commonMetrics, error) {
@@ -56,6 +58,18 @@ func newCommonMetrics(ctx context.Context) (*commonMetrics, error) {
 		return nil, err
 	}
 
+	ret.DecisionCacheRequests, err = meter.Int64Counter(otelutils.GetComponentKeyWithPrefix("authorization.decision_cache.req.total"),
+		metric.WithDescription("Total number of decision cache lookups by result"))
+	if err != nil {
+		return nil, err
+	}
+
+	ret.DecisionCacheSavedDuration, err = meter.Float64Histogram(otelutils.GetComponentKeyWithPrefix("authorization.decision_cache.saved_duration"),
+		metric.WithUnit("us"), metric.WithDescription("Estimated evaluation time saved by decision cache hits in microseconds"))
+	if err != nil {
+		return nil, err
+	}
+
 	return ret, nil
 }
 
@@ -76,3 +90,28 @@ func (m *commonMetrics) atAuthorizationRequestEnd(startTime time.Time, additiona
 	m.TotalRequests.Add(ctx, 1,
 		metric.WithAttributeSet(m.CommonAttributeSet))
 }
+
+func (m *commonMetrics) atDecisionCacheHit(saved time.Duration) {
+	ctx := context.Background()
+	m.DecisionCacheRequests.Add(ctx, 1,
+		metric.WithAttributeSet(m.CommonAttributeSet),
+		metric.WithAttributes(attribute.String("result", "hit")))
+
+	if saved > 0 {
+		m.DecisionCacheSavedDuration.Record(ctx,
+			float64(saved.Nanoseconds())/1000,
+			metric.WithAttributeSet(m.CommonAttributeSet))
+	}
+}
+
+func (m *commonMetrics) atDecisionCacheMiss() {
+	m.DecisionCacheRequests.Add(context.Background(), 1,
+		metric.WithAttributeSet(m.CommonAttributeSet),
+		metric.WithAttributes(attribute.String("result", "miss")))
+}
+
+func (m *commonMetrics) atDecisionCacheUncacheable() {
+	m.DecisionCacheRequests.Add(context.Background(), 1,
+		metric.WithAttributeSet(m.CommonAttributeSet),
+		metric.WithAttributes(attribute.String("result", "uncacheable")))
+}
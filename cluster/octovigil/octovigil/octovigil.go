@@ -22,6 +22,7 @@ import (
 	"net"
 	"net/http"
 	"net/netip"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -38,10 +39,12 @@ import (
 	"github.com/octelium/octelium/cluster/common/octeliumc"
 	"github.com/octelium/octelium/cluster/common/octovigilc"
 	"github.com/octelium/octelium/cluster/common/oscope"
+	"github.com/octelium/octelium/cluster/common/otelutils"
+	"github.com/octelium/octelium/cluster/common/svcconfig"
 	"github.com/octelium/octelium/cluster/common/watchers"
 	"github.com/octelium/octelium/cluster/octovigil/octovigil/acache"
+	"github.com/octelium/octelium/cluster/octovigil/octovigil/dcache"
 	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
-	"github.com/octelium/octelium/pkg/common/pbutils"
 	"github.com/pkg/errors"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
@@ -63,6 +66,7 @@ type Server struct {
 	octeliumC octeliumc.ClientInterface
 
 	cache  *acache.Cache
+	dCache *dcache.Cache
 	jwkCtl *jwkctl.Controller
 	domain string
 
@@ -75,17 +79,22 @@ type Server struct {
 	ccCtl            *ccctl.Controller
 	policyTriggerCtl *policyTriggerCtl
 	commonMetrics    *commonMetrics
+	activity         *activityRecorder
+
+	listenHost string
 }
 
 type policyTriggerCtl struct {
 	sync.RWMutex
-	ptMap map[string]*corev1.PolicyTrigger
+	ptMap  map[string]*corev1.PolicyTrigger
+	dCache *dcache.Cache
 }
 
 func (c *policyTriggerCtl) SetPolicyTrigger(i *corev1.PolicyTrigger) error {
 	c.Lock()
 	c.ptMap[i.Metadata.Uid] = i
 	c.Unlock()
+	c.dCache.Flush()
 	return nil
 }
 
@@ -93,6 +102,7 @@ func (c *policyTriggerCtl) DeletePolicyTrigger(i *corev1.PolicyTrigger) error {
 	c.Lock()
 	delete(c.ptMap, i.Metadata.Uid)
 	c.Unlock()
+	c.dCache.Flush()
 	return nil
 }
 
@@ -103,12 +113,17 @@ func New(ctx context.Context, octeliumC octeliumc.ClientInterface) (*Server, err
 		return nil, err
 	}
 
+	dCache := dcache.New(nil)
+
 	ret := &Server{
 		octeliumC: octeliumC,
 		cache:     aCache,
+		dCache:    dCache,
 		policyTriggerCtl: &policyTriggerCtl{
-			ptMap: make(map[string]*corev1.PolicyTrigger),
+			ptMap:  make(map[string]*corev1.PolicyTrigger),
+			dCache: dCache,
 		},
+		activity: newActivityRecorder(octeliumC),
 	}
 
 	cc, err := octeliumC.CoreV1Utils().GetClusterConfig(ctx)
@@ -116,6 +131,7 @@ func New(ctx context.Context, octeliumC octeliumc.ClientInterface) (*Server, err
 		return nil, err
 	}
 	ret.domain = cc.Status.Domain
+	ret.setDecisionCacheOpts(cc)
 
 	if cc.Status.Network.ClusterNetwork.V4 != "" {
 		v4Prefix, err := netip.ParsePrefix(cc.Status.Network.ClusterNetwork.V4)
@@ -145,7 +161,12 @@ func New(ctx context.Context, octeliumC octeliumc.ClientInterface) (*Server, err
 		return nil, err
 	}
 
-	ret.ccCtl, err = ccctl.New(ctx, octeliumC, &ccctl.Opts{})
+	ret.ccCtl, err = ccctl.New(ctx, octeliumC, &ccctl.Opts{
+		OnUpdate: func(ctx context.Context, new, old *corev1.ClusterConfig) error {
+			ret.setDecisionCacheOpts(new)
+			return nil
+		},
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -162,6 +183,10 @@ func (s *Server) GetCache() *acache.Cache {
 	return s.cache
 }
 
+func (s *Server) GetDecisionCache() *dcache.Cache {
+	return s.dCache
+}
+
 func (s *Server) Close() error {
 	err := s.cache.Close()
 	if s.grpcSrv != nil {
@@ -207,6 +232,24 @@ func (s *Server) getConnectionIdentifier(r *coctovigilv1.DoAuthenticateAndAuthor
 	}
 
 	if svc.Spec.IsPublic {
+		if len(req.ClientCertificate) > 0 {
+			claims, err := s.jwkCtl.VerifyClientCertificate(req.ClientCertificate)
+			if err != nil {
+				return "", nil, err
+			}
+
+			return claims.SessionUID, claims, nil
+		}
+
+		if req.AccessToken != "" {
+			claims, err := s.jwkCtl.VerifyAccessToken(req.AccessToken)
+			if err != nil {
+				return "", nil, err
+			}
+
+			return claims.SessionUID, claims, nil
+		}
+
 		if hdr := s.getHTTPHeadersFromReq(req); hdr != nil {
 			if tkn, err := s.getAccessTokenFromHTTPHeader(hdr); err == nil {
 				claims, err := s.jwkCtl.VerifyAccessToken(tkn)
@@ -364,6 +407,8 @@ func (s *Server) authenticate(ctx context.Context, req *coctovigilv1.DoAuthentic
 		}
 	}
 
+	s.activity.record(di.Session)
+
 	return di, nil
 }
 
@@ -425,11 +470,12 @@ func (s *Server) isAuthorizedWithMetrics(ctx context.Context, req *corev1.Reques
 
 func (s *Server) getServiceConfigName(ctx context.Context, reqCtx *corev1.RequestContext) (string, error) {
 	svc := reqCtx.Service
-	if svc.Spec.DynamicConfig == nil || len(svc.Spec.DynamicConfig.Rules) < 1 {
+	if svc.Spec.DynamicConfig == nil ||
+		(len(svc.Spec.DynamicConfig.Rules) < 1 && svc.Spec.DynamicConfig.Split == nil) {
 		return "", nil
 	}
 
-	reqCtxMap, err := pbutils.ConvertToMap(reqCtx)
+	reqCtxMap, err := s.getReqCtxMap(reqCtx)
 	if err != nil {
 		return "", err
 	}
@@ -450,7 +496,7 @@ func (s *Server) getServiceConfigName(ctx context.Context, reqCtx *corev1.Reques
 		}
 	}
 
-	return "", nil
+	return svcconfig.GetSplitConfigName(ctx, s.celEngine, reqCtx, inputMap), nil
 }
 
 func (s *Server) DoAuthorize(ctx context.Context,
@@ -576,37 +622,58 @@ func (s *Server) isAuthorized(ctx context.Context,
 
 }
 
+type Opts struct {
+	// ListenHost sets the host on which the gRPC server listens. All the
+	// addresses are used if not set.
+	ListenHost string
+	// IsEmbedded is set when Octovigil runs within another process, such as
+	// the standalone runtime, which already initializes the common telemetry.
+	IsEmbedded bool
+}
+
 func Run(ctx context.Context) error {
+	return RunWithOpts(ctx, nil)
+}
+
+func RunWithOpts(ctx context.Context, o *Opts) error {
 
 	zap.L().Debug("Starting running Octovigil")
 
+	if o == nil {
+		o = &Opts{}
+	}
+
 	octeliumC, err := octeliumc.NewClient(ctx)
 	if err != nil {
 		return err
 	}
 
-	if err := commoninit.Run(ctx, nil); err != nil {
-		return err
+	if !o.IsEmbedded {
+		if err := commoninit.Run(ctx, nil); err != nil {
+			return err
+		}
 	}
 
 	s, err := New(ctx, octeliumC)
 	if err != nil {
 		return err
 	}
+	s.listenHost = o.ListenHost
+
 	if err := s.run(ctx); err != nil {
 		return err
 	}
 
 	watcher := watchers.NewCoreV1(octeliumC)
 
-	usrCtl := usercontroller.NewController(s.GetCache())
-	groupCtl := groupcontroller.NewController(s.GetCache())
-	deviceCtl := devicecontroller.NewController(s.GetCache())
-	sessCtl := sesscontroller.NewController(s.GetCache())
-	svcCtl := svccontroller.NewController(s.GetCache())
-	policyCtl := policycontroller.NewController(s.GetCache())
+	usrCtl := usercontroller.NewController(s.GetCache(), s.GetDecisionCache())
+	groupCtl := groupcontroller.NewController(s.GetCache(), s.GetDecisionCache())
+	deviceCtl := devicecontroller.NewController(s.GetCache(), s.GetDecisionCache())
+	sessCtl := sesscontroller.NewController(s.GetCache(), s.GetDecisionCache())
+	svcCtl := svccontroller.NewController(s.GetCache(), s.GetDecisionCache())
+	policyCtl := policycontroller.NewController(s.GetCache(), s.GetDecisionCache())
 
-	nsCtl := nscontroller.NewController(s.GetCache())
+	nsCtl := nscontroller.NewController(s.GetCache(), s.GetDecisionCache())
 	ptCtl := ptctl.NewController(s.policyTriggerCtl)
 
 	if err := watcher.User(ctx, nil, usrCtl.OnAdd, usrCtl.OnUpdate, usrCtl.OnDelete); err != nil {
@@ -664,13 +731,14 @@ func (s *Server) run(ctx context.Context) error {
 		grpc.MaxConcurrentStreams(100*1000),
 		grpc.MaxRecvMsgSize(33*1024*1024),
 		grpc.MaxSendMsgSize(33*1024*1024),
+		grpc.UnaryInterceptor(otelutils.UnaryServerInterceptor()),
 	)
 	coctovigilv1.RegisterInternalServiceServer(s.grpcSrv, &internalService{
 		s: s,
 	})
 	grpc_health_v1.RegisterHealthServer(s.grpcSrv, healthcheck.NewServer())
 
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", octovigilc.GetPort()))
+	lis, err := net.Listen("tcp", net.JoinHostPort(s.listenHost, strconv.Itoa(octovigilc.GetPort())))
 	if err != nil {
 		return err
 	}
@@ -18,6 +18,8 @@ package octovigil
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
 	"fmt"
 	"testing"
 
@@ -1417,6 +1419,67 @@ func TestAuthenticate(t *testing.T) {
 			assert.Equal(t, res.User.Metadata.Uid, usr.Usr.Metadata.Uid)
 			assert.Equal(t, res.Device.Metadata.Uid, usr.Device.Metadata.Uid)
 		}
+
+		{
+			res, err := srv.Authenticate(ctx, &coctovigilv1.DoAuthenticateAndAuthorizeRequest{
+				Service: svc,
+				Request: &coctovigilv1.DownstreamRequest{
+					Source: &coctovigilv1.DownstreamRequest_Source{
+						Address: "1.2.3.4",
+					},
+					AccessToken: accessToken,
+				},
+			})
+			assert.Nil(t, err)
+
+			assert.Equal(t, res.Session.Metadata.Uid, usr.Session.Metadata.Uid)
+			assert.Equal(t, res.User.Metadata.Uid, usr.Usr.Metadata.Uid)
+			assert.Equal(t, res.Device.Metadata.Uid, usr.Device.Metadata.Uid)
+		}
+
+		{
+			_, err := srv.Authenticate(ctx, &coctovigilv1.DoAuthenticateAndAuthorizeRequest{
+				Service: svc,
+				Request: &coctovigilv1.DownstreamRequest{
+					Source: &coctovigilv1.DownstreamRequest_Source{
+						Address: "1.2.3.4",
+					},
+					AccessToken: utilrand.GetRandomString(32),
+				},
+			})
+			assert.NotNil(t, err)
+		}
+
+		{
+			_, clientKey, err := ed25519.GenerateKey(rand.Reader)
+			assert.Nil(t, err)
+
+			crt, err := srv.jwkCtl.CreateClientCertificate(usr.Session, clientKey.Public())
+			assert.Nil(t, err)
+
+			res, err := srv.Authenticate(ctx, &coctovigilv1.DoAuthenticateAndAuthorizeRequest{
+				Service: svc,
+				Request: &coctovigilv1.DownstreamRequest{
+					Source: &coctovigilv1.DownstreamRequest_Source{
+						Address: "1.2.3.4",
+					},
+					ClientCertificate: crt,
+				},
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, res.Session.Metadata.Uid, usr.Session.Metadata.Uid)
+
+			_, err = srv.Authenticate(ctx, &coctovigilv1.DoAuthenticateAndAuthorizeRequest{
+				Service: svc,
+				Request: &coctovigilv1.DownstreamRequest{
+					Source: &coctovigilv1.DownstreamRequest_Source{
+						Address: "1.2.3.4",
+					},
+					ClientCertificate: crt[:len(crt)-1],
+				},
+			})
+			assert.NotNil(t, err)
+		}
 	}
 
 }
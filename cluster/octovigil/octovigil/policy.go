@@ -59,11 +59,19 @@ type policyRuleInline struct {
 }
 
 func (s *Server) getDecision(ctx context.Context, req *getDecisionReq) (*getDecisionResp, error) {
-	reqCtxMap, err := pbutils.ConvertToMap(req.i)
+	reqCtxMap, err := s.getReqCtxMap(req.i)
 	if err != nil {
 		return nil, err
 	}
 
+	if s.dCache.IsEnabled() {
+		return s.getDecisionWithCache(ctx, req, reqCtxMap)
+	}
+
+	return s.getDecisionFromRules(ctx, req, reqCtxMap)
+}
+
+func (s *Server) getDecisionFromRules(ctx context.Context, req *getDecisionReq, reqCtxMap map[string]any) (*getDecisionResp, error) {
 	allRules, err := s.getAllRules(ctx, req, reqCtxMap)
 	if err != nil {
 		return nil, err
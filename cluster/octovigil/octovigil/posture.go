@@ -0,0 +1,76 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package octovigil
+
+import (
+	"time"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
+	"github.com/octelium/octelium/pkg/common/pbutils"
+)
+
+// getReqCtxMap converts the RequestContext to the map exposed to Policies as
+// `ctx`. If the request comes from a Device, its posture is exposed under
+// `ctx.device.posture` with all its fields always set so that conditions
+// do not have to check for the existence of each field.
+func (s *Server) getReqCtxMap(reqCtx *corev1.RequestContext) (map[string]any, error) {
+	ret, err := pbutils.ConvertToMap(reqCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if reqCtx.Device == nil {
+		return ret, nil
+	}
+
+	deviceMap, ok := ret["device"].(map[string]any)
+	if !ok {
+		deviceMap = make(map[string]any)
+		ret["device"] = deviceMap
+	}
+
+	deviceMap["posture"] = getDevicePostureMap(reqCtx.Device, s.ccCtl.Get(), time.Now())
+
+	return ret, nil
+}
+
+func getDevicePostureMap(dev *corev1.Device, cc *corev1.ClusterConfig, now time.Time) map[string]any {
+	posture := dev.GetStatus().GetPosture()
+
+	ret := map[string]any{
+		"isReported":          posture != nil,
+		"isStale":             ucorev1.ToDevice(dev).IsPostureStale(ucorev1.ToClusterConfig(cc).GetDevicePostureMaxAge(), now),
+		"osVersion":           posture.GetOsVersion(),
+		"isDiskEncrypted":     posture.GetIsDiskEncrypted(),
+		"isScreenLockEnabled": posture.GetIsScreenLockEnabled(),
+		"isFirewallEnabled":   posture.GetIsFirewallEnabled(),
+		"clientVersion":       posture.GetClientVersion(),
+		"reportedAt":          "",
+		"receivedAt":          "",
+	}
+
+	if posture.GetReportedAt() != nil {
+		ret["reportedAt"] = posture.ReportedAt.AsTime().Format(time.RFC3339Nano)
+	}
+
+	if posture.GetReceivedAt() != nil {
+		ret["receivedAt"] = posture.ReceivedAt.AsTime().Format(time.RFC3339Nano)
+	}
+
+	return ret
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package octovigil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/cluster/common/celengine"
+	"github.com/octelium/octelium/pkg/common/pbutils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetDevicePostureMap(t *testing.T) {
+
+	ctx := context.Background()
+	now := time.Now()
+
+	celEngine, err := celengine.New(ctx, &celengine.Opts{})
+	assert.Nil(t, err)
+
+	eval := func(dev *corev1.Device, cc *corev1.ClusterConfig, match string) bool {
+		reqCtxMap, err := pbutils.ConvertToMap(&corev1.RequestContext{
+			Device: dev,
+		})
+		assert.Nil(t, err)
+		deviceMap, ok := reqCtxMap["device"].(map[string]any)
+		if !ok {
+			deviceMap = make(map[string]any)
+			reqCtxMap["device"] = deviceMap
+		}
+		deviceMap["posture"] = getDevicePostureMap(dev, cc, now)
+
+		ret, err := celEngine.EvalCondition(ctx, &corev1.Condition{
+			Type: &corev1.Condition_Match{
+				Match: match,
+			},
+		}, map[string]any{
+			"ctx": reqCtxMap,
+		})
+		assert.Nil(t, err)
+		return ret
+	}
+
+	{
+		dev := &corev1.Device{
+			Status: &corev1.Device_Status{},
+		}
+		assert.False(t, eval(dev, nil, `ctx.device.posture.isReported`))
+		assert.True(t, eval(dev, nil, `ctx.device.posture.isStale`))
+		assert.False(t, eval(dev, nil, `ctx.device.posture.isDiskEncrypted`))
+	}
+
+	{
+		dev := &corev1.Device{
+			Status: &corev1.Device_Status{
+				Posture: &corev1.Device_Status_Posture{
+					OsVersion:       "Ubuntu 24.04",
+					IsDiskEncrypted: true,
+					ReportedAt:      timestamppb.New(now.Add(-1 * time.Minute)),
+					ReceivedAt:      timestamppb.New(now.Add(-1 * time.Minute)),
+				},
+			},
+		}
+		assert.True(t, eval(dev, nil, `ctx.device.posture.isReported`))
+		assert.False(t, eval(dev, nil, `ctx.device.posture.isStale`))
+		assert.True(t, eval(dev, nil, `ctx.device.posture.isDiskEncrypted`))
+		assert.False(t, eval(dev, nil, `ctx.device.posture.isFirewallEnabled`))
+		assert.True(t, eval(dev, nil, `ctx.device.posture.osVersion.startsWith("Ubuntu")`))
+
+		cc := &corev1.ClusterConfig{
+			Spec: &corev1.ClusterConfig_Spec{
+				Device: &corev1.ClusterConfig_Spec_Device{
+					Posture: &corev1.ClusterConfig_Spec_Device_Posture{
+						MaxAge: &metav1.Duration{
+							Type: &metav1.Duration_Seconds{
+								Seconds: 30,
+							},
+						},
+					},
+				},
+			},
+		}
+		assert.True(t, eval(dev, cc, `ctx.device.posture.isStale`))
+	}
+
+	{
+		dev := &corev1.Device{
+			Status: &corev1.Device_Status{
+				Posture: &corev1.Device_Status_Posture{
+					IsDiskEncrypted: true,
+					ReceivedAt:      timestamppb.New(now.Add(-1 * time.Hour)),
+				},
+			},
+		}
+		assert.True(t, eval(dev, nil, `ctx.device.posture.isStale`))
+		assert.False(t, eval(dev, nil,
+			`ctx.device.posture.isDiskEncrypted && !ctx.device.posture.isStale`))
+	}
+}
@@ -80,12 +80,12 @@ func (s *server) getTemplateGlobals() *templateGlobals {
 	return val.(*templateGlobals)
 }
 
-func (s *server) run(ctx context.Context) error {
+func (s *server) run(ctx context.Context, addr string) error {
 
 	go func() error {
 		srv := &http.Server{
 			Handler:      s,
-			Addr:         vutils.ManagedServiceAddr,
+			Addr:         addr,
 			WriteTimeout: 15 * time.Second,
 			ReadTimeout:  15 * time.Second,
 		}
@@ -109,7 +109,24 @@ func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+type Opts struct {
+	// Port sets the local port on which the Portal listens. The default
+	// managed Service port is used if not set.
+	Port int
+	// IsEmbedded is set when the Portal runs within another process, such as
+	// the standalone runtime, which serves its own health checks.
+	IsEmbedded bool
+}
+
 func Run(ctx context.Context) error {
+	return RunWithOpts(ctx, nil)
+}
+
+func RunWithOpts(ctx context.Context, o *Opts) error {
+
+	if o == nil {
+		o = &Opts{}
+	}
 
 	octeliumC, err := octeliumc.NewClient(ctx)
 	if err != nil {
@@ -126,11 +143,13 @@ func Run(ctx context.Context) error {
 		return err
 	}
 
-	if err := s.run(ctx); err != nil {
+	if err := s.run(ctx, vutils.GetManagedServiceAddr(o.Port)); err != nil {
 		return err
 	}
 
-	healthcheck.Run(vutils.HealthCheckPortManagedService)
+	if !o.IsEmbedded {
+		healthcheck.Run(vutils.HealthCheckPortManagedService)
+	}
 	zap.S().Info("Portal is running")
 	<-ctx.Done()
 
@@ -0,0 +1,403 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package rscserver
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"regexp"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/apis/rsc/rauditv1"
+	"github.com/octelium/octelium/cluster/common/otelutils"
+	"github.com/octelium/octelium/cluster/common/postgresutils"
+	"github.com/octelium/octelium/cluster/common/vutils"
+	"github.com/octelium/octelium/cluster/rscserver/rscserver/rerr"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
+	"github.com/octelium/octelium/pkg/apiutils/umetav1"
+	"github.com/octelium/octelium/pkg/common/pbutils"
+	"github.com/octelium/octelium/pkg/grpcerr"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const auditTableName = postgresutils.AuditTable
+
+var rgxAuditLogUID = regexp.MustCompile(`^[a-zA-Z0-9-]{1,64}$`)
+
+type srvAudit struct {
+	s *Server
+	rauditv1.UnimplementedMainServiceServer
+}
+
+func (s *srvAudit) ListAuditLog(ctx context.Context, req *corev1.ListAuditLogOptions) (*corev1.AuditLogList, error) {
+	return s.s.doListAuditLog(ctx, req)
+}
+
+func (s *srvAudit) GetAuditLog(ctx context.Context, req *rauditv1.GetAuditLogRequest) (*corev1.AuditLog, error) {
+	return s.s.doGetAuditLog(ctx, req.Uid)
+}
+
+// newAuditLog builds the AuditLog of a write operation. newObj is nil for
+// DELETE operations while oldObj is nil for CREATE operations.
+func (s *Server) newAuditLog(ctx context.Context, op corev1.AuditLog_Entry_Operation,
+	newObj, oldObj umetav1.ResourceObjectI, api, version, kind string) (*corev1.AuditLog, error) {
+
+	obj := newObj
+	if obj == nil {
+		obj = oldObj
+	}
+
+	ret := &corev1.AuditLog{
+		ApiVersion: vutils.GetApiVersion(ucorev1.API, ucorev1.Version),
+		Kind:       "AuditLog",
+		Metadata: &metav1.LogMetadata{
+			Id:        vutils.GenerateLogID(),
+			CreatedAt: pbutils.Now(),
+			ActorRef:  getActorRef(ctx),
+			TargetRef: &metav1.ObjectReference{
+				ApiVersion:      vutils.GetApiVersion(api, version),
+				Kind:            kind,
+				Name:            obj.GetMetadata().Name,
+				Uid:             obj.GetMetadata().Uid,
+				ResourceVersion: obj.GetMetadata().ResourceVersion,
+			},
+		},
+		Entry: &corev1.AuditLog_Entry{
+			Operation:      op,
+			ActorOperation: getActorOp(ctx),
+			IsRedacted:     s.isTypeSecret(kind),
+		},
+	}
+
+	var err error
+	if newObj != nil {
+		ret.Entry.ResourceVersion = newObj.GetMetadata().ResourceVersion
+		if ret.Entry.New, err = s.getAuditLogSnapshot(newObj, kind); err != nil {
+			return nil, err
+		}
+	}
+
+	if oldObj != nil {
+		if ret.Entry.Old, err = s.getAuditLogSnapshot(oldObj, kind); err != nil {
+			return nil, err
+		}
+	}
+
+	return ret, nil
+}
+
+// getAuditLogSnapshot converts the resource to a Struct. The data of
+// Secrets is never stored in AuditLogs.
+func (s *Server) getAuditLogSnapshot(obj umetav1.ResourceObjectI, kind string) (*structpb.Struct, error) {
+	objMap, err := pbutils.ConvertToMap(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.isTypeSecret(kind) {
+		delete(objMap, "data")
+	}
+
+	return structpb.NewStruct(objMap)
+}
+
+// isStatusOnlyUpdate reports whether the update only changes the status of
+// the resource. Such updates, e.g. the frequent status updates of Sessions,
+// Devices and Gateways by the Cluster components, are not recorded as
+// AuditLogs since they are not changes of the desired state.
+func isStatusOnlyUpdate(newObj, oldObj umetav1.ResourceObjectI) (bool, error) {
+	newMap, err := pbutils.ConvertToMap(newObj)
+	if err != nil {
+		return false, err
+	}
+
+	oldMap, err := pbutils.ConvertToMap(oldObj)
+	if err != nil {
+		return false, err
+	}
+
+	delete(newMap, "status")
+	delete(oldMap, "status")
+
+	return reflect.DeepEqual(newMap, oldMap), nil
+}
+
+// execWithAuditLog executes the resource write query and inserts its AuditLog
+// within the same transaction so that no write goes unrecorded. A nil
+// auditLog, as in the case of status-only updates, only executes the query.
+func (s *Server) execWithAuditLog(ctx context.Context,
+	sqln string, sqlargs []any, auditLog *corev1.AuditLog) error {
+
+	if auditLog == nil {
+		_, err := s.db.ExecContext(ctx, sqln, sqlargs...)
+		return err
+	}
+
+	auditSQL, auditArgs, err := getAuditLogInsertSQL(auditLog)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, sqln, sqlargs...); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, auditSQL, auditArgs...); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	otelutils.EmitAuditLog(auditLog)
+
+	return nil
+}
+
+func getAuditLogInsertSQL(auditLog *corev1.AuditLog) (string, []any, error) {
+	md := auditLog.Metadata
+
+	api, version := vutils.SplitApiVersion(md.TargetRef.ApiVersion)
+
+	logJSON, err := pbutils.MarshalJSON(auditLog, false)
+	if err != nil {
+		return "", nil, err
+	}
+
+	ds := goqu.Insert(auditTableName).
+		Cols("uid", "created_at", "api", "version", "kind",
+			"resource_uid", "resource_name", "actor_uid", "actor_name", "audit_log").
+		Vals(goqu.Vals{
+			md.Id, md.CreatedAt.AsTime(), api, version, md.TargetRef.Kind,
+			md.TargetRef.Uid, md.TargetRef.Name,
+			md.ActorRef.GetUid(), md.ActorRef.GetName(), string(logJSON),
+		})
+
+	return ds.ToSQL()
+}
+
+const auditLogPruneInterval = time.Hour
+
+// startAuditLogPruneLoop periodically deletes the AuditLogs that exceed the
+// ClusterConfig's AuditLog retention.
+func (s *Server) startAuditLogPruneLoop(ctx context.Context) {
+	tickerCh := time.NewTicker(auditLogPruneInterval)
+	defer tickerCh.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tickerCh.C:
+			if err := s.pruneAuditLogs(ctx); err != nil {
+				zap.L().Warn("Could not prune AuditLogs", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (s *Server) pruneAuditLogs(ctx context.Context) error {
+	cc, err := s.getClusterConfig(ctx)
+	if err != nil {
+		if grpcerr.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	retention := umetav1.ToDuration(cc.Spec.GetAuditLog().GetRetention()).ToGo()
+	if retention <= 0 {
+		return nil
+	}
+
+	sqln, sqlargs, err := getAuditLogPruneSQL(time.Now().Add(-retention))
+	if err != nil {
+		return err
+	}
+
+	res, err := s.db.ExecContext(ctx, sqln, sqlargs...)
+	if err != nil {
+		return err
+	}
+
+	if n, _ := res.RowsAffected(); n > 0 {
+		zap.L().Info("Pruned AuditLogs", zap.Int64("count", n), zap.Duration("retention", retention))
+	}
+
+	return nil
+}
+
+func getAuditLogPruneSQL(before time.Time) (string, []any, error) {
+	return goqu.Delete(auditTableName).Where(goqu.C("created_at").Lt(before.UTC())).ToSQL()
+}
+
+func getAuditLogListFilters(req *corev1.ListAuditLogOptions) []exp.Expression {
+	var filters []exp.Expression
+
+	if ref := req.ResourceRef; ref != nil {
+		if ref.ApiVersion != "" {
+			api, version := vutils.SplitApiVersion(ref.ApiVersion)
+			filters = append(filters, goqu.C("api").Eq(api), goqu.C("version").Eq(version))
+		}
+		if ref.Kind != "" {
+			filters = append(filters, goqu.C("kind").Eq(ref.Kind))
+		}
+		if ref.Name != "" {
+			filters = append(filters, goqu.C("resource_name").Eq(ref.Name))
+		}
+		if ref.Uid != "" {
+			filters = append(filters, goqu.C("resource_uid").Eq(ref.Uid))
+		}
+	}
+
+	if ref := req.ActorRef; ref != nil {
+		if ref.Name != "" {
+			filters = append(filters, goqu.C("actor_name").Eq(ref.Name))
+		}
+		if ref.Uid != "" {
+			filters = append(filters, goqu.C("actor_uid").Eq(ref.Uid))
+		}
+	}
+
+	if req.From != nil {
+		filters = append(filters, goqu.C("created_at").Gte(req.From.AsTime()))
+	}
+
+	if req.To != nil {
+		filters = append(filters, goqu.C("created_at").Lte(req.To.AsTime()))
+	}
+
+	return filters
+}
+
+func (s *Server) doListAuditLog(ctx context.Context, req *corev1.ListAuditLogOptions) (*corev1.AuditLogList, error) {
+	ret := &corev1.AuditLogList{
+		ApiVersion:       vutils.GetApiVersion(ucorev1.API, ucorev1.Version),
+		Kind:             "AuditLogList",
+		ListResponseMeta: &metav1.ListResponseMeta{},
+	}
+
+	ds := goqu.From(auditTableName).Where(getAuditLogListFilters(req)...).
+		Select("audit_log", goqu.L(`count(*) OVER() AS full_count`))
+
+	{
+		page := req.Common.GetPage()
+		if page > 10000 {
+			return nil, rerr.InvalidWithErr(errors.Errorf("Page number is too high"))
+		}
+
+		limit := req.Common.GetItemsPerPage()
+		if limit == 0 {
+			limit = defaultItemsPerPage
+		} else if limit > maxItemsPerPage {
+			limit = maxItemsPerPage
+		}
+
+		ds = ds.Offset(uint(page * limit)).Limit(uint(limit))
+
+		ret.ListResponseMeta.Page = page
+		ret.ListResponseMeta.ItemsPerPage = limit
+	}
+
+	// The most recent AuditLogs are listed first unless otherwise requested.
+	if req.Common.GetOrderBy().GetMode() == metav1.CommonListOptions_OrderBy_ASC {
+		ds = ds.Order(goqu.I("id").Asc())
+	} else {
+		ds = ds.Order(goqu.I("id").Desc())
+	}
+
+	sqln, sqlargs, err := ds.ToSQL()
+	if err != nil {
+		return nil, rerr.InternalWithErr(err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, sqln, sqlargs...)
+	if err != nil {
+		return nil, rerr.InternalWithErr(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var data []byte
+		var count int
+		if err := rows.Scan(&data, &count); err != nil {
+			return nil, rerr.InternalWithErr(err)
+		}
+
+		ret.ListResponseMeta.TotalCount = uint32(count)
+
+		itm := &corev1.AuditLog{}
+		if err := pbutils.UnmarshalJSON(data, itm); err != nil {
+			return nil, rerr.InternalWithErr(err)
+		}
+
+		ret.Items = append(ret.Items, itm)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, rerr.InternalWithErr(err)
+	}
+
+	meta := ret.ListResponseMeta
+	if meta.TotalCount > (meta.Page+1)*meta.ItemsPerPage {
+		meta.HasMore = true
+	}
+
+	return ret, nil
+}
+
+func (s *Server) doGetAuditLog(ctx context.Context, uid string) (*corev1.AuditLog, error) {
+	if !rgxAuditLogUID.MatchString(uid) {
+		return nil, rerr.InvalidWithErr(errors.Errorf("Invalid AuditLog UID: %s", uid))
+	}
+
+	sqln, sqlargs, err := goqu.From(auditTableName).
+		Where(goqu.C("uid").Eq(uid)).Select("audit_log").ToSQL()
+	if err != nil {
+		return nil, rerr.InternalWithErr(err)
+	}
+
+	var data []byte
+	if err := s.db.QueryRowContext(ctx, sqln, sqlargs...).Scan(&data); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, rerr.NotFound("AuditLog %s does not exist", uid)
+		}
+		return nil, rerr.InternalWithErr(err)
+	}
+
+	ret := &corev1.AuditLog{}
+	if err := pbutils.UnmarshalJSON(data, ret); err != nil {
+		return nil, rerr.InternalWithErr(err)
+	}
+
+	return ret, nil
+}
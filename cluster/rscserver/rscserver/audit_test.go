@@ -0,0 +1,201 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package rscserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/apis/rsc/rmetav1"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
+	"github.com/octelium/octelium/pkg/common/pbutils"
+	"github.com/octelium/octelium/pkg/grpcerr"
+	"github.com/octelium/octelium/pkg/utils/utilrand"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAuditLog(t *testing.T) {
+	ctx := context.Background()
+	srv := &Server{}
+
+	{
+		sec := &corev1.Secret{
+			Metadata: &metav1.Metadata{
+				Name:            "sec-1",
+				Uid:             "uid-1",
+				ResourceVersion: "rv-1",
+			},
+			Spec: &corev1.Secret_Spec{},
+			Data: &corev1.Secret_Data{
+				Type: &corev1.Secret_Data_Value{
+					Value: "secret-value",
+				},
+			},
+		}
+
+		auditLog, err := srv.newAuditLog(ctx, corev1.AuditLog_Entry_CREATE,
+			sec, nil, ucorev1.API, ucorev1.Version, ucorev1.KindSecret)
+		assert.Nil(t, err)
+		assert.True(t, auditLog.Entry.IsRedacted)
+		assert.Nil(t, auditLog.Entry.Old)
+		assert.NotNil(t, auditLog.Entry.New)
+		assert.Nil(t, auditLog.Entry.New.Fields["data"])
+		assert.Equal(t, "rv-1", auditLog.Entry.ResourceVersion)
+		assert.Equal(t, "sec-1", auditLog.Metadata.TargetRef.Name)
+		assert.Equal(t, ucorev1.KindSecret, auditLog.Metadata.TargetRef.Kind)
+		assert.Equal(t, "core/v1", auditLog.Metadata.TargetRef.ApiVersion)
+		assert.NotContains(t, auditLog.String(), "secret-value")
+	}
+
+	{
+		pol := &corev1.Policy{
+			Metadata: &metav1.Metadata{
+				Name:            "pol-1",
+				Uid:             "uid-2",
+				ResourceVersion: "rv-2",
+			},
+			Spec: &corev1.Policy_Spec{
+				IsDisabled: true,
+			},
+		}
+
+		auditLog, err := srv.newAuditLog(ctx, corev1.AuditLog_Entry_DELETE,
+			nil, pol, ucorev1.API, ucorev1.Version, ucorev1.KindPolicy)
+		assert.Nil(t, err)
+		assert.False(t, auditLog.Entry.IsRedacted)
+		assert.Nil(t, auditLog.Entry.New)
+		assert.Equal(t, "", auditLog.Entry.ResourceVersion)
+		assert.True(t, auditLog.Entry.Old.Fields["spec"].GetStructValue().Fields["isDisabled"].GetBoolValue())
+	}
+}
+
+func TestIsStatusOnlyUpdate(t *testing.T) {
+	oldObj := &corev1.Policy{
+		Metadata: &metav1.Metadata{
+			Name:            "pol-1",
+			ResourceVersion: "rv-1",
+		},
+		Spec: &corev1.Policy_Spec{},
+	}
+
+	newObj := pbutils.Clone(oldObj).(*corev1.Policy)
+	newObj.Status = &corev1.Policy_Status{
+		ParentPolicyRef: &metav1.ObjectReference{Name: "parent"},
+	}
+
+	res, err := isStatusOnlyUpdate(newObj, oldObj)
+	assert.Nil(t, err)
+	assert.True(t, res)
+
+	newObj.Spec.IsDisabled = true
+	res, err = isStatusOnlyUpdate(newObj, oldObj)
+	assert.Nil(t, err)
+	assert.False(t, res)
+
+	newObj = pbutils.Clone(oldObj).(*corev1.Policy)
+	newObj.Metadata.Labels = map[string]string{"k": "v"}
+	res, err = isStatusOnlyUpdate(newObj, oldObj)
+	assert.Nil(t, err)
+	assert.False(t, res)
+}
+
+func TestGetAuditLogPruneSQL(t *testing.T) {
+	sqln, _, err := getAuditLogPruneSQL(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.Equal(t, `DELETE FROM "octelium_audit_logs" WHERE ("created_at" < '2026-01-02T03:04:05Z')`, sqln)
+}
+
+func TestAuditLog(t *testing.T) {
+	tst, err := initTest()
+	assert.Nil(t, err)
+
+	ctx := context.Background()
+
+	srv, err := NewServer(ctx, nil)
+	assert.Nil(t, err)
+
+	t.Cleanup(func() {
+		tst.Destroy()
+	})
+
+	api := ucorev1.API
+	version := ucorev1.Version
+	kind := ucorev1.KindPolicy
+
+	obj := newTestResource(kind)
+	obj.GetMetadata().Name = utilrand.GetRandomStringLowercase(8)
+
+	created, err := srv.doCreate(ctx, obj, api, version, kind)
+	assert.Nil(t, err)
+
+	created.(*corev1.Policy).Spec.IsDisabled = true
+	updated, _, err := srv.doUpdate(ctx, created, api, version, kind)
+	assert.Nil(t, err)
+
+	// Status-only updates are not recorded
+	updated.(*corev1.Policy).Status = &corev1.Policy_Status{
+		ParentPolicyRef: &metav1.ObjectReference{Name: "parent"},
+	}
+	updated, _, err = srv.doUpdate(ctx, updated, api, version, kind)
+	assert.Nil(t, err)
+
+	_, err = srv.doDelete(ctx, &rmetav1.DeleteOptions{Uid: updated.GetMetadata().Uid}, api, version, kind)
+	assert.Nil(t, err)
+
+	itmList, err := srv.doListAuditLog(ctx, &corev1.ListAuditLogOptions{
+		ResourceRef: &metav1.ObjectReference{
+			Kind: kind,
+			Name: obj.GetMetadata().Name,
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(itmList.Items))
+	assert.Equal(t, uint32(3), itmList.ListResponseMeta.TotalCount)
+
+	// The most recent AuditLogs are listed first
+	assert.Equal(t, corev1.AuditLog_Entry_DELETE, itmList.Items[0].Entry.Operation)
+	assert.Equal(t, corev1.AuditLog_Entry_UPDATE, itmList.Items[1].Entry.Operation)
+	assert.Equal(t, corev1.AuditLog_Entry_CREATE, itmList.Items[2].Entry.Operation)
+
+	{
+		res, err := srv.doListAuditLog(ctx, &corev1.ListAuditLogOptions{
+			ResourceRef: &metav1.ObjectReference{
+				Kind: ucorev1.KindUser,
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(res.Items))
+	}
+
+	{
+		auditLog, err := srv.doGetAuditLog(ctx, itmList.Items[1].Metadata.Id)
+		assert.Nil(t, err)
+		assert.Equal(t, updated.GetMetadata().LastResourceVersion, auditLog.Entry.ResourceVersion)
+
+		_, err = srv.doGetAuditLog(ctx, "does-not-exist")
+		assert.True(t, grpcerr.IsNotFound(err))
+	}
+
+	{
+		// Audit records cannot be changed
+		_, err := srv.db.ExecContext(ctx, "UPDATE octelium_audit_logs SET actor_name = 'x'")
+		assert.NotNil(t, err)
+	}
+}
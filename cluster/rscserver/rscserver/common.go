@@ -27,6 +27,7 @@ import (
 	"github.com/doug-martin/goqu/v9"
 	"github.com/doug-martin/goqu/v9/exp"
 	"github.com/go-redis/redis/v8"
+	"github.com/octelium/octelium/apis/main/corev1"
 	"github.com/octelium/octelium/apis/main/metav1"
 	"github.com/octelium/octelium/apis/rsc/rmetav1"
 	"github.com/octelium/octelium/cluster/common/grpcutils"
@@ -144,6 +145,11 @@ func (s *Server) doGet(ctx context.Context, req *rmetav1.GetOptions, api, versio
 		return nil, rerr.InternalWithErr(err)
 	}
 
+	attr, err = s.openResourceJSON(kind, attr)
+	if err != nil {
+		return nil, rerr.InternalWithErr(err)
+	}
+
 	ret, err := s.opts.NewResourceObject(api, version, kind)
 	if err != nil {
 		return nil, err
@@ -177,7 +183,44 @@ func (s *Server) GetResource(ctx context.Context, req *rmetav1.GetOptions, api,
 	return s.doGet(ctx, req, api, version, kind)
 }
 
+// RestoreResource writes a resource restored from a backup archive. An existing
+// resource with the same UID is updated. Otherwise, the resource is created while
+// keeping its UID and creation time so that the references to it within the other
+// restored resources remain valid.
+func (s *Server) RestoreResource(ctx context.Context, req umetav1.ResourceObjectI, api, version, kind string) (umetav1.ResourceObjectI, error) {
+	md := req.GetMetadata()
+	if md == nil || md.Uid == "" {
+		return nil, grpcutils.InvalidArg("Nil Metadata UID")
+	}
+
+	old, err := s.doGet(ctx, &rmetav1.GetOptions{
+		Uid: md.Uid,
+	}, api, version, kind)
+	switch {
+	case err == nil:
+		md.ResourceVersion = old.GetMetadata().ResourceVersion
+		ret, _, err := s.doUpdate(ctx, req, api, version, kind)
+		return ret, err
+	case grpcerr.IsNotFound(err):
+		return s.doCreateWithOpts(ctx, req, api, version, kind, &createOpts{
+			keepUID: true,
+		})
+	default:
+		return nil, err
+	}
+}
+
+type createOpts struct {
+	// keepUID keeps the UID and the creation time set in the request metadata
+	keepUID bool
+}
+
 func (s *Server) doCreate(ctx context.Context, req umetav1.ResourceObjectI, api, version, kind string) (umetav1.ResourceObjectI, error) {
+	return s.doCreateWithOpts(ctx, req, api, version, kind, &createOpts{})
+}
+
+func (s *Server) doCreateWithOpts(ctx context.Context,
+	req umetav1.ResourceObjectI, api, version, kind string, o *createOpts) (umetav1.ResourceObjectI, error) {
 
 	reqMap, err := pbutils.ConvertToMap(req)
 	if err != nil {
@@ -225,8 +268,12 @@ func (s *Server) doCreate(ctx context.Context, req umetav1.ResourceObjectI, api,
 		}
 	}
 
-	md.CreatedAt = pbutils.Now()
-	md.Uid = vutils.UUIDv4()
+	if !o.keepUID || md.Uid == "" {
+		md.Uid = vutils.UUIDv4()
+	}
+	if !o.keepUID || md.CreatedAt == nil {
+		md.CreatedAt = pbutils.Now()
+	}
 	md.ResourceVersion = vutils.UUIDv7()
 	md.ActorRef = getActorRef(ctx)
 	md.ActorOperation = getActorOp(ctx)
@@ -241,6 +288,11 @@ func (s *Server) doCreate(ctx context.Context, req umetav1.ResourceObjectI, api,
 		return nil, rerr.InternalWithErr(err)
 	}
 
+	reqJSONBytes, err = s.sealResourceJSON(kind, md.Uid, reqJSONBytes)
+	if err != nil {
+		return nil, rerr.InternalWithErr(err)
+	}
+
 	ds := goqu.Insert(tableName).
 		Cols("uid", "created_at", "api", "version", "kind", "resource").
 		Vals(goqu.Vals{md.Uid, md.CreatedAt.AsTime(), api, version, kind, string(reqJSONBytes)})
@@ -256,11 +308,15 @@ func (s *Server) doCreate(ctx context.Context, req umetav1.ResourceObjectI, api,
 		}
 	}
 
-	_, err = s.db.ExecContext(ctx, sqln, sqlargs...)
+	auditLog, err := s.newAuditLog(ctx, corev1.AuditLog_Entry_CREATE, req, nil, api, version, kind)
 	if err != nil {
 		return nil, rerr.InternalWithErr(err)
 	}
 
+	if err := s.execWithAuditLog(ctx, sqln, sqlargs, auditLog); err != nil {
+		return nil, rerr.InternalWithErr(err)
+	}
+
 	if s.isTypeSecret(kind) {
 		req, err = s.doGet(ctx, &rmetav1.GetOptions{
 			Uid: md.Uid,
@@ -307,6 +363,11 @@ func (s *Server) doUpdate(ctx context.Context, req umetav1.ResourceObjectI, api,
 		return req, old, nil
 	}
 
+	isStatusOnly, err := isStatusOnlyUpdate(req, old)
+	if err != nil {
+		return nil, nil, rerr.InternalWithErr(err)
+	}
+
 	mdNew.LastResourceVersion = mdNew.ResourceVersion
 	mdNew.ResourceVersion = vutils.UUIDv7()
 	mdNew.UpdatedAt = pbutils.Now()
@@ -323,6 +384,11 @@ func (s *Server) doUpdate(ctx context.Context, req umetav1.ResourceObjectI, api,
 		return nil, nil, rerr.InternalWithErr(err)
 	}
 
+	reqJSONBytes, err = s.sealResourceJSON(kind, mdNew.Uid, reqJSONBytes)
+	if err != nil {
+		return nil, nil, rerr.InternalWithErr(err)
+	}
+
 	ds := goqu.Update(tableName).Where(goqu.C("uid").Eq(mdNew.Uid)).Set(
 		goqu.Record{"resource": string(reqJSONBytes)},
 	)
@@ -338,7 +404,15 @@ func (s *Server) doUpdate(ctx context.Context, req umetav1.ResourceObjectI, api,
 		}
 	}
 
-	if _, err := s.db.ExecContext(ctx, sqln, sqlargs...); err != nil {
+	var auditLog *corev1.AuditLog
+	if !isStatusOnly {
+		auditLog, err = s.newAuditLog(ctx, corev1.AuditLog_Entry_UPDATE, req, old, api, version, kind)
+		if err != nil {
+			return nil, nil, rerr.InternalWithErr(err)
+		}
+	}
+
+	if err := s.execWithAuditLog(ctx, sqln, sqlargs, auditLog); err != nil {
 		return nil, nil, rerr.InternalWithErr(err)
 	}
 
@@ -488,6 +562,11 @@ func (s *Server) doList(ctx context.Context,
 
 		listMeta.TotalCount = uint32(count)
 
+		data, err = s.openResourceJSON(kind, data)
+		if err != nil {
+			return nil, nil, rerr.InternalWithErr(err)
+		}
+
 		obj, err := s.opts.NewResourceObject(api, version, kind)
 		if err != nil {
 			return nil, nil, rerr.InternalWithErr(err)
@@ -562,11 +641,15 @@ func (s *Server) doDelete(ctx context.Context, req *rmetav1.DeleteOptions, api,
 		}
 	}
 
-	_, err = s.db.ExecContext(ctx, sqln, sqlargs...)
+	auditLog, err := s.newAuditLog(ctx, corev1.AuditLog_Entry_DELETE, nil, itm, api, version, kind)
 	if err != nil {
 		return nil, rerr.InternalWithErr(err)
 	}
 
+	if err := s.execWithAuditLog(ctx, sqln, sqlargs, auditLog); err != nil {
+		return nil, rerr.InternalWithErr(err)
+	}
+
 	md := itm.GetMetadata()
 
 	md.LastResourceVersion = md.ResourceVersion
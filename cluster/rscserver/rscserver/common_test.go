@@ -44,6 +44,7 @@ import (
 	"github.com/octelium/octelium/pkg/utils/utilrand"
 
 	"github.com/octelium/octelium/cluster/common/postgresutils"
+	"github.com/octelium/octelium/cluster/common/vutils"
 )
 
 type T struct {
@@ -304,6 +305,44 @@ func TestCommon(t *testing.T) {
 		}
 	})
 
+	t.Run("restore", func(t *testing.T) {
+
+		api := "core"
+		version := "v1"
+		kind := ucorev1.KindUser
+
+		obj := newTestResource(kind)
+		md := obj.GetMetadata()
+		md.Name = utilrand.GetRandomStringLowercase(8)
+		md.Uid = vutils.UUIDv4()
+		md.CreatedAt = pbutils.Timestamp(time.Now().Add(-24 * time.Hour))
+
+		rscOut, err := srv.RestoreResource(ctx, obj, api, version, kind)
+		assert.Nil(t, err)
+		assert.Equal(t, md.Uid, rscOut.GetMetadata().Uid)
+		assert.True(t, proto.Equal(md.CreatedAt, rscOut.GetMetadata().CreatedAt))
+
+		rscGet, err := srv.doGet(ctx, &rmetav1.GetOptions{Uid: md.Uid}, api, version, kind)
+		assert.Nil(t, err)
+		assert.True(t, proto.Equal(rscGet, rscOut))
+
+		rscGet.GetMetadata().Labels = map[string]string{
+			"key1": "val1",
+		}
+		rscGet.GetMetadata().ResourceVersion = ""
+
+		rscUpdate, err := srv.RestoreResource(ctx, rscGet, api, version, kind)
+		assert.Nil(t, err)
+		assert.Equal(t, md.Uid, rscUpdate.GetMetadata().Uid)
+		assert.Equal(t, "val1", rscUpdate.GetMetadata().Labels["key1"])
+
+		obj = newTestResource(kind)
+		obj.GetMetadata().Name = md.Name
+		obj.GetMetadata().Uid = vutils.UUIDv4()
+		_, err = srv.RestoreResource(ctx, obj, api, version, kind)
+		assert.NotNil(t, err)
+	})
+
 	t.Run("list", func(t *testing.T) {
 
 		api := "core"
@@ -0,0 +1,382 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package rscserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/octelium/octelium/cluster/common/ocrypto/keyring"
+	"github.com/octelium/octelium/cluster/rscserver/rscserver/rerr"
+	"github.com/octelium/octelium/pkg/utils/ldflags"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	fieldData          = "data"
+	fieldEncryptedData = "encryptedData"
+)
+
+type keyringCtl struct {
+	mu  sync.RWMutex
+	kr  *keyring.Keyring
+	dir string
+}
+
+// newKeyringCtl uses the given keyring if set. Otherwise, the keyring mounted
+// in the KEK dir is loaded and reloaded upon KEK rotation.
+func newKeyringCtl(kr *keyring.Keyring) *keyringCtl {
+	if kr != nil {
+		return &keyringCtl{
+			kr: kr,
+		}
+	}
+
+	ret := &keyringCtl{
+		dir: keyring.DefaultDir,
+	}
+
+	if dir := os.Getenv("OCTELIUM_RSCSERVER_KEK_DIR"); dir != "" {
+		ret.dir = dir
+	}
+
+	if err := ret.reload(); err != nil {
+		if os.IsNotExist(errors.Cause(err)) {
+			if !ldflags.IsTest() {
+				zap.L().Warn("No KEK keyring found. Secrets will not be encrypted at rest",
+					zap.String("dir", ret.dir))
+			}
+		} else {
+			zap.L().Error("Could not load the KEK keyring", zap.Error(err))
+		}
+	}
+
+	return ret
+}
+
+func (c *keyringCtl) get() *keyring.Keyring {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.kr
+}
+
+// reload re-reads the mounted keyring which is updated in place by k8s upon KEK rotation.
+func (c *keyringCtl) reload() error {
+	if c.dir == "" {
+		return nil
+	}
+
+	kr, err := keyring.LoadFromDir(c.dir)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	c.kr = kr
+	c.mu.Unlock()
+
+	return nil
+}
+
+// sealResourceJSON replaces the "data" field of a Secret resource JSON with its
+// envelope-encrypted "encryptedData" counterpart before it is written to the database.
+func (s *Server) sealResourceJSON(kind, uid string, in []byte) ([]byte, error) {
+	if !s.isTypeSecret(kind) {
+		return in, nil
+	}
+
+	kr := s.keyringC.get()
+	if kr == nil {
+		return in, nil
+	}
+
+	rscMap := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(in, &rscMap); err != nil {
+		return nil, err
+	}
+
+	data, ok := rscMap[fieldData]
+	if !ok || isJSONNull(data) {
+		return in, nil
+	}
+
+	env, err := kr.Seal(data, []byte(uid))
+	if err != nil {
+		return nil, err
+	}
+
+	envJSON, err := json.Marshal(env)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(rscMap, fieldData)
+	rscMap[fieldEncryptedData] = envJSON
+
+	return json.Marshal(rscMap)
+}
+
+// openResourceJSON reverses sealResourceJSON so that the resource JSON can be
+// unmarshaled as a regular Secret.
+func (s *Server) openResourceJSON(kind string, in []byte) ([]byte, error) {
+	if !s.isTypeSecret(kind) {
+		return in, nil
+	}
+
+	ret, err := OpenResourceJSON(s.keyringC.get(), in)
+	if err != nil {
+		// The mounted keyring might have been rotated after the last reload
+		if errReload := s.keyringC.reload(); errReload != nil {
+			return nil, err
+		}
+		return OpenResourceJSON(s.keyringC.get(), in)
+	}
+
+	return ret, nil
+}
+
+// OpenResourceJSON replaces the "encryptedData" envelope of a Secret resource
+// JSON, as stored in the database, with its plaintext "data" field.
+func OpenResourceJSON(kr *keyring.Keyring, in []byte) ([]byte, error) {
+	if !bytes.Contains(in, []byte(`"`+fieldEncryptedData+`"`)) {
+		return in, nil
+	}
+
+	rscMap, env, uid, err := parseSealedResourceJSON(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if env == nil {
+		return in, nil
+	}
+
+	if kr == nil {
+		return nil, errors.Errorf("Secret %s is encrypted but there is no KEK keyring", uid)
+	}
+
+	data, err := kr.Open(env, []byte(uid))
+	if err != nil {
+		return nil, err
+	}
+
+	delete(rscMap, fieldEncryptedData)
+	rscMap[fieldData] = data
+
+	return json.Marshal(rscMap)
+}
+
+// reencryptResourceJSON encrypts a plaintext Secret resource JSON or re-wraps its
+// DEK by the current KEK. It returns false if the record is already up-to-date.
+func (s *Server) reencryptResourceJSON(kr *keyring.Keyring, in []byte) ([]byte, bool, error) {
+	rscMap, env, uid, err := parseSealedResourceJSON(in)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if env == nil {
+		if data, ok := rscMap[fieldData]; !ok || isJSONNull(data) {
+			return in, false, nil
+		}
+
+		env, err = kr.Seal(rscMap[fieldData], []byte(uid))
+		if err != nil {
+			return nil, false, err
+		}
+		delete(rscMap, fieldData)
+	} else {
+		var changed bool
+		env, changed, err = kr.Rewrap(env, []byte(uid))
+		if err != nil {
+			return nil, false, err
+		}
+		if !changed {
+			return in, false, nil
+		}
+	}
+
+	envJSON, err := json.Marshal(env)
+	if err != nil {
+		return nil, false, err
+	}
+	rscMap[fieldEncryptedData] = envJSON
+
+	ret, err := json.Marshal(rscMap)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return ret, true, nil
+}
+
+func parseSealedResourceJSON(in []byte) (map[string]json.RawMessage, *keyring.Envelope, string, error) {
+	rscMap := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(in, &rscMap); err != nil {
+		return nil, nil, "", err
+	}
+
+	var md struct {
+		UID string `json:"uid"`
+	}
+	if err := json.Unmarshal(rscMap["metadata"], &md); err != nil {
+		return nil, nil, "", err
+	}
+
+	envJSON, ok := rscMap[fieldEncryptedData]
+	if !ok || isJSONNull(envJSON) {
+		return rscMap, nil, md.UID, nil
+	}
+
+	env := &keyring.Envelope{}
+	if err := json.Unmarshal(envJSON, env); err != nil {
+		return nil, nil, "", err
+	}
+
+	return rscMap, env, md.UID, nil
+}
+
+func isJSONNull(in json.RawMessage) bool {
+	return len(in) == 0 || bytes.Equal(bytes.TrimSpace(in), []byte("null"))
+}
+
+func (s *Server) startKeyringLoop(ctx context.Context) {
+	var doneKEKID string
+
+	doReencrypt := func() {
+		kr := s.keyringC.get()
+		if kr == nil || kr.CurrentID() == doneKEKID {
+			return
+		}
+
+		zap.L().Info("Reencrypting Secrets by the current KEK", zap.String("kekID", kr.CurrentID()))
+		if err := s.reencryptSecrets(ctx, kr); err != nil {
+			zap.L().Warn("Could not reencrypt Secrets", zap.Error(err))
+			return
+		}
+		doneKEKID = kr.CurrentID()
+	}
+
+	doReencrypt()
+
+	tickerCh := time.NewTicker(30 * time.Second)
+	defer tickerCh.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tickerCh.C:
+			if err := s.keyringC.reload(); err != nil {
+				if !os.IsNotExist(errors.Cause(err)) {
+					zap.L().Warn("Could not reload the KEK keyring", zap.Error(err))
+				}
+			}
+
+			doReencrypt()
+		}
+	}
+}
+
+// reencryptSecrets encrypts the Secret rows that are still stored in plaintext
+// (e.g. created before encryption at rest was enabled) and re-wraps the DEKs of the
+// rows that are not wrapped by the current KEK. Rows are conditionally updated so
+// that concurrent writes always win over the migration.
+func (s *Server) reencryptSecrets(ctx context.Context, kr *keyring.Keyring) error {
+	var lastID int64
+	var count int
+
+	for {
+		ds := goqu.From(tableName).Where(
+			goqu.C("kind").Like("%Secret"),
+			goqu.C("id").Gt(lastID),
+			goqu.Or(
+				goqu.L(`jsonb_typeof(resource->'data')`).Eq("object"),
+				goqu.L(`resource->'encryptedData'->>'kekID'`).Neq(kr.CurrentID()),
+			),
+		).Select("id", "resource").Order(goqu.I("id").Asc()).Limit(100)
+
+		sqln, sqlargs, err := ds.ToSQL()
+		if err != nil {
+			return rerr.InternalWithErr(err)
+		}
+
+		type row struct {
+			id       int64
+			resource []byte
+		}
+
+		var rows []row
+		if err := func() error {
+			dbRows, err := s.db.QueryContext(ctx, sqln, sqlargs...)
+			if err != nil {
+				return err
+			}
+			defer dbRows.Close()
+
+			for dbRows.Next() {
+				var itm row
+				if err := dbRows.Scan(&itm.id, &itm.resource); err != nil {
+					return err
+				}
+				rows = append(rows, itm)
+			}
+			return dbRows.Err()
+		}(); err != nil {
+			return err
+		}
+
+		if len(rows) == 0 {
+			break
+		}
+
+		for _, itm := range rows {
+			lastID = itm.id
+
+			out, changed, err := s.reencryptResourceJSON(kr, itm.resource)
+			if err != nil {
+				zap.L().Warn("Could not reencrypt Secret row", zap.Int64("id", itm.id), zap.Error(err))
+				continue
+			}
+			if !changed {
+				continue
+			}
+
+			sqln, sqlargs, err := goqu.Update(tableName).Where(
+				goqu.C("id").Eq(itm.id),
+				goqu.C("resource").Eq(string(itm.resource)),
+			).Set(goqu.Record{"resource": string(out)}).ToSQL()
+			if err != nil {
+				return rerr.InternalWithErr(err)
+			}
+
+			if _, err := s.db.ExecContext(ctx, sqln, sqlargs...); err != nil {
+				return err
+			}
+			count++
+		}
+	}
+
+	zap.L().Debug("Secrets reencryption done", zap.Int("count", count), zap.String("kekID", kr.CurrentID()))
+
+	return nil
+}
@@ -0,0 +1,208 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package rscserver
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/cluster/common/ocrypto/keyring"
+	"github.com/octelium/octelium/cluster/common/vutils"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
+	"github.com/octelium/octelium/pkg/common/pbutils"
+	"github.com/octelium/octelium/pkg/utils/utilrand"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeKeyringDir(t *testing.T, dir string, data map[string][]byte) {
+	for k, v := range data {
+		assert.Nil(t, os.WriteFile(filepath.Join(dir, k), v, 0600))
+	}
+}
+
+func TestEnvelope(t *testing.T) {
+
+	dir := t.TempDir()
+	kekData := map[string][]byte{}
+	_, err := keyring.AddKey(kekData)
+	assert.Nil(t, err)
+	writeKeyringDir(t, dir, kekData)
+
+	t.Setenv("OCTELIUM_RSCSERVER_KEK_DIR", dir)
+
+	srv := &Server{
+		keyringC: newKeyringCtl(nil),
+	}
+	assert.NotNil(t, srv.keyringC.get())
+
+	secretVal := utilrand.GetRandomString(32)
+
+	sec := &corev1.Secret{
+		ApiVersion: ucorev1.APIVersion,
+		Kind:       ucorev1.KindSecret,
+		Metadata: &metav1.Metadata{
+			Uid:  vutils.UUIDv4(),
+			Name: utilrand.GetRandomStringCanonical(8),
+		},
+		Spec:   &corev1.Secret_Spec{},
+		Status: &corev1.Secret_Status{},
+		Data: &corev1.Secret_Data{
+			Type: &corev1.Secret_Data_Value{
+				Value: secretVal,
+			},
+		},
+	}
+
+	plainJSON, err := pbutils.MarshalJSON(sec, false)
+	assert.Nil(t, err)
+
+	{
+		out, err := srv.sealResourceJSON(ucorev1.KindUser, sec.Metadata.Uid, plainJSON)
+		assert.Nil(t, err)
+		assert.Equal(t, plainJSON, out)
+	}
+
+	sealedJSON, err := srv.sealResourceJSON(sec.Kind, sec.Metadata.Uid, plainJSON)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(sealedJSON), secretVal)
+
+	{
+		rscMap := make(map[string]json.RawMessage)
+		assert.Nil(t, json.Unmarshal(sealedJSON, &rscMap))
+		_, ok := rscMap[fieldData]
+		assert.False(t, ok)
+		_, ok = rscMap[fieldEncryptedData]
+		assert.True(t, ok)
+	}
+
+	openedJSON, err := srv.openResourceJSON(sec.Kind, sealedJSON)
+	assert.Nil(t, err)
+
+	{
+		out := &corev1.Secret{}
+		assert.Nil(t, pbutils.UnmarshalJSON(openedJSON, out))
+		assert.True(t, pbutils.IsEqual(sec, out))
+	}
+
+	{
+		out, err := srv.openResourceJSON(sec.Kind, plainJSON)
+		assert.Nil(t, err)
+		assert.Equal(t, plainJSON, out)
+	}
+
+	{
+		kr := srv.keyringC.get()
+		out, changed, err := srv.reencryptResourceJSON(kr, plainJSON)
+		assert.Nil(t, err)
+		assert.True(t, changed)
+		assert.NotContains(t, string(out), secretVal)
+
+		_, changed, err = srv.reencryptResourceJSON(kr, out)
+		assert.Nil(t, err)
+		assert.False(t, changed)
+	}
+
+	{
+		swappedJSON, err := srv.sealResourceJSON(sec.Kind, vutils.UUIDv4(), plainJSON)
+		assert.Nil(t, err)
+		_, err = srv.openResourceJSON(sec.Kind, swappedJSON)
+		assert.NotNil(t, err, "envelope must be bound to the resource UID")
+	}
+
+	oldKEKID := srv.keyringC.get().CurrentID()
+	newKEKID, err := keyring.AddKey(kekData)
+	assert.Nil(t, err)
+	writeKeyringDir(t, dir, kekData)
+
+	{
+		// The keyring is reloaded upon unwrapping by an unknown KEK
+		srv2 := &Server{
+			keyringC: &keyringCtl{
+				dir: dir,
+			},
+		}
+		assert.Nil(t, srv2.keyringC.reload())
+
+		rotatedJSON, err := srv2.sealResourceJSON(sec.Kind, sec.Metadata.Uid, plainJSON)
+		assert.Nil(t, err)
+
+		srv.keyringC.kr, err = keyring.New(map[string][]byte{
+			keyring.CurrentKey: []byte(oldKEKID),
+			oldKEKID:           kekData[oldKEKID],
+		})
+		assert.Nil(t, err)
+
+		_, err = srv.openResourceJSON(sec.Kind, rotatedJSON)
+		assert.Nil(t, err)
+		assert.Equal(t, newKEKID, srv.keyringC.get().CurrentID())
+	}
+
+	{
+		kr := srv.keyringC.get()
+		out, changed, err := srv.reencryptResourceJSON(kr, sealedJSON)
+		assert.Nil(t, err)
+		assert.True(t, changed)
+
+		env := &keyring.Envelope{}
+		rscMap := make(map[string]json.RawMessage)
+		assert.Nil(t, json.Unmarshal(out, &rscMap))
+		assert.Nil(t, json.Unmarshal(rscMap[fieldEncryptedData], env))
+		assert.Equal(t, newKEKID, env.KEKID)
+
+		openedJSON, err := srv.openResourceJSON(sec.Kind, out)
+		assert.Nil(t, err)
+		outSec := &corev1.Secret{}
+		assert.Nil(t, pbutils.UnmarshalJSON(openedJSON, outSec))
+		assert.True(t, pbutils.IsEqual(sec, outSec))
+	}
+}
+
+func TestOpenResourceJSON(t *testing.T) {
+	data := make(map[string][]byte)
+	_, err := keyring.AddKey(data)
+	assert.Nil(t, err)
+	kr, err := keyring.New(data)
+	assert.Nil(t, err)
+
+	env, err := kr.Seal([]byte(`{"value":"secret-value"}`), []byte("uid-1"))
+	assert.Nil(t, err)
+	envBytes, err := json.Marshal(env)
+	assert.Nil(t, err)
+
+	in := []byte(`{"metadata":{"uid":"uid-1","name":"sec1"},"encryptedData":` + string(envBytes) + `}`)
+
+	out, err := OpenResourceJSON(kr, in)
+	assert.Nil(t, err)
+
+	var rsc map[string]json.RawMessage
+	assert.Nil(t, json.Unmarshal(out, &rsc))
+	assert.JSONEq(t, `{"value":"secret-value"}`, string(rsc["data"]))
+	_, ok := rsc["encryptedData"]
+	assert.False(t, ok)
+
+	_, err = OpenResourceJSON(nil, in)
+	assert.NotNil(t, err)
+
+	plain := []byte(`{"metadata":{"uid":"uid-2","name":"sec2"},"data":{"value":"v"}}`)
+	out, err = OpenResourceJSON(kr, plain)
+	assert.Nil(t, err)
+	assert.Equal(t, plain, out)
+}
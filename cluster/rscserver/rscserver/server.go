@@ -19,7 +19,6 @@ package rscserver
 import (
 	"context"
 	"database/sql"
-	"fmt"
 	"net"
 	"os"
 	"strconv"
@@ -31,12 +30,15 @@ import (
 	_ "github.com/lib/pq"
 	"github.com/octelium/octelium/apis/cluster/csecretmanv1"
 	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/rsc/rauditv1"
 	"github.com/octelium/octelium/apis/rsc/rcachev1"
 	"github.com/octelium/octelium/apis/rsc/rcorev1"
 	"github.com/octelium/octelium/apis/rsc/rmetav1"
 	"github.com/octelium/octelium/apis/rsc/rratelimitv1"
 	"github.com/octelium/octelium/cluster/common/grpcutils"
 	"github.com/octelium/octelium/cluster/common/healthcheck"
+	"github.com/octelium/octelium/cluster/common/ocrypto/keyring"
+	"github.com/octelium/octelium/cluster/common/otelutils"
 	"github.com/octelium/octelium/cluster/common/postgresutils"
 	"github.com/octelium/octelium/cluster/common/redisutils"
 	"github.com/octelium/octelium/cluster/common/vutils"
@@ -63,13 +65,23 @@ type Server struct {
 
 	secretmanC       csecretmanv1.MainServiceClient
 	hasSecretManager bool
+
+	keyringC *keyringCtl
 }
 
 func NewServer(ctx context.Context, o *Opts) (*Server, error) {
 
-	db, err := postgresutils.NewDB()
-	if err != nil {
-		return nil, errors.Errorf("Could not create a db client: %+v", err)
+	if o == nil {
+		o = &Opts{}
+	}
+
+	db := o.DB
+	if db == nil {
+		var err error
+		db, err = postgresutils.NewDB()
+		if err != nil {
+			return nil, errors.Errorf("Could not create a db client: %+v", err)
+		}
 	}
 
 	{
@@ -79,16 +91,15 @@ func NewServer(ctx context.Context, o *Opts) (*Server, error) {
 		}
 	}
 
-	redisC := redisutils.NewClient()
+	redisC := o.RedisC
+	if redisC == nil {
+		redisC = redisutils.NewClient()
+	}
 
 	if err := postgresutils.Migrate(ctx, db); err != nil {
 		return nil, errors.Errorf("Could not migrate database: %+v", err)
 	}
 
-	if o == nil {
-		o = &Opts{}
-	}
-
 	if o.NewResourceObject == nil {
 		o.NewResourceObject = vutils.NewResourceObject
 	}
@@ -107,6 +118,7 @@ func NewServer(ctx context.Context, o *Opts) (*Server, error) {
 		redisC:        redisC,
 		opts:          o,
 		commonMetrics: commonMetrics,
+		keyringC:      newKeyringCtl(o.Keyring),
 	}
 
 	/*
@@ -180,6 +192,20 @@ type Opts struct {
 	// NewResourceWatchEvent func(api, version, kind string) (metav1.ObjectI, error)
 
 	NewResourceObjectList func(api string, version string, kind string) (protoreflect.ProtoMessage, error)
+
+	// ListenHost sets the host on which the gRPC server listens. All the
+	// addresses are used if not set. This is used by the standalone runtime
+	// to only listen on the loopback address since the Resource server has
+	// no authentication of its own.
+	ListenHost string
+
+	// DB, RedisC and Keyring are used instead of the Postgres and Redis
+	// clients set via the env vars and the KEK keyring mounted in the
+	// rscserver pod. This is used by octops restore to write the restored
+	// resources through the Resource server from outside the Cluster.
+	DB      *sql.DB
+	RedisC  *redis.Client
+	Keyring *keyring.Keyring
 }
 
 func (s *Server) GetDB() *sql.DB {
@@ -210,12 +236,15 @@ func (s *Server) Run(ctx context.Context) error {
 		return err
 	}
 
+	go s.startKeyringLoop(ctx)
+	go s.startAuditLogPruneLoop(ctx)
+
 	s.grpcSrv = grpc.NewServer(
 		grpc.MaxConcurrentStreams(1000000),
 		grpc.StreamInterceptor(
 			grpc_middleware.ChainStreamServer(s.handleStreamRequest)),
 		grpc.UnaryInterceptor(
-			grpc_middleware.ChainUnaryServer(s.handleUnaryRequest)),
+			grpc_middleware.ChainUnaryServer(otelutils.UnaryServerInterceptor(), s.handleUnaryRequest)),
 	)
 
 	grpc_health_v1.RegisterHealthServer(s.grpcSrv, healthcheck.NewServer())
@@ -227,6 +256,13 @@ func (s *Server) Run(ctx context.Context) error {
 		rcachev1.RegisterMainServiceServer(s.grpcSrv, cacheSrv)
 	}
 
+	{
+		auditSrv := &srvAudit{
+			s: s,
+		}
+		rauditv1.RegisterMainServiceServer(s.grpcSrv, auditSrv)
+	}
+
 	{
 		rateLimitSrv := &srvRateLimit{
 			redisC: s.redisC,
@@ -250,6 +286,8 @@ func (s *Server) Run(ctx context.Context) error {
 		if ldflags.IsTest() {
 			p, _ := strconv.Atoi(os.Getenv("OCTELIUM_TEST_RSCSERVER_PORT"))
 			return p
+		} else if p, _ := strconv.Atoi(os.Getenv("OCTELIUM_RSCSERVER_PORT")); p > 0 {
+			return p
 		} else {
 			return 8080
 		}
@@ -259,7 +297,7 @@ func (s *Server) Run(ctx context.Context) error {
 		return err
 	}
 
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	lis, err := net.Listen("tcp", net.JoinHostPort(s.opts.ListenHost, strconv.Itoa(port)))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,134 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package file implements a secret manager backend that stores every secret in
+// its own file, envelope-encrypted by a KEK keyring, inside a local directory
+// which is normally backed by a persistent volume.
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/octelium/octelium/cluster/common/ocrypto/keyring"
+	"github.com/octelium/octelium/cluster/secretman/secretman/backend"
+	"github.com/pkg/errors"
+)
+
+type Opts struct {
+	Dir     string
+	Keyring *keyring.Keyring
+}
+
+type Backend struct {
+	dir string
+	kr  *keyring.Keyring
+}
+
+func New(o *Opts) (*Backend, error) {
+	if o == nil || o.Dir == "" {
+		return nil, errors.Errorf("Directory is not set")
+	}
+	if o.Keyring == nil {
+		return nil, errors.Errorf("Keyring is not set")
+	}
+
+	if err := os.MkdirAll(o.Dir, 0700); err != nil {
+		return nil, err
+	}
+
+	return &Backend{
+		dir: o.Dir,
+		kr:  o.Keyring,
+	}, nil
+}
+
+func (b *Backend) getPath(key string) string {
+	return filepath.Join(b.dir, key+".json")
+}
+
+func (b *Backend) Get(ctx context.Context, key string) ([]byte, error) {
+	if err := backend.CheckKey(key); err != nil {
+		return nil, err
+	}
+
+	envJSON, err := os.ReadFile(b.getPath(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, backend.ErrNotFound
+		}
+		return nil, err
+	}
+
+	env := &keyring.Envelope{}
+	if err := json.Unmarshal(envJSON, env); err != nil {
+		return nil, err
+	}
+
+	return b.kr.Open(env, []byte(key))
+}
+
+func (b *Backend) Set(ctx context.Context, key string, data []byte) error {
+	if err := backend.CheckKey(key); err != nil {
+		return err
+	}
+
+	env, err := b.kr.Seal(data, []byte(key))
+	if err != nil {
+		return err
+	}
+
+	envJSON, err := json.Marshal(env)
+	if err != nil {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(b.dir, ".tmp-"+key+"-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.Write(envJSON); err != nil {
+		tmpFile.Close()
+		return err
+	}
+
+	if err := tmpFile.Sync(); err != nil {
+		tmpFile.Close()
+		return err
+	}
+
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), b.getPath(key))
+}
+
+func (b *Backend) Delete(ctx context.Context, key string) error {
+	if err := backend.CheckKey(key); err != nil {
+		return err
+	}
+
+	if err := os.Remove(b.getPath(key)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
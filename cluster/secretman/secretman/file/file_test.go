@@ -0,0 +1,103 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package file
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/octelium/octelium/cluster/common/ocrypto/keyring"
+	"github.com/octelium/octelium/cluster/common/vutils"
+	"github.com/octelium/octelium/cluster/secretman/secretman/backend"
+	"github.com/octelium/octelium/pkg/utils/utilrand"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackend(t *testing.T) {
+	ctx := context.Background()
+
+	kekData := map[string][]byte{}
+	_, err := keyring.AddKey(kekData)
+	assert.Nil(t, err)
+	kr, err := keyring.New(kekData)
+	assert.Nil(t, err)
+
+	dir := filepath.Join(t.TempDir(), "data")
+
+	b, err := New(&Opts{
+		Dir:     dir,
+		Keyring: kr,
+	})
+	assert.Nil(t, err)
+
+	key := vutils.UUIDv4()
+	_, err = b.Get(ctx, key)
+	assert.True(t, backend.IsNotFound(err))
+
+	data := []byte(utilrand.GetRandomString(64))
+	assert.Nil(t, b.Set(ctx, key, data))
+
+	{
+		raw, err := os.ReadFile(filepath.Join(dir, key+".json"))
+		assert.Nil(t, err)
+		assert.NotContains(t, string(raw), string(data))
+	}
+
+	out, err := b.Get(ctx, key)
+	assert.Nil(t, err)
+	assert.Equal(t, data, out)
+
+	{
+		// A file moved to another key must not be decryptable
+		key2 := vutils.UUIDv4()
+		assert.Nil(t, os.Link(filepath.Join(dir, key+".json"), filepath.Join(dir, key2+".json")))
+		_, err := b.Get(ctx, key2)
+		assert.NotNil(t, err)
+		assert.False(t, backend.IsNotFound(err))
+		assert.Nil(t, b.Delete(ctx, key2))
+	}
+
+	{
+		// Data written before a KEK rotation remains readable
+		_, err := keyring.AddKey(kekData)
+		assert.Nil(t, err)
+		kr2, err := keyring.New(kekData)
+		assert.Nil(t, err)
+		b2, err := New(&Opts{
+			Dir:     dir,
+			Keyring: kr2,
+		})
+		assert.Nil(t, err)
+
+		out, err := b2.Get(ctx, key)
+		assert.Nil(t, err)
+		assert.Equal(t, data, out)
+	}
+
+	assert.Nil(t, b.Delete(ctx, key))
+	_, err = b.Get(ctx, key)
+	assert.True(t, backend.IsNotFound(err))
+	assert.Nil(t, b.Delete(ctx, key))
+
+	assert.NotNil(t, b.Set(ctx, "../escape", data))
+
+	entries, err := os.ReadDir(dir)
+	assert.Nil(t, err)
+	assert.Len(t, entries, 0)
+}
@@ -0,0 +1,240 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package secretman
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+
+	"github.com/octelium/octelium/apis/cluster/csecretmanv1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/cluster/common/commoninit"
+	"github.com/octelium/octelium/cluster/common/grpcutils"
+	"github.com/octelium/octelium/cluster/common/healthcheck"
+	"github.com/octelium/octelium/cluster/common/ocrypto/keyring"
+	"github.com/octelium/octelium/cluster/secretman/secretman/backend"
+	"github.com/octelium/octelium/cluster/secretman/secretman/file"
+	"github.com/octelium/octelium/cluster/secretman/secretman/vault"
+	"github.com/octelium/octelium/pkg/utils/ldflags"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type Server struct {
+	csecretmanv1.UnimplementedMainServiceServer
+	backend backend.Backend
+	grpcSrv *grpc.Server
+}
+
+func NewServer(b backend.Backend) *Server {
+	return &Server{
+		backend: b,
+	}
+}
+
+func getKey(ref *metav1.ObjectReference) (string, error) {
+	if ref == nil || ref.Uid == "" {
+		return "", grpcutils.InvalidArg("Secret UID must be set")
+	}
+
+	if err := backend.CheckKey(ref.Uid); err != nil {
+		return "", grpcutils.InvalidArgWithErr(err)
+	}
+
+	return ref.Uid, nil
+}
+
+func (s *Server) GetSecret(ctx context.Context, req *csecretmanv1.GetSecretRequest) (*csecretmanv1.GetSecretResponse, error) {
+	key, err := getKey(req.SecretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.backend.Get(ctx, key)
+	if err != nil {
+		if backend.IsNotFound(err) {
+			return nil, grpcutils.NotFound("Secret %s is not found", key)
+		}
+		return nil, grpcutils.InternalWithErr(err)
+	}
+
+	return &csecretmanv1.GetSecretResponse{
+		Data: data,
+	}, nil
+}
+
+func (s *Server) SetSecret(ctx context.Context, req *csecretmanv1.SetSecretRequest) (*csecretmanv1.SetSecretResponse, error) {
+	key, err := getKey(req.SecretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.backend.Set(ctx, key, req.Data); err != nil {
+		return nil, grpcutils.InternalWithErr(err)
+	}
+
+	return &csecretmanv1.SetSecretResponse{}, nil
+}
+
+func (s *Server) DeleteSecret(ctx context.Context, req *csecretmanv1.DeleteSecretRequest) (*csecretmanv1.DeleteSecretResponse, error) {
+	key, err := getKey(req.SecretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.backend.Delete(ctx, key); err != nil {
+		return nil, grpcutils.InternalWithErr(err)
+	}
+
+	return &csecretmanv1.DeleteSecretResponse{}, nil
+}
+
+// ListSecret returns the data of the existing Secrets among the requested ones.
+// Missing Secrets are skipped rather than failing the whole request.
+func (s *Server) ListSecret(ctx context.Context, req *csecretmanv1.ListSecretRequest) (*csecretmanv1.ListSecretResponse, error) {
+	ret := &csecretmanv1.ListSecretResponse{}
+
+	for _, ref := range req.SecretRefs {
+		key, err := getKey(ref)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := s.backend.Get(ctx, key)
+		if err != nil {
+			if backend.IsNotFound(err) {
+				zap.L().Debug("Secret is not found. Skipping it", zap.String("uid", key))
+				continue
+			}
+			return nil, grpcutils.InternalWithErr(err)
+		}
+
+		ret.Items = append(ret.Items, &csecretmanv1.ListSecretResponse_Item{
+			SecretRef: ref,
+			Data:      data,
+		})
+	}
+
+	return ret, nil
+}
+
+func (s *Server) Serve(lis net.Listener) error {
+	s.grpcSrv = grpc.NewServer(
+		grpc.MaxRecvMsgSize(33 * 1024 * 1024),
+	)
+
+	grpc_health_v1.RegisterHealthServer(s.grpcSrv, healthcheck.NewServer())
+	csecretmanv1.RegisterMainServiceServer(s.grpcSrv, s)
+
+	return s.grpcSrv.Serve(lis)
+}
+
+func (s *Server) Stop() {
+	if s.grpcSrv != nil {
+		s.grpcSrv.Stop()
+	}
+}
+
+// NewBackendFromEnv creates the backend set by genesis in the component's environment.
+func NewBackendFromEnv() (backend.Backend, error) {
+	switch os.Getenv("OCTELIUM_SECRETMAN_BACKEND") {
+	case "vault":
+		return vault.New(&vault.Opts{
+			Address:    os.Getenv("OCTELIUM_SECRETMAN_VAULT_ADDRESS"),
+			Token:      os.Getenv("OCTELIUM_SECRETMAN_VAULT_TOKEN"),
+			Mount:      os.Getenv("OCTELIUM_SECRETMAN_VAULT_MOUNT"),
+			PathPrefix: os.Getenv("OCTELIUM_SECRETMAN_VAULT_PATH_PREFIX"),
+			Namespace:  os.Getenv("OCTELIUM_SECRETMAN_VAULT_NAMESPACE"),
+		})
+	case "file":
+		kr, err := keyring.LoadFromDir(getEnvOrDefault("OCTELIUM_SECRETMAN_FILE_KEK_DIR", DefaultFileKEKDir))
+		if err != nil {
+			return nil, errors.Errorf("Could not load the file backend keyring: %+v", err)
+		}
+
+		return file.New(&file.Opts{
+			Dir:     getEnvOrDefault("OCTELIUM_SECRETMAN_FILE_DIR", DefaultFileDir),
+			Keyring: kr,
+		})
+	default:
+		return nil, errors.Errorf("Unknown secret manager backend: %s", os.Getenv("OCTELIUM_SECRETMAN_BACKEND"))
+	}
+}
+
+const (
+	// DefaultFileDir is where the file backend persistent volume is mounted.
+	DefaultFileDir = "/var/lib/octelium/secretman"
+	// DefaultFileKEKDir is where the file backend keyring k8s Secret is mounted.
+	DefaultFileKEKDir = "/etc/octelium/secretman/kek"
+)
+
+func getEnvOrDefault(key, def string) string {
+	if ret := os.Getenv(key); ret != "" {
+		return ret
+	}
+	return def
+}
+
+func getPort() int {
+	if ldflags.IsTest() {
+		if p, _ := strconv.Atoi(os.Getenv("OCTELIUM_TEST_SECRETMAN_PORT")); p > 0 {
+			return p
+		}
+	}
+	return 8080
+}
+
+func Run(ctx context.Context) error {
+
+	zap.L().Debug("Starting secret manager")
+
+	if err := commoninit.Run(ctx, nil); err != nil {
+		return err
+	}
+
+	b, err := NewBackendFromEnv()
+	if err != nil {
+		return err
+	}
+
+	srv := NewServer(b)
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", getPort()))
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		if err := srv.Serve(lis); err != nil {
+			zap.L().Warn("gRPC server closed", zap.Error(err))
+		}
+	}()
+
+	zap.L().Info("Secret manager is now running...",
+		zap.String("backend", os.Getenv("OCTELIUM_SECRETMAN_BACKEND")))
+
+	<-ctx.Done()
+
+	srv.Stop()
+
+	return nil
+}
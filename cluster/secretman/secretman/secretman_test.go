@@ -0,0 +1,174 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package secretman
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/octelium/octelium/apis/cluster/csecretmanv1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/cluster/common/ocrypto/keyring"
+	"github.com/octelium/octelium/cluster/common/vutils"
+	"github.com/octelium/octelium/cluster/secretman/secretman/backend"
+	"github.com/octelium/octelium/cluster/secretman/secretman/file"
+	"github.com/octelium/octelium/cluster/secretman/secretman/vault"
+	"github.com/octelium/octelium/cluster/secretman/secretman/vault/vaulttest"
+	"github.com/octelium/octelium/pkg/grpcerr"
+	"github.com/octelium/octelium/pkg/utils/utilrand"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func runTestServer(t *testing.T, b backend.Backend) csecretmanv1.MainServiceClient {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+
+	srv := NewServer(b)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		conn.Close()
+	})
+
+	return csecretmanv1.NewMainServiceClient(conn)
+}
+
+func doTestServer(t *testing.T, c csecretmanv1.MainServiceClient) {
+	ctx := context.Background()
+
+	{
+		_, err := c.GetSecret(ctx, &csecretmanv1.GetSecretRequest{})
+		assert.True(t, grpcerr.IsInvalidArg(err))
+
+		_, err = c.SetSecret(ctx, &csecretmanv1.SetSecretRequest{
+			SecretRef: &metav1.ObjectReference{Uid: "../../etc/passwd"},
+		})
+		assert.True(t, grpcerr.IsInvalidArg(err))
+	}
+
+	ref1 := &metav1.ObjectReference{
+		Uid:  vutils.UUIDv4(),
+		Name: utilrand.GetRandomStringCanonical(8),
+	}
+	ref2 := &metav1.ObjectReference{
+		Uid:  vutils.UUIDv4(),
+		Name: utilrand.GetRandomStringCanonical(8),
+	}
+
+	_, err := c.GetSecret(ctx, &csecretmanv1.GetSecretRequest{SecretRef: ref1})
+	assert.True(t, grpcerr.IsNotFound(err))
+
+	data1 := []byte(`{"value":"` + utilrand.GetRandomString(32) + `"}`)
+	data2 := []byte(`{"valueBytes":"` + utilrand.GetRandomString(32) + `"}`)
+
+	_, err = c.SetSecret(ctx, &csecretmanv1.SetSecretRequest{SecretRef: ref1, Data: data1})
+	assert.Nil(t, err)
+	_, err = c.SetSecret(ctx, &csecretmanv1.SetSecretRequest{SecretRef: ref2, Data: data2})
+	assert.Nil(t, err)
+
+	resp, err := c.GetSecret(ctx, &csecretmanv1.GetSecretRequest{SecretRef: ref1})
+	assert.Nil(t, err)
+	assert.Equal(t, data1, resp.Data)
+
+	{
+		resp, err := c.ListSecret(ctx, &csecretmanv1.ListSecretRequest{
+			SecretRefs: []*metav1.ObjectReference{ref1, ref2},
+		})
+		assert.Nil(t, err)
+		assert.Len(t, resp.Items, 2)
+		assert.Equal(t, ref1.Uid, resp.Items[0].SecretRef.Uid)
+		assert.Equal(t, data1, resp.Items[0].Data)
+		assert.Equal(t, ref2.Uid, resp.Items[1].SecretRef.Uid)
+		assert.Equal(t, data2, resp.Items[1].Data)
+	}
+
+	_, err = c.DeleteSecret(ctx, &csecretmanv1.DeleteSecretRequest{SecretRef: ref1})
+	assert.Nil(t, err)
+
+	_, err = c.GetSecret(ctx, &csecretmanv1.GetSecretRequest{SecretRef: ref1})
+	assert.True(t, grpcerr.IsNotFound(err))
+
+	{
+		resp, err := c.ListSecret(ctx, &csecretmanv1.ListSecretRequest{
+			SecretRefs: []*metav1.ObjectReference{ref1, ref2},
+		})
+		assert.Nil(t, err)
+		assert.Len(t, resp.Items, 1)
+		assert.Equal(t, ref2.Uid, resp.Items[0].SecretRef.Uid)
+	}
+
+	_, err = c.DeleteSecret(ctx, &csecretmanv1.DeleteSecretRequest{SecretRef: ref1})
+	assert.Nil(t, err)
+}
+
+func TestServerVault(t *testing.T) {
+	token := utilrand.GetRandomString(32)
+	vaultSrv := vaulttest.NewServer(token)
+	defer vaultSrv.Close()
+
+	b, err := vault.New(&vault.Opts{
+		Address: vaultSrv.URL,
+		Token:   token,
+	})
+	assert.Nil(t, err)
+
+	doTestServer(t, runTestServer(t, b))
+	assert.Equal(t, 1, vaultSrv.Len())
+}
+
+func TestServerFile(t *testing.T) {
+	kekData := map[string][]byte{}
+	_, err := keyring.AddKey(kekData)
+	assert.Nil(t, err)
+	kr, err := keyring.New(kekData)
+	assert.Nil(t, err)
+
+	b, err := file.New(&file.Opts{
+		Dir:     filepath.Join(t.TempDir(), "data"),
+		Keyring: kr,
+	})
+	assert.Nil(t, err)
+
+	doTestServer(t, runTestServer(t, b))
+}
+
+func TestNewBackendFromEnv(t *testing.T) {
+	t.Setenv("OCTELIUM_SECRETMAN_BACKEND", "")
+	_, err := NewBackendFromEnv()
+	assert.NotNil(t, err)
+
+	t.Setenv("OCTELIUM_SECRETMAN_BACKEND", "vault")
+	t.Setenv("OCTELIUM_SECRETMAN_VAULT_ADDRESS", "https://vault.example.com")
+	t.Setenv("OCTELIUM_SECRETMAN_VAULT_TOKEN", "token")
+	b, err := NewBackendFromEnv()
+	assert.Nil(t, err)
+	_, ok := b.(*vault.Backend)
+	assert.True(t, ok)
+
+	t.Setenv("OCTELIUM_SECRETMAN_BACKEND", "file")
+	t.Setenv("OCTELIUM_SECRETMAN_FILE_DIR", filepath.Join(t.TempDir(), "data"))
+	t.Setenv("OCTELIUM_SECRETMAN_FILE_KEK_DIR", filepath.Join(t.TempDir(), "none"))
+	_, err = NewBackendFromEnv()
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,197 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package vault implements a secret manager backend on top of the HTTP API of
+// the Vault KV version 2 secrets engine.
+package vault
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/octelium/octelium/cluster/secretman/secretman/backend"
+	"github.com/pkg/errors"
+)
+
+type Opts struct {
+	Address    string
+	Token      string
+	Mount      string
+	PathPrefix string
+	Namespace  string
+
+	HTTPClient *http.Client
+}
+
+type Backend struct {
+	opts    *Opts
+	httpC   *http.Client
+	baseURL string
+}
+
+func New(o *Opts) (*Backend, error) {
+	if o == nil || o.Address == "" {
+		return nil, errors.Errorf("Vault address is not set")
+	}
+	if o.Token == "" {
+		return nil, errors.Errorf("Vault token is not set")
+	}
+
+	opts := *o
+	if opts.Mount == "" {
+		opts.Mount = "secret"
+	}
+	if opts.PathPrefix == "" {
+		opts.PathPrefix = "octelium"
+	}
+
+	ret := &Backend{
+		opts:    &opts,
+		httpC:   opts.HTTPClient,
+		baseURL: strings.TrimSuffix(opts.Address, "/"),
+	}
+
+	if ret.httpC == nil {
+		ret.httpC = &http.Client{
+			Timeout: 20 * time.Second,
+		}
+	}
+
+	return ret, nil
+}
+
+func (b *Backend) getURL(typ, key string) string {
+	return fmt.Sprintf("%s/v1/%s/%s/%s/%s",
+		b.baseURL, strings.Trim(b.opts.Mount, "/"), typ, strings.Trim(b.opts.PathPrefix, "/"), key)
+}
+
+type kvData struct {
+	Data string `json:"data"`
+}
+
+type setRequest struct {
+	Data kvData `json:"data"`
+}
+
+type getResponse struct {
+	Data *struct {
+		Data *kvData `json:"data"`
+	} `json:"data"`
+}
+
+func (b *Backend) Get(ctx context.Context, key string) ([]byte, error) {
+	if err := backend.CheckKey(key); err != nil {
+		return nil, err
+	}
+
+	respBody, err := b.do(ctx, http.MethodGet, b.getURL("data", key), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &getResponse{}
+	if err := json.Unmarshal(respBody, resp); err != nil {
+		return nil, err
+	}
+
+	// The latest version of a soft-deleted secret has no data
+	if resp.Data == nil || resp.Data.Data == nil {
+		return nil, backend.ErrNotFound
+	}
+
+	return base64.StdEncoding.DecodeString(resp.Data.Data.Data)
+}
+
+func (b *Backend) Set(ctx context.Context, key string, data []byte) error {
+	if err := backend.CheckKey(key); err != nil {
+		return err
+	}
+
+	reqBody, err := json.Marshal(&setRequest{
+		Data: kvData{
+			Data: base64.StdEncoding.EncodeToString(data),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = b.do(ctx, http.MethodPost, b.getURL("data", key), reqBody)
+	return err
+}
+
+// Delete permanently deletes all the versions of the secret.
+func (b *Backend) Delete(ctx context.Context, key string) error {
+	if err := backend.CheckKey(key); err != nil {
+		return err
+	}
+
+	_, err := b.do(ctx, http.MethodDelete, b.getURL("metadata", key), nil)
+	if err != nil && !backend.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (b *Backend) do(ctx context.Context, method, url string, body []byte) ([]byte, error) {
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-Vault-Token", b.opts.Token)
+	req.Header.Set("X-Vault-Request", "true")
+	if b.opts.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", b.opts.Namespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := b.httpC.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 8*1024*1024))
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, backend.ErrNotFound
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return respBody, nil
+	default:
+		return nil, errors.Errorf("Vault %s request failed with status code %d: %s",
+			method, resp.StatusCode, string(respBody))
+	}
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package vault
+
+import (
+	"context"
+	"testing"
+
+	"github.com/octelium/octelium/cluster/common/vutils"
+	"github.com/octelium/octelium/cluster/secretman/secretman/backend"
+	"github.com/octelium/octelium/cluster/secretman/secretman/vault/vaulttest"
+	"github.com/octelium/octelium/pkg/utils/utilrand"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackend(t *testing.T) {
+	ctx := context.Background()
+
+	token := utilrand.GetRandomString(32)
+	srv := vaulttest.NewServer(token)
+	defer srv.Close()
+	srv.Namespace = "ns1"
+
+	{
+		_, err := New(&Opts{Address: srv.URL})
+		assert.NotNil(t, err)
+	}
+
+	{
+		b, err := New(&Opts{
+			Address:   srv.URL,
+			Token:     "invalid",
+			Namespace: srv.Namespace,
+		})
+		assert.Nil(t, err)
+		err = b.Set(ctx, vutils.UUIDv4(), []byte("data"))
+		assert.NotNil(t, err)
+	}
+
+	b, err := New(&Opts{
+		Address:   srv.URL + "/",
+		Token:     token,
+		Namespace: srv.Namespace,
+	})
+	assert.Nil(t, err)
+
+	key := vutils.UUIDv4()
+	_, err = b.Get(ctx, key)
+	assert.True(t, backend.IsNotFound(err))
+
+	data := utilrand.GetRandomBytesMust(64)
+	assert.Nil(t, b.Set(ctx, key, data))
+	assert.Equal(t, 1, srv.Len())
+
+	out, err := b.Get(ctx, key)
+	assert.Nil(t, err)
+	assert.Equal(t, data, out)
+
+	data2 := []byte(`{"value":"updated"}`)
+	assert.Nil(t, b.Set(ctx, key, data2))
+	out, err = b.Get(ctx, key)
+	assert.Nil(t, err)
+	assert.Equal(t, data2, out)
+
+	assert.Nil(t, b.Delete(ctx, key))
+	assert.Equal(t, 0, srv.Len())
+	_, err = b.Get(ctx, key)
+	assert.True(t, backend.IsNotFound(err))
+
+	assert.Nil(t, b.Delete(ctx, key))
+
+	_, err = b.Get(ctx, "../sys/seal")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package vaulttest provides a minimal in-memory stand-in of the Vault KV version 2
+// HTTP API to be used in tests.
+package vaulttest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+type Server struct {
+	*httptest.Server
+
+	Token     string
+	Namespace string
+
+	mu    sync.Mutex
+	items map[string]map[string]any
+}
+
+func NewServer(token string) *Server {
+	ret := &Server{
+		Token: token,
+		items: make(map[string]map[string]any),
+	}
+
+	ret.Server = httptest.NewServer(http.HandlerFunc(ret.handle))
+
+	return ret
+}
+
+// Len returns the number of the stored secrets.
+func (s *Server) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.items)
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("X-Vault-Token") != s.Token {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"errors":["permission denied"]}`))
+		return
+	}
+
+	if s.Namespace != "" && r.Header.Get("X-Vault-Namespace") != s.Namespace {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	// /v1/<mount>/<data|metadata>/<path>
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/v1/"), "/", 3)
+	if len(parts) != 3 {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	key := parts[0] + "/" + parts[2]
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case parts[1] == "data" && r.Method == http.MethodGet:
+		data, ok := s.items[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":[]}`))
+			return
+		}
+
+		json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{
+				"data": data,
+				"metadata": map[string]any{
+					"version": 1,
+				},
+			},
+		})
+	case parts[1] == "data" && (r.Method == http.MethodPost || r.Method == http.MethodPut):
+		req := struct {
+			Data map[string]any `json:"data"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Data == nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		s.items[key] = req.Data
+		json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{
+				"version": 1,
+			},
+		})
+	case parts[1] == "metadata" && r.Method == http.MethodDelete:
+		delete(s.items, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
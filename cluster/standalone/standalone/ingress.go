@@ -0,0 +1,789 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package standalone
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/rsc/rmetav1"
+	"github.com/octelium/octelium/cluster/common/octeliumc"
+	"github.com/octelium/octelium/cluster/common/urscsrv"
+	"github.com/octelium/octelium/cluster/common/vutils"
+	"github.com/octelium/octelium/cluster/common/watchers"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"golang.org/x/net/http2"
+)
+
+const acmeHTTP01PathPrefix = "/.well-known/acme-challenge/"
+
+// ingress is the public ingress of the standalone runtime. It routes the
+// public Services the same way as the Envoy-based ingress does with
+// Kubernetes: TLS passthrough Services are routed via SNI as-is to their
+// Vigils while the rest are terminated and proxied over HTTP.
+type ingress struct {
+	octeliumC octeliumc.ClientInterface
+	domain    string
+	addr      string
+	httpAddr  string
+
+	snapshot   atomic.Pointer[ingressSnapshot]
+	snapshotCh chan struct{}
+	proxies    map[proxyOpts]*httputil.ReverseProxy
+
+	tlsConfig *tls.Config
+	lis       net.Listener
+	connLis   *connListener
+	httpsSrv  *http.Server
+	httpSrv   *http.Server
+}
+
+type ingressSnapshot struct {
+	certs []*tls.Certificate
+	// passthrough maps the TLS passthrough server names to their upstreams
+	passthrough map[string]string
+	// apiRoutes are the routes of the octelium-api host
+	apiRoutes []*ingressRoute
+	// hosts maps the Service hosts to their proxies
+	hosts      map[string]http.Handler
+	isHTTP01   bool
+	challenges map[string]string
+}
+
+type ingressRoute struct {
+	path    string
+	isExact bool
+	handler http.Handler
+}
+
+func (r *ingressRoute) match(path string) bool {
+	if r.isExact {
+		return path == r.path
+	}
+	return strings.HasPrefix(path, r.path)
+}
+
+func newIngress(octeliumC octeliumc.ClientInterface, domain, addr, httpAddr string) *ingress {
+	ret := &ingress{
+		octeliumC:  octeliumC,
+		domain:     domain,
+		addr:       addr,
+		httpAddr:   httpAddr,
+		snapshotCh: make(chan struct{}, 1),
+		proxies:    make(map[proxyOpts]*httputil.ReverseProxy),
+	}
+
+	ret.tlsConfig = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: []string{"h2", "http/1.1"},
+		GetCertificate: func(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return getCertificate(ret.snapshot.Load().certs, hello)
+		},
+	}
+
+	return ret
+}
+
+func (i *ingress) run(ctx context.Context) error {
+	if err := i.doSnapshot(ctx); err != nil {
+		return err
+	}
+
+	watcher := watchers.NewCoreV1(i.octeliumC)
+
+	{
+		onChange, onUpdate := getTriggerFns[*corev1.Service](i)
+		if err := watcher.Service(ctx, nil, onChange, onUpdate, onChange); err != nil {
+			return err
+		}
+	}
+
+	{
+		onChange, onUpdate := getTriggerFns[*corev1.Secret](i)
+		if err := watcher.Secret(ctx, nil, onChange, onUpdate, onChange); err != nil {
+			return err
+		}
+	}
+
+	{
+		onChange, onUpdate := getTriggerFns[*corev1.Gateway](i)
+		if err := watcher.Gateway(ctx, nil, onChange, onUpdate, onChange); err != nil {
+			return err
+		}
+	}
+
+	{
+		_, onUpdate := getTriggerFns[*corev1.ClusterConfig](i)
+		if err := watcher.ClusterConfig(ctx, nil, onUpdate); err != nil {
+			return err
+		}
+	}
+
+	go i.startSnapshotLoop(ctx)
+
+	lis, err := net.Listen("tcp", i.addr)
+	if err != nil {
+		return err
+	}
+	i.lis = lis
+	i.connLis = newConnListener(lis.Addr())
+
+	i.httpsSrv = &http.Server{
+		Handler:           http.HandlerFunc(i.serveHTTPS),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	if err := http2.ConfigureServer(i.httpsSrv, &http2.Server{}); err != nil {
+		return err
+	}
+
+	go i.httpsSrv.Serve(i.connLis)
+	go i.startAcceptLoop()
+
+	httpLis, err := net.Listen("tcp", i.httpAddr)
+	if err != nil {
+		return err
+	}
+
+	i.httpSrv = &http.Server{
+		Handler:           http.HandlerFunc(i.serveHTTP),
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       10 * time.Second,
+		IdleTimeout:       30 * time.Second,
+	}
+
+	go i.httpSrv.Serve(httpLis)
+
+	zap.L().Info("Ingress is now running", zap.String("addr", i.addr), zap.String("httpAddr", i.httpAddr))
+
+	return nil
+}
+
+func (i *ingress) close() {
+	if i.lis != nil {
+		i.lis.Close()
+	}
+	if i.connLis != nil {
+		i.connLis.Close()
+	}
+	if i.httpsSrv != nil {
+		i.httpsSrv.Close()
+	}
+	if i.httpSrv != nil {
+		i.httpSrv.Close()
+	}
+}
+
+func getTriggerFns[T any](i *ingress) (func(ctx context.Context, itm T) error,
+	func(ctx context.Context, new, old T) error) {
+	return func(ctx context.Context, itm T) error {
+			i.triggerSnapshot()
+			return nil
+		}, func(ctx context.Context, new, old T) error {
+			i.triggerSnapshot()
+			return nil
+		}
+}
+
+func (i *ingress) triggerSnapshot() {
+	select {
+	case i.snapshotCh <- struct{}{}:
+	default:
+	}
+}
+
+func (i *ingress) startSnapshotLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-i.snapshotCh:
+			if err := i.doSnapshot(ctx); err != nil {
+				zap.L().Warn("Could not do ingress snapshot", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (i *ingress) doSnapshot(ctx context.Context) error {
+	rgn, err := i.octeliumC.CoreC().GetRegion(ctx, &rmetav1.GetOptions{
+		Name: vutils.GetMyRegionName(),
+	})
+	if err != nil {
+		return err
+	}
+
+	svcList, err := i.octeliumC.CoreC().ListService(ctx, &rmetav1.ListOptions{
+		Filters: []*rmetav1.ListOptions_Filter{
+			urscsrv.FilterFieldBooleanTrue("spec.isPublic"),
+			urscsrv.FilterFieldEQValStr("status.regionRef.uid", rgn.Metadata.Uid),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	crtList, err := i.octeliumC.CoreC().ListSecret(ctx, &rmetav1.ListOptions{
+		SystemLabels: map[string]string{
+			"octelium-cert": "true",
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	cc, err := i.octeliumC.CoreV1Utils().GetClusterConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	isHTTP01 := ucorev1.ToClusterConfig(cc).IsACMEHTTP01Enabled()
+
+	var challengeList []*corev1.Secret
+	if isHTTP01 {
+		secretList, err := i.octeliumC.CoreC().ListSecret(ctx, &rmetav1.ListOptions{
+			SystemLabels: map[string]string{
+				"octelium-acme-http01": "true",
+			},
+		})
+		if err != nil {
+			return err
+		}
+		challengeList = secretList.Items
+	}
+
+	var gwList []*corev1.Gateway
+	if ucorev1.ToClusterConfig(cc).IsTLSEnabled() {
+		itemList, err := i.octeliumC.CoreC().ListGateway(ctx, &rmetav1.ListOptions{
+			Filters: []*rmetav1.ListOptions_Filter{
+				urscsrv.FilterFieldEQValStr("status.regionRef.uid", rgn.Metadata.Uid),
+			},
+		})
+		if err != nil {
+			return err
+		}
+		gwList = itemList.Items
+	}
+
+	usedProxies := make(map[proxyOpts]bool)
+	snap := newIngressSnapshot(i.domain, svcList.Items, crtList.Items, gwList,
+		challengeList, isHTTP01, func(o proxyOpts) http.Handler {
+			usedProxies[o] = true
+			if ret, ok := i.proxies[o]; ok {
+				return ret
+			}
+			ret := newProxy(o)
+			i.proxies[o] = ret
+			return ret
+		})
+
+	i.snapshot.Store(snap)
+
+	for o, proxy := range i.proxies {
+		if !usedProxies[o] {
+			if t, ok := proxy.Transport.(interface{ CloseIdleConnections() }); ok {
+				t.CloseIdleConnections()
+			}
+			delete(i.proxies, o)
+		}
+	}
+
+	zap.L().Debug("Ingress snapshot is done", zap.Int("services", len(svcList.Items)))
+
+	return nil
+}
+
+func newIngressSnapshot(domain string,
+	svcList []*corev1.Service, crtList []*corev1.Secret, gwList []*corev1.Gateway,
+	challengeList []*corev1.Secret, isHTTP01 bool,
+	getProxy func(o proxyOpts) http.Handler) *ingressSnapshot {
+	ret := &ingressSnapshot{
+		passthrough: make(map[string]string),
+		hosts:       make(map[string]http.Handler),
+		isHTTP01:    isHTTP01,
+		challenges:  make(map[string]string),
+	}
+
+	for _, crt := range crtList {
+		if !vutils.IsCertReady(crt) {
+			continue
+		}
+
+		chain, key, err := ucorev1.ToSecret(crt).GetCertificateChainAndKey()
+		if err != nil {
+			zap.L().Error("Could not find cert data. Skipping...",
+				zap.Error(err), zap.String("name", crt.Metadata.Name))
+			continue
+		}
+
+		cert, err := tls.X509KeyPair(chain, key)
+		if err != nil {
+			zap.L().Error("Could not parse cert. Skipping...",
+				zap.Error(err), zap.String("name", crt.Metadata.Name))
+			continue
+		}
+
+		ret.certs = append(ret.certs, &cert)
+	}
+
+	// The Gateway tunnel paths are more specific than the API Server
+	// prefixes and must therefore be matched first
+	for _, gw := range gwList {
+		if gw.Status == nil || gw.Status.Tls == nil ||
+			gw.Status.Tls.Address == "" || gw.Status.Tls.Port == 0 {
+			continue
+		}
+
+		ret.apiRoutes = append(ret.apiRoutes, &ingressRoute{
+			path:    vutils.GetGatewayTLSTunnelPath(gw.Status.Id),
+			isExact: true,
+			handler: getProxy(proxyOpts{
+				addr:     net.JoinHostPort(gw.Status.Tls.Address, strconv.Itoa(int(gw.Status.Tls.Port))),
+				isTLS:    true,
+				sni:      fmt.Sprintf("octelium-api.%s", domain),
+				keepHost: true,
+			}),
+		})
+	}
+
+	var apiServerRoutes []*ingressRoute
+
+	for _, svc := range svcList {
+		ip := getIP(getServiceAddr(svc))
+		if ip == "" {
+			zap.L().Debug("Service has no address yet. Skipping...", zap.String("svc", svc.Metadata.Name))
+			continue
+		}
+
+		addr := net.JoinHostPort(ip, strconv.Itoa(ucorev1.ToService(svc).RealPort()))
+		fqdns := vutils.GetServicePublicFQDNs(svc, domain)
+
+		switch {
+		case isTLSPassthrough(svc):
+			for _, fqdn := range fqdns {
+				// The Cluster domain itself is always terminated by the ingress
+				if fqdn != domain {
+					ret.passthrough[fqdn] = addr
+				}
+			}
+		case isAPIServer(svc):
+			handler := getProxy(proxyOpts{
+				addr:    addr,
+				isHTTP2: ucorev1.ToService(svc).IsListenerHTTP2(),
+				isTLS:   svc.Spec.IsTLS,
+				sni:     fqdns[0],
+			})
+
+			for _, path := range strings.Split(svc.Metadata.SystemLabels["apiserver-path"], ",") {
+				apiServerRoutes = append(apiServerRoutes, &ingressRoute{
+					path:    strings.TrimSpace(path),
+					handler: handler,
+				})
+			}
+		default:
+			handler := getProxy(proxyOpts{
+				addr:    addr,
+				isHTTP2: ucorev1.ToService(svc).IsListenerHTTP2(),
+				isTLS:   svc.Spec.IsTLS,
+				sni:     fqdns[0],
+				host:    fqdns[0],
+				forwardHost: ucorev1.ToService(svc).IsManagedService() &&
+					svc.Status.ManagedService != nil && svc.Status.ManagedService.ForwardHost,
+			})
+
+			for _, fqdn := range fqdns {
+				ret.hosts[fqdn] = handler
+			}
+		}
+	}
+
+	if len(apiServerRoutes) > 0 {
+		ret.apiRoutes = append(ret.apiRoutes, apiServerRoutes...)
+	} else {
+		ret.apiRoutes = nil
+	}
+
+	for _, sec := range challengeList {
+		keyAuth := ucorev1.ToSecret(sec).GetValueStr()
+		token, _, ok := strings.Cut(keyAuth, ".")
+		if !ok || token == "" || strings.ContainsAny(token, "/?#") {
+			zap.L().Warn("Skipping invalid ACME HTTP-01 challenge Secret",
+				zap.String("name", sec.Metadata.Name))
+			continue
+		}
+		ret.challenges[token] = keyAuth
+	}
+
+	return ret
+}
+
+// isTLSPassthrough returns whether the public Service TLS connections are
+// routed via SNI as-is to its Vigil which terminates the TLS itself.
+func isTLSPassthrough(svc *corev1.Service) bool {
+	return svc.Spec.IsPublic && svc.Spec.IsTLS && svc.Spec.Mode == corev1.Service_Spec_TCP
+}
+
+func isAPIServer(svc *corev1.Service) bool {
+	return svc.Metadata.SystemLabels != nil &&
+		svc.Metadata.SystemLabels["octelium-apiserver"] == "true" &&
+		svc.Metadata.SystemLabels["apiserver-path"] != ""
+}
+
+// lookupHost returns the value of the host. Wildcard hosts are matched by
+// their longest suffix.
+func lookupHost[T any](hosts map[string]T, host string) (T, bool) {
+	if ret, ok := hosts[host]; ok {
+		return ret, true
+	}
+
+	for idx := strings.IndexByte(host, '.'); idx >= 0; {
+		if ret, ok := hosts["*"+host[idx:]]; ok {
+			return ret, true
+		}
+
+		next := strings.IndexByte(host[idx+1:], '.')
+		if next < 0 {
+			break
+		}
+		idx = idx + 1 + next
+	}
+
+	var zero T
+	return zero, false
+}
+
+func getCertificate(certs []*tls.Certificate, hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	if len(certs) == 0 {
+		return nil, errors.Errorf("No certificates")
+	}
+
+	for _, cert := range certs {
+		if hello.SupportsCertificate(cert) == nil {
+			return cert, nil
+		}
+	}
+
+	return certs[0], nil
+}
+
+func getRequestHost(r *http.Request) string {
+	host := r.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.ToLower(host)
+}
+
+func (i *ingress) serveHTTPS(w http.ResponseWriter, r *http.Request) {
+	snap := i.snapshot.Load()
+	host := getRequestHost(r)
+
+	if len(snap.apiRoutes) > 0 && host == fmt.Sprintf("octelium-api.%s", i.domain) {
+		for _, route := range snap.apiRoutes {
+			if route.match(r.URL.Path) {
+				route.handler.ServeHTTP(w, r)
+				return
+			}
+		}
+
+		http.NotFound(w, r)
+		return
+	}
+
+	if handler, ok := lookupHost(snap.hosts, host); ok {
+		handler.ServeHTTP(w, r)
+		return
+	}
+
+	http.NotFound(w, r)
+}
+
+// serveHTTP serves the plain HTTP requests. It answers the pending ACME
+// HTTP-01 challenges and redirects every other request to HTTPS.
+func (i *ingress) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	snap := i.snapshot.Load()
+
+	if snap.isHTTP01 && strings.HasPrefix(r.URL.Path, acmeHTTP01PathPrefix) {
+		if keyAuth, ok := snap.challenges[strings.TrimPrefix(r.URL.Path, acmeHTTP01PathPrefix)]; ok {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(keyAuth))
+			return
+		}
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("https://%s%s", getRequestHost(r), r.URL.RequestURI()),
+		http.StatusMovedPermanently)
+}
+
+func (i *ingress) startAcceptLoop() {
+	for {
+		conn, err := i.lis.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			zap.L().Debug("Could not accept conn", zap.Error(err))
+			continue
+		}
+
+		go i.handleConn(conn)
+	}
+}
+
+func (i *ingress) handleConn(c net.Conn) {
+	c.SetReadDeadline(time.Now().Add(10 * time.Second))
+	sni, peeked, err := peekSNI(c)
+	if err != nil {
+		zap.L().Debug("Could not read TLS ClientHello", zap.Error(err))
+		c.Close()
+		return
+	}
+	c.SetReadDeadline(time.Time{})
+
+	conn := &prefixConn{
+		Conn: c,
+		r:    io.MultiReader(bytes.NewReader(peeked), c),
+	}
+
+	sni = strings.ToLower(sni)
+
+	if upstream, ok := lookupHost(i.snapshot.Load().passthrough, sni); ok {
+		proxyTCP(conn, upstream)
+		return
+	}
+
+	if sni != i.domain && !strings.HasSuffix(sni, "."+i.domain) {
+		zap.L().Debug("Unknown SNI. Closing conn", zap.String("sni", sni))
+		c.Close()
+		return
+	}
+
+	i.connLis.push(tls.Server(conn, i.tlsConfig))
+}
+
+func proxyTCP(downstream net.Conn, addr string) {
+	defer downstream.Close()
+
+	upstream, err := net.DialTimeout("tcp", addr, 15*time.Second)
+	if err != nil {
+		zap.L().Debug("Could not dial TLS passthrough upstream", zap.String("addr", addr), zap.Error(err))
+		return
+	}
+	defer upstream.Close()
+
+	doneCh := make(chan struct{}, 2)
+	go func() {
+		io.Copy(upstream, downstream)
+		doneCh <- struct{}{}
+	}()
+	go func() {
+		io.Copy(downstream, upstream)
+		doneCh <- struct{}{}
+	}()
+
+	<-doneCh
+}
+
+var errPeekedSNI = errors.New("peeked SNI")
+
+// peekSNI reads the TLS ClientHello and returns its server name along with
+// the read bytes which are replayed afterwards.
+func peekSNI(r io.Reader) (string, []byte, error) {
+	buf := &bytes.Buffer{}
+	var sni string
+	var isPeeked bool
+
+	err := tls.Server(&readOnlyConn{r: io.TeeReader(r, buf)}, &tls.Config{
+		GetConfigForClient: func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+			sni = hello.ServerName
+			isPeeked = true
+			return nil, errPeekedSNI
+		},
+	}).Handshake()
+	if !isPeeked {
+		return "", nil, err
+	}
+
+	return sni, buf.Bytes(), nil
+}
+
+type readOnlyConn struct {
+	r io.Reader
+}
+
+func (c *readOnlyConn) Read(p []byte) (int, error)         { return c.r.Read(p) }
+func (c *readOnlyConn) Write(p []byte) (int, error)        { return 0, io.ErrClosedPipe }
+func (c *readOnlyConn) Close() error                       { return nil }
+func (c *readOnlyConn) LocalAddr() net.Addr                { return nil }
+func (c *readOnlyConn) RemoteAddr() net.Addr               { return nil }
+func (c *readOnlyConn) SetDeadline(t time.Time) error      { return nil }
+func (c *readOnlyConn) SetReadDeadline(t time.Time) error  { return nil }
+func (c *readOnlyConn) SetWriteDeadline(t time.Time) error { return nil }
+
+type prefixConn struct {
+	net.Conn
+	r io.Reader
+}
+
+func (c *prefixConn) Read(p []byte) (int, error) {
+	return c.r.Read(p)
+}
+
+// connListener passes the TLS connections terminated by the ingress to its
+// HTTP server.
+type connListener struct {
+	addr    net.Addr
+	connCh  chan net.Conn
+	closeCh chan struct{}
+	once    sync.Once
+}
+
+func newConnListener(addr net.Addr) *connListener {
+	return &connListener{
+		addr:    addr,
+		connCh:  make(chan net.Conn),
+		closeCh: make(chan struct{}),
+	}
+}
+
+func (l *connListener) push(c net.Conn) {
+	select {
+	case l.connCh <- c:
+	case <-l.closeCh:
+		c.Close()
+	}
+}
+
+func (l *connListener) Accept() (net.Conn, error) {
+	select {
+	case c := <-l.connCh:
+		return c, nil
+	case <-l.closeCh:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *connListener) Close() error {
+	l.once.Do(func() {
+		close(l.closeCh)
+	})
+	return nil
+}
+
+func (l *connListener) Addr() net.Addr {
+	return l.addr
+}
+
+type proxyOpts struct {
+	addr    string
+	isTLS   bool
+	isHTTP2 bool
+	sni     string
+	// host rewrites the request host. The upstream address is used if
+	// neither host nor keepHost are set.
+	host        string
+	keepHost    bool
+	forwardHost bool
+}
+
+func newProxy(o proxyOpts) *httputil.ReverseProxy {
+	scheme := "http"
+	if o.isTLS {
+		scheme = "https"
+	}
+
+	return &httputil.ReverseProxy{
+		Rewrite: func(r *httputil.ProxyRequest) {
+			r.Out.URL.Scheme = scheme
+			r.Out.URL.Host = o.addr
+
+			switch {
+			case o.host != "":
+				r.Out.Host = o.host
+			case o.keepHost:
+				r.Out.Host = r.In.Host
+			default:
+				r.Out.Host = ""
+			}
+
+			if clientIP, _, err := net.SplitHostPort(r.In.RemoteAddr); err == nil {
+				r.Out.Header.Set("X-Forwarded-For", clientIP)
+			}
+			r.Out.Header.Set("X-Forwarded-Proto", "https")
+
+			if o.forwardHost {
+				r.Out.Header.Set("X-Forwarded-Host", r.In.Host)
+			}
+		},
+		Transport:     getProxyTransport(o),
+		FlushInterval: -1,
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			zap.L().Debug("Could not proxy request", zap.String("addr", o.addr), zap.Error(err))
+			w.WriteHeader(http.StatusBadGateway)
+		},
+	}
+}
+
+func getProxyTransport(o proxyOpts) http.RoundTripper {
+	tlsConfig := &tls.Config{
+		ServerName: o.sni,
+		// The upstreams are the local Vigils and Gateways whose certificates
+		// are not verified, the same as with the Envoy-based ingress
+		InsecureSkipVerify: true,
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   15 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+
+	if o.isHTTP2 {
+		if o.isTLS {
+			tlsConfig.NextProtos = []string{"h2"}
+			return &http2.Transport{
+				TLSClientConfig: tlsConfig,
+			}
+		}
+
+		return &http2.Transport{
+			AllowHTTP: true,
+			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+				return dialer.DialContext(ctx, network, addr)
+			},
+		}
+	}
+
+	return &http.Transport{
+		DialContext:         dialer.DialContext,
+		TLSClientConfig:     tlsConfig,
+		MaxIdleConnsPerHost: 100,
+		IdleConnTimeout:     90 * time.Second,
+	}
+}
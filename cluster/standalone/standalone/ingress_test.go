@@ -0,0 +1,234 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package standalone
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/cluster/common/vutils"
+	"github.com/stretchr/testify/assert"
+)
+
+type tstHandler struct {
+	o proxyOpts
+}
+
+func (h *tstHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {}
+
+func tstGetProxy(o proxyOpts) http.Handler {
+	return &tstHandler{o: o}
+}
+
+func tstNewService(name string, ip string, port uint32) *corev1.Service {
+	return &corev1.Service{
+		Metadata: &metav1.Metadata{
+			Name: name,
+		},
+		Spec: &corev1.Service_Spec{
+			IsPublic: true,
+			Mode:     corev1.Service_Spec_HTTP,
+		},
+		Status: &corev1.Service_Status{
+			Port: port,
+			NamespaceRef: &metav1.ObjectReference{
+				Name: strings.Split(name, ".")[1],
+			},
+			Addresses: []*corev1.Service_Status_Address{
+				{
+					DualStackIP: &metav1.DualStackIP{
+						Ipv4: ip,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestLookupHost(t *testing.T) {
+	hosts := map[string]int{
+		"svc.example.com":        1,
+		"*.svc.example.com":      2,
+		"*.a.svc.example.com":    3,
+		"portal.example.com":     4,
+		"*.other.example.com":    5,
+		"nested.b.example.com":   6,
+		"*.nested.b.example.com": 7,
+	}
+
+	for _, tc := range []struct {
+		host string
+		val  int
+		ok   bool
+	}{
+		{host: "svc.example.com", val: 1, ok: true},
+		{host: "x.svc.example.com", val: 2, ok: true},
+		{host: "x.y.svc.example.com", val: 2, ok: true},
+		{host: "x.a.svc.example.com", val: 3, ok: true},
+		{host: "portal.example.com", val: 4, ok: true},
+		{host: "other.example.com"},
+		{host: "x.nested.b.example.com", val: 7, ok: true},
+		{host: "b.example.com"},
+		{host: "example.com"},
+		{host: ""},
+	} {
+		val, ok := lookupHost(hosts, tc.host)
+		assert.Equal(t, tc.ok, ok, "%s", tc.host)
+		assert.Equal(t, tc.val, val, "%s", tc.host)
+	}
+}
+
+func TestNewIngressSnapshot(t *testing.T) {
+	domain := "example.com"
+
+	svcWeb := tstNewService("web.default", "10.1.0.16", 8080)
+
+	svcTCP := tstNewService("db.default", "10.1.0.17", 5432)
+	svcTCP.Spec.Mode = corev1.Service_Spec_TCP
+	svcTCP.Spec.IsTLS = true
+
+	svcAPI := tstNewService("default.octelium-api", "10.1.0.18", 8080)
+	svcAPI.Spec.Mode = corev1.Service_Spec_GRPC
+	svcAPI.Metadata.SystemLabels = map[string]string{
+		"octelium-apiserver": "true",
+		"apiserver-path":     "/octelium.api.main.core, /octelium.api.main.user",
+	}
+
+	svcPortal := tstNewService("portal.default", "10.1.0.19", 8080)
+	svcPortal.Status.ManagedService = &corev1.Service_Status_ManagedService{
+		ForwardHost: true,
+	}
+
+	svcNoAddr := tstNewService("pending.default", "", 8080)
+	svcNoAddr.Status.Addresses = nil
+
+	gwList := []*corev1.Gateway{
+		{
+			Metadata: &metav1.Metadata{Name: "gw-1"},
+			Status: &corev1.Gateway_Status{
+				Id: "abcd",
+				Tls: &corev1.Gateway_Status_TLS{
+					Port:    8445,
+					Address: "127.0.0.1",
+				},
+			},
+		},
+		{
+			Metadata: &metav1.Metadata{Name: "gw-2"},
+			Status: &corev1.Gateway_Status{
+				Id: "efgh",
+			},
+		},
+	}
+
+	challengeList := []*corev1.Secret{
+		{
+			Metadata: &metav1.Metadata{Name: "acme-1"},
+			Data: &corev1.Secret_Data{
+				Type: &corev1.Secret_Data_Value{
+					Value: "token1.thumbprint",
+				},
+			},
+		},
+		{
+			Metadata: &metav1.Metadata{Name: "acme-2"},
+			Data: &corev1.Secret_Data{
+				Type: &corev1.Secret_Data_Value{
+					Value: "invalid",
+				},
+			},
+		},
+	}
+
+	snap := newIngressSnapshot(domain,
+		[]*corev1.Service{svcWeb, svcTCP, svcAPI, svcPortal, svcNoAddr},
+		nil, gwList, challengeList, true, tstGetProxy)
+
+	assert.Equal(t, map[string]string{
+		"db.default.example.com": "10.1.0.17:5432",
+		"db.example.com":         "10.1.0.17:5432",
+	}, snap.passthrough)
+
+	{
+		handler, ok := lookupHost(snap.hosts, "web.example.com")
+		assert.True(t, ok)
+		o := handler.(*tstHandler).o
+		assert.Equal(t, "10.1.0.16:8080", o.addr)
+		assert.Equal(t, "web.default.example.com", o.host)
+		assert.False(t, o.forwardHost)
+		assert.False(t, o.isTLS)
+	}
+
+	{
+		handler, ok := lookupHost(snap.hosts, "portal.example.com")
+		assert.True(t, ok)
+		assert.True(t, handler.(*tstHandler).o.forwardHost)
+	}
+
+	_, ok := lookupHost(snap.hosts, "pending.example.com")
+	assert.False(t, ok)
+	_, ok = lookupHost(snap.hosts, "db.example.com")
+	assert.False(t, ok)
+	_, ok = lookupHost(snap.hosts, "octelium-api.example.com")
+	assert.False(t, ok)
+
+	// The Gateway tunnel route comes first followed by the API Server prefixes
+	assert.Equal(t, 3, len(snap.apiRoutes))
+	assert.Equal(t, vutils.GetGatewayTLSTunnelPath("abcd"), snap.apiRoutes[0].path)
+	assert.True(t, snap.apiRoutes[0].isExact)
+	assert.Equal(t, "127.0.0.1:8445", snap.apiRoutes[0].handler.(*tstHandler).o.addr)
+	assert.True(t, snap.apiRoutes[0].handler.(*tstHandler).o.keepHost)
+	assert.Equal(t, "/octelium.api.main.core", snap.apiRoutes[1].path)
+	assert.Equal(t, "/octelium.api.main.user", snap.apiRoutes[2].path)
+	assert.True(t, snap.apiRoutes[2].match("/octelium.api.main.user.v1.MainService/GetStatus"))
+	assert.True(t, snap.apiRoutes[1].handler.(*tstHandler).o.isHTTP2)
+
+	assert.Equal(t, map[string]string{
+		"token1": "token1.thumbprint",
+	}, snap.challenges)
+
+	{
+		// No API routes without API Servers, the same as the Envoy-based ingress
+		snap := newIngressSnapshot(domain, []*corev1.Service{svcWeb}, nil, gwList, nil, false, tstGetProxy)
+		assert.Equal(t, 0, len(snap.apiRoutes))
+	}
+}
+
+func TestPeekSNI(t *testing.T) {
+	clientConn, srvConn := net.Pipe()
+	defer srvConn.Close()
+
+	go func() {
+		tls.Client(clientConn, &tls.Config{
+			ServerName:         "svc.example.com",
+			InsecureSkipVerify: true,
+		}).Handshake()
+		clientConn.Close()
+	}()
+
+	sni, peeked, err := peekSNI(srvConn)
+	assert.Nil(t, err)
+	assert.Equal(t, "svc.example.com", sni)
+	assert.True(t, len(peeked) > 0)
+	// The peeked bytes start with the TLS handshake record type
+	assert.Equal(t, byte(0x16), peeked[0])
+}
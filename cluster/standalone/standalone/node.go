@@ -0,0 +1,77 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package standalone
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/octelium/octelium/cluster/common/vutils"
+	k8scorev1 "k8s.io/api/core/v1"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// getNode returns the node representing the host to the Gateway agent. Its
+// UID is persisted in the data dir so that the Gateway name, which is derived
+// from the node name and UID, remains the same across restarts.
+func getNode(dataDir string) (*k8scorev1.Node, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+
+	uid, err := getNodeUID(dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &k8scorev1.Node{
+		ObjectMeta: k8smetav1.ObjectMeta{
+			Name: strings.ToLower(hostname),
+			UID:  types.UID(uid),
+		},
+		Status: k8scorev1.NodeStatus{
+			Addresses: []k8scorev1.NodeAddress{
+				{
+					Type:    k8scorev1.NodeInternalIP,
+					Address: localhost,
+				},
+			},
+		},
+	}, nil
+}
+
+func getNodeUID(dataDir string) (string, error) {
+	uidPath := filepath.Join(dataDir, "node-uid")
+
+	uid, err := os.ReadFile(uidPath)
+	if err == nil {
+		return strings.TrimSpace(string(uid)), nil
+	}
+	if !os.IsNotExist(err) {
+		return "", err
+	}
+
+	ret := vutils.UUIDv4()
+	if err := os.WriteFile(uidPath, []byte(ret), 0600); err != nil {
+		return "", err
+	}
+
+	return ret, nil
+}
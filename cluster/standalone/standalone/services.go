@@ -0,0 +1,464 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package standalone
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/apis/rsc/rmetav1"
+	"github.com/octelium/octelium/cluster/common/octeliumc"
+	"github.com/octelium/octelium/cluster/common/vutils"
+	"github.com/octelium/octelium/cluster/common/watchers"
+	"github.com/octelium/octelium/cluster/vigil/vigil"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
+	"github.com/octelium/octelium/pkg/grpcerr"
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+	"google.golang.org/protobuf/proto"
+)
+
+// svcLinkName is the dummy link holding the Service addresses. Services are
+// served by in-process Vigils on these addresses instead of pods.
+const svcLinkName = "octelium-svc"
+
+// firstServiceAddrIndex is the index of the first Gateway CIDR address assigned
+// to Services. The lower addresses are reserved for the Gateway itself, the
+// same as the range start of the CNI config used with Kubernetes.
+const firstServiceAddrIndex = 16
+
+type serviceController struct {
+	octeliumC octeliumc.ClientInterface
+	alloc     *addrAllocator
+	link      netlink.Link
+
+	// ctx is the context of the Vigils. The watcher handler contexts cannot be
+	// used since they are canceled whenever the watcher reconnects.
+	ctx context.Context
+
+	mu     sync.Mutex
+	vigils map[string]*vigilRunner
+}
+
+type vigilRunner struct {
+	srv      *vigil.Server
+	cancelFn context.CancelFunc
+	addr     string
+}
+
+func newServiceController(octeliumC octeliumc.ClientInterface,
+	gw *corev1.Gateway) (*serviceController, error) {
+	alloc, err := newAddrAllocator(gw.Status.Cidr)
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := getServiceLink()
+	if err != nil {
+		return nil, errors.Errorf("Could not create the Service link: %+v", err)
+	}
+
+	return &serviceController{
+		octeliumC: octeliumC,
+		alloc:     alloc,
+		link:      link,
+		vigils:    make(map[string]*vigilRunner),
+	}, nil
+}
+
+func getServiceLink() (netlink.Link, error) {
+	// The addresses left by a previous run are removed along with the link
+	if l, err := netlink.LinkByName(svcLinkName); err == nil {
+		if err := netlink.LinkDel(l); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := netlink.LinkAdd(&netlink.Dummy{
+		LinkAttrs: netlink.LinkAttrs{
+			Name: svcLinkName,
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	l, err := netlink.LinkByName(svcLinkName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := netlink.LinkSetUp(l); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+func (c *serviceController) run(ctx context.Context) error {
+	c.ctx = ctx
+
+	svcList, err := c.octeliumC.CoreC().ListService(ctx, &rmetav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	// The current addresses are reserved first so that the Services keep
+	// their addresses across restarts
+	for _, svc := range svcList.Items {
+		if c.shouldServe(svc) {
+			c.alloc.reserve(svc.Metadata.Uid, getServiceAddr(svc))
+		}
+	}
+
+	if err := watchers.NewCoreV1(c.octeliumC).Service(ctx, nil, c.OnAdd, c.OnUpdate, c.OnDelete); err != nil {
+		return err
+	}
+
+	for _, svc := range svcList.Items {
+		if err := c.serve(svc); err != nil {
+			zap.L().Warn("Could not serve Service", zap.String("svc", svc.Metadata.Name), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+func (c *serviceController) OnAdd(ctx context.Context, svc *corev1.Service) error {
+	return c.serve(svc)
+}
+
+func (c *serviceController) OnUpdate(ctx context.Context, new, old *corev1.Service) error {
+	return c.serve(new)
+}
+
+func (c *serviceController) OnDelete(ctx context.Context, svc *corev1.Service) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.stop(svc.Metadata.Uid)
+	c.alloc.release(svc.Metadata.Uid)
+
+	return nil
+}
+
+func (c *serviceController) shouldServe(svc *corev1.Service) bool {
+	if !ucorev1.ToService(svc).IsInMyRegion() {
+		return false
+	}
+
+	if hasContainerUpstream(svc) {
+		zap.L().Warn("Skipping Service with a container upstream since it requires Kubernetes",
+			zap.String("svc", svc.Metadata.Name))
+		return false
+	}
+
+	return true
+}
+
+func hasContainerUpstream(svc *corev1.Service) bool {
+	if svc.Spec.Config.GetUpstream().GetContainer() != nil {
+		return true
+	}
+
+	for _, cfg := range svc.Spec.DynamicConfig.GetConfigs() {
+		if cfg.GetUpstream().GetContainer() != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *serviceController) serve(svc *corev1.Service) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.shouldServe(svc) {
+		c.stop(svc.Metadata.Uid)
+		c.alloc.release(svc.Metadata.Uid)
+		return nil
+	}
+
+	addr, err := c.alloc.allocate(svc.Metadata.Uid, getServiceAddr(svc))
+	if err != nil {
+		return err
+	}
+
+	if err := c.setServiceAddr(c.ctx, svc, addr); err != nil {
+		return err
+	}
+
+	ip := getIP(addr)
+	if r, ok := c.vigils[svc.Metadata.Uid]; ok {
+		if r.addr == ip {
+			return nil
+		}
+		c.stop(svc.Metadata.Uid)
+	}
+
+	if err := netlink.AddrReplace(c.link, getLinkAddr(ip)); err != nil {
+		return errors.Errorf("Could not add the Service address %s: %+v", ip, err)
+	}
+
+	vigilCtx, cancelFn := context.WithCancel(c.ctx)
+
+	srv, err := vigil.NewServer(vigilCtx, &vigil.Opts{
+		OcteliumC:  c.octeliumC,
+		Service:    svc,
+		ListenHost: ip,
+	})
+	if err != nil {
+		cancelFn()
+		return err
+	}
+
+	if err := srv.Run(vigilCtx); err != nil {
+		srv.Close()
+		cancelFn()
+		return err
+	}
+
+	c.vigils[svc.Metadata.Uid] = &vigilRunner{
+		srv:      srv,
+		cancelFn: cancelFn,
+		addr:     ip,
+	}
+
+	zap.L().Info("Service is now served", zap.String("svc", svc.Metadata.Name), zap.String("addr", ip))
+
+	return nil
+}
+
+// stop stops the Vigil of the Service, if any, and removes its address.
+func (c *serviceController) stop(uid string) {
+	r, ok := c.vigils[uid]
+	if !ok {
+		return
+	}
+	delete(c.vigils, uid)
+
+	if err := r.srv.Close(); err != nil {
+		zap.L().Debug("Could not close Vigil server", zap.Error(err))
+	}
+	r.cancelFn()
+
+	if err := netlink.AddrDel(c.link, getLinkAddr(r.addr)); err != nil {
+		zap.L().Warn("Could not remove Service address", zap.String("addr", r.addr), zap.Error(err))
+	}
+}
+
+func (c *serviceController) setServiceAddr(ctx context.Context,
+	svc *corev1.Service, addr *metav1.DualStackIP) error {
+	svc = proto.Clone(svc).(*corev1.Service)
+
+	for range 5 {
+		if len(svc.Status.Addresses) == 1 &&
+			proto.Equal(svc.Status.Addresses[0].DualStackIP, addr) {
+			return nil
+		}
+
+		svc.Status.Addresses = []*corev1.Service_Status_Address{
+			{
+				DualStackIP: addr,
+			},
+		}
+
+		_, err := c.octeliumC.CoreC().UpdateService(ctx, svc)
+		if err == nil {
+			return nil
+		}
+		if !grpcerr.IsResourceChanged(err) {
+			return err
+		}
+
+		svc, err = c.octeliumC.CoreC().GetService(ctx, &rmetav1.GetOptions{Uid: svc.Metadata.Uid})
+		if err != nil {
+			return err
+		}
+	}
+
+	return errors.Errorf("Could not set the Service address")
+}
+
+func (c *serviceController) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for uid := range c.vigils {
+		c.stop(uid)
+	}
+
+	if err := netlink.LinkDel(c.link); err != nil {
+		zap.L().Warn("Could not remove the Service link", zap.Error(err))
+	}
+}
+
+func getServiceAddr(svc *corev1.Service) *metav1.DualStackIP {
+	if len(svc.Status.Addresses) == 0 {
+		return nil
+	}
+	return svc.Status.Addresses[0].DualStackIP
+}
+
+// getIP returns the single-stack address of the Service. Only single-stack
+// Clusters are supported by the standalone runtime.
+func getIP(addr *metav1.DualStackIP) string {
+	if addr == nil {
+		return ""
+	}
+	if addr.Ipv4 != "" {
+		return addr.Ipv4
+	}
+	return addr.Ipv6
+}
+
+func getLinkAddr(ip string) *netlink.Addr {
+	netIP := net.ParseIP(ip)
+	if netIP.To4() != nil {
+		return &netlink.Addr{
+			IPNet: &net.IPNet{
+				IP:   netIP,
+				Mask: net.CIDRMask(32, 32),
+			},
+		}
+	}
+
+	return &netlink.Addr{
+		IPNet: &net.IPNet{
+			IP:   netIP,
+			Mask: net.CIDRMask(128, 128),
+		},
+		// The address must be usable right away for the Vigil to listen on it
+		Flags: unix.IFA_F_NODAD,
+	}
+}
+
+// addrAllocator allocates the Service addresses from the Gateway CIDR.
+type addrAllocator struct {
+	cidr    *metav1.DualStackNetwork
+	network *net.IPNet
+	maxIdx  int
+	// addrs maps the Service UIDs to their addresses
+	addrs map[string]string
+}
+
+func newAddrAllocator(cidr *metav1.DualStackNetwork) (*addrAllocator, error) {
+	if cidr == nil || (cidr.V4 == "") == (cidr.V6 == "") {
+		return nil, errors.Errorf("The Gateway CIDR must be single-stack")
+	}
+
+	_, network, err := net.ParseCIDR(getCIDR(cidr))
+	if err != nil {
+		return nil, err
+	}
+
+	ones, bits := network.Mask.Size()
+	hostBits := min(bits-ones, 16)
+
+	ret := &addrAllocator{
+		cidr:    cidr,
+		network: network,
+		// The last address is excluded since it is the IPv4 broadcast address
+		maxIdx: (1 << hostBits) - 2,
+		addrs:  make(map[string]string),
+	}
+
+	if ret.maxIdx < firstServiceAddrIndex {
+		return nil, errors.Errorf("The Gateway CIDR is too small")
+	}
+
+	return ret, nil
+}
+
+func getCIDR(cidr *metav1.DualStackNetwork) string {
+	if cidr.V4 != "" {
+		return cidr.V4
+	}
+	return cidr.V6
+}
+
+// reserve sets the current address of the Service if it is valid.
+func (a *addrAllocator) reserve(uid string, current *metav1.DualStackIP) {
+	if ip := getIP(current); a.isAvailable(uid, ip) {
+		a.addrs[uid] = ip
+	}
+}
+
+// allocate returns the address of the Service. The current address is kept
+// if it is valid, otherwise the lowest free address is allocated.
+func (a *addrAllocator) allocate(uid string, current *metav1.DualStackIP) (*metav1.DualStackIP, error) {
+	if ip := getIP(current); a.isAvailable(uid, ip) {
+		a.addrs[uid] = ip
+		return current, nil
+	}
+
+	for idx := firstServiceAddrIndex; idx <= a.maxIdx; idx++ {
+		addr, err := vutils.GetDualStackIPByIndex(a.cidr, idx)
+		if err != nil {
+			return nil, err
+		}
+
+		if ip := getIP(addr); a.isAvailable(uid, ip) {
+			a.addrs[uid] = ip
+			return addr, nil
+		}
+	}
+
+	return nil, errors.Errorf("No available Service addresses")
+}
+
+func (a *addrAllocator) release(uid string) {
+	delete(a.addrs, uid)
+}
+
+func (a *addrAllocator) isAvailable(uid string, ip string) bool {
+	idx, ok := a.getIndex(ip)
+	if !ok || idx < firstServiceAddrIndex || idx > a.maxIdx {
+		return false
+	}
+
+	for svcUID, addr := range a.addrs {
+		if svcUID != uid && addr == ip {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (a *addrAllocator) getIndex(ip string) (int, bool) {
+	netIP := net.ParseIP(ip)
+	if netIP == nil || !a.network.Contains(netIP) {
+		return 0, false
+	}
+
+	if v4 := netIP.To4(); v4 != nil {
+		netIP = v4
+	}
+
+	ones, bits := a.network.Mask.Size()
+	hostBits := min(bits-ones, 16)
+
+	idx := int(netIP[len(netIP)-2])<<8 | int(netIP[len(netIP)-1])
+
+	return idx & ((1 << hostBits) - 1), true
+}
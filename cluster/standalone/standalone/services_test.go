@@ -0,0 +1,89 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package standalone
+
+import (
+	"testing"
+
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddrAllocator(t *testing.T) {
+	{
+		_, err := newAddrAllocator(&metav1.DualStackNetwork{
+			V4: "10.1.0.0/24",
+			V6: "fdee::/112",
+		})
+		assert.NotNil(t, err)
+	}
+
+	{
+		alloc, err := newAddrAllocator(&metav1.DualStackNetwork{
+			V4: "10.1.0.0/24",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 254, alloc.maxIdx)
+
+		addr1, err := alloc.allocate("svc-1", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, "10.1.0.16", addr1.Ipv4)
+
+		// The same address is kept for the same Service
+		addr, err := alloc.allocate("svc-1", addr1)
+		assert.Nil(t, err)
+		assert.Equal(t, "10.1.0.16", addr.Ipv4)
+
+		// Another Service cannot take an already allocated address
+		addr2, err := alloc.allocate("svc-2", addr1)
+		assert.Nil(t, err)
+		assert.Equal(t, "10.1.0.17", addr2.Ipv4)
+
+		// The reserved and out-of-CIDR addresses are reallocated
+		addr3, err := alloc.allocate("svc-3", &metav1.DualStackIP{Ipv4: "10.1.0.2"})
+		assert.Nil(t, err)
+		assert.Equal(t, "10.1.0.18", addr3.Ipv4)
+
+		addr4, err := alloc.allocate("svc-4", &metav1.DualStackIP{Ipv4: "10.2.0.100"})
+		assert.Nil(t, err)
+		assert.Equal(t, "10.1.0.19", addr4.Ipv4)
+
+		alloc.release("svc-1")
+		addr5, err := alloc.allocate("svc-5", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, "10.1.0.16", addr5.Ipv4)
+	}
+
+	{
+		alloc, err := newAddrAllocator(&metav1.DualStackNetwork{
+			V6: "fdee:1::/112",
+		})
+		assert.Nil(t, err)
+
+		// A valid current address is reserved even if it is not the lowest one
+		alloc.reserve("svc-1", &metav1.DualStackIP{Ipv6: "fdee:1::1:20"})
+		alloc.reserve("svc-2", &metav1.DualStackIP{Ipv6: "fdee:1::1:20"})
+
+		addr, err := alloc.allocate("svc-2", &metav1.DualStackIP{Ipv6: "fdee:1::1:20"})
+		assert.Nil(t, err)
+		assert.Equal(t, "fdee:1::10", addr.Ipv6)
+
+		addr, err = alloc.allocate("svc-1", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, "fdee:1::11", addr.Ipv6)
+	}
+}
@@ -0,0 +1,410 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package standalone
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/octelium/octelium/apis/cluster/cbootstrapv1"
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/rsc/rmetav1"
+	"github.com/octelium/octelium/cluster/apiserver/apiserver"
+	"github.com/octelium/octelium/cluster/authserver/authserver"
+	"github.com/octelium/octelium/cluster/common/commoninit"
+	"github.com/octelium/octelium/cluster/common/healthcheck"
+	"github.com/octelium/octelium/cluster/common/k8sutils"
+	"github.com/octelium/octelium/cluster/common/octeliumc"
+	"github.com/octelium/octelium/cluster/common/vutils"
+	"github.com/octelium/octelium/cluster/dnsserver/dnsserver"
+	"github.com/octelium/octelium/cluster/genesis/genesis"
+	"github.com/octelium/octelium/cluster/gwagent/gwagent"
+	"github.com/octelium/octelium/cluster/nocturne/nocturne"
+	"github.com/octelium/octelium/cluster/octovigil/octovigil"
+	"github.com/octelium/octelium/cluster/portal/portal"
+	"github.com/octelium/octelium/cluster/rscserver/rscserver"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
+	"github.com/octelium/octelium/pkg/grpcerr"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const localhost = "127.0.0.1"
+
+const (
+	rscServerPort      = 50110
+	octovigilPort      = 50111
+	apiServerPort      = 50100
+	authServerGRPCPort = 50101
+	authServerHTTPPort = 50102
+	portalPort         = 50103
+	dnsServerPort      = 50104
+)
+
+// managedServicePorts are the local ports of the managed Services. Their
+// Vigils, which run in the same process, upstream to them over the loopback
+// address.
+var managedServicePorts = map[string]int{
+	"default.octelium-api": apiServerPort,
+	"auth.octelium-api":    authServerGRPCPort,
+	"default.default":      authServerHTTPPort,
+	"portal.default":       portalPort,
+	"dns.octelium":         dnsServerPort,
+}
+
+type config struct {
+	dataDir     string
+	domain      string
+	publicIPs   []string
+	networkMode cbootstrapv1.Config_Spec_Network_Mode
+	ingressAddr string
+	httpAddr    string
+	// storageUser is the user running the embedded Postgres and Redis
+	// servers when running as root
+	storageUser string
+	redisServer string
+	// postgresBinariesDir is an existing PostgreSQL installation used instead
+	// of downloading the PostgreSQL binaries upon the first run
+	postgresBinariesDir string
+}
+
+func loadConfig() (*config, error) {
+	ret := &config{
+		dataDir:     getEnv("OCTELIUM_STANDALONE_DATA_DIR", "/var/lib/octelium"),
+		domain:      os.Getenv("OCTELIUM_STANDALONE_DOMAIN"),
+		ingressAddr: getEnv("OCTELIUM_STANDALONE_INGRESS_ADDR", ":443"),
+		httpAddr:    getEnv("OCTELIUM_STANDALONE_INGRESS_HTTP_ADDR", ":80"),
+		storageUser: getEnv("OCTELIUM_STANDALONE_STORAGE_USER", "nobody"),
+		redisServer: getEnv("OCTELIUM_STANDALONE_REDIS_SERVER", "redis-server"),
+
+		postgresBinariesDir: os.Getenv("OCTELIUM_STANDALONE_POSTGRES_BINARIES_DIR"),
+	}
+
+	if ret.postgresBinariesDir != "" {
+		if _, err := os.Stat(filepath.Join(ret.postgresBinariesDir, "bin", "pg_ctl")); err != nil {
+			return nil, errors.Errorf("Invalid PostgreSQL binaries dir %s: %+v", ret.postgresBinariesDir, err)
+		}
+	}
+
+	for _, ip := range strings.Split(os.Getenv("OCTELIUM_STANDALONE_PUBLIC_IPS"), ",") {
+		ip = strings.TrimSpace(ip)
+		if ip == "" {
+			continue
+		}
+		if net.ParseIP(ip) == nil {
+			return nil, errors.Errorf("Invalid public IP address: %s", ip)
+		}
+		ret.publicIPs = append(ret.publicIPs, ip)
+	}
+
+	switch strings.ToLower(getEnv("OCTELIUM_STANDALONE_NETWORK_MODE", "v4")) {
+	case "v4":
+		ret.networkMode = cbootstrapv1.Config_Spec_Network_V4_ONLY
+	case "v6":
+		ret.networkMode = cbootstrapv1.Config_Spec_Network_V6_ONLY
+	default:
+		return nil, errors.Errorf("Invalid network mode. It must be either v4 or v6")
+	}
+
+	return ret, nil
+}
+
+func getEnv(key, defaultVal string) string {
+	if ret := os.Getenv(key); ret != "" {
+		return ret
+	}
+	return defaultVal
+}
+
+// Run runs the whole Cluster within a single process without Kubernetes. The
+// Resource server, the API Server, the auth server, Octovigil, the DNS server,
+// the Portal, the Kubernetes-independent Nocturne controllers, a Gateway,
+// the ingress and the Service Vigils all run in-process. Postgres and Redis
+// are run as child processes unless they are explicitly set via their env vars.
+// Redis requires the redis-server binary installed on the host, which is shipped
+// with the standalone image, while the PostgreSQL binaries are downloaded upon
+// the first run unless OCTELIUM_STANDALONE_POSTGRES_BINARIES_DIR is set.
+func Run(ctx context.Context) error {
+	if isPostgresProcess() {
+		return runPostgresProcess(ctx)
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	// The data dir must be traversable by the unprivileged embedded
+	// Postgres user
+	if err := os.MkdirAll(cfg.dataDir, 0711); err != nil {
+		return err
+	}
+
+	stg, err := newStorage(ctx, cfg)
+	if err != nil {
+		return errors.Errorf("Could not run storage: %+v", err)
+	}
+	defer stg.close()
+
+	setEnv()
+
+	if err := commoninit.Run(ctx, nil); err != nil {
+		return err
+	}
+
+	rscSrv, err := rscserver.NewServer(ctx, &rscserver.Opts{
+		ListenHost: localhost,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := rscSrv.Run(ctx); err != nil {
+		return err
+	}
+	defer rscSrv.Stop()
+
+	octeliumC, err := octeliumc.NewClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := initCluster(ctx, cfg, rscSrv, octeliumC); err != nil {
+		return errors.Errorf("Could not initialize the Cluster: %+v", err)
+	}
+
+	rgn, err := octeliumC.CoreC().GetRegion(ctx, &rmetav1.GetOptions{Name: vutils.GetMyRegionName()})
+	if err != nil {
+		return err
+	}
+
+	if err := os.Setenv("OCTELIUM_REGION_UID", rgn.Metadata.Uid); err != nil {
+		return err
+	}
+
+	cc, err := octeliumC.CoreV1Utils().GetClusterConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	if ucorev1.ToClusterConfig(cc).GetNetworkMode() ==
+		corev1.ClusterConfig_Status_NetworkConfig_DUAL_STACK {
+		return errors.Errorf("Dual-stack Clusters are not supported by the standalone runtime")
+	}
+
+	if err := setManagedServicePorts(ctx, octeliumC); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errCh := make(chan error, 8)
+	runComponent := func(name string, fn func(ctx context.Context) error) {
+		go func() {
+			if err := fn(ctx); err != nil {
+				errCh <- errors.Errorf("Could not run %s: %+v", name, err)
+			}
+		}()
+	}
+
+	runComponent("octovigil", func(ctx context.Context) error {
+		return octovigil.RunWithOpts(ctx, &octovigil.Opts{
+			ListenHost: localhost,
+			IsEmbedded: true,
+		})
+	})
+	runComponent("apiserver", func(ctx context.Context) error {
+		return apiserver.RunWithOpts(ctx, &apiserver.Opts{
+			Port:       apiServerPort,
+			IsEmbedded: true,
+		})
+	})
+	runComponent("authserver gRPC", func(ctx context.Context) error {
+		return authserver.RunWithOpts(ctx, true, &authserver.Opts{
+			Port:       authServerGRPCPort,
+			IsEmbedded: true,
+		})
+	})
+	runComponent("authserver", func(ctx context.Context) error {
+		return authserver.RunWithOpts(ctx, false, &authserver.Opts{
+			Port:       authServerHTTPPort,
+			IsEmbedded: true,
+		})
+	})
+	runComponent("portal", func(ctx context.Context) error {
+		return portal.RunWithOpts(ctx, &portal.Opts{
+			Port:       portalPort,
+			IsEmbedded: true,
+		})
+	})
+	runComponent("dnsserver", func(ctx context.Context) error {
+		return dnsserver.RunWithOpts(ctx, &dnsserver.Opts{
+			Port:       dnsServerPort,
+			IsEmbedded: true,
+		})
+	})
+
+	if err := nocturne.RunStandalone(ctx, octeliumC); err != nil {
+		return err
+	}
+
+	node, err := getNode(cfg.dataDir)
+	if err != nil {
+		return err
+	}
+
+	gwSrv := gwagent.NewStandaloneServer(octeliumC, node, cfg.publicIPs)
+	defer gwSrv.Close()
+	if err := gwSrv.Run(ctx); err != nil {
+		return errors.Errorf("Could not run the Gateway agent: %+v", err)
+	}
+
+	gw, err := octeliumC.CoreC().GetGateway(ctx, &rmetav1.GetOptions{
+		Name: k8sutils.GetGatewayName(node),
+	})
+	if err != nil {
+		return err
+	}
+
+	svcCtl, err := newServiceController(octeliumC, gw)
+	if err != nil {
+		return err
+	}
+	defer svcCtl.close()
+
+	if err := svcCtl.run(ctx); err != nil {
+		return err
+	}
+
+	ing := newIngress(octeliumC, cc.Status.Domain, cfg.ingressAddr, cfg.httpAddr)
+	defer ing.close()
+
+	if err := ing.run(ctx); err != nil {
+		return err
+	}
+
+	healthcheck.Run(vutils.HealthCheckPortMain)
+	zap.L().Info("Standalone Cluster is now running", zap.String("domain", cc.Status.Domain))
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-errCh:
+		return err
+	}
+}
+
+func setEnv() {
+	setEnvIfEmpty := func(key, val string) {
+		if os.Getenv(key) == "" {
+			os.Setenv(key, val)
+		}
+	}
+
+	setEnvIfEmpty("OCTELIUM_REGION_NAME", "default")
+	setEnvIfEmpty("OCTELIUM_RSCSERVER_PORT", strconv.Itoa(rscServerPort))
+	setEnvIfEmpty("OCTELIUM_RSCSERVER_ADDR",
+		net.JoinHostPort(localhost, os.Getenv("OCTELIUM_RSCSERVER_PORT")))
+	setEnvIfEmpty("OCTELIUM_OCTOVIGIL_PORT", strconv.Itoa(octovigilPort))
+	setEnvIfEmpty("OCTELIUM_OCTOVIGIL_ADDR",
+		net.JoinHostPort(localhost, os.Getenv("OCTELIUM_OCTOVIGIL_PORT")))
+}
+
+// initCluster initializes the Cluster resources upon the first run. The
+// bootstrap config Secret is only created at the end of a successful
+// initialization and is therefore used as the initialization marker.
+func initCluster(ctx context.Context, cfg *config,
+	rscSrv *rscserver.Server, octeliumC octeliumc.ClientInterface) error {
+	_, err := octeliumC.CoreC().GetSecret(ctx, &rmetav1.GetOptions{Name: "sys:bootstrap-config"})
+	if err == nil {
+		zap.L().Debug("The Cluster is already initialized")
+		return nil
+	}
+	if !grpcerr.IsNotFound(err) {
+		return err
+	}
+
+	if cfg.domain == "" {
+		return errors.Errorf("The Cluster domain must be set via OCTELIUM_STANDALONE_DOMAIN")
+	}
+
+	token, err := genesis.NewStandalone(octeliumC).RunInitStandalone(ctx, rscSrv,
+		&genesis.StandaloneInitOpts{
+			Domain: cfg.domain,
+			Bootstrap: &cbootstrapv1.Config{
+				Spec: &cbootstrapv1.Config_Spec{
+					Network: &cbootstrapv1.Config_Spec_Network{
+						Mode: cfg.networkMode,
+					},
+				},
+			},
+		})
+	if err != nil {
+		return err
+	}
+
+	tokenPath := filepath.Join(cfg.dataDir, "init-token")
+	if err := os.WriteFile(tokenPath, []byte(token), 0600); err != nil {
+		return err
+	}
+
+	zap.L().Info("The Cluster is now initialized. The initial authentication token is written",
+		zap.String("path", tokenPath))
+
+	return nil
+}
+
+// setManagedServicePorts sets the managed Service ports to the local ports
+// of their in-process servers.
+func setManagedServicePorts(ctx context.Context, octeliumC octeliumc.ClientInterface) error {
+	for name, port := range managedServicePorts {
+		svc, err := octeliumC.CoreC().GetService(ctx, &rmetav1.GetOptions{Name: name})
+		if err != nil {
+			if grpcerr.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+
+		if svc.Status.ManagedService == nil ||
+			svc.Status.ManagedService.Port == uint32(port) {
+			continue
+		}
+
+		svc.Status.ManagedService.Port = uint32(port)
+		if _, err := octeliumC.CoreC().UpdateService(ctx, svc); err != nil {
+			return errors.Errorf("Could not set the managed Service %s port: %+v", name, err)
+		}
+	}
+
+	return nil
+}
+
+func getListenHost(svcAddr *corev1.Service_Status_Address) string {
+	if svcAddr == nil || svcAddr.DualStackIP == nil {
+		return ""
+	}
+	if svcAddr.DualStackIP.Ipv4 != "" {
+		return svcAddr.DualStackIP.Ipv4
+	}
+	return svcAddr.DualStackIP.Ipv6
+}
@@ -0,0 +1,370 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package standalone
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"os/exec"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	embeddedpostgres "github.com/fergusstrange/embedded-postgres"
+	"github.com/octelium/octelium/pkg/utils/utilrand"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	postgresPort = 50120
+	redisPort    = 50121
+)
+
+// envPostgresProcess marks the child process running the embedded Postgres.
+// Postgres refuses to run as root and is therefore run by re-executing the
+// binary as an unprivileged user.
+const envPostgresProcess = "OCTELIUM_STANDALONE_POSTGRES_PROCESS"
+const envPostgresDir = "OCTELIUM_STANDALONE_POSTGRES_DIR"
+
+type storage struct {
+	redis    *childProcess
+	postgres *childProcess
+}
+
+// childProcess is a storage server run as a child process. The child is
+// signaled with stopSignal upon the exit of the standalone process.
+type childProcess struct {
+	name       string
+	cmd        *exec.Cmd
+	stopSignal syscall.Signal
+	doneCh     chan struct{}
+}
+
+// newStorage runs the embedded Postgres and Redis servers unless external
+// ones are set via the OCTELIUM_POSTGRES_HOST and OCTELIUM_REDIS_HOST env vars.
+func newStorage(ctx context.Context, cfg *config) (*storage, error) {
+	ret := &storage{}
+
+	if os.Getenv("OCTELIUM_REDIS_HOST") == "" {
+		if err := ret.runRedis(ctx, cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	if os.Getenv("OCTELIUM_POSTGRES_HOST") == "" {
+		if err := ret.runPostgres(ctx, cfg); err != nil {
+			ret.close()
+			return nil, err
+		}
+	}
+
+	return ret, nil
+}
+
+// runRedis runs the Redis server installed on the host, which is shipped
+// with the standalone container image. Redis is mainly used as a cache and a
+// message broker by the Cluster components and its data is persisted in the
+// data dir via the append-only file.
+func (s *storage) runRedis(ctx context.Context, cfg *config) error {
+	redisServer, err := exec.LookPath(cfg.redisServer)
+	if err != nil {
+		return errors.Errorf(
+			"Could not find the Redis server %s. Either install Redis or set OCTELIUM_REDIS_HOST to use an external Redis: %+v",
+			cfg.redisServer, err)
+	}
+
+	password, err := getPassword(cfg.dataDir, "redis-password")
+	if err != nil {
+		return err
+	}
+
+	redisDir := filepath.Join(cfg.dataDir, "redis")
+	if err := os.MkdirAll(redisDir, 0700); err != nil {
+		return err
+	}
+
+	confPath := filepath.Join(redisDir, "redis.conf")
+	if err := os.WriteFile(confPath, []byte(getRedisConfig(redisDir, password)), 0600); err != nil {
+		return err
+	}
+
+	os.Setenv("OCTELIUM_REDIS_HOST", localhost)
+	os.Setenv("OCTELIUM_REDIS_PORT", strconv.Itoa(redisPort))
+	os.Setenv("OCTELIUM_REDIS_PASSWORD", password)
+
+	s.redis, err = startChildProcess("Redis", exec.Command(redisServer, confPath),
+		syscall.SIGTERM, redisDir, cfg)
+	if err != nil {
+		return err
+	}
+
+	return s.redis.waitForPort(ctx, redisPort, time.Minute)
+}
+
+func getRedisConfig(dir, password string) string {
+	return fmt.Sprintf(`bind %s
+port %d
+protected-mode yes
+dir %s
+appendonly yes
+requirepass %s
+`, localhost, redisPort, dir, password)
+}
+
+func (s *storage) runPostgres(ctx context.Context, cfg *config) error {
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	password, err := getPassword(cfg.dataDir, "postgres-password")
+	if err != nil {
+		return err
+	}
+
+	pgDir := filepath.Join(cfg.dataDir, "postgres")
+	if err := os.MkdirAll(pgDir, 0700); err != nil {
+		return err
+	}
+
+	os.Setenv("OCTELIUM_POSTGRES_HOST", localhost)
+	os.Setenv("OCTELIUM_POSTGRES_PORT", strconv.Itoa(postgresPort))
+	os.Setenv("OCTELIUM_POSTGRES_USERNAME", "postgres")
+	os.Setenv("OCTELIUM_POSTGRES_PASSWORD", password)
+	os.Setenv("OCTELIUM_POSTGRES_DATABASE", "octelium")
+	os.Setenv("OCTELIUM_POSTGRES_NOSSL", "true")
+
+	cmd := exec.Command(exe)
+	cmd.Env = append(os.Environ(),
+		envPostgresProcess+"=true",
+		envPostgresDir+"="+pgDir)
+
+	// The child stops Postgres upon SIGINT
+	s.postgres, err = startChildProcess("Postgres", cmd, syscall.SIGINT, pgDir, cfg)
+	if err != nil {
+		return err
+	}
+
+	if cfg.postgresBinariesDir == "" {
+		zap.L().Info("The PostgreSQL binaries are downloaded upon the first run. " +
+			"Set OCTELIUM_STANDALONE_POSTGRES_BINARIES_DIR to use an existing PostgreSQL installation instead")
+	}
+
+	// The first run might take a while since the Postgres binaries might be
+	// downloaded and the data dir is initialized
+	return s.postgres.waitForPort(ctx, postgresPort, 5*time.Minute)
+}
+
+// startChildProcess starts the storage server process. The process is run by
+// the storage user, which owns the dir of the server, if running as root.
+func startChildProcess(name string, cmd *exec.Cmd, stopSignal syscall.Signal,
+	dir string, cfg *config) (*childProcess, error) {
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Pdeathsig: stopSignal,
+	}
+
+	if os.Geteuid() == 0 {
+		uid, gid, err := lookupUser(cfg.storageUser)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := filepath.WalkDir(dir, func(path string, _ fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			return os.Lchown(path, int(uid), int(gid))
+		}); err != nil {
+			return nil, err
+		}
+
+		cmd.SysProcAttr.Credential = &syscall.Credential{
+			Uid: uid,
+			Gid: gid,
+		}
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, errors.Errorf("Could not start the embedded %s process: %+v", name, err)
+	}
+
+	ret := &childProcess{
+		name:       name,
+		cmd:        cmd,
+		stopSignal: stopSignal,
+		doneCh:     make(chan struct{}),
+	}
+
+	go func() {
+		if err := cmd.Wait(); err != nil {
+			zap.L().Warn("The embedded storage process exited", zap.String("name", name), zap.Error(err))
+		}
+		close(ret.doneCh)
+	}()
+
+	return ret, nil
+}
+
+func (p *childProcess) waitForPort(ctx context.Context, port int, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		conn, err := net.DialTimeout("tcp",
+			net.JoinHostPort(localhost, strconv.Itoa(port)), time.Second)
+		if err == nil {
+			conn.Close()
+			zap.L().Debug("The embedded storage is now running", zap.String("name", p.name))
+			return nil
+		}
+
+		select {
+		case <-p.doneCh:
+			return errors.Errorf("The embedded %s process exited before being ready", p.name)
+		case <-ctx.Done():
+			return errors.Errorf("Timeout waiting for the embedded %s", p.name)
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func (p *childProcess) stop() {
+	if p == nil || p.cmd.Process == nil {
+		return
+	}
+
+	if err := p.cmd.Process.Signal(p.stopSignal); err != nil {
+		return
+	}
+
+	select {
+	case <-p.doneCh:
+	case <-time.After(30 * time.Second):
+		zap.L().Warn("Timeout waiting for the embedded storage process to exit", zap.String("name", p.name))
+	}
+}
+
+func (s *storage) close() {
+	s.postgres.stop()
+	s.redis.stop()
+}
+
+// getPassword returns the password persisted in the data dir or generates
+// one upon the first run.
+func getPassword(dataDir, name string) (string, error) {
+	passwordPath := filepath.Join(dataDir, name)
+
+	password, err := os.ReadFile(passwordPath)
+	if err == nil {
+		return strings.TrimSpace(string(password)), nil
+	}
+	if !os.IsNotExist(err) {
+		return "", err
+	}
+
+	ret := utilrand.GetRandomStringCanonical(32)
+	if err := os.WriteFile(passwordPath, []byte(ret), 0600); err != nil {
+		return "", err
+	}
+
+	return ret, nil
+}
+
+func lookupUser(name string) (uint32, uint32, error) {
+	usr, err := user.Lookup(name)
+	if err != nil {
+		return 0, 0, errors.Errorf("Could not find the storage user %s: %+v", name, err)
+	}
+
+	uid, err := strconv.ParseUint(usr.Uid, 10, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	gid, err := strconv.ParseUint(usr.Gid, 10, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return uint32(uid), uint32(gid), nil
+}
+
+func isPostgresProcess() bool {
+	return os.Getenv(envPostgresProcess) == "true"
+}
+
+// runPostgresProcess runs the embedded Postgres within the child process
+// until it is signaled to stop. The data is persisted in the data dir across
+// restarts. The PostgreSQL binaries are downloaded from Maven Central, or
+// from the repository set via OCTELIUM_STANDALONE_POSTGRES_REPOSITORY_URL,
+// upon the first run unless an existing PostgreSQL installation is set via
+// OCTELIUM_STANDALONE_POSTGRES_BINARIES_DIR, which is required for
+// air-gapped installations.
+func runPostgresProcess(ctx context.Context) error {
+	pgDir := os.Getenv(envPostgresDir)
+	if pgDir == "" {
+		return errors.Errorf("The embedded Postgres dir is not set")
+	}
+
+	port, err := strconv.Atoi(os.Getenv("OCTELIUM_POSTGRES_PORT"))
+	if err != nil {
+		return err
+	}
+
+	binariesDir := os.Getenv("OCTELIUM_STANDALONE_POSTGRES_BINARIES_DIR")
+	if binariesDir == "" {
+		binariesDir = filepath.Join(pgDir, "bin")
+	}
+
+	pgConfig := embeddedpostgres.DefaultConfig().
+		Version(embeddedpostgres.V17).
+		Port(uint32(port)).
+		Username(os.Getenv("OCTELIUM_POSTGRES_USERNAME")).
+		Password(os.Getenv("OCTELIUM_POSTGRES_PASSWORD")).
+		Database(os.Getenv("OCTELIUM_POSTGRES_DATABASE")).
+		DataPath(filepath.Join(pgDir, "data")).
+		RuntimePath(filepath.Join(pgDir, "runtime")).
+		BinariesPath(binariesDir).
+		CachePath(filepath.Join(pgDir, "cache")).
+		StartTimeout(time.Minute).
+		Logger(os.Stderr)
+
+	if repositoryURL := os.Getenv("OCTELIUM_STANDALONE_POSTGRES_REPOSITORY_URL"); repositoryURL != "" {
+		pgConfig = pgConfig.BinaryRepositoryURL(repositoryURL)
+	}
+
+	pg := embeddedpostgres.NewDatabase(pgConfig)
+
+	if err := pg.Start(); err != nil {
+		return errors.Errorf("Could not start the embedded Postgres: %+v", err)
+	}
+
+	zap.L().Info("The embedded Postgres is now running")
+
+	<-ctx.Done()
+
+	return pg.Stop()
+}
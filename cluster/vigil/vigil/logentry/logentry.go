@@ -36,6 +36,10 @@ type InitializeLogEntryOpts struct {
 	SessionID       string
 	Sequence        int64
 	Reason          *corev1.AccessLog_Entry_Common_Reason
+	TraceID         string
+	SpanID          string
+
+	ServiceConfigName string
 }
 
 func InitializeLogEntry(opts *InitializeLogEntryOpts) *corev1.AccessLog {
@@ -81,6 +85,10 @@ func InitializeLogEntry(opts *InitializeLogEntryOpts) *corev1.AccessLog {
 			ConnectionID: opts.ConnectionID,
 			SessionID:    opts.SessionID,
 			Sequence:     opts.Sequence,
+			TraceID:      opts.TraceID,
+			SpanID:       opts.SpanID,
+
+			ServiceConfigName: opts.ServiceConfigName,
 		},
 		Info: &corev1.AccessLog_Entry_Info{},
 	}
@@ -18,6 +18,8 @@ package modes
 
 import (
 	"context"
+	"net"
+	"strconv"
 
 	"github.com/octelium/octelium/apis/cluster/coctovigilv1"
 	"github.com/octelium/octelium/apis/main/corev1"
@@ -43,6 +45,26 @@ type Opts struct {
 
 	PostAuthorize func(ctx context.Context, req *PostAuthorizeRequest) (*PostAuthorizeResponse, error)
 	GetUpstream   func(ctx context.Context, opts *Opts, reqCtx *corev1.RequestContext) (*loadbalancer.Upstream, error)
+
+	// ListenHost sets the host on which the Service is served. All the
+	// addresses are used if not set, which is the case whenever the Vigil
+	// runs in its own pod.
+	ListenHost string
+}
+
+// GetListenAddr returns the address on which the mode server listens.
+func GetListenAddr(host string, port int) string {
+	return net.JoinHostPort(host, strconv.Itoa(port))
+}
+
+// GetListenIP returns the IP address on which the UDP-based mode servers
+// listen. The unspecified address is used if host is not set.
+func GetListenIP(host string) net.IP {
+	if host == "" {
+		return net.ParseIP("::")
+	}
+
+	return net.ParseIP(host)
 }
 
 type PostAuthorizeRequest struct {
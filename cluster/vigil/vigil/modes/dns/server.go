@@ -29,6 +29,7 @@ import (
 	"github.com/octelium/octelium/apis/main/metav1"
 	"github.com/octelium/octelium/cluster/common/octeliumc"
 	"github.com/octelium/octelium/cluster/common/otelutils"
+	"github.com/octelium/octelium/cluster/common/vutils"
 	"github.com/octelium/octelium/cluster/vigil/vigil/controllers"
 	"github.com/octelium/octelium/cluster/vigil/vigil/loadbalancer"
 	"github.com/octelium/octelium/cluster/vigil/vigil/logentry"
@@ -59,6 +60,7 @@ type Server struct {
 	svcCtl     *controllers.ServiceController
 	sessionCtl *controllers.SessionController
 	lbManager  *loadbalancer.LBManager
+	listenHost string
 
 	// logManager *logmanager.LogManager
 	secretMan *secretman.SecretManager
@@ -78,6 +80,7 @@ func New(ctx context.Context, opts *modes.Opts) (*Server, error) {
 		vCache:       opts.VCache,
 		octeliumC:    opts.OcteliumC,
 		lbManager:    opts.LBManager,
+		listenHost:   opts.ListenHost,
 		svcCtl:       &controllers.ServiceController{},
 		sessionCtl:   &controllers.SessionController{},
 		secretMan:    opts.SecretMan,
@@ -108,7 +111,7 @@ func (s *Server) Run(ctx context.Context) error {
 	s.svcRef = umetav1.GetObjectReference(svc)
 
 	addr := &net.UDPAddr{
-		IP:   net.ParseIP("::"),
+		IP:   modes.GetListenIP(s.listenHost),
 		Port: ucorev1.ToService(svc).RealPort(),
 	}
 
@@ -280,6 +283,10 @@ func (s *Server) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 	clientReq := dns.Msg{}
 	clientReq.SetQuestion(q.Name, q.Qtype)
 
+	if isClusterDNS(svc) {
+		setSessionUIDOption(&clientReq, authResp.RequestContext.Session.Metadata.Uid)
+	}
+
 	proxiedMsg, _, err := client.Exchange(&clientReq, upstream.HostPort)
 	if err != nil {
 		zap.L().Warn("Could not do exchange", zap.Error(err), zap.String("upstream", upstream.HostPort))
@@ -301,3 +308,20 @@ func (s *Server) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 	}
 	otelutils.EmitAccessLog(logE)
 }
+
+// isClusterDNS returns whether the Service is the Cluster DNS Service whose
+// upstream is the Cluster DNS server.
+func isClusterDNS(svc *corev1.Service) bool {
+	return svc.Status.ManagedService != nil && svc.Status.ManagedService.Type == "dnsserver"
+}
+
+// setSessionUIDOption passes the querying Session UID to the Cluster DNS server
+// in order to filter the answers according to the Session's authorization.
+func setSessionUIDOption(m *dns.Msg, sessionUID string) {
+	m.SetEdns0(dns.DefaultMsgSize, false)
+	opt := m.IsEdns0()
+	opt.Option = append(opt.Option, &dns.EDNS0_LOCAL{
+		Code: vutils.DNSSessionUIDOptionCode,
+		Data: []byte(sessionUID),
+	})
+}
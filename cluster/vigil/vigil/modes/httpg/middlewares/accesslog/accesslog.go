@@ -114,6 +114,12 @@ func (m *middleware) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		IsAuthorized:    reqCtx.IsAuthorized || httputils.IsAnonymousMode(req),
 		ReqCtx:          reqCtx.DownstreamInfo,
 		Reason:          reqCtx.DecisionReason,
+		TraceID:         reqCtx.TraceID,
+		SpanID:          reqCtx.SpanID,
+	}
+
+	if reqCtx.AuthResponse != nil {
+		opts.ServiceConfigName = reqCtx.AuthResponse.ServiceConfigName
 	}
 
 	logE := logentry.InitializeLogEntry(opts)
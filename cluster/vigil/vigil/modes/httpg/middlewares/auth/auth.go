@@ -24,6 +24,7 @@ import (
 	"github.com/octelium/octelium/apis/main/corev1"
 	"github.com/octelium/octelium/cluster/common/celengine"
 	"github.com/octelium/octelium/cluster/common/octeliumc"
+	"github.com/octelium/octelium/cluster/common/svcconfig"
 	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/httputils"
 	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares"
 	"github.com/octelium/octelium/cluster/vigil/vigil/octovigilc"
@@ -210,7 +211,8 @@ func (m *middleware) getDownstreamReq(req *http.Request,
 
 func (s *middleware) getServiceConfigName(ctx context.Context, reqCtx *corev1.RequestContext) string {
 	svc := reqCtx.Service
-	if svc.Spec.DynamicConfig == nil || len(svc.Spec.DynamicConfig.Rules) < 1 {
+	if svc.Spec.DynamicConfig == nil ||
+		(len(svc.Spec.DynamicConfig.Rules) < 1 && svc.Spec.DynamicConfig.Split == nil) {
 		return ""
 	}
 
@@ -233,5 +235,5 @@ func (s *middleware) getServiceConfigName(ctx context.Context, reqCtx *corev1.Re
 		}
 	}
 
-	return ""
+	return svcconfig.GetSplitConfigName(ctx, s.celEngine, reqCtx, inputMap)
 }
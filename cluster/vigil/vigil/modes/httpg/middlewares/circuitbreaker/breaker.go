@@ -0,0 +1,222 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package circuitbreaker
+
+import (
+	"sync"
+	"time"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/pkg/apiutils/umetav1"
+)
+
+type state int
+
+const (
+	stateClosed state = iota
+	stateOpen
+	stateHalfOpen
+)
+
+func (s state) String() string {
+	switch s {
+	case stateOpen:
+		return "OPEN"
+	case stateHalfOpen:
+		return "HALF_OPEN"
+	default:
+		return "CLOSED"
+	}
+}
+
+type breakerOpts struct {
+	interval             time.Duration
+	minRequests          uint32
+	errorRatePercentage  uint32
+	slowRequestThreshold time.Duration
+	slowRatePercentage   uint32
+	openDuration         time.Duration
+	halfOpenMaxRequests  uint32
+}
+
+func getBreakerOpts(cfg *corev1.Service_Spec_Config_HTTP_CircuitBreaker) *breakerOpts {
+	ret := &breakerOpts{
+		interval:             10 * time.Second,
+		minRequests:          20,
+		errorRatePercentage:  50,
+		slowRatePercentage:   50,
+		openDuration:         30 * time.Second,
+		halfOpenMaxRequests:  5,
+		slowRequestThreshold: umetav1.ToDuration(cfg.SlowRequestThreshold).ToGo(),
+	}
+
+	if val := umetav1.ToDuration(cfg.Interval).ToGo(); val > 0 {
+		ret.interval = val
+	}
+	if cfg.MinRequests > 0 {
+		ret.minRequests = cfg.MinRequests
+	}
+	if cfg.ErrorRatePercentage > 0 {
+		ret.errorRatePercentage = cfg.ErrorRatePercentage
+	}
+	if cfg.SlowRatePercentage > 0 {
+		ret.slowRatePercentage = cfg.SlowRatePercentage
+	}
+	if val := umetav1.ToDuration(cfg.OpenDuration).ToGo(); val > 0 {
+		ret.openDuration = val
+	}
+	if cfg.HalfOpenMaxRequests > 0 {
+		ret.halfOpenMaxRequests = cfg.HalfOpenMaxRequests
+	}
+
+	return ret
+}
+
+type breaker struct {
+	mu   sync.Mutex
+	opts *breakerOpts
+	now  func() time.Time
+
+	state       state
+	windowStart time.Time
+	openedAt    time.Time
+
+	total  uint32
+	failed uint32
+	slow   uint32
+
+	halfOpenInflight  uint32
+	halfOpenSucceeded uint32
+
+	onStateChange func(from, to state)
+}
+
+func newBreaker(opts *breakerOpts) *breaker {
+	return &breaker{
+		opts: opts,
+		now:  time.Now,
+	}
+}
+
+// allow reports whether a request can be forwarded to the upstream. If not,
+// it returns the duration after which the circuit breaker could be tried again.
+func (b *breaker) allow() (bool, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+
+	switch b.state {
+	case stateOpen:
+		remaining := b.openedAt.Add(b.opts.openDuration).Sub(now)
+		if remaining > 0 {
+			return false, remaining
+		}
+		b.setState(stateHalfOpen, now)
+		fallthrough
+	case stateHalfOpen:
+		if b.halfOpenInflight+b.halfOpenSucceeded >= b.opts.halfOpenMaxRequests {
+			return false, time.Second
+		}
+		b.halfOpenInflight++
+		return true, 0
+	default:
+		if now.Sub(b.windowStart) >= b.opts.interval {
+			b.resetWindow(now)
+		}
+		return true, 0
+	}
+}
+
+// done records the result of a request previously allowed by allow.
+func (b *breaker) done(isFailed bool, duration time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	isSlow := b.opts.slowRequestThreshold > 0 && duration >= b.opts.slowRequestThreshold
+
+	switch b.state {
+	case stateHalfOpen:
+		if b.halfOpenInflight > 0 {
+			b.halfOpenInflight--
+		}
+		if isFailed || isSlow {
+			b.setState(stateOpen, now)
+			return
+		}
+
+		b.halfOpenSucceeded++
+		if b.halfOpenSucceeded >= b.opts.halfOpenMaxRequests {
+			b.setState(stateClosed, now)
+		}
+	case stateClosed:
+		if now.Sub(b.windowStart) >= b.opts.interval {
+			b.resetWindow(now)
+		}
+
+		b.total++
+		if isFailed {
+			b.failed++
+		}
+		if isSlow {
+			b.slow++
+		}
+
+		if b.total < b.opts.minRequests {
+			return
+		}
+
+		if b.failed*100 >= b.opts.errorRatePercentage*b.total ||
+			(b.opts.slowRequestThreshold > 0 && b.slow*100 >= b.opts.slowRatePercentage*b.total) {
+			b.setState(stateOpen, now)
+		}
+	}
+}
+
+func (b *breaker) getState() state {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+func (b *breaker) setState(to state, now time.Time) {
+	from := b.state
+	if from == to {
+		return
+	}
+
+	b.state = to
+	b.halfOpenInflight = 0
+	b.halfOpenSucceeded = 0
+	b.resetWindow(now)
+
+	if to == stateOpen {
+		b.openedAt = now
+	}
+
+	if b.onStateChange != nil {
+		b.onStateChange(from, to)
+	}
+}
+
+func (b *breaker) resetWindow(now time.Time) {
+	b.windowStart = now
+	b.total = 0
+	b.failed = 0
+	b.slow = 0
+}
@@ -0,0 +1,221 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package circuitbreaker
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/cluster/common/otelutils"
+	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+type middleware struct {
+	next     http.Handler
+	mu       sync.Mutex
+	breakers map[string]*breakerEntry
+	metrics  *breakerMetrics
+}
+
+type breakerEntry struct {
+	cfg     *corev1.Service_Spec_Config_HTTP_CircuitBreaker
+	breaker *breaker
+}
+
+func New(ctx context.Context, next http.Handler) (http.Handler, error) {
+	metrics, err := newBreakerMetrics()
+	if err != nil {
+		return nil, err
+	}
+
+	return &middleware{
+		next:     next,
+		breakers: make(map[string]*breakerEntry),
+		metrics:  metrics,
+	}, nil
+}
+
+func (m *middleware) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	reqCtx := middlewares.GetCtxRequestContext(req.Context())
+	svcCfg := reqCtx.ServiceConfig
+
+	if svcCfg == nil || svcCfg.GetHttp() == nil ||
+		svcCfg.GetHttp().CircuitBreaker == nil {
+		m.next.ServeHTTP(rw, req)
+		return
+	}
+
+	cfgName := getConfigName(svcCfg)
+	b := m.getBreaker(cfgName, svcCfg.GetHttp().CircuitBreaker)
+
+	isAllowed, retryAfter := b.allow()
+	if !isAllowed {
+		m.metrics.rejected.Add(req.Context(), 1, metric.WithAttributes(
+			attribute.String("config", cfgName)))
+
+		rw.Header().Set("Server", "octelium")
+		rw.Header().Set("Retry-After", fmt.Sprintf("%d", int(math.Ceil(retryAfter.Seconds()))))
+		rw.WriteHeader(http.StatusServiceUnavailable)
+		rw.Write([]byte(http.StatusText(http.StatusServiceUnavailable)))
+		return
+	}
+
+	crw := &responseWriter{
+		ResponseWriter: rw,
+		statusCode:     http.StatusOK,
+		startedAt:      time.Now(),
+	}
+
+	m.next.ServeHTTP(crw, req)
+
+	duration := crw.firstByteDuration
+	if duration == 0 {
+		duration = time.Since(crw.startedAt)
+	}
+
+	b.done(isFailedStatusCode(crw.statusCode), duration)
+}
+
+func getConfigName(cfg *corev1.Service_Spec_Config) string {
+	if cfg.Name == "" {
+		return "default"
+	}
+	return cfg.Name
+}
+
+func isFailedStatusCode(statusCode int) bool {
+	switch {
+	case statusCode >= 500 && statusCode < 600:
+		return true
+	default:
+		return false
+	}
+}
+
+func (m *middleware) getBreaker(cfgName string,
+	cfg *corev1.Service_Spec_Config_HTTP_CircuitBreaker) *breaker {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if entry, ok := m.breakers[cfgName]; ok && proto.Equal(entry.cfg, cfg) {
+		return entry.breaker
+	}
+
+	b := newBreaker(getBreakerOpts(cfg))
+	b.onStateChange = func(from, to state) {
+		zap.L().Debug("Circuit breaker state changed",
+			zap.String("config", cfgName),
+			zap.String("from", from.String()),
+			zap.String("to", to.String()))
+		m.metrics.setState(cfgName, to)
+	}
+
+	m.breakers[cfgName] = &breakerEntry{
+		cfg:     cfg,
+		breaker: b,
+	}
+	m.metrics.setState(cfgName, stateClosed)
+
+	return b
+}
+
+type responseWriter struct {
+	http.ResponseWriter
+	statusCode        int
+	isHeaderWritten   bool
+	startedAt         time.Time
+	firstByteDuration time.Duration
+}
+
+func (w *responseWriter) WriteHeader(statusCode int) {
+	if !w.isHeaderWritten {
+		w.statusCode = statusCode
+		w.isHeaderWritten = true
+		w.firstByteDuration = time.Since(w.startedAt)
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	if !w.isHeaderWritten {
+		w.isHeaderWritten = true
+		w.firstByteDuration = time.Since(w.startedAt)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hj, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.Errorf("ResponseWriter is not a Hijacker")
+	}
+
+	return hj.Hijack()
+}
+
+func (w *responseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+type breakerMetrics struct {
+	state    metric.Int64Gauge
+	rejected metric.Int64Counter
+}
+
+func newBreakerMetrics() (*breakerMetrics, error) {
+	ret := &breakerMetrics{}
+	var err error
+
+	meter := otelutils.GetMeter()
+
+	ret.state, err = meter.Int64Gauge(otelutils.GetComponentKeyWithPrefix("http.circuit_breaker.state"),
+		metric.WithDescription("Circuit breaker state: 0 is closed, 1 is open and 2 is half-open"))
+	if err != nil {
+		return nil, err
+	}
+
+	ret.rejected, err = meter.Int64Counter(otelutils.GetComponentKeyWithPrefix("http.circuit_breaker.req.rejected"),
+		metric.WithDescription("Number of requests rejected by an open circuit breaker"))
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (m *breakerMetrics) setState(cfgName string, s state) {
+	m.state.Record(context.Background(), int64(s), metric.WithAttributes(
+		attribute.String("config", cfgName)))
+}
@@ -0,0 +1,160 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package circuitbreaker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBreaker(t *testing.T) {
+	now := time.Now()
+
+	b := newBreaker(getBreakerOpts(&corev1.Service_Spec_Config_HTTP_CircuitBreaker{
+		MinRequests:         4,
+		ErrorRatePercentage: 50,
+		HalfOpenMaxRequests: 2,
+		SlowRequestThreshold: &metav1.Duration{
+			Type: &metav1.Duration_Seconds{
+				Seconds: 2,
+			},
+		},
+	}))
+	b.now = func() time.Time {
+		return now
+	}
+
+	for range 3 {
+		isAllowed, _ := b.allow()
+		assert.True(t, isAllowed)
+		b.done(true, time.Millisecond)
+	}
+	assert.Equal(t, stateClosed, b.getState())
+
+	isAllowed, _ := b.allow()
+	assert.True(t, isAllowed)
+	b.done(false, time.Millisecond)
+	assert.Equal(t, stateOpen, b.getState())
+
+	isAllowed, retryAfter := b.allow()
+	assert.False(t, isAllowed)
+	assert.Equal(t, 30*time.Second, retryAfter)
+
+	now = now.Add(31 * time.Second)
+
+	isAllowed, _ = b.allow()
+	assert.True(t, isAllowed)
+	assert.Equal(t, stateHalfOpen, b.getState())
+	isAllowed, _ = b.allow()
+	assert.True(t, isAllowed)
+	isAllowed, _ = b.allow()
+	assert.False(t, isAllowed)
+
+	b.done(false, time.Millisecond)
+	assert.Equal(t, stateHalfOpen, b.getState())
+	b.done(false, time.Millisecond)
+	assert.Equal(t, stateClosed, b.getState())
+
+	for range 4 {
+		isAllowed, _ := b.allow()
+		assert.True(t, isAllowed)
+		b.done(false, 3*time.Second)
+	}
+	assert.Equal(t, stateOpen, b.getState())
+
+	now = now.Add(31 * time.Second)
+	isAllowed, _ = b.allow()
+	assert.True(t, isAllowed)
+	b.done(true, time.Millisecond)
+	assert.Equal(t, stateOpen, b.getState())
+}
+
+func TestBreakerWindow(t *testing.T) {
+	now := time.Now()
+
+	b := newBreaker(getBreakerOpts(&corev1.Service_Spec_Config_HTTP_CircuitBreaker{
+		MinRequests: 4,
+	}))
+	b.now = func() time.Time {
+		return now
+	}
+
+	for range 3 {
+		b.allow()
+		b.done(true, time.Millisecond)
+	}
+
+	now = now.Add(11 * time.Second)
+
+	for range 3 {
+		b.allow()
+		b.done(true, time.Millisecond)
+	}
+	assert.Equal(t, stateClosed, b.getState())
+}
+
+func TestMiddleware(t *testing.T) {
+	ctx := context.Background()
+
+	statusCode := http.StatusBadGateway
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(statusCode)
+	})
+
+	mdlwr, err := New(ctx, next)
+	assert.Nil(t, err)
+
+	svcCfg := &corev1.Service_Spec_Config{
+		Type: &corev1.Service_Spec_Config_Http{
+			Http: &corev1.Service_Spec_Config_HTTP{
+				CircuitBreaker: &corev1.Service_Spec_Config_HTTP_CircuitBreaker{
+					MinRequests: 2,
+				},
+			},
+		},
+	}
+
+	doReq := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "http://localhost/", nil)
+		req = req.WithContext(context.WithValue(context.Background(),
+			middlewares.CtxRequestContext,
+			&middlewares.RequestContext{
+				CreatedAt:     time.Now(),
+				ServiceConfig: svcCfg,
+			}))
+
+		rw := httptest.NewRecorder()
+		mdlwr.ServeHTTP(rw, req)
+		return rw
+	}
+
+	assert.Equal(t, http.StatusBadGateway, doReq().Code)
+	assert.Equal(t, http.StatusBadGateway, doReq().Code)
+
+	statusCode = http.StatusOK
+	rw := doReq()
+	assert.Equal(t, http.StatusServiceUnavailable, rw.Code)
+	assert.Equal(t, "30", rw.Header().Get("Retry-After"))
+}
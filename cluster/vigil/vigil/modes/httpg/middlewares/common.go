@@ -24,6 +24,8 @@ import (
 
 	"github.com/octelium/octelium/apis/cluster/coctovigilv1"
 	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/cluster/common/otelutils"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Constructor func(http.Handler) (http.Handler, error)
@@ -71,10 +73,51 @@ func (c Chain) Extend(chain Chain) Chain {
 	return c.Append(chain.constructors...)
 }
 
+// Traced wraps the handler built by c in a span named name. The span ends as
+// soon as the wrapped handler passes the request to next so that each phase
+// of the chain is recorded as a sibling span under the request span instead
+// of nesting all the subsequent phases.
+func Traced(name string, c Constructor) Constructor {
+	return func(next http.Handler) (http.Handler, error) {
+		handler, err := c(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			ctx := req.Context()
+			if ph, ok := ctx.Value(ctxPhase).(*phase); ok {
+				ph.span.End()
+				ctx = trace.ContextWithSpan(ctx, ph.parent)
+			}
+
+			next.ServeHTTP(w, req.WithContext(ctx))
+		}))
+		if err != nil {
+			return nil, err
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			ctx := req.Context()
+			parent := trace.SpanFromContext(ctx)
+			ctx, span := otelutils.GetTracer().Start(ctx, name)
+			defer span.End()
+
+			ctx = context.WithValue(ctx, ctxPhase, &phase{
+				span:   span,
+				parent: parent,
+			})
+
+			handler.ServeHTTP(w, req.WithContext(ctx))
+		}), nil
+	}
+}
+
+type phase struct {
+	span   trace.Span
+	parent trace.Span
+}
+
 type CtxKey string
 
 const (
 	CtxRequestContext CtxKey = "c-req-ctx"
+	ctxPhase          CtxKey = "c-phase"
 )
 
 type RequestContext struct {
@@ -95,6 +138,9 @@ type RequestContext struct {
 	BodyJSONMap map[string]any
 
 	ReqCtxMap map[string]any
+
+	TraceID string
+	SpanID  string
 }
 
 func GetCtxRequestContext(ctx context.Context) *RequestContext {
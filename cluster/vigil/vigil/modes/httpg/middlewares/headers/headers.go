@@ -144,7 +144,7 @@ func (m *middleware) setRequestHeaders(req *http.Request, reqCtx *middlewares.Re
 			}
 		}
 
-		removeOcteliumCookie(req)
+		RemoveOcteliumCookie(req)
 	}
 
 	if !isAnonymous && isManagedSvc &&
@@ -285,7 +285,8 @@ func (m *middleware) isOriginAllowed(origin string, allowOriginList []string) (b
 	return false, ""
 }
 
-func removeOcteliumCookie(req *http.Request) {
+// RemoveOcteliumCookie removes the Octelium access and refresh token cookies from the request.
+func RemoveOcteliumCookie(req *http.Request) {
 
 	var cookieHdr string
 
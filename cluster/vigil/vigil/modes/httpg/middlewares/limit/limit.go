@@ -0,0 +1,149 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package limit
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/cluster/common/otelutils"
+	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares"
+	"github.com/octelium/octelium/pkg/apiutils/umetav1"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/protobuf/proto"
+)
+
+const defaultMaxPendingDuration = 5 * time.Second
+
+type middleware struct {
+	next     http.Handler
+	mu       sync.Mutex
+	limiters map[string]*limiter
+	rejected metric.Int64Counter
+}
+
+type limiter struct {
+	cfg                *corev1.Service_Spec_Config_HTTP_Limit
+	sem                chan struct{}
+	pending            atomic.Int64
+	maxPending         int64
+	maxPendingDuration time.Duration
+}
+
+func New(ctx context.Context, next http.Handler) (http.Handler, error) {
+	rejected, err := otelutils.GetMeter().Int64Counter(
+		otelutils.GetComponentKeyWithPrefix("http.limit.req.rejected"),
+		metric.WithDescription("Number of requests rejected due to the concurrent upstream request limit"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &middleware{
+		next:     next,
+		limiters: make(map[string]*limiter),
+		rejected: rejected,
+	}, nil
+}
+
+func (m *middleware) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	reqCtx := middlewares.GetCtxRequestContext(req.Context())
+	svcCfg := reqCtx.ServiceConfig
+
+	if svcCfg == nil || svcCfg.GetHttp() == nil ||
+		svcCfg.GetHttp().Limit == nil || svcCfg.GetHttp().Limit.MaxConcurrentRequests == 0 {
+		m.next.ServeHTTP(rw, req)
+		return
+	}
+
+	cfgName := svcCfg.Name
+	if cfgName == "" {
+		cfgName = "default"
+	}
+
+	l := m.getLimiter(cfgName, svcCfg.GetHttp().Limit)
+
+	if !l.acquire(req.Context()) {
+		m.rejected.Add(req.Context(), 1, metric.WithAttributes(
+			attribute.String("config", cfgName)))
+
+		rw.Header().Set("Server", "octelium")
+		rw.Header().Set("Retry-After", "1")
+		rw.WriteHeader(http.StatusServiceUnavailable)
+		rw.Write([]byte(http.StatusText(http.StatusServiceUnavailable)))
+		return
+	}
+	defer l.release()
+
+	m.next.ServeHTTP(rw, req)
+}
+
+func (m *middleware) getLimiter(cfgName string, cfg *corev1.Service_Spec_Config_HTTP_Limit) *limiter {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if l, ok := m.limiters[cfgName]; ok && proto.Equal(l.cfg, cfg) {
+		return l
+	}
+
+	l := &limiter{
+		cfg:                cfg,
+		sem:                make(chan struct{}, cfg.MaxConcurrentRequests),
+		maxPending:         int64(cfg.MaxPendingRequests),
+		maxPendingDuration: defaultMaxPendingDuration,
+	}
+	if val := umetav1.ToDuration(cfg.MaxPendingDuration).ToGo(); val > 0 {
+		l.maxPendingDuration = val
+	}
+
+	m.limiters[cfgName] = l
+	return l
+}
+
+func (l *limiter) acquire(ctx context.Context) bool {
+	select {
+	case l.sem <- struct{}{}:
+		return true
+	default:
+	}
+
+	if l.pending.Add(1) > l.maxPending {
+		l.pending.Add(-1)
+		return false
+	}
+	defer l.pending.Add(-1)
+
+	timer := time.NewTimer(l.maxPendingDuration)
+	defer timer.Stop()
+
+	select {
+	case l.sem <- struct{}{}:
+		return true
+	case <-timer.C:
+		return false
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (l *limiter) release() {
+	<-l.sem
+}
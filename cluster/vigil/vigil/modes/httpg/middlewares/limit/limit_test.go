@@ -0,0 +1,98 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package limit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiddleware(t *testing.T) {
+	ctx := context.Background()
+
+	startedCh := make(chan struct{}, 8)
+	releaseCh := make(chan struct{})
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		startedCh <- struct{}{}
+		<-releaseCh
+		w.WriteHeader(http.StatusOK)
+	})
+
+	mdlwr, err := New(ctx, next)
+	assert.Nil(t, err)
+
+	svcCfg := &corev1.Service_Spec_Config{
+		Type: &corev1.Service_Spec_Config_Http{
+			Http: &corev1.Service_Spec_Config_HTTP{
+				Limit: &corev1.Service_Spec_Config_HTTP_Limit{
+					MaxConcurrentRequests: 1,
+					MaxPendingRequests:    1,
+					MaxPendingDuration: &metav1.Duration{
+						Type: &metav1.Duration_Seconds{
+							Seconds: 5,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	doReq := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "http://localhost/", nil)
+		req = req.WithContext(context.WithValue(context.Background(),
+			middlewares.CtxRequestContext,
+			&middlewares.RequestContext{
+				CreatedAt:     time.Now(),
+				ServiceConfig: svcCfg,
+			}))
+
+		rw := httptest.NewRecorder()
+		mdlwr.ServeHTTP(rw, req)
+		return rw
+	}
+
+	resCh := make(chan int, 2)
+	go func() {
+		resCh <- doReq().Code
+	}()
+	<-startedCh
+
+	go func() {
+		resCh <- doReq().Code
+	}()
+
+	assert.Eventually(t, func() bool {
+		l := mdlwr.(*middleware).getLimiter("default", svcCfg.GetHttp().Limit)
+		return l.pending.Load() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	rw := doReq()
+	assert.Equal(t, http.StatusServiceUnavailable, rw.Code)
+	assert.Equal(t, "1", rw.Header().Get("Retry-After"))
+
+	close(releaseCh)
+	assert.Equal(t, http.StatusOK, <-resCh)
+	assert.Equal(t, http.StatusOK, <-resCh)
+}
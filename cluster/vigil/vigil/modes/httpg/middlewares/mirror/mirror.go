@@ -0,0 +1,443 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package mirror
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/cluster/common/celengine"
+	"github.com/octelium/octelium/cluster/common/otelutils"
+	"github.com/octelium/octelium/cluster/common/rscutils"
+	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares"
+	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares/commonplugin"
+	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares/headers"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
+	"github.com/octelium/octelium/pkg/apiutils/umetav1"
+	"github.com/octelium/octelium/pkg/utils/utilrand"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultMaxBodySize           = 1_000_000
+	defaultMaxConcurrentRequests = 64
+	defaultTimeout               = 10 * time.Second
+)
+
+type middleware struct {
+	next      http.Handler
+	phase     corev1.Service_Spec_Config_HTTP_Plugin_Phase
+	celEngine *celengine.CELEngine
+	client    *http.Client
+	sems      sync.Map
+	metrics   *mirrorMetrics
+}
+
+func New(ctx context.Context,
+	next http.Handler, celEngine *celengine.CELEngine,
+	phase corev1.Service_Spec_Config_HTTP_Plugin_Phase) (http.Handler, error) {
+
+	metrics, err := newMirrorMetrics()
+	if err != nil {
+		return nil, err
+	}
+
+	return &middleware{
+		next:      next,
+		phase:     phase,
+		celEngine: celEngine,
+		client: &http.Client{
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		metrics: metrics,
+	}, nil
+}
+
+func (m *middleware) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	reqCtx := middlewares.GetCtxRequestContext(ctx)
+	cfg := reqCtx.ServiceConfig
+
+	if cfg == nil || cfg.GetHttp() == nil || len(cfg.GetHttp().Plugins) == 0 {
+		m.next.ServeHTTP(rw, req)
+		return
+	}
+
+	for _, plugin := range cfg.GetHttp().Plugins {
+		switch plugin.Type.(type) {
+		case *corev1.Service_Spec_Config_HTTP_Plugin_Mirror_:
+			if !commonplugin.ShouldEnforcePlugin(ctx, &commonplugin.ShouldEnforcePluginOpts{
+				Plugin:    plugin,
+				CELEngine: m.celEngine,
+				Phase:     m.phase,
+			}) {
+				continue
+			}
+
+			mirrorC := plugin.GetMirror()
+			if !shouldMirror(mirrorC, req) {
+				continue
+			}
+
+			upstreamURL, err := getMirrorURL(mirrorC, reqCtx.Service)
+			if err != nil {
+				zap.L().Debug("Could not get mirror upstream URL", zap.Error(err))
+				continue
+			}
+
+			m.serveAndMirror(rw, req, plugin.Name, mirrorC, upstreamURL)
+			return
+		default:
+			continue
+		}
+	}
+
+	m.next.ServeHTTP(rw, req)
+}
+
+func shouldMirror(mirrorC *corev1.Service_Spec_Config_HTTP_Plugin_Mirror, req *http.Request) bool {
+	if req.Header.Get("Upgrade") != "" {
+		return false
+	}
+
+	if req.ContentLength > int64(getMaxBodySize(mirrorC)) {
+		return false
+	}
+
+	if mirrorC.Percentage == 0 || mirrorC.Percentage >= 100 {
+		return true
+	}
+
+	return utilrand.GetRandomRangeMath(1, 100) <= int(mirrorC.Percentage)
+}
+
+func getMaxBodySize(mirrorC *corev1.Service_Spec_Config_HTTP_Plugin_Mirror) uint64 {
+	if mirrorC.MaxBodySize == 0 {
+		return defaultMaxBodySize
+	}
+	return mirrorC.MaxBodySize
+}
+
+func getMirrorURL(mirrorC *corev1.Service_Spec_Config_HTTP_Plugin_Mirror,
+	svc *corev1.Service) (*url.URL, error) {
+	switch mirrorC.Type.(type) {
+	case *corev1.Service_Spec_Config_HTTP_Plugin_Mirror_Url:
+		return getSchemeURL(mirrorC.GetUrl(), 0)
+	case *corev1.Service_Spec_Config_HTTP_Plugin_Mirror_ConfigName:
+		if svc == nil || svc.Spec.DynamicConfig == nil {
+			return nil, errors.Errorf("No dynamic configs")
+		}
+
+		for _, namedCfg := range svc.Spec.DynamicConfig.Configs {
+			if namedCfg.Name != mirrorC.GetConfigName() {
+				continue
+			}
+
+			var eps []*corev1.Service_Spec_Config_Upstream_Loadbalance_Endpoint
+			for _, ep := range ucorev1.ToService(svc).GetAllUpstreamEndpointsByConfig(
+				rscutils.GetMergedServiceConfig(namedCfg, svc)) {
+				if ep.User == "" {
+					eps = append(eps, ep)
+				}
+			}
+
+			if len(eps) == 0 {
+				return nil, errors.Errorf("No upstream endpoints for config: %s", namedCfg.Name)
+			}
+
+			ep := eps[utilrand.GetRandomRangeMath(0, len(eps)-1)]
+			return getSchemeURL(ep.Url, ucorev1.EndpointRealPort(ep))
+		}
+
+		return nil, errors.Errorf("Config not found: %s", mirrorC.GetConfigName())
+	default:
+		return nil, errors.Errorf("Mirror upstream is not set")
+	}
+}
+
+func getSchemeURL(arg string, port int) (*url.URL, error) {
+	u, err := url.Parse(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Host == "" {
+		return nil, errors.Errorf("Invalid mirror URL: %s", arg)
+	}
+
+	scheme := "http"
+	switch u.Scheme {
+	case "https", "wss":
+		scheme = "https"
+	}
+
+	host := u.Host
+	if u.Port() == "" && port != 0 {
+		host = net.JoinHostPort(u.Hostname(), fmt.Sprintf("%d", port))
+	}
+
+	return &url.URL{
+		Scheme: scheme,
+		Host:   host,
+		Path:   u.Path,
+	}, nil
+}
+
+func (m *middleware) getSemaphore(name string,
+	mirrorC *corev1.Service_Spec_Config_HTTP_Plugin_Mirror) chan struct{} {
+	size := int(mirrorC.MaxConcurrentRequests)
+	if size == 0 {
+		size = defaultMaxConcurrentRequests
+	}
+
+	ret, _ := m.sems.LoadOrStore(fmt.Sprintf("%s:%d", name, size), make(chan struct{}, size))
+	return ret.(chan struct{})
+}
+
+func (m *middleware) serveAndMirror(rw http.ResponseWriter, req *http.Request, name string,
+	mirrorC *corev1.Service_Spec_Config_HTTP_Plugin_Mirror, upstreamURL *url.URL) {
+
+	mirrorReq := &http.Request{
+		Method: req.Method,
+		Header: req.Header.Clone(),
+		URL: &url.URL{
+			Scheme:   upstreamURL.Scheme,
+			Host:     upstreamURL.Host,
+			Path:     strings.TrimSuffix(upstreamURL.Path, "/") + req.URL.Path,
+			RawQuery: req.URL.RawQuery,
+		},
+		Host: upstreamURL.Host,
+	}
+	removeCredentials(mirrorReq)
+	mirrorReq.Header.Set("X-Octelium-Mirror", "true")
+
+	var body *teeBody
+	if req.Body != nil && req.Body != http.NoBody && req.ContentLength != 0 {
+		body = &teeBody{
+			ReadCloser: req.Body,
+			max:        int(getMaxBodySize(mirrorC)),
+		}
+		req.Body = body
+	}
+
+	srw := &statusResponseWriter{
+		ResponseWriter: rw,
+		statusCode:     http.StatusOK,
+	}
+
+	startedAt := time.Now()
+	m.next.ServeHTTP(srw, req)
+	primaryDuration := time.Since(startedAt)
+
+	if body != nil {
+		if !body.isComplete() {
+			return
+		}
+		mirrorReq.Body = io.NopCloser(bytes.NewReader(body.buf.Bytes()))
+		mirrorReq.ContentLength = int64(body.buf.Len())
+	}
+
+	sem := m.getSemaphore(name, mirrorC)
+	select {
+	case sem <- struct{}{}:
+	default:
+		m.metrics.dropped.Add(context.Background(), 1)
+		return
+	}
+
+	go func() {
+		defer func() {
+			<-sem
+		}()
+		m.doMirror(mirrorReq, mirrorC, srw.statusCode, primaryDuration)
+	}()
+}
+
+// removeCredentials removes the downstream's Octelium credentials from the
+// mirror request since the mirror runs before the headers middleware and its
+// upstream can be any external address.
+func removeCredentials(req *http.Request) {
+	req.Header.Del("Authorization")
+	req.Header.Del("Proxy-Authorization")
+
+	for name := range req.Header {
+		if strings.HasPrefix(name, "X-Octelium") {
+			req.Header.Del(name)
+		}
+	}
+
+	headers.RemoveOcteliumCookie(req)
+	if req.Header.Get("Cookie") == "" {
+		req.Header.Del("Cookie")
+	}
+}
+
+func (m *middleware) doMirror(req *http.Request,
+	mirrorC *corev1.Service_Spec_Config_HTTP_Plugin_Mirror, primaryStatusCode int, primaryDuration time.Duration) {
+	timeout := defaultTimeout
+	if mirrorC.Timeout != nil {
+		timeout = umetav1.ToDuration(mirrorC.Timeout).ToGo()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	startedAt := time.Now()
+	resp, err := m.client.Do(req.WithContext(ctx))
+	if err != nil {
+		zap.L().Debug("Could not do mirror request", zap.Error(err))
+		m.metrics.record(primaryStatusCode, 0, primaryDuration, time.Since(startedAt))
+		return
+	}
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<20))
+	resp.Body.Close()
+
+	m.metrics.record(primaryStatusCode, resp.StatusCode, primaryDuration, time.Since(startedAt))
+}
+
+// teeBody copies the request body read by the primary upstream so that it
+// can be sent to the mirror afterwards without delaying the primary request.
+type teeBody struct {
+	io.ReadCloser
+	buf      bytes.Buffer
+	max      int
+	exceeded bool
+	isEOF    bool
+}
+
+func (b *teeBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if n > 0 && !b.exceeded {
+		if b.buf.Len()+n > b.max {
+			b.exceeded = true
+			b.buf = bytes.Buffer{}
+		} else {
+			b.buf.Write(p[:n])
+		}
+	}
+	if err == io.EOF {
+		b.isEOF = true
+	}
+	return n, err
+}
+
+func (b *teeBody) isComplete() bool {
+	return b.isEOF && !b.exceeded
+}
+
+type statusResponseWriter struct {
+	http.ResponseWriter
+	statusCode    int
+	isHeaderWrite bool
+}
+
+func (w *statusResponseWriter) WriteHeader(statusCode int) {
+	if !w.isHeaderWrite {
+		w.statusCode = statusCode
+		w.isHeaderWrite = true
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *statusResponseWriter) Write(b []byte) (int, error) {
+	w.isHeaderWrite = true
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *statusResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *statusResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hj, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.Errorf("ResponseWriter is not a Hijacker")
+	}
+
+	return hj.Hijack()
+}
+
+func (w *statusResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+type mirrorMetrics struct {
+	total    metric.Int64Counter
+	dropped  metric.Int64Counter
+	duration metric.Float64Histogram
+}
+
+func newMirrorMetrics() (*mirrorMetrics, error) {
+	ret := &mirrorMetrics{}
+	var err error
+
+	meter := otelutils.GetMeter()
+
+	ret.total, err = meter.Int64Counter(otelutils.GetComponentKeyWithPrefix("http.mirror.req.total"),
+		metric.WithDescription("Total number of mirrored requests"))
+	if err != nil {
+		return nil, err
+	}
+
+	ret.dropped, err = meter.Int64Counter(otelutils.GetComponentKeyWithPrefix("http.mirror.req.dropped"),
+		metric.WithDescription("Number of requests not mirrored due to the concurrency limit"))
+	if err != nil {
+		return nil, err
+	}
+
+	ret.duration, err = meter.Float64Histogram(otelutils.GetComponentKeyWithPrefix("http.mirror.req.duration"),
+		metric.WithUnit("ms"), metric.WithDescription("Duration of primary and mirrored requests in milliseconds"))
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (m *mirrorMetrics) record(primaryStatusCode, mirrorStatusCode int,
+	primaryDuration, mirrorDuration time.Duration) {
+	ctx := context.Background()
+
+	m.total.Add(ctx, 1, metric.WithAttributes(
+		attribute.Int("primary.status_code", primaryStatusCode),
+		attribute.Int("mirror.status_code", mirrorStatusCode),
+		attribute.Bool("status_match", primaryStatusCode == mirrorStatusCode),
+	))
+
+	m.duration.Record(ctx, float64(primaryDuration.Nanoseconds())/1000000,
+		metric.WithAttributes(attribute.String("target", "primary")))
+	m.duration.Record(ctx, float64(mirrorDuration.Nanoseconds())/1000000,
+		metric.WithAttributes(attribute.String("target", "mirror")))
+}
@@ -0,0 +1,243 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package mirror
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/cluster/common/celengine"
+	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares"
+	"github.com/octelium/octelium/pkg/utils/utilrand"
+	"github.com/stretchr/testify/assert"
+)
+
+type mirroredReq struct {
+	method string
+	path   string
+	header http.Header
+	body   string
+}
+
+func TestMiddleware(t *testing.T) {
+	ctx := context.Background()
+
+	mirroredCh := make(chan *mirroredReq, 16)
+	mirrorSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mirroredCh <- &mirroredReq{
+			method: r.Method,
+			path:   r.URL.RequestURI(),
+			header: r.Header,
+			body:   string(body),
+		}
+		w.WriteHeader(http.StatusTeapot)
+	}))
+	t.Cleanup(mirrorSrv.Close)
+
+	var primaryBody string
+	var primaryHeader http.Header
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		primaryBody = string(body)
+		primaryHeader = r.Header
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	celEngine, err := celengine.New(ctx, &celengine.Opts{})
+	assert.Nil(t, err)
+	mdlwr, err := New(ctx, next, celEngine, corev1.Service_Spec_Config_HTTP_Plugin_POST_AUTH)
+	assert.Nil(t, err)
+
+	getCfg := func(mirrorC *corev1.Service_Spec_Config_HTTP_Plugin_Mirror) *corev1.Service_Spec_Config {
+		return &corev1.Service_Spec_Config{
+			Type: &corev1.Service_Spec_Config_Http{
+				Http: &corev1.Service_Spec_Config_HTTP{
+					Plugins: []*corev1.Service_Spec_Config_HTTP_Plugin{
+						{
+							Name: "mirror",
+							Condition: &corev1.Condition{
+								Type: &corev1.Condition_MatchAny{
+									MatchAny: true,
+								},
+							},
+							Type: &corev1.Service_Spec_Config_HTTP_Plugin_Mirror_{
+								Mirror: mirrorC,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	doReq := func(cfg *corev1.Service_Spec_Config, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "http://localhost/v1/items?a=b", strings.NewReader(body))
+		req.Header.Set("X-Custom", "value")
+		req.Header.Set("Authorization", "Bearer access-token")
+		req.Header.Set("X-Octelium-Auth", "access-token")
+		req.Header.Set("X-Octelium-Session-Uid", "session-uid")
+		req.AddCookie(&http.Cookie{Name: "octelium_auth", Value: "access-token"})
+		req.AddCookie(&http.Cookie{Name: "octelium_rt", Value: "refresh-token"})
+		req.AddCookie(&http.Cookie{Name: "app", Value: "app-value"})
+		req = req.WithContext(context.WithValue(context.Background(),
+			middlewares.CtxRequestContext,
+			&middlewares.RequestContext{
+				CreatedAt:     time.Now(),
+				ServiceConfig: cfg,
+			}))
+
+		rw := httptest.NewRecorder()
+		mdlwr.ServeHTTP(rw, req)
+		return rw
+	}
+
+	{
+		body := utilrand.GetRandomString(64)
+		rw := doReq(getCfg(&corev1.Service_Spec_Config_HTTP_Plugin_Mirror{
+			Type: &corev1.Service_Spec_Config_HTTP_Plugin_Mirror_Url{
+				Url: mirrorSrv.URL,
+			},
+		}), body)
+
+		assert.Equal(t, http.StatusCreated, rw.Code)
+		assert.Equal(t, body, primaryBody)
+		assert.Equal(t, "Bearer access-token", primaryHeader.Get("Authorization"))
+
+		select {
+		case m := <-mirroredCh:
+			assert.Equal(t, http.MethodPost, m.method)
+			assert.Equal(t, "/v1/items?a=b", m.path)
+			assert.Equal(t, "value", m.header.Get("X-Custom"))
+			assert.Equal(t, "true", m.header.Get("X-Octelium-Mirror"))
+			assert.Equal(t, body, m.body)
+
+			assert.Equal(t, "", m.header.Get("Authorization"))
+			assert.Equal(t, "", m.header.Get("X-Octelium-Auth"))
+			assert.Equal(t, "", m.header.Get("X-Octelium-Session-Uid"))
+			assert.Equal(t, "app=app-value", m.header.Get("Cookie"))
+			assert.NotContains(t, m.header.Get("Cookie"), "token")
+		case <-time.After(5 * time.Second):
+			t.Fatal("request was not mirrored")
+		}
+	}
+
+	{
+		body := utilrand.GetRandomString(64)
+		rw := doReq(getCfg(&corev1.Service_Spec_Config_HTTP_Plugin_Mirror{
+			Type: &corev1.Service_Spec_Config_HTTP_Plugin_Mirror_Url{
+				Url: mirrorSrv.URL,
+			},
+			MaxBodySize: 32,
+		}), body)
+
+		assert.Equal(t, http.StatusCreated, rw.Code)
+		assert.Equal(t, body, primaryBody)
+
+		select {
+		case <-mirroredCh:
+			t.Fatal("request exceeding maxBodySize must not be mirrored")
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+
+	{
+		body := utilrand.GetRandomString(64)
+		rw := doReq(getCfg(&corev1.Service_Spec_Config_HTTP_Plugin_Mirror{
+			Type: &corev1.Service_Spec_Config_HTTP_Plugin_Mirror_Url{
+				Url: "http://127.0.0.1:1",
+			},
+			Timeout: &metav1.Duration{
+				Type: &metav1.Duration_Seconds{
+					Seconds: 1,
+				},
+			},
+		}), body)
+
+		assert.Equal(t, http.StatusCreated, rw.Code)
+		assert.Equal(t, body, primaryBody)
+	}
+}
+
+func TestGetMirrorURL(t *testing.T) {
+	{
+		_, err := getMirrorURL(&corev1.Service_Spec_Config_HTTP_Plugin_Mirror{}, nil)
+		assert.NotNil(t, err)
+	}
+
+	{
+		u, err := getMirrorURL(&corev1.Service_Spec_Config_HTTP_Plugin_Mirror{
+			Type: &corev1.Service_Spec_Config_HTTP_Plugin_Mirror_Url{
+				Url: "https://example.com/prefix",
+			},
+		}, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, "https://example.com/prefix", u.String())
+	}
+
+	{
+		svc := &corev1.Service{
+			Metadata: &metav1.Metadata{
+				Name: "svc1.default",
+			},
+			Status: &corev1.Service_Status{},
+			Spec: &corev1.Service_Spec{
+				Config: &corev1.Service_Spec_Config{
+					Upstream: &corev1.Service_Spec_Config_Upstream{
+						Type: &corev1.Service_Spec_Config_Upstream_Url{
+							Url: "http://primary:8080",
+						},
+					},
+				},
+				DynamicConfig: &corev1.Service_Spec_DynamicConfig{
+					Configs: []*corev1.Service_Spec_Config{
+						{
+							Name: "shadow",
+							Upstream: &corev1.Service_Spec_Config_Upstream{
+								Type: &corev1.Service_Spec_Config_Upstream_Url{
+									Url: "grpc://shadow:9090",
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		u, err := getMirrorURL(&corev1.Service_Spec_Config_HTTP_Plugin_Mirror{
+			Type: &corev1.Service_Spec_Config_HTTP_Plugin_Mirror_ConfigName{
+				ConfigName: "shadow",
+			},
+		}, svc)
+		assert.Nil(t, err)
+		assert.Equal(t, "http://shadow:9090", u.String())
+
+		_, err = getMirrorURL(&corev1.Service_Spec_Config_HTTP_Plugin_Mirror{
+			Type: &corev1.Service_Spec_Config_HTTP_Plugin_Mirror_ConfigName{
+				ConfigName: "does-not-exist",
+			},
+		}, svc)
+		assert.NotNil(t, err)
+	}
+}
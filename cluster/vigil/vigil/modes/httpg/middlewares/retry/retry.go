@@ -23,6 +23,7 @@ import (
 	"net"
 	"net/http"
 	"slices"
+	"strconv"
 	"time"
 
 	"github.com/cenkalti/backoff/v5"
@@ -218,6 +219,10 @@ func (w *responseWriter) setIsRetry() {
 		return
 	}
 
+	if retryAfter := getRetryAfter(w.headers); retryAfter > w.nextDuration {
+		w.nextDuration = retryAfter
+	}
+
 	if time.Since(w.startedAt)+w.nextDuration > w.maxElapsedTime {
 		w.isRetry = false
 		return
@@ -242,6 +247,28 @@ func (w *responseWriter) setIsRetry() {
 	w.isRetry = false
 }
 
+func getRetryAfter(headers http.Header) time.Duration {
+	val := headers.Get("Retry-After")
+	if val == "" {
+		return 0
+	}
+
+	if secs, err := strconv.Atoi(val); err == nil {
+		if secs < 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+
+	if at, err := http.ParseTime(val); err == nil {
+		if d := time.Until(at); d > 0 {
+			return d
+		}
+	}
+
+	return 0
+}
+
 type defaultTimer struct {
 	timer *time.Timer
 }
@@ -149,3 +149,14 @@ func TestMiddleware(t *testing.T) {
 	}
 
 }
+
+func TestGetRetryAfter(t *testing.T) {
+	assert.Equal(t, time.Duration(0), getRetryAfter(http.Header{}))
+	assert.Equal(t, time.Duration(0), getRetryAfter(http.Header{"Retry-After": []string{"-3"}}))
+	assert.Equal(t, time.Duration(0), getRetryAfter(http.Header{"Retry-After": []string{"invalid"}}))
+	assert.Equal(t, 7*time.Second, getRetryAfter(http.Header{"Retry-After": []string{"7"}}))
+
+	d := getRetryAfter(http.Header{"Retry-After": []string{
+		time.Now().Add(1 * time.Minute).UTC().Format(http.TimeFormat)}})
+	assert.True(t, d > 50*time.Second && d <= 1*time.Minute)
+}
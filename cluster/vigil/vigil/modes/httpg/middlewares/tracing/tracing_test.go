@@ -0,0 +1,100 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/cluster/common/otelutils"
+	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestMiddleware(t *testing.T) {
+
+	ctx := context.Background()
+
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(otelutils.GetPropagator())
+
+	var upstreamSpan trace.SpanContext
+	final := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upstreamSpan = trace.SpanContextFromContext(r.Context())
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	passThrough := func(next http.Handler) (http.Handler, error) {
+		return next, nil
+	}
+
+	handler, err := middlewares.New(
+		func(next http.Handler) (http.Handler, error) {
+			return New(ctx, next)
+		},
+		middlewares.Traced("phase-a", passThrough),
+		middlewares.Traced("phase-b", passThrough),
+	).Then(final)
+	assert.Nil(t, err)
+
+	reqCtx := &middlewares.RequestContext{
+		CreatedAt: time.Now(),
+		Service: &corev1.Service{
+			Metadata: &metav1.Metadata{
+				Name: "svc.default",
+			},
+		},
+	}
+
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+
+	req := httptest.NewRequest(http.MethodGet, "http://localhost/v1", nil)
+	req.Header.Set("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01")
+	req = req.WithContext(context.WithValue(ctx, middlewares.CtxRequestContext, reqCtx))
+
+	rw := httptest.NewRecorder()
+	handler.ServeHTTP(rw, req)
+	assert.Equal(t, http.StatusAccepted, rw.Code)
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 3)
+
+	spanA, spanB, root := spans[0], spans[1], spans[2]
+	assert.Equal(t, "phase-a", spanA.Name())
+	assert.Equal(t, "phase-b", spanB.Name())
+	assert.Equal(t, trace.SpanKindServer, root.SpanKind())
+
+	assert.Equal(t, traceID, root.SpanContext().TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", root.Parent().SpanID().String())
+
+	assert.Equal(t, root.SpanContext().SpanID(), spanA.Parent().SpanID())
+	assert.Equal(t, root.SpanContext().SpanID(), spanB.Parent().SpanID())
+	assert.Equal(t, root.SpanContext().SpanID(), upstreamSpan.SpanID())
+
+	assert.Equal(t, traceID, reqCtx.TraceID)
+	assert.Equal(t, root.SpanContext().SpanID().String(), reqCtx.SpanID)
+}
@@ -273,6 +273,10 @@ func (s *Server) getProxy(ctx context.Context) (http.Handler, error) {
 	return ret, nil
 }
 
+func isForwardTraceContext(httpCfg *corev1.Service_Spec_Config_HTTP) bool {
+	return httpCfg != nil && httpCfg.Header != nil && httpCfg.Header.ForwardTraceContext
+}
+
 func isWebSocketUpgrade(req *http.Request) bool {
 	if !httpguts.HeaderValuesContainsToken(req.Header["Connection"], "Upgrade") {
 		return false
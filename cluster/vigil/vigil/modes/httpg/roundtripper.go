@@ -19,16 +19,24 @@ package httpg
 import (
 	"context"
 	"crypto/tls"
+	"io"
 	"net"
 	"net/http"
 	"time"
 
 	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/cluster/common/otelutils"
 	"github.com/octelium/octelium/cluster/vigil/vigil/loadbalancer"
 	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares"
 	"github.com/octelium/octelium/cluster/vigil/vigil/mtls"
 	"github.com/octelium/octelium/cluster/vigil/vigil/secretman"
 	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
+	"github.com/octelium/octelium/pkg/apiutils/umetav1"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/net/http/httpguts"
 	"golang.org/x/net/http2"
 )
@@ -48,12 +56,39 @@ func (s *Server) getRoundTripper(
 
 func (r *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
 
+	ctx, span := otelutils.GetTracer().Start(req.Context(), "httpg.upstream",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", req.Method),
+			attribute.String("server.address", req.URL.Host),
+		))
+	defer span.End()
+
+	req = req.WithContext(ctx)
+
+	reqCtx := middlewares.GetCtxRequestContext(ctx)
+	if reqCtx.ServiceConfig != nil && isForwardTraceContext(reqCtx.ServiceConfig.GetHttp()) {
+		req.Header = req.Header.Clone()
+		otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+	}
+
 	rt, err := r.getRoundTripper(req)
 	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "")
 		return nil, err
 	}
 
-	return rt.RoundTrip(req)
+	resp, err := roundTripWithTimeouts(rt, req, reqCtx.ServiceConfig)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "")
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+
+	return resp, nil
 }
 
 func (r *roundTripper) getRoundTripper(req *http.Request) (http.RoundTripper, error) {
@@ -133,3 +168,84 @@ func (r *roundTripper) getRoundTripperHTTP1(req *http.Request, svc *corev1.Servi
 
 	return ret, nil
 }
+
+func roundTripWithTimeouts(rt http.RoundTripper,
+	req *http.Request, svcCfg *corev1.Service_Spec_Config) (*http.Response, error) {
+	requestTimeout, idleStreamTimeout := getTimeouts(req, svcCfg)
+	if requestTimeout == 0 && idleStreamTimeout == 0 {
+		return rt.RoundTrip(req)
+	}
+
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if requestTimeout > 0 {
+		ctx, cancel = context.WithTimeout(req.Context(), requestTimeout)
+	} else {
+		ctx, cancel = context.WithCancel(req.Context())
+	}
+
+	resp, err := rt.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	resp.Body = newTimeoutBody(resp.Body, cancel, idleStreamTimeout)
+
+	return resp, nil
+}
+
+func getTimeouts(req *http.Request, svcCfg *corev1.Service_Spec_Config) (time.Duration, time.Duration) {
+	if svcCfg == nil || svcCfg.GetHttp() == nil || svcCfg.GetHttp().Timeout == nil {
+		return 0, 0
+	}
+
+	if httpguts.HeaderValuesContainsToken(req.Header["Connection"], "Upgrade") {
+		return 0, 0
+	}
+
+	timeoutCfg := svcCfg.GetHttp().Timeout
+
+	return umetav1.ToDuration(timeoutCfg.Request).ToGo(),
+		umetav1.ToDuration(timeoutCfg.IdleStream).ToGo()
+}
+
+// timeoutBody cancels the upstream request once the response body is closed
+// or once no data is read from the upstream for the idle stream timeout.
+type timeoutBody struct {
+	io.ReadCloser
+	cancel      context.CancelFunc
+	idleTimeout time.Duration
+	idleTimer   *time.Timer
+}
+
+func newTimeoutBody(body io.ReadCloser, cancel context.CancelFunc, idleTimeout time.Duration) *timeoutBody {
+	ret := &timeoutBody{
+		ReadCloser:  body,
+		cancel:      cancel,
+		idleTimeout: idleTimeout,
+	}
+
+	if idleTimeout > 0 {
+		ret.idleTimer = time.AfterFunc(idleTimeout, cancel)
+	}
+
+	return ret
+}
+
+func (b *timeoutBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if b.idleTimer != nil && n > 0 {
+		b.idleTimer.Reset(b.idleTimeout)
+	}
+	return n, err
+}
+
+func (b *timeoutBody) Close() error {
+	if b.idleTimer != nil {
+		b.idleTimer.Stop()
+	}
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
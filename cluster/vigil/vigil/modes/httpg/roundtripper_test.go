@@ -0,0 +1,107 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package httpg
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundTripWithTimeouts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("first"))
+		w.(http.Flusher).Flush()
+		select {
+		case <-time.After(3 * time.Second):
+		case <-r.Context().Done():
+			return
+		}
+		w.Write([]byte("second"))
+	}))
+	t.Cleanup(srv.Close)
+
+	getCfg := func(timeout *corev1.Service_Spec_Config_HTTP_Timeout) *corev1.Service_Spec_Config {
+		return &corev1.Service_Spec_Config{
+			Type: &corev1.Service_Spec_Config_Http{
+				Http: &corev1.Service_Spec_Config_HTTP{
+					Timeout: timeout,
+				},
+			},
+		}
+	}
+
+	{
+		req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+		assert.Nil(t, err)
+
+		startedAt := time.Now()
+		resp, err := roundTripWithTimeouts(http.DefaultTransport, req,
+			getCfg(&corev1.Service_Spec_Config_HTTP_Timeout{
+				IdleStream: &metav1.Duration{
+					Type: &metav1.Duration_Milliseconds{Milliseconds: 300},
+				},
+			}))
+		assert.Nil(t, err)
+
+		_, err = io.ReadAll(resp.Body)
+		assert.NotNil(t, err)
+		resp.Body.Close()
+		assert.True(t, time.Since(startedAt) < 2*time.Second)
+	}
+
+	{
+		req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+		assert.Nil(t, err)
+
+		startedAt := time.Now()
+		resp, err := roundTripWithTimeouts(http.DefaultTransport, req,
+			getCfg(&corev1.Service_Spec_Config_HTTP_Timeout{
+				Request: &metav1.Duration{
+					Type: &metav1.Duration_Milliseconds{Milliseconds: 500},
+				},
+			}))
+		assert.Nil(t, err)
+
+		_, err = io.ReadAll(resp.Body)
+		assert.NotNil(t, err)
+		resp.Body.Close()
+		assert.True(t, time.Since(startedAt) < 2*time.Second)
+	}
+
+	{
+		req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+		assert.Nil(t, err)
+		req.Header.Set("Connection", "Upgrade")
+
+		requestTimeout, idleStreamTimeout := getTimeouts(req,
+			getCfg(&corev1.Service_Spec_Config_HTTP_Timeout{
+				Request: &metav1.Duration{
+					Type: &metav1.Duration_Seconds{Seconds: 1},
+				},
+			}))
+		assert.Equal(t, time.Duration(0), requestTimeout)
+		assert.Equal(t, time.Duration(0), idleStreamTimeout)
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"log"
 	"net"
 	"net/http"
+	"strings"
 	"time"
 
 	"sync"
@@ -39,18 +40,22 @@ import (
 	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares/accesslog"
 	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares/auth"
 	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares/cache"
+	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares/circuitbreaker"
 	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares/compress"
 	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares/direct"
 	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares/extproc"
 	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares/headers"
 	jsonschema "github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares/jsonchema"
+	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares/limit"
 	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares/lua"
 	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares/metrics"
+	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares/mirror"
 	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares/path"
 	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares/paths"
 	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares/preauth"
 	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares/ratelimit"
 	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares/retry"
+	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares/tracing"
 	"github.com/octelium/octelium/cluster/vigil/vigil/modes/httpg/middlewares/validation"
 	"github.com/octelium/octelium/cluster/vigil/vigil/octovigilc"
 	"github.com/octelium/octelium/cluster/vigil/vigil/secretman"
@@ -80,8 +85,9 @@ type Server struct {
 	mu       sync.Mutex
 	isClosed bool
 
-	lbManager *loadbalancer.LBManager
-	secretMan *secretman.SecretManager
+	lbManager  *loadbalancer.LBManager
+	listenHost string
+	secretMan  *secretman.SecretManager
 
 	crtMan struct {
 		mu  sync.RWMutex
@@ -121,6 +127,7 @@ func New(ctx context.Context, opts *modes.Opts) (*Server, error) {
 		octovigilC:   opts.OctovigilC,
 		octeliumC:    opts.OcteliumC,
 		lbManager:    opts.LBManager,
+		listenHost:   opts.ListenHost,
 		secretMan:    opts.SecretMan,
 		metricsStore: &metricsStore{},
 		reverseProxyErrLogger: log.New(&zapWriter{
@@ -235,7 +242,7 @@ func (s *Server) Run(ctx context.Context) error {
 
 	svc := s.svc()
 
-	addr := fmt.Sprintf(":%d", ucorev1.ToService(s.svc()).RealPort())
+	addr := modes.GetListenAddr(s.listenHost, ucorev1.ToService(s.svc()).RealPort())
 	if svc.Spec.IsTLS {
 		tlsCfg, err := s.getTLSConfig(ctx, svc)
 		if err != nil {
@@ -268,44 +275,56 @@ func (s *Server) getHTTPHandler(ctx context.Context, svc *corev1.Service) (http.
 	chain := middlewares.New()
 
 	appendPlugins := func(phase corev1.Service_Spec_Config_HTTP_Plugin_Phase) {
+		plugins := middlewares.New()
 
-		chain = chain.Append(func(next http.Handler) (http.Handler, error) {
+		plugins = plugins.Append(func(next http.Handler) (http.Handler, error) {
 			return path.New(ctx, next, s.celEngine, phase)
 		})
 
-		chain = chain.Append(func(next http.Handler) (http.Handler, error) {
+		plugins = plugins.Append(func(next http.Handler) (http.Handler, error) {
 			return ratelimit.New(ctx, next, s.celEngine, s.octeliumC, phase)
 		})
 
-		chain = chain.Append(func(next http.Handler) (http.Handler, error) {
+		plugins = plugins.Append(func(next http.Handler) (http.Handler, error) {
 			return jsonschema.New(ctx, next, s.celEngine, phase)
 		})
 
-		chain = chain.Append(func(next http.Handler) (http.Handler, error) {
+		plugins = plugins.Append(func(next http.Handler) (http.Handler, error) {
 			return direct.New(ctx, next, s.celEngine, phase)
 		})
 
-		chain = chain.Append(func(next http.Handler) (http.Handler, error) {
+		plugins = plugins.Append(func(next http.Handler) (http.Handler, error) {
 			return cache.New(ctx, next, s.celEngine, s.octeliumC, s.svcUID, phase)
 		})
 
-		chain = chain.Append(func(next http.Handler) (http.Handler, error) {
+		plugins = plugins.Append(func(next http.Handler) (http.Handler, error) {
 			return lua.New(ctx, next, s.celEngine, phase)
 		})
 
-		chain = chain.Append(func(next http.Handler) (http.Handler, error) {
+		plugins = plugins.Append(func(next http.Handler) (http.Handler, error) {
 			return extproc.New(ctx, next, s.celEngine, phase)
 		})
+
+		plugins = plugins.Append(func(next http.Handler) (http.Handler, error) {
+			return mirror.New(ctx, next, s.celEngine, phase)
+		})
+
+		chain = chain.Append(middlewares.Traced(
+			fmt.Sprintf("httpg.plugins.%s", strings.ToLower(phase.String())), plugins.Then))
 	}
 
 	chain = chain.Append(func(next http.Handler) (http.Handler, error) {
-		return metrics.New(ctx, next, s.metricsStore.CommonMetrics)
+		return tracing.New(ctx, next)
 	})
 
 	chain = chain.Append(func(next http.Handler) (http.Handler, error) {
-		return preauth.New(ctx, next, s.octeliumC, s.domain)
+		return metrics.New(ctx, next, s.metricsStore.CommonMetrics)
 	})
 
+	chain = chain.Append(middlewares.Traced("httpg.preauth", func(next http.Handler) (http.Handler, error) {
+		return preauth.New(ctx, next, s.octeliumC, s.domain)
+	}))
+
 	chain = chain.Append(func(next http.Handler) (http.Handler, error) {
 		return compress.New(ctx, next)
 	})
@@ -316,27 +335,35 @@ func (s *Server) getHTTPHandler(ctx context.Context, svc *corev1.Service) (http.
 
 	appendPlugins(corev1.Service_Spec_Config_HTTP_Plugin_PRE_AUTH)
 
-	chain = chain.Append(func(next http.Handler) (http.Handler, error) {
+	chain = chain.Append(middlewares.Traced("httpg.auth", func(next http.Handler) (http.Handler, error) {
 		return auth.New(ctx, next, s.octeliumC, s.octovigilC, s.domain)
-	})
+	}))
 
-	chain = chain.Append(func(next http.Handler) (http.Handler, error) {
+	chain = chain.Append(middlewares.Traced("httpg.validation", func(next http.Handler) (http.Handler, error) {
 		return validation.New(ctx, next)
-	})
+	}))
 
 	appendPlugins(corev1.Service_Spec_Config_HTTP_Plugin_POST_AUTH)
 
-	chain = chain.Append(func(next http.Handler) (http.Handler, error) {
+	chain = chain.Append(middlewares.Traced("httpg.headers", func(next http.Handler) (http.Handler, error) {
 		return headers.New(ctx, next, s.secretMan)
-	})
+	}))
 
-	chain = chain.Append(func(next http.Handler) (http.Handler, error) {
+	chain = chain.Append(middlewares.Traced("httpg.paths", func(next http.Handler) (http.Handler, error) {
 		return paths.New(ctx, next)
-	})
+	}))
 
-	chain = chain.Append(func(next http.Handler) (http.Handler, error) {
+	chain = chain.Append(middlewares.Traced("httpg.retry", func(next http.Handler) (http.Handler, error) {
 		return retry.New(ctx, next)
-	})
+	}))
+
+	chain = chain.Append(middlewares.Traced("httpg.circuitbreaker", func(next http.Handler) (http.Handler, error) {
+		return circuitbreaker.New(ctx, next)
+	}))
+
+	chain = chain.Append(middlewares.Traced("httpg.limit", func(next http.Handler) (http.Handler, error) {
+		return limit.New(ctx, next)
+	}))
 
 	handler, err := chain.Then(s)
 	if err != nil {
@@ -364,7 +391,7 @@ func (s *Server) serve(ctx context.Context) error {
 	}
 
 	s.srv = &http.Server{
-		Addr:              fmt.Sprintf(":%d", ucorev1.ToService(svc).RealPort()),
+		Addr:              modes.GetListenAddr(s.listenHost, ucorev1.ToService(svc).RealPort()),
 		Handler:           handler,
 		ReadHeaderTimeout: 10 * time.Second,
 		ConnContext: func(ctx context.Context, c net.Conn) context.Context {
@@ -18,7 +18,6 @@ package mysql
 
 import (
 	"crypto/tls"
-	"fmt"
 	"net"
 	"time"
 
@@ -69,6 +68,7 @@ type Server struct {
 	svcCtl     *controllers.ServiceController
 	sessionCtl *controllers.SessionController
 	lbManager  *loadbalancer.LBManager
+	listenHost string
 
 	secretMan *secretman.SecretManager
 
@@ -106,6 +106,7 @@ func New(ctx context.Context, opts *modes.Opts) (*Server, error) {
 		octovigilC:   opts.OctovigilC,
 		octeliumC:    opts.OcteliumC,
 		lbManager:    opts.LBManager,
+		listenHost:   opts.ListenHost,
 		svcCtl:       &controllers.ServiceController{},
 		sessionCtl:   &controllers.SessionController{},
 		secretMan:    opts.SecretMan,
@@ -312,7 +313,7 @@ func (s *Server) Run(ctx context.Context) error {
 
 	zap.L().Debug("Starting running MySQL server")
 	var err error
-	s.lis, err = net.Listen("tcp", fmt.Sprintf(":%d", ucorev1.ToService(s.svc()).RealPort()))
+	s.lis, err = net.Listen("tcp", modes.GetListenAddr(s.listenHost, ucorev1.ToService(s.svc()).RealPort()))
 	if err != nil {
 		return err
 	}
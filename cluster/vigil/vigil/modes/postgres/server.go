@@ -18,7 +18,6 @@ package postgres
 
 import (
 	"crypto/tls"
-	"fmt"
 	"net"
 	"time"
 
@@ -67,7 +66,8 @@ type Server struct {
 
 	// svcCtl     *controllers.ServiceController
 	// sessionCtl *controllers.SessionController
-	lbManager *loadbalancer.LBManager
+	lbManager  *loadbalancer.LBManager
+	listenHost string
 
 	secretMan *secretman.SecretManager
 
@@ -105,6 +105,7 @@ func New(ctx context.Context, opts *modes.Opts) (*Server, error) {
 		vCache:       opts.VCache,
 		octeliumC:    opts.OcteliumC,
 		lbManager:    opts.LBManager,
+		listenHost:   opts.ListenHost,
 		// svcCtl:       &controllers.ServiceController{},
 		// sessionCtl:   &controllers.SessionController{},
 		secretMan:    opts.SecretMan,
@@ -444,7 +445,7 @@ func (s *Server) Run(ctx context.Context) error {
 
 	zap.L().Debug("Starting running Postgres server")
 	var err error
-	s.lis, err = net.Listen("tcp", fmt.Sprintf(":%d", ucorev1.ToService(s.svc()).RealPort()))
+	s.lis, err = net.Listen("tcp", modes.GetListenAddr(s.listenHost, ucorev1.ToService(s.svc()).RealPort()))
 	if err != nil {
 		return err
 	}
@@ -17,7 +17,6 @@
 package ssh
 
 import (
-	"fmt"
 	"net"
 	"time"
 
@@ -51,7 +50,8 @@ type Server struct {
 
 	sshConfig *ssh.ServerConfig
 
-	lbManager *loadbalancer.LBManager
+	lbManager  *loadbalancer.LBManager
+	listenHost string
 
 	octovigilC *octovigilc.Client
 	vCache     *vcache.Cache
@@ -94,6 +94,7 @@ func New(ctx context.Context, opts *modes.Opts) (*Server, error) {
 		svcCtl:       &controllers.ServiceController{},
 		sessionCtl:   &controllers.SessionController{},
 		lbManager:    opts.LBManager,
+		listenHost:   opts.ListenHost,
 		secretMan:    opts.SecretMan,
 		opts:         opts,
 		metricsStore: &metricsStore{},
@@ -436,7 +437,7 @@ func (s *Server) authConn(ctx context.Context, c net.Conn, sshConn *ssh.ServerCo
 func (s *Server) Run(ctx context.Context) error {
 	var err error
 	zap.L().Debug("Starting running SSH server")
-	s.lis, err = net.Listen("tcp", fmt.Sprintf(":%d", ucorev1.ToService(s.vCache.GetService()).RealPort()))
+	s.lis, err = net.Listen("tcp", modes.GetListenAddr(s.listenHost, ucorev1.ToService(s.vCache.GetService()).RealPort()))
 	if err != nil {
 		return err
 	}
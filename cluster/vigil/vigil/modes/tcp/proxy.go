@@ -79,13 +79,44 @@ func (p *proxy) getUpstreamConn(ctx context.Context, svc *corev1.Service, secret
 		if err != nil {
 			return nil, err
 		}
-		return tls.Dial("tcp", upstream.HostPort, tlsCfg)
+
+		if p.getProxyProtocolConfig() == nil {
+			return tls.Dial("tcp", upstream.HostPort, tlsCfg)
+		}
+
+		// The PROXY protocol header must precede the TLS handshake
+		rawConn, err := p.dialUpstream(ctx, upstream.HostPort, secretMan)
+		if err != nil {
+			return nil, err
+		}
+
+		if tlsCfg.ServerName == "" {
+			tlsCfg = tlsCfg.Clone()
+			tlsCfg.ServerName, _, _ = net.SplitHostPort(upstream.HostPort)
+		}
+
+		ret := tls.Client(rawConn, tlsCfg)
+		if err := ret.HandshakeContext(ctx); err != nil {
+			rawConn.Close()
+			return nil, err
+		}
+		return ret, nil
 	}
 
-	ret, err := net.DialTimeout("tcp", upstream.HostPort, 20*time.Second)
+	return p.dialUpstream(ctx, upstream.HostPort, secretMan)
+}
+
+func (p *proxy) dialUpstream(ctx context.Context, hostPort string, secretMan *secretman.SecretManager) (*net.TCPConn, error) {
+	ret, err := net.DialTimeout("tcp", hostPort, 20*time.Second)
 	if err != nil {
 		return nil, err
 	}
+
+	if err := p.writeProxyProtocolHeader(ctx, ret, secretMan); err != nil {
+		ret.Close()
+		return nil, err
+	}
+
 	return ret.(*net.TCPConn), nil
 
 }
@@ -0,0 +1,146 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package tcp
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/cluster/vigil/vigil/secretman"
+	"github.com/octelium/octelium/pkg/apiutils/ucorev1"
+	"github.com/pires/go-proxyproto"
+	"github.com/pkg/errors"
+)
+
+// The custom PROXY protocol v2 TLV types that carry the downstream identity.
+const (
+	tlvTypeUserUID    = proxyproto.PP2_TYPE_MIN_CUSTOM
+	tlvTypeUserName   = proxyproto.PP2_TYPE_MIN_CUSTOM + 1
+	tlvTypeSessionUID = proxyproto.PP2_TYPE_MIN_CUSTOM + 2
+	tlvTypeDeviceUID  = proxyproto.PP2_TYPE_MIN_CUSTOM + 3
+	tlvTypeToken      = proxyproto.PP2_TYPE_MIN_CUSTOM + 4
+)
+
+const proxyProtocolTokenDuration = 1 * time.Minute
+
+type proxyProtocolTokenClaims struct {
+	jwt.RegisteredClaims
+	UserName   string `json:"user_name,omitempty"`
+	SessionUID string `json:"sid,omitempty"`
+	DeviceUID  string `json:"did,omitempty"`
+}
+
+func (p *proxy) getProxyProtocolConfig() *corev1.Service_Spec_Config_TCP_ProxyProtocol {
+	if p.dctx.svcConfig == nil {
+		return nil
+	}
+
+	ret := p.dctx.svcConfig.GetTcp().GetProxyProtocol()
+	if ret == nil || !ret.IsEnabled {
+		return nil
+	}
+
+	return ret
+}
+
+func (p *proxy) writeProxyProtocolHeader(ctx context.Context, upstreamConn net.Conn, secretMan *secretman.SecretManager) error {
+	cfg := p.getProxyProtocolConfig()
+	if cfg == nil {
+		return nil
+	}
+
+	var tokenKey []byte
+	if cfg.Token != nil && cfg.Token.GetFromSecret() != "" {
+		secret, err := secretMan.GetByName(ctx, cfg.Token.GetFromSecret())
+		if err != nil {
+			return errors.Errorf("Could not get PROXY protocol token Secret: %+v", err)
+		}
+		tokenKey = ucorev1.ToSecret(secret).GetValueBytes()
+	}
+
+	header, err := getProxyProtocolHeader(p.dctx.conn, p.dctx.authResp.RequestContext, cfg, tokenKey, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if _, err := header.WriteTo(upstreamConn); err != nil {
+		return errors.Errorf("Could not write PROXY protocol header: %+v", err)
+	}
+
+	return nil
+}
+
+func getProxyProtocolHeader(conn net.Conn,
+	reqCtx *corev1.RequestContext,
+	cfg *corev1.Service_Spec_Config_TCP_ProxyProtocol, tokenKey []byte, now time.Time) (*proxyproto.Header, error) {
+
+	ret := proxyproto.HeaderProxyFromAddrs(2, conn.RemoteAddr(), conn.LocalAddr())
+
+	var tlvs []proxyproto.TLV
+	appendTLV := func(typ proxyproto.PP2Type, val string) {
+		if val != "" {
+			tlvs = append(tlvs, proxyproto.TLV{
+				Type:  typ,
+				Value: []byte(val),
+			})
+		}
+	}
+
+	if cfg.IncludeIdentity {
+		appendTLV(tlvTypeUserUID, reqCtx.GetUser().GetMetadata().GetUid())
+		appendTLV(tlvTypeUserName, reqCtx.GetUser().GetMetadata().GetName())
+		appendTLV(tlvTypeSessionUID, reqCtx.GetSession().GetMetadata().GetUid())
+		appendTLV(tlvTypeDeviceUID, reqCtx.GetDevice().GetMetadata().GetUid())
+	}
+
+	if len(tokenKey) > 0 {
+		tkn, err := getProxyProtocolToken(reqCtx, tokenKey, now)
+		if err != nil {
+			return nil, err
+		}
+		appendTLV(tlvTypeToken, tkn)
+	}
+
+	if len(tlvs) > 0 {
+		if err := ret.SetTLVs(tlvs); err != nil {
+			return nil, errors.Errorf("Could not set PROXY protocol TLVs: %+v", err)
+		}
+	}
+
+	return ret, nil
+}
+
+func getProxyProtocolToken(reqCtx *corev1.RequestContext, key []byte, now time.Time) (string, error) {
+	claims := &proxyProtocolTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    "octelium",
+			Subject:   reqCtx.GetUser().GetMetadata().GetUid(),
+			Audience:  jwt.ClaimStrings{reqCtx.GetService().GetMetadata().GetName()},
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(proxyProtocolTokenDuration)),
+		},
+		UserName:   reqCtx.GetUser().GetMetadata().GetName(),
+		SessionUID: reqCtx.GetSession().GetMetadata().GetUid(),
+		DeviceUID:  reqCtx.GetDevice().GetMetadata().GetUid(),
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(key)
+}
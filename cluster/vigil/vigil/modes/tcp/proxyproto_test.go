@@ -0,0 +1,145 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package tcp
+
+import (
+	"bufio"
+	"bytes"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/pires/go-proxyproto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetProxyProtocolHeader(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer lis.Close()
+
+	go func() {
+		conn, err := lis.Accept()
+		if err == nil {
+			defer conn.Close()
+		}
+	}()
+
+	conn, err := net.Dial("tcp", lis.Addr().String())
+	assert.Nil(t, err)
+	defer conn.Close()
+
+	reqCtx := &corev1.RequestContext{
+		User: &corev1.User{
+			Metadata: &metav1.Metadata{
+				Uid:  "user-uid",
+				Name: "john",
+			},
+		},
+		Session: &corev1.Session{
+			Metadata: &metav1.Metadata{
+				Uid: "sess-uid",
+			},
+		},
+		Service: &corev1.Service{
+			Metadata: &metav1.Metadata{
+				Name: "svc1.default",
+			},
+		},
+	}
+
+	parseHeader := func(header *proxyproto.Header) *proxyproto.Header {
+		hdrBytes, err := header.Format()
+		assert.Nil(t, err)
+		ret, err := proxyproto.Read(bufio.NewReader(bytes.NewReader(hdrBytes)))
+		assert.Nil(t, err)
+		return ret
+	}
+
+	{
+		header, err := getProxyProtocolHeader(conn, reqCtx, &corev1.Service_Spec_Config_TCP_ProxyProtocol{
+			IsEnabled: true,
+		}, nil, time.Now())
+		assert.Nil(t, err)
+
+		parsed := parseHeader(header)
+		assert.Equal(t, byte(2), parsed.Version)
+		assert.Equal(t, proxyproto.TCPv4, parsed.TransportProtocol)
+		assert.Equal(t, conn.RemoteAddr().String(), parsed.SourceAddr.String())
+		assert.Equal(t, conn.LocalAddr().String(), parsed.DestinationAddr.String())
+
+		tlvs, err := parsed.TLVs()
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(tlvs))
+	}
+
+	{
+		header, err := getProxyProtocolHeader(conn, reqCtx, &corev1.Service_Spec_Config_TCP_ProxyProtocol{
+			IsEnabled:       true,
+			IncludeIdentity: true,
+		}, nil, time.Now())
+		assert.Nil(t, err)
+
+		tlvs, err := parseHeader(header).TLVs()
+		assert.Nil(t, err)
+
+		tlvMap := make(map[proxyproto.PP2Type]string)
+		for _, tlv := range tlvs {
+			tlvMap[tlv.Type] = string(tlv.Value)
+		}
+
+		assert.Equal(t, "user-uid", tlvMap[tlvTypeUserUID])
+		assert.Equal(t, "john", tlvMap[tlvTypeUserName])
+		assert.Equal(t, "sess-uid", tlvMap[tlvTypeSessionUID])
+		_, hasDevice := tlvMap[tlvTypeDeviceUID]
+		assert.False(t, hasDevice)
+		_, hasToken := tlvMap[tlvTypeToken]
+		assert.False(t, hasToken)
+	}
+
+	{
+		key := []byte("secret-key")
+		now := time.Now()
+		header, err := getProxyProtocolHeader(conn, reqCtx, &corev1.Service_Spec_Config_TCP_ProxyProtocol{
+			IsEnabled: true,
+		}, key, now)
+		assert.Nil(t, err)
+
+		tlvs, err := parseHeader(header).TLVs()
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(tlvs))
+		assert.Equal(t, tlvTypeToken, tlvs[0].Type)
+
+		claims := &proxyProtocolTokenClaims{}
+		_, err = jwt.ParseWithClaims(string(tlvs[0].Value), claims, func(t *jwt.Token) (any, error) {
+			return key, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, "user-uid", claims.Subject)
+		assert.Equal(t, "john", claims.UserName)
+		assert.Equal(t, "sess-uid", claims.SessionUID)
+		assert.True(t, claims.VerifyAudience("svc1.default", true))
+
+		_, err = jwt.ParseWithClaims(string(tlvs[0].Value), &proxyProtocolTokenClaims{}, func(t *jwt.Token) (any, error) {
+			return []byte("invalid-key"), nil
+		})
+		assert.NotNil(t, err)
+	}
+}
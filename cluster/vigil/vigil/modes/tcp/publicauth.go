@@ -0,0 +1,92 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package tcp
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"net"
+	"time"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/pkg/errors"
+)
+
+const (
+	publicAuthTimeout        = 10 * time.Second
+	publicAuthMaxTokenLength = 4096
+)
+
+// getPublicClientCertificate completes the TLS handshake of public downstreams,
+// whose TLS connections are routed by the ingress via SNI, and returns the
+// DER-encoded leaf client certificate that they presented, if any.
+func getPublicClientCertificate(ctx context.Context, c *tls.Conn) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, publicAuthTimeout)
+	defer cancel()
+
+	if err := c.HandshakeContext(ctx); err != nil {
+		return nil, err
+	}
+
+	crts := c.ConnectionState().PeerCertificates
+	if len(crts) == 0 {
+		return nil, nil
+	}
+
+	return crts[0].Raw, nil
+}
+
+func isPublicAccessTokenEnabled(svc *corev1.Service) bool {
+	return svc.Spec.Config.GetTcp().GetEnablePublicAccessToken()
+}
+
+// readPublicAccessToken reads the access token that public downstreams
+// without a client certificate send as a single line right after the TLS
+// handshake when the Service enables it.
+func readPublicAccessToken(c net.Conn) (string, error) {
+	if err := c.SetReadDeadline(time.Now().Add(publicAuthTimeout)); err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	b := make([]byte, 1)
+
+	for {
+		if _, err := c.Read(b); err != nil {
+			return "", err
+		}
+		if b[0] == '\n' {
+			break
+		}
+		if buf.Len() >= publicAuthMaxTokenLength {
+			return "", errors.Errorf("Access token is too long")
+		}
+		buf.WriteByte(b[0])
+	}
+
+	if err := c.SetReadDeadline(time.Time{}); err != nil {
+		return "", err
+	}
+
+	ret := string(bytes.TrimSpace(buf.Bytes()))
+	if ret == "" {
+		return "", errors.Errorf("Empty access token")
+	}
+
+	return ret, nil
+}
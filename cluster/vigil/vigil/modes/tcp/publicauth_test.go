@@ -0,0 +1,162 @@
+/*
+ * Copyright Octelium Labs, LLC. All rights reserved.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License version 3,
+ * as published by the Free Software Foundation of the License.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package tcp
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/octelium/octelium/apis/main/corev1"
+	"github.com/octelium/octelium/pkg/utils/utilrand"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadPublicAccessToken(t *testing.T) {
+
+	doRead := func(arg string) (string, string, error) {
+		c, s := net.Pipe()
+		defer c.Close()
+		defer s.Close()
+
+		go func() {
+			c.Write([]byte(arg))
+		}()
+
+		ret, err := readPublicAccessToken(s)
+		if err != nil {
+			return "", "", err
+		}
+
+		remaining := make([]byte, 1024)
+		n, _ := s.Read(remaining)
+
+		return ret, string(remaining[:n]), nil
+	}
+
+	{
+		tkn := utilrand.GetRandomString(64)
+		ret, remaining, err := doRead(tkn + "\r\nPING")
+		assert.Nil(t, err)
+		assert.Equal(t, tkn, ret)
+		assert.Equal(t, "PING", remaining)
+	}
+
+	{
+		tkn := utilrand.GetRandomString(64)
+		ret, remaining, err := doRead(tkn + "\nPING")
+		assert.Nil(t, err)
+		assert.Equal(t, tkn, ret)
+		assert.Equal(t, "PING", remaining)
+	}
+
+	{
+		_, _, err := doRead("\r\nPING")
+		assert.NotNil(t, err)
+	}
+
+	{
+		_, _, err := doRead(strings.Repeat("a", publicAuthMaxTokenLength+1) + "\n")
+		assert.NotNil(t, err)
+	}
+}
+
+func genTestTLSCertificate(t *testing.T, name string) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+
+	der, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-1 * time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+	}, &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+	}, key.Public(), key)
+	assert.Nil(t, err)
+
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+	}
+}
+
+func TestGetPublicClientCertificate(t *testing.T) {
+	ctx := context.Background()
+	srvCrt := genTestTLSCertificate(t, "svc.example.com")
+
+	doHandshake := func(clientCrts []tls.Certificate) ([]byte, error) {
+		c, s := net.Pipe()
+		defer c.Close()
+		defer s.Close()
+
+		go func() {
+			tls.Client(c, &tls.Config{
+				ServerName:         "svc.example.com",
+				InsecureSkipVerify: true,
+				Certificates:       clientCrts,
+			}).Handshake()
+		}()
+
+		return getPublicClientCertificate(ctx, tls.Server(s, &tls.Config{
+			ClientAuth:   tls.RequestClientCert,
+			Certificates: []tls.Certificate{srvCrt},
+		}))
+	}
+
+	{
+		clientCrt := genTestTLSCertificate(t, "client")
+		ret, err := doHandshake([]tls.Certificate{clientCrt})
+		assert.Nil(t, err)
+		assert.Equal(t, clientCrt.Certificate[0], ret)
+	}
+
+	{
+		ret, err := doHandshake(nil)
+		assert.Nil(t, err)
+		assert.Nil(t, ret)
+	}
+}
+
+func TestIsPublicAccessTokenEnabled(t *testing.T) {
+	assert.False(t, isPublicAccessTokenEnabled(&corev1.Service{
+		Spec: &corev1.Service_Spec{},
+	}))
+
+	assert.True(t, isPublicAccessTokenEnabled(&corev1.Service{
+		Spec: &corev1.Service_Spec{
+			Config: &corev1.Service_Spec_Config{
+				Type: &corev1.Service_Spec_Config_Tcp{
+					Tcp: &corev1.Service_Spec_Config_TCP{
+						EnablePublicAccessToken: true,
+					},
+				},
+			},
+		},
+	}))
+}
@@ -18,7 +18,6 @@ package tcp
 
 import (
 	"crypto/tls"
-	"fmt"
 	"net"
 	"time"
 
@@ -67,6 +66,7 @@ type Server struct {
 	svcCtl     *controllers.ServiceController
 	sessionCtl *controllers.SessionController
 	lbManager  *loadbalancer.LBManager
+	listenHost string
 
 	// logManager *logmanager.LogManager
 
@@ -107,6 +107,7 @@ func New(ctx context.Context, opts *modes.Opts) (*Server, error) {
 		vCache:       opts.VCache,
 		octeliumC:    opts.OcteliumC,
 		lbManager:    opts.LBManager,
+		listenHost:   opts.ListenHost,
 		svcCtl:       &controllers.ServiceController{},
 		sessionCtl:   &controllers.SessionController{},
 		secretMan:    opts.SecretMan,
@@ -181,8 +182,21 @@ func (s *Server) handleConn(ctx context.Context, c net.Conn) {
 		}
 	}
 
+	downstreamReq := s.getDownstreamReq(ctx, c)
+
+	isPublicTLS := svc.Spec.IsPublic && svc.Spec.IsTLS
+	if isPublicTLS {
+		crt, err := getPublicClientCertificate(ctx, c.(*tls.Conn))
+		if err != nil {
+			zap.S().Debugf("Could not do public TLS handshake: %+v", err)
+			c.Close()
+			return
+		}
+		downstreamReq.ClientCertificate = crt
+	}
+
 	authResp, err := s.octovigilC.AuthenticateAndAuthorize(ctx, &octovigilc.AuthenticateAndAuthorizeRequest{
-		Request: s.getDownstreamReq(ctx, c),
+		Request: downstreamReq,
 	})
 	if err != nil {
 		zap.S().Debugf("Could not auth conn: %+v", err)
@@ -190,7 +204,35 @@ func (s *Server) handleConn(ctx context.Context, c net.Conn) {
 		return
 	}
 
-	if authResp.IsAuthenticated && !authResp.IsAuthorized {
+	if !authResp.IsAuthenticated && isPublicTLS &&
+		len(downstreamReq.ClientCertificate) == 0 && isPublicAccessTokenEnabled(svc) {
+		accessToken, err := readPublicAccessToken(c)
+		if err != nil {
+			zap.S().Debugf("Could not read public access token: %+v", err)
+			c.Close()
+			return
+		}
+
+		req := s.getDownstreamReq(ctx, c)
+		req.AccessToken = accessToken
+
+		authResp, err = s.octovigilC.AuthenticateAndAuthorize(ctx, &octovigilc.AuthenticateAndAuthorizeRequest{
+			Request: req,
+		})
+		if err != nil {
+			zap.S().Debugf("Could not auth public conn: %+v", err)
+			c.Close()
+			return
+		}
+	}
+
+	if !authResp.IsAuthenticated {
+		zap.S().Debugf("Conn is not authenticated")
+		c.Close()
+		return
+	}
+
+	if !authResp.IsAuthorized {
 		logE := logentry.InitializeLogEntry(&logentry.InitializeLogEntryOpts{
 			StartTime:       startTime,
 			IsAuthenticated: true,
@@ -220,6 +262,8 @@ func (s *Server) handleConn(ctx context.Context, c net.Conn) {
 			ReqCtx:          i,
 			ConnectionID:    dctx.id,
 			Reason:          authResp.AuthorizationDecisionReason,
+
+			ServiceConfigName: authResp.ServiceConfigName,
 		})
 		logE.Entry.Info.Type = &corev1.AccessLog_Entry_Info_Tcp{
 			Tcp: &corev1.AccessLog_Entry_Info_TCP{
@@ -339,7 +383,9 @@ func (s *Server) setTLSConfig(ctx context.Context) error {
 
 	s.tlsCfgMan.mu.Lock()
 	s.tlsCfgMan.tlsCfg = &tls.Config{
-		ClientAuth: tls.NoClientCert,
+		// Client certificates of public downstreams are verified by Octovigil
+		// since they are signed by the Cluster's rotating root keys.
+		ClientAuth: tls.RequestClientCert,
 		MinVersion: tls.VersionTLS12,
 		MaxVersion: tls.VersionTLS13,
 		GetCertificate: func(chi *tls.ClientHelloInfo) (*tls.Certificate, error) {
@@ -362,7 +408,7 @@ func (s *Server) Run(ctx context.Context) error {
 
 	zap.L().Debug("Starting running TCP server")
 	var err error
-	s.lis, err = net.Listen("tcp", fmt.Sprintf(":%d", ucorev1.ToService(s.svc()).RealPort()))
+	s.lis, err = net.Listen("tcp", modes.GetListenAddr(s.listenHost, ucorev1.ToService(s.svc()).RealPort()))
 	if err != nil {
 		return err
 	}
@@ -63,6 +63,7 @@ type Server struct {
 	svcCtl     *controllers.ServiceController
 	sessionCtl *controllers.SessionController
 	lbManager  *loadbalancer.LBManager
+	listenHost string
 
 	// logManager *logmanager.LogManager
 	secretMan *secretman.SecretManager
@@ -88,6 +89,7 @@ func New(ctx context.Context, opts *modes.Opts) (*Server, error) {
 		vCache:       opts.VCache,
 		octeliumC:    opts.OcteliumC,
 		lbManager:    opts.LBManager,
+		listenHost:   opts.ListenHost,
 		svcCtl:       &controllers.ServiceController{},
 		sessionCtl:   &controllers.SessionController{},
 		secretMan:    opts.SecretMan,
@@ -122,7 +124,7 @@ func (s *Server) Run(ctx context.Context) error {
 	var err error
 
 	addr := &net.UDPAddr{
-		IP:   net.ParseIP("::"),
+		IP:   modes.GetListenIP(s.listenHost),
 		Port: ucorev1.ToService(svc).RealPort(),
 	}
 
@@ -23,11 +23,15 @@ import (
 	"github.com/octelium/octelium/apis/main/corev1"
 	"github.com/octelium/octelium/cluster/common/octeliumc"
 	"github.com/octelium/octelium/cluster/common/octovigilc"
+	"github.com/octelium/octelium/cluster/common/otelutils"
 	"github.com/octelium/octelium/cluster/octovigil/octovigil"
 	"github.com/octelium/octelium/cluster/octovigil/octovigil/acache"
 	"github.com/octelium/octelium/cluster/vigil/vigil/vcache"
 	"github.com/octelium/octelium/pkg/utils/ldflags"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -89,6 +93,25 @@ func NewClient(ctx context.Context, opts *Opts) (*Client, error) {
 }
 
 func (c *Client) AuthenticateAndAuthorize(ctx context.Context, req *AuthenticateAndAuthorizeRequest) (*coctovigilv1.AuthenticateAndAuthorizeResponse, error) {
+	ctx, span := otelutils.GetTracer().Start(ctx, "octovigil.AuthenticateAndAuthorize",
+		trace.WithAttributes(attribute.Bool("octelium.octovigil.embedded", c.isEmbedded)))
+	defer span.End()
+
+	resp, err := c.doAuthenticateAndAuthorize(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "")
+		return nil, err
+	}
+
+	span.SetAttributes(
+		attribute.Bool("octelium.authenticated", resp.IsAuthenticated),
+		attribute.Bool("octelium.authorized", resp.IsAuthorized))
+
+	return resp, nil
+}
+
+func (c *Client) doAuthenticateAndAuthorize(ctx context.Context, req *AuthenticateAndAuthorizeRequest) (*coctovigilv1.AuthenticateAndAuthorizeResponse, error) {
 	if c.isEmbedded {
 		return c.embeddedSrv.AuthenticateAndAuthorize(ctx, &coctovigilv1.DoAuthenticateAndAuthorizeRequest{
 			Service: c.vCache.GetService(),
@@ -104,6 +127,23 @@ func (c *Client) AuthenticateAndAuthorize(ctx context.Context, req *Authenticate
 }
 
 func (c *Client) Authorize(ctx context.Context, req *coctovigilv1.AuthorizeRequest) (*coctovigilv1.AuthorizeResponse, error) {
+	ctx, span := otelutils.GetTracer().Start(ctx, "octovigil.Authorize",
+		trace.WithAttributes(attribute.Bool("octelium.octovigil.embedded", c.isEmbedded)))
+	defer span.End()
+
+	resp, err := c.doAuthorize(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "")
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Bool("octelium.authorized", resp.IsAuthorized))
+
+	return resp, nil
+}
+
+func (c *Client) doAuthorize(ctx context.Context, req *coctovigilv1.AuthorizeRequest) (*coctovigilv1.AuthorizeResponse, error) {
 	if c.isEmbedded {
 		di, err := c.embeddedSrv.GetCache().GetDownstreamInfoBySessionIdentifier(req.SessionUID)
 		if err != nil {
@@ -206,6 +206,10 @@ func (s *SecretManager) ApplyService(ctx context.Context) error {
 
 		}
 
+		if cfg.GetTcp().GetProxyProtocol().GetToken().GetFromSecret() != "" {
+			doAppend(cfg.GetTcp().GetProxyProtocol().GetToken().GetFromSecret())
+		}
+
 		if cfg.GetKubernetes() != nil {
 			k8sC := svc.Spec.GetConfig().GetKubernetes()
 
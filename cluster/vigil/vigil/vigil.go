@@ -73,6 +73,11 @@ type Opts struct {
 
 	PostAuthorize func(ctx context.Context, req *modes.PostAuthorizeRequest) (*modes.PostAuthorizeResponse, error)
 	GetUpstream   func(ctx context.Context, opts *modes.Opts, reqCtx *corev1.RequestContext) (*loadbalancer.Upstream, error)
+
+	// ListenHost sets the host on which the Service is served. This is used
+	// by the standalone runtime where many Vigils share the same host and
+	// each Service is served on its own address.
+	ListenHost string
 }
 
 func NewServer(ctx context.Context, opts *Opts) (*Server, error) {
@@ -166,6 +171,7 @@ func (s *Server) createServer(ctx context.Context) error {
 		// MetricsStore:  s.metricsStore,
 		GetUpstream:   s.opts.GetUpstream,
 		PostAuthorize: s.opts.PostAuthorize,
+		ListenHost:    s.opts.ListenHost,
 	}
 
 	switch mode {
@@ -240,6 +246,12 @@ func (s *Server) Run(ctx context.Context) error {
 	return nil
 }
 
+// Close closes the Service server. The watchers started by Run are stopped
+// by canceling its context.
+func (s *Server) Close() error {
+	return s.server.Close()
+}
+
 func Run(ctx context.Context) error {
 
 	pprofsrv.New().Run(ctx)
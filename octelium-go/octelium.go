@@ -30,6 +30,7 @@ import (
 	"golang.org/x/oauth2"
 
 	"github.com/octelium/octelium/apis/main/authv1"
+	"github.com/octelium/octelium/apis/main/metav1"
 	"github.com/octelium/octelium/octelium-go/authc"
 	"github.com/octelium/octelium/pkg/grpcerr"
 	"github.com/octelium/octelium/pkg/utils"
@@ -103,14 +104,27 @@ type GRPC interface {
 type ClientConfig struct {
 	// Domain is the Cluster domain (e.g. `example.com`, `octelium.example.com`, etc...).
 	Domain string
-	// AuthenticationToken is the authentication Token used to obtain a valid Session in order to interact with the Cluster. This field is required.
+	// AuthenticationToken is the authentication Token used to obtain a valid Session in order to interact with the Cluster.
+	// This field is required unless the client authenticates via an assertion.
 	AuthenticationToken string
+	// Assertion is the optional workload identity assertion (e.g. an OIDC identity token issued by GitHub Actions or a
+	// Kubernetes ServiceAccount token) used to authenticate instead of an AuthenticationToken.
+	Assertion *ClientConfigAssertion
 	// Scopes is the optional list of Scopes to further limit the access permissions. Read the docs to understand more about Scopes.
 	Scopes []string
 	// AuthenticateOnCreation disables the default behavior of doing the initial authentication lazily whenever first needed.
 	AuthenticateOnCreation bool
 }
 
+// ClientConfigAssertion sets the assertion-based authentication via a workload IdentityProvider
+type ClientConfigAssertion struct {
+	// IdentityProvider is the name of the IdentityProvider that issues the assertion.
+	IdentityProvider string
+	// GetAssertion returns the assertion. It is called on every authentication since assertions
+	// are usually short-lived and need to be refreshed.
+	GetAssertion func(ctx context.Context) (string, error)
+}
+
 // NewClient creates a new Octelium client
 func NewClient(ctx context.Context, cc *ClientConfig) (*Client, error) {
 	var err error
@@ -122,7 +136,7 @@ func NewClient(ctx context.Context, cc *ClientConfig) (*Client, error) {
 		cc.Domain = os.Getenv("OCTELIUM_DOMAIN")
 	}
 
-	if cc.AuthenticationToken == "" {
+	if cc.AuthenticationToken == "" && cc.Assertion == nil {
 		cc.AuthenticationToken = os.Getenv("OCTELIUM_AUTH_TOKEN")
 	}
 
@@ -130,7 +144,14 @@ func NewClient(ctx context.Context, cc *ClientConfig) (*Client, error) {
 		return nil, errors.Errorf("Empty Domain")
 	}
 
-	if cc.AuthenticationToken == "" {
+	if cc.Assertion != nil {
+		if cc.Assertion.IdentityProvider == "" {
+			return nil, errors.Errorf("Empty assertion IdentityProvider")
+		}
+		if cc.Assertion.GetAssertion == nil {
+			return nil, errors.Errorf("Nil GetAssertion function")
+		}
+	} else if cc.AuthenticationToken == "" {
 		return nil, errors.Errorf("Empty authentication token")
 	}
 
@@ -163,6 +184,11 @@ func NewClient(ctx context.Context, cc *ClientConfig) (*Client, error) {
 	return ret, nil
 }
 
+// Domain returns the Cluster domain of the Client
+func (c *Client) Domain() string {
+	return c.cc.Domain
+}
+
 // GetAccessToken returns an access token
 func (c *Client) GetAccessToken(ctx context.Context) (string, error) {
 	return c.doGetAccessToken(ctx)
@@ -354,10 +380,7 @@ func (c *Client) setAccessTokenResponse(ctx context.Context) error {
 	var resp *authv1.SessionToken
 	var err error
 	if c.sessToken.t == nil {
-		resp, err = c.c.C().AuthenticateWithAuthenticationToken(ctx, &authv1.AuthenticateWithAuthenticationTokenRequest{
-			AuthenticationToken: c.cc.AuthenticationToken,
-			Scopes:              c.cc.Scopes,
-		})
+		resp, err = c.doAuthenticate(ctx)
 		if err != nil {
 			return err
 		}
@@ -380,6 +403,28 @@ func (c *Client) setAccessTokenResponse(ctx context.Context) error {
 	return nil
 }
 
+func (c *Client) doAuthenticate(ctx context.Context) (*authv1.SessionToken, error) {
+	if c.cc.Assertion == nil {
+		return c.c.C().AuthenticateWithAuthenticationToken(ctx, &authv1.AuthenticateWithAuthenticationTokenRequest{
+			AuthenticationToken: c.cc.AuthenticationToken,
+			Scopes:              c.cc.Scopes,
+		})
+	}
+
+	assertion, err := c.cc.Assertion.GetAssertion(ctx)
+	if err != nil {
+		return nil, errors.Errorf("Could not get assertion: %+v", err)
+	}
+
+	return c.c.C().AuthenticateWithAssertion(ctx, &authv1.AuthenticateWithAssertionRequest{
+		IdentityProviderRef: &metav1.ObjectReference{
+			Name: c.cc.Assertion.IdentityProvider,
+		},
+		Assertion: assertion,
+		Scopes:    c.cc.Scopes,
+	})
+}
+
 func (c *Client) needsNewAccessToken() bool {
 
 	if _, err := c.sessToken.getAccessToken(); err != nil {
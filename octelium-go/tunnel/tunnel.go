@@ -0,0 +1,581 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// Package tunnel brings up an embedded userspace tunnel to an Octelium Cluster
+// within the process of the application. The tunnel uses a gVisor netstack and
+// therefore neither requires elevated privileges nor modifies the host's
+// network configuration. Applications can use it to access the Cluster
+// Services as well as to host Services that are served by the tunnel.
+package tunnel
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/octelium/octelium/apis/client/cliconfigv1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/apis/main/userv1"
+	"github.com/octelium/octelium/client/octelium/commands/connect/controller"
+	"github.com/octelium/octelium/client/octelium/commands/connect/l3mode"
+	"github.com/octelium/octelium/octelium-go"
+	"github.com/octelium/octelium/pkg/common/pbutils"
+	"github.com/octelium/octelium/pkg/utils/utilrand"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// ErrDisconnected is returned by Err when the Cluster disconnects the tunnel
+var ErrDisconnected = errors.New("Disconnected by the Cluster")
+
+// ErrClosed is returned when using a closed Tunnel
+var ErrClosed = errors.New("Tunnel is closed")
+
+// Opts sets the Tunnel options
+type Opts struct {
+	// ServeServices is the optional list of Services (e.g. `svc1`, `svc2.ns2`) that are hosted by the
+	// application through this Tunnel. Use ListenService to accept the Services' connections.
+	ServeServices []string
+	// ServeAll serves all the Services that can be hosted by the User of the Client.
+	ServeAll bool
+	// L3Mode is the tunnel's IP mode. It can be either `v4`, `v6` or `both`. Defaults to `both`.
+	L3Mode string
+}
+
+// Tunnel is an embedded userspace tunnel to the Cluster
+type Tunnel struct {
+	c      *octelium.Client
+	opts   *Opts
+	domain string
+
+	grpcConn io.Closer
+	streamC  userv1.MainService_ConnectClient
+	connCfg  *cliconfigv1.Connection
+	ctl      *controller.Controller
+
+	transport *http.Transport
+
+	cancelFn context.CancelFunc
+	doneCh   chan struct{}
+
+	mu       sync.Mutex
+	isClosed bool
+	err      error
+
+	svcs struct {
+		sync.RWMutex
+		hosted map[string]*userv1.HostedService
+	}
+}
+
+// New authenticates the Client if needed, connects to the Cluster and brings up the Tunnel.
+// The Tunnel lives until it is closed or disconnected by the Cluster.
+func New(ctx context.Context, c *octelium.Client, opts *Opts) (*Tunnel, error) {
+	if c == nil {
+		return nil, errors.Errorf("Nil Client")
+	}
+
+	if opts == nil {
+		opts = &Opts{}
+	}
+
+	for _, svc := range opts.ServeServices {
+		if getServiceFullName(svc) == "" {
+			return nil, errors.Errorf("Invalid Service name: %s", svc)
+		}
+	}
+
+	ret := &Tunnel{
+		c:      c,
+		opts:   opts,
+		domain: c.Domain(),
+		doneCh: make(chan struct{}),
+	}
+	ret.svcs.hosted = make(map[string]*userv1.HostedService)
+
+	ret.transport = &http.Transport{
+		DialContext:           ret.Dial,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+
+	if err := ret.start(ctx); err != nil {
+		ret.Close()
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (t *Tunnel) start(ctx context.Context) error {
+	conn, err := t.c.GRPC().GetConn(ctx)
+	if err != nil {
+		return err
+	}
+	if closer, ok := conn.(io.Closer); ok {
+		t.grpcConn = closer
+	}
+
+	// The stream, and hence the Tunnel, must outlive the context passed to New.
+	streamCtx, cancelFn := context.WithCancel(context.Background())
+	t.cancelFn = cancelFn
+
+	t.streamC, err = userv1.NewMainServiceClient(conn).Connect(streamCtx)
+	if err != nil {
+		return errors.Errorf("Could not connect to the Cluster: %+v", err)
+	}
+
+	initReq, err := t.getInitializeRequest()
+	if err != nil {
+		return err
+	}
+
+	if err := t.streamC.Send(initReq); err != nil {
+		return errors.Errorf("Could not send initialize request: %+v", err)
+	}
+
+	state, err := t.getStateMsg(ctx)
+	if err != nil {
+		return err
+	}
+
+	t.connCfg = t.getConnectionConfig(state)
+	t.setHostedServices(state.ServiceOptions.GetServices())
+
+	t.ctl, err = controller.NewController(t.connCfg)
+	if err != nil {
+		return errors.Errorf("Could not create tunnel controller: %+v", err)
+	}
+
+	if err := t.ctl.Start(streamCtx); err != nil {
+		return errors.Errorf("Could not start tunnel controller: %+v", err)
+	}
+
+	go t.runEventLoop(streamCtx)
+
+	return nil
+}
+
+func (t *Tunnel) getInitializeRequest() (*userv1.ConnectRequest, error) {
+	mode := t.opts.L3Mode
+	if mode == "" {
+		mode = "both"
+	}
+
+	l3Mode, err := l3mode.GetL3Mode(mode)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &userv1.ConnectRequest_Initialize{
+		L3Mode: l3Mode,
+	}
+
+	switch {
+	case t.opts.ServeAll:
+		req.ServiceOptions = &userv1.ConnectRequest_Initialize_ServiceOptions{
+			ServeAll:  true,
+			PortStart: int32(utilrand.GetRandomRangeMath(20000, 24000)),
+		}
+	case len(t.opts.ServeServices) > 0:
+		req.ServiceOptions = &userv1.ConnectRequest_Initialize_ServiceOptions{
+			PortStart: int32(utilrand.GetRandomRangeMath(20000, 24000)),
+		}
+		for _, svc := range t.opts.ServeServices {
+			req.ServiceOptions.Services = append(req.ServiceOptions.Services,
+				&userv1.ConnectRequest_Initialize_ServiceOptions_Service{
+					Name: getServiceFullName(svc),
+				})
+		}
+	}
+
+	return &userv1.ConnectRequest{
+		Type: &userv1.ConnectRequest_Initialize_{
+			Initialize: req,
+		},
+	}, nil
+}
+
+func (t *Tunnel) getStateMsg(ctx context.Context) (*userv1.ConnectionState, error) {
+	ctx, cancel := context.WithTimeout(ctx, 20*time.Second)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	resCh := make(chan *userv1.ConnectionState, 1)
+
+	go func() {
+		for {
+			msg, err := t.streamC.Recv()
+			if err != nil {
+				errCh <- err
+				return
+			}
+
+			if msg.GetState() != nil {
+				resCh <- msg.GetState()
+				return
+			}
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil, errors.Errorf("Could not get initial state message after a timeout")
+	case res := <-resCh:
+		return res, nil
+	case err := <-errCh:
+		return nil, err
+	}
+}
+
+func (t *Tunnel) getConnectionConfig(state *userv1.ConnectionState) *cliconfigv1.Connection {
+	ret := &cliconfigv1.Connection{
+		Connection: state,
+		CreatedAt:  pbutils.Now(),
+		Info: &cliconfigv1.Connection_Info{
+			Cluster: &cliconfigv1.Connection_Info_Cluster{
+				Domain: t.domain,
+			},
+		},
+		Preferences: &cliconfigv1.Connection_Preferences{
+			RuntimeMode:      cliconfigv1.Connection_Preferences_IN_APP,
+			DeviceName:       "octelium-sdk",
+			KeepAliveSeconds: 30,
+			L3Mode: func() cliconfigv1.Connection_Preferences_L3Mode {
+				switch state.L3Mode {
+				case userv1.ConnectionState_V4:
+					return cliconfigv1.Connection_Preferences_V4
+				case userv1.ConnectionState_V6:
+					return cliconfigv1.Connection_Preferences_V6
+				default:
+					return cliconfigv1.Connection_Preferences_BOTH
+				}
+			}(),
+			ServeOpts: &cliconfigv1.Connection_Preferences_ServeOpts{
+				IsEnabled: t.opts.ServeAll || len(t.opts.ServeServices) > 0,
+			},
+			LocalDNS: &cliconfigv1.Connection_Preferences_LocalDNS{},
+		},
+	}
+
+	switch runtime.GOOS {
+	case "linux":
+		ret.Preferences.LinuxPrefs = &cliconfigv1.Connection_Preferences_Linux{
+			ImplementationMode:        cliconfigv1.Connection_Preferences_Linux_WG_NETSTACK,
+			EnforceImplementationMode: true,
+		}
+	case "windows":
+		ret.Preferences.WindowsPrefs = &cliconfigv1.Connection_Preferences_Windows{}
+	case "darwin":
+		ret.Preferences.MacosPrefs = &cliconfigv1.Connection_Preferences_MacOS{}
+	}
+
+	return ret
+}
+
+func (t *Tunnel) runEventLoop(ctx context.Context) {
+	for {
+		resp, err := t.streamC.Recv()
+		if err != nil {
+			if ctx.Err() == nil {
+				zap.L().Debug("Tunnel stream error", zap.Error(err))
+				t.closeWithErr(errors.Errorf("Tunnel stream error: %+v", err))
+			}
+			return
+		}
+
+		if resp.GetDisconnect() != nil {
+			t.closeWithErr(ErrDisconnected)
+			return
+		}
+
+		if err := t.handleEvent(ctx, resp); err != nil {
+			zap.L().Warn("Could not handle tunnel event", zap.Error(err))
+		}
+	}
+}
+
+func (t *Tunnel) handleEvent(ctx context.Context, resp *userv1.ConnectResponse) error {
+	switch {
+	case resp.GetAddGateway() != nil:
+		return t.ctl.AddGateway(ctx, resp.GetAddGateway().Gateway)
+	case resp.GetUpdateGateway() != nil:
+		return t.ctl.UpdateGateway(ctx, resp.GetUpdateGateway().Gateway)
+	case resp.GetDeleteGateway() != nil:
+		return t.ctl.DeleteGateway(ctx, resp.GetDeleteGateway().Id)
+	case resp.GetUpdateDNS() != nil:
+		t.connCfg.Connection.Dns = resp.GetUpdateDNS().Dns
+		return t.ctl.SetDNS()
+	case resp.GetAddService() != nil:
+		t.setHostedService(resp.GetAddService().Service)
+	case resp.GetUpdateService() != nil:
+		t.setHostedService(resp.GetUpdateService().Service)
+	case resp.GetDeleteService() != nil:
+		t.svcs.Lock()
+		delete(t.svcs.hosted, resp.GetDeleteService().Name)
+		t.svcs.Unlock()
+	case resp.GetState() != nil:
+		t.connCfg.Connection = resp.GetState()
+		t.setHostedServices(resp.GetState().ServiceOptions.GetServices())
+		return t.ctl.Reconfigure()
+	default:
+		zap.L().Debug("Unhandled tunnel event", zap.Any("event", resp))
+	}
+
+	return nil
+}
+
+func (t *Tunnel) setHostedService(svc *userv1.HostedService) {
+	if svc == nil {
+		return
+	}
+
+	t.svcs.Lock()
+	t.svcs.hosted[svc.Name] = svc
+	t.svcs.Unlock()
+}
+
+func (t *Tunnel) setHostedServices(svcs []*userv1.HostedService) {
+	t.svcs.Lock()
+	defer t.svcs.Unlock()
+
+	t.svcs.hosted = make(map[string]*userv1.HostedService)
+	for _, svc := range svcs {
+		t.svcs.hosted[svc.Name] = svc
+	}
+}
+
+func (t *Tunnel) getNet() (*controller.Net, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.isClosed {
+		return nil, ErrClosed
+	}
+
+	ret := t.ctl.GetNetstackNet()
+	if ret == nil {
+		return nil, errors.Errorf("Tunnel netstack is not initialized")
+	}
+
+	return ret, nil
+}
+
+// Dial connects to the address on the named network. Cluster hostnames (e.g. `svc1`,
+// `svc1.ns1.local.<DOMAIN>`) and addresses are resolved through the Cluster private DNS
+// and dialed through the Tunnel. Any other address is dialed directly.
+func (t *Tunnel) Dial(ctx context.Context, network, address string) (net.Conn, error) {
+	if _, err := t.getNet(); err != nil {
+		return nil, err
+	}
+
+	return t.ctl.DialContext(ctx, network, address)
+}
+
+// Listen listens on the Tunnel's own address. The host of the address must be either
+// empty or one of the Connection addresses. Only TCP networks are currently supported.
+func (t *Tunnel) Listen(network, address string) (net.Listener, error) {
+	nsNet, err := t.getNet()
+	if err != nil {
+		return nil, err
+	}
+
+	host, portStr, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+
+	port, err := strconv.Atoi(portStr)
+	if err != nil || port < 0 || port > 65535 {
+		return nil, errors.Errorf("Invalid port: %s", portStr)
+	}
+
+	ip, err := getListenIP(network, host,
+		t.connCfg.Preferences.L3Mode, t.connCfg.Connection.Addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	return nsNet.ListenTCP(&net.TCPAddr{
+		IP:   ip,
+		Port: port,
+	})
+}
+
+// ListenService returns a listener that accepts the connections of a Service hosted by the Tunnel.
+// The Service (e.g. `svc1`, `svc2.ns2`) must be served via the Opts ServeServices or ServeAll.
+func (t *Tunnel) ListenService(name string) (net.Listener, error) {
+	nsNet, err := t.getNet()
+	if err != nil {
+		return nil, err
+	}
+
+	t.svcs.RLock()
+	svc, ok := t.svcs.hosted[getServiceFullName(name)]
+	t.svcs.RUnlock()
+	if !ok {
+		return nil, errors.Errorf("The Service %s is not hosted by this Tunnel", name)
+	}
+
+	if svc.L4Type != userv1.HostedService_TCP {
+		return nil, errors.Errorf("Only TCP-based hosted Services are currently supported")
+	}
+
+	var ip net.IP
+	switch {
+	case svc.Address == nil:
+		ip, err = getListenIP("tcp", "",
+			t.connCfg.Preferences.L3Mode, t.connCfg.Connection.Addresses)
+		if err != nil {
+			return nil, err
+		}
+	case svc.Address.Ipv4 != "" && t.connCfg.Preferences.L3Mode != cliconfigv1.Connection_Preferences_V6:
+		ip = net.ParseIP(svc.Address.Ipv4)
+	default:
+		ip = net.ParseIP(svc.Address.Ipv6)
+	}
+
+	if ip == nil {
+		return nil, errors.Errorf("Could not find an address for the hosted Service %s", name)
+	}
+
+	return nsNet.ListenTCP(&net.TCPAddr{
+		IP:   ip,
+		Port: int(svc.Port),
+	})
+}
+
+// RoundTripper returns an http.RoundTripper that dials its connections through the Tunnel
+func (t *Tunnel) RoundTripper() http.RoundTripper {
+	return t.transport
+}
+
+// HTTPClient returns an HTTP client that uses the Tunnel's RoundTripper
+func (t *Tunnel) HTTPClient() *http.Client {
+	return &http.Client{
+		Transport: t.transport,
+	}
+}
+
+// Done returns a channel that is closed once the Tunnel is closed
+func (t *Tunnel) Done() <-chan struct{} {
+	return t.doneCh
+}
+
+// Err returns the reason why the Tunnel has been closed, if any.
+// It returns nil if the Tunnel is still up or closed via Close.
+func (t *Tunnel) Err() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.err
+}
+
+// Close brings the Tunnel down and disconnects from the Cluster
+func (t *Tunnel) Close() error {
+	t.closeWithErr(nil)
+	return nil
+}
+
+func (t *Tunnel) closeWithErr(err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.isClosed {
+		return
+	}
+	t.isClosed = true
+	t.err = err
+
+	t.transport.CloseIdleConnections()
+
+	if t.ctl != nil {
+		if err := t.ctl.Close(); err != nil {
+			zap.L().Debug("Could not close tunnel controller", zap.Error(err))
+		}
+	}
+
+	if t.streamC != nil {
+		t.streamC.CloseSend()
+	}
+
+	if t.cancelFn != nil {
+		t.cancelFn()
+	}
+
+	if t.grpcConn != nil {
+		t.grpcConn.Close()
+	}
+
+	close(t.doneCh)
+}
+
+func getServiceFullName(arg string) string {
+	args := strings.Split(arg, ".")
+	switch {
+	case arg == "" || len(args) > 2:
+		return ""
+	case len(args) == 1:
+		return fmt.Sprintf("%s.default", arg)
+	default:
+		return arg
+	}
+}
+
+func getListenIP(network, host string, l3Mode cliconfigv1.Connection_Preferences_L3Mode, addrs []*metav1.DualStackNetwork) (net.IP, error) {
+	var acceptV4, acceptV6 bool
+	switch network {
+	case "tcp":
+		acceptV4, acceptV6 = true, true
+	case "tcp4":
+		acceptV4 = true
+	case "tcp6":
+		acceptV6 = true
+	default:
+		return nil, errors.Errorf("Unsupported network: %s", network)
+	}
+
+	acceptV4 = acceptV4 && l3Mode != cliconfigv1.Connection_Preferences_V6
+	acceptV6 = acceptV6 && l3Mode != cliconfigv1.Connection_Preferences_V4
+
+	for _, addr := range addrs {
+		for _, cidr := range []string{addr.V4, addr.V6} {
+			ip, _, err := net.ParseCIDR(cidr)
+			if err != nil {
+				continue
+			}
+
+			isV4 := ip.To4() != nil
+			if (isV4 && !acceptV4) || (!isV4 && !acceptV6) {
+				continue
+			}
+
+			if host == "" || ip.Equal(net.ParseIP(host)) {
+				return ip, nil
+			}
+		}
+	}
+
+	if host == "" {
+		return nil, errors.Errorf("Could not find a Connection address to listen on")
+	}
+
+	return nil, errors.Errorf("The address %s is not a Connection address", host)
+}
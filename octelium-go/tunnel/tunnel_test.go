@@ -0,0 +1,172 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package tunnel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/octelium/octelium/apis/client/cliconfigv1"
+	"github.com/octelium/octelium/apis/main/metav1"
+	"github.com/octelium/octelium/apis/main/userv1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetServiceFullName(t *testing.T) {
+	assert.Equal(t, "svc1.default", getServiceFullName("svc1"))
+	assert.Equal(t, "svc1.ns1", getServiceFullName("svc1.ns1"))
+	assert.Equal(t, "", getServiceFullName(""))
+	assert.Equal(t, "", getServiceFullName("svc1.ns1.example.com"))
+}
+
+func TestGetListenIP(t *testing.T) {
+	addrs := []*metav1.DualStackNetwork{
+		{
+			V4: "100.64.0.2/32",
+			V6: "fdee::2/128",
+		},
+	}
+
+	{
+		ip, err := getListenIP("tcp", "", cliconfigv1.Connection_Preferences_BOTH, addrs)
+		assert.Nil(t, err)
+		assert.Equal(t, "100.64.0.2", ip.String())
+	}
+
+	{
+		ip, err := getListenIP("tcp6", "", cliconfigv1.Connection_Preferences_BOTH, addrs)
+		assert.Nil(t, err)
+		assert.Equal(t, "fdee::2", ip.String())
+	}
+
+	{
+		ip, err := getListenIP("tcp", "", cliconfigv1.Connection_Preferences_V6, addrs)
+		assert.Nil(t, err)
+		assert.Equal(t, "fdee::2", ip.String())
+	}
+
+	{
+		ip, err := getListenIP("tcp", "fdee::2", cliconfigv1.Connection_Preferences_BOTH, addrs)
+		assert.Nil(t, err)
+		assert.Equal(t, "fdee::2", ip.String())
+	}
+
+	{
+		_, err := getListenIP("tcp4", "", cliconfigv1.Connection_Preferences_V6, addrs)
+		assert.NotNil(t, err)
+	}
+
+	{
+		_, err := getListenIP("tcp", "100.64.0.3", cliconfigv1.Connection_Preferences_BOTH, addrs)
+		assert.NotNil(t, err)
+	}
+
+	{
+		_, err := getListenIP("udp", "", cliconfigv1.Connection_Preferences_BOTH, addrs)
+		assert.NotNil(t, err)
+	}
+}
+
+func TestGetInitializeRequest(t *testing.T) {
+	{
+		tun := &Tunnel{
+			opts: &Opts{},
+		}
+		req, err := tun.getInitializeRequest()
+		assert.Nil(t, err)
+		assert.Equal(t, userv1.ConnectRequest_Initialize_BOTH, req.GetInitialize().L3Mode)
+		assert.Nil(t, req.GetInitialize().ServiceOptions)
+	}
+
+	{
+		tun := &Tunnel{
+			opts: &Opts{
+				L3Mode:        "v4",
+				ServeServices: []string{"svc1", "svc2.ns2"},
+			},
+		}
+		req, err := tun.getInitializeRequest()
+		assert.Nil(t, err)
+		assert.Equal(t, userv1.ConnectRequest_Initialize_V4, req.GetInitialize().L3Mode)
+		svcOpts := req.GetInitialize().ServiceOptions
+		assert.False(t, svcOpts.ServeAll)
+		assert.True(t, svcOpts.PortStart >= 20000)
+		assert.Equal(t, 2, len(svcOpts.Services))
+		assert.Equal(t, "svc1.default", svcOpts.Services[0].Name)
+		assert.Equal(t, "svc2.ns2", svcOpts.Services[1].Name)
+	}
+
+	{
+		tun := &Tunnel{
+			opts: &Opts{
+				ServeAll: true,
+			},
+		}
+		req, err := tun.getInitializeRequest()
+		assert.Nil(t, err)
+		assert.True(t, req.GetInitialize().ServiceOptions.ServeAll)
+	}
+}
+
+func TestHandleEventServices(t *testing.T) {
+	ctx := context.Background()
+
+	tun := &Tunnel{
+		opts: &Opts{},
+	}
+	tun.setHostedServices([]*userv1.HostedService{
+		{
+			Name: "svc1.default",
+			Port: 20001,
+		},
+	})
+
+	err := tun.handleEvent(ctx, &userv1.ConnectResponse{
+		Event: &userv1.ConnectResponse_AddService_{
+			AddService: &userv1.ConnectResponse_AddService{
+				Service: &userv1.HostedService{
+					Name: "svc2.ns2",
+					Port: 20002,
+				},
+			},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(tun.svcs.hosted))
+
+	err = tun.handleEvent(ctx, &userv1.ConnectResponse{
+		Event: &userv1.ConnectResponse_UpdateService_{
+			UpdateService: &userv1.ConnectResponse_UpdateService{
+				Service: &userv1.HostedService{
+					Name: "svc2.ns2",
+					Port: 20003,
+				},
+			},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(20003), tun.svcs.hosted["svc2.ns2"].Port)
+
+	err = tun.handleEvent(ctx, &userv1.ConnectResponse{
+		Event: &userv1.ConnectResponse_DeleteService_{
+			DeleteService: &userv1.ConnectResponse_DeleteService{
+				Name: "svc1.default",
+			},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(tun.svcs.hosted))
+	_, ok := tun.svcs.hosted["svc1.default"]
+	assert.False(t, ok)
+}
@@ -911,6 +911,43 @@ func (c *ClusterConfig) GetGatewayPortQUICv0() uint32 {
 	return c.Status.NetworkConfig.Quicv0.GatewayPort
 }
 
+func (c *ClusterConfig) GetGatewayPortMASQUE() uint32 {
+	if c.Status.NetworkConfig == nil || c.Status.NetworkConfig.Masque == nil || c.Status.NetworkConfig.Masque.GatewayPort == 0 {
+		return 8444
+	}
+
+	return c.Status.NetworkConfig.Masque.GatewayPort
+}
+
+func (c *ClusterConfig) GetGatewayPortTLS() uint32 {
+	if c.Status.NetworkConfig == nil || c.Status.NetworkConfig.Tls == nil || c.Status.NetworkConfig.Tls.GatewayPort == 0 {
+		return 8445
+	}
+
+	return c.Status.NetworkConfig.Tls.GatewayPort
+}
+
+func (c *ClusterConfig) IsQUICV0Enabled() bool {
+	return c.Status.NetworkConfig != nil && c.Status.NetworkConfig.Quicv0 != nil &&
+		c.Status.NetworkConfig.Quicv0.Enable
+}
+
+func (c *ClusterConfig) IsMASQUEEnabled() bool {
+	return c.Status.NetworkConfig != nil && c.Status.NetworkConfig.Masque != nil &&
+		c.Status.NetworkConfig.Masque.Enable
+}
+
+func (c *ClusterConfig) IsTLSEnabled() bool {
+	return c.Status.NetworkConfig != nil && c.Status.NetworkConfig.Tls != nil &&
+		c.Status.NetworkConfig.Tls.Enable
+}
+
+// IsACMEHTTP01Enabled returns whether the Cluster certificates are obtained via
+// ACME HTTP-01 challenges which are answered by the ingress plain HTTP listener.
+func (c *ClusterConfig) IsACMEHTTP01Enabled() bool {
+	return c.Spec.Certificate.GetAcme().GetHttp01() != nil
+}
+
 func (c *ClusterConfig) GetDevMTUWireGuard() int {
 	if c.Status.NetworkConfig == nil || c.Status.NetworkConfig.Wireguard == nil || c.Status.NetworkConfig.Wireguard.Mtu == 0 {
 		return 1280
@@ -974,3 +1011,36 @@ func ToAuthenticator(a *corev1.Authenticator) *Authenticator {
 		Authenticator: a,
 	}
 }
+
+type Device struct {
+	*corev1.Device
+}
+
+func ToDevice(a *corev1.Device) *Device {
+	return &Device{
+		Device: a,
+	}
+}
+
+const defaultDevicePostureMaxAge = 10 * time.Minute
+
+func (c *ClusterConfig) GetDevicePostureMaxAge() time.Duration {
+	if c == nil {
+		return defaultDevicePostureMaxAge
+	}
+
+	if ret := umetav1.ToDuration(c.GetSpec().GetDevice().GetPosture().GetMaxAge()).ToGo(); ret > 0 {
+		return ret
+	}
+
+	return defaultDevicePostureMaxAge
+}
+
+// IsPostureStale returns whether the Device has not reported a posture within maxAge
+func (d *Device) IsPostureStale(maxAge time.Duration, now time.Time) bool {
+	if d == nil || d.GetStatus().GetPosture().GetReceivedAt() == nil {
+		return true
+	}
+
+	return now.Sub(d.Status.Posture.ReceivedAt.AsTime()) > maxAge
+}
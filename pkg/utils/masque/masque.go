@@ -0,0 +1,316 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package masque implements the wire format of the MASQUE CONNECT-IP
+// protocol (RFC 9484) shared by the Gateway agent and the client.
+package masque
+
+import (
+	"bytes"
+	"io"
+	"net/netip"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/quic-go/quic-go/http3"
+	"github.com/quic-go/quic-go/quicvarint"
+)
+
+const (
+	// Protocol is the value of the `:protocol` pseudo-header of the extended
+	// CONNECT request.
+	Protocol = "connect-ip"
+
+	// PathPrefix is the prefix of the default CONNECT-IP URI template.
+	PathPrefix = "/.well-known/masque/ip/"
+
+	// Path is the request path used to tunnel IP packets to any target and
+	// for any IP protocol.
+	Path = PathPrefix + "*/*/"
+)
+
+const (
+	CapsuleTypeAddressAssign      http3.CapsuleType = 0x01
+	CapsuleTypeAddressRequest     http3.CapsuleType = 0x02
+	CapsuleTypeRouteAdvertisement http3.CapsuleType = 0x03
+)
+
+const maxCapsuleSize = 64 * 1024
+
+// contextIDIP is the context ID of HTTP datagrams carrying full IP packets.
+const contextIDIP = 0
+
+var ErrUnknownContextID = errors.New("Unknown datagram context ID")
+
+// AssignedAddress is an entry of an ADDRESS_ASSIGN capsule.
+type AssignedAddress struct {
+	RequestID uint64
+	Prefix    netip.Prefix
+}
+
+// IPRange is an entry of a ROUTE_ADVERTISEMENT capsule. An IPProtocol of 0
+// means that all IP protocols are allowed.
+type IPRange struct {
+	Start      netip.Addr
+	End        netip.Addr
+	IPProtocol uint8
+}
+
+func (r IPRange) Contains(addr netip.Addr) bool {
+	return addr.BitLen() == r.Start.BitLen() &&
+		r.Start.Compare(addr) <= 0 && addr.Compare(r.End) <= 0
+}
+
+// PrefixToRange returns the IP range covered by the prefix.
+func PrefixToRange(prefix netip.Prefix) IPRange {
+	prefix = prefix.Masked()
+	start := prefix.Addr()
+	end := start.AsSlice()
+	for i := prefix.Bits(); i < len(end)*8; i++ {
+		end[i/8] |= 0x80 >> (i % 8)
+	}
+	endAddr, _ := netip.AddrFromSlice(end)
+
+	return IPRange{
+		Start: start,
+		End:   endAddr,
+	}
+}
+
+// IsValidPath reports whether the path of a CONNECT-IP request is supported.
+// Only the wildcard target and IP protocol are currently supported since the
+// Gateway already restricts the reachable destinations to its Services.
+func IsValidPath(path string) bool {
+	if !strings.HasPrefix(path, PathPrefix) {
+		return false
+	}
+
+	args := strings.Split(strings.TrimSuffix(strings.TrimPrefix(path, PathPrefix), "/"), "/")
+	if len(args) != 2 {
+		return false
+	}
+
+	return args[0] == "*" && args[1] == "*"
+}
+
+// EncodeDatagram prepends the IP packet context ID to the packet.
+func EncodeDatagram(pkt []byte) []byte {
+	ret := make([]byte, 0, len(pkt)+1)
+	ret = quicvarint.Append(ret, contextIDIP)
+	return append(ret, pkt...)
+}
+
+// DecodeDatagram returns the IP packet carried by the HTTP datagram. Datagrams
+// with unknown context IDs must be silently dropped by the caller.
+func DecodeDatagram(b []byte) ([]byte, error) {
+	contextID, n, err := quicvarint.Parse(b)
+	if err != nil {
+		return nil, err
+	}
+
+	if contextID != contextIDIP {
+		return nil, ErrUnknownContextID
+	}
+
+	return b[n:], nil
+}
+
+func appendAddr(b []byte, addr netip.Addr) []byte {
+	if addr.Is4() {
+		b = append(b, 4)
+	} else {
+		b = append(b, 6)
+	}
+	return append(b, addr.AsSlice()...)
+}
+
+func readAddr(r io.Reader, version byte) (netip.Addr, error) {
+	var buf []byte
+	switch version {
+	case 4:
+		buf = make([]byte, 4)
+	case 6:
+		buf = make([]byte, 16)
+	default:
+		return netip.Addr{}, errors.Errorf("Invalid IP version: %d", version)
+	}
+
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return netip.Addr{}, err
+	}
+
+	addr, _ := netip.AddrFromSlice(buf)
+	return addr, nil
+}
+
+func readByte(r io.Reader) (byte, error) {
+	var buf [1]byte
+	if _, err := io.ReadFull(r, buf[:]); err != nil {
+		return 0, err
+	}
+	return buf[0], nil
+}
+
+// writeCapsule writes the whole capsule at once so that it is carried by a
+// single HTTP/3 DATA frame.
+func writeCapsule(w io.Writer, typ http3.CapsuleType, value []byte) error {
+	buf := &bytes.Buffer{}
+	if err := http3.WriteCapsule(buf, typ, value); err != nil {
+		return err
+	}
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// WriteAddressAssign writes an ADDRESS_ASSIGN capsule.
+func WriteAddressAssign(w io.Writer, addrs []AssignedAddress) error {
+	var b []byte
+	for _, addr := range addrs {
+		b = quicvarint.Append(b, addr.RequestID)
+		b = appendAddr(b, addr.Prefix.Addr())
+		b = append(b, byte(addr.Prefix.Bits()))
+	}
+
+	return writeCapsule(w, CapsuleTypeAddressAssign, b)
+}
+
+// ParseAddressAssign parses the value of an ADDRESS_ASSIGN capsule. It can
+// also parse ADDRESS_REQUEST capsules since both share the same format.
+func ParseAddressAssign(r io.Reader) ([]AssignedAddress, error) {
+	var ret []AssignedAddress
+	vr := quicvarint.NewReader(r)
+
+	for {
+		requestID, err := quicvarint.Read(vr)
+		if err == io.EOF {
+			return ret, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		version, err := readByte(vr)
+		if err != nil {
+			return nil, err
+		}
+
+		addr, err := readAddr(vr, version)
+		if err != nil {
+			return nil, err
+		}
+
+		bits, err := readByte(vr)
+		if err != nil {
+			return nil, err
+		}
+
+		prefix, err := addr.Prefix(int(bits))
+		if err != nil {
+			return nil, err
+		}
+		if prefix.Addr() != addr {
+			return nil, errors.Errorf("Address %s is not the start of its prefix", addr.String())
+		}
+
+		ret = append(ret, AssignedAddress{
+			RequestID: requestID,
+			Prefix:    prefix,
+		})
+	}
+}
+
+// WriteRouteAdvertisement writes a ROUTE_ADVERTISEMENT capsule. The ranges
+// are sorted as required by the RFC, IPv4 ranges first.
+func WriteRouteAdvertisement(w io.Writer, ranges []IPRange) error {
+	ranges = append([]IPRange(nil), ranges...)
+	sort.Slice(ranges, func(i, j int) bool {
+		if ranges[i].Start.BitLen() != ranges[j].Start.BitLen() {
+			return ranges[i].Start.BitLen() < ranges[j].Start.BitLen()
+		}
+		if ranges[i].IPProtocol != ranges[j].IPProtocol {
+			return ranges[i].IPProtocol < ranges[j].IPProtocol
+		}
+		return ranges[i].Start.Less(ranges[j].Start)
+	})
+
+	var b []byte
+	for _, rng := range ranges {
+		b = appendAddr(b, rng.Start)
+		b = append(b, rng.End.AsSlice()...)
+		b = append(b, rng.IPProtocol)
+	}
+
+	return writeCapsule(w, CapsuleTypeRouteAdvertisement, b)
+}
+
+// ParseRouteAdvertisement parses the value of a ROUTE_ADVERTISEMENT capsule.
+func ParseRouteAdvertisement(r io.Reader) ([]IPRange, error) {
+	var ret []IPRange
+
+	for {
+		version, err := readByte(r)
+		if err == io.EOF {
+			return ret, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		start, err := readAddr(r, version)
+		if err != nil {
+			return nil, err
+		}
+		end, err := readAddr(r, version)
+		if err != nil {
+			return nil, err
+		}
+
+		if end.Less(start) {
+			return nil, errors.Errorf("Invalid IP range: %s-%s", start.String(), end.String())
+		}
+
+		proto, err := readByte(r)
+		if err != nil {
+			return nil, err
+		}
+
+		ret = append(ret, IPRange{
+			Start:      start,
+			End:        end,
+			IPProtocol: proto,
+		})
+	}
+}
+
+// ReadCapsule reads the next capsule from the stream and returns its type and
+// its full value.
+func ReadCapsule(r quicvarint.Reader) (http3.CapsuleType, []byte, error) {
+	typ, cr, err := http3.ParseCapsule(r)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	n, err := io.Copy(buf, io.LimitReader(cr, maxCapsuleSize+1))
+	if err != nil {
+		return 0, nil, err
+	}
+	if n > maxCapsuleSize {
+		return 0, nil, errors.Errorf("Capsule is too large")
+	}
+
+	return typ, buf.Bytes(), nil
+}
@@ -0,0 +1,107 @@
+// Copyright Octelium Labs, LLC. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package masque
+
+import (
+	"bytes"
+	"net/netip"
+	"testing"
+
+	"github.com/quic-go/quic-go/quicvarint"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddressAssign(t *testing.T) {
+	addrs := []AssignedAddress{
+		{
+			Prefix: netip.MustParsePrefix("100.64.1.2/32"),
+		},
+		{
+			RequestID: 1000,
+			Prefix:    netip.MustParsePrefix("fd00:1::/64"),
+		},
+	}
+
+	buf := &bytes.Buffer{}
+	err := WriteAddressAssign(buf, addrs)
+	assert.Nil(t, err)
+
+	typ, value, err := ReadCapsule(quicvarint.NewReader(buf))
+	assert.Nil(t, err)
+	assert.Equal(t, CapsuleTypeAddressAssign, typ)
+
+	res, err := ParseAddressAssign(bytes.NewReader(value))
+	assert.Nil(t, err)
+	assert.Equal(t, addrs, res)
+
+	_, err = ParseAddressAssign(bytes.NewReader([]byte{0, 5, 1, 2, 3, 4, 32}))
+	assert.NotNil(t, err)
+
+	_, err = ParseAddressAssign(bytes.NewReader([]byte{0, 4, 1, 2, 3, 4, 24}))
+	assert.NotNil(t, err, "the address must be the start of the prefix")
+}
+
+func TestRouteAdvertisement(t *testing.T) {
+	ranges := []IPRange{
+		PrefixToRange(netip.MustParsePrefix("fd00:2::/64")),
+		PrefixToRange(netip.MustParsePrefix("100.64.0.0/10")),
+		PrefixToRange(netip.MustParsePrefix("10.0.0.0/8")),
+	}
+
+	assert.Equal(t, netip.MustParseAddr("100.127.255.255"), ranges[1].End)
+	assert.Equal(t, netip.MustParseAddr("fd00:2::ffff:ffff:ffff:ffff"), ranges[0].End)
+
+	buf := &bytes.Buffer{}
+	err := WriteRouteAdvertisement(buf, ranges)
+	assert.Nil(t, err)
+
+	typ, value, err := ReadCapsule(quicvarint.NewReader(buf))
+	assert.Nil(t, err)
+	assert.Equal(t, CapsuleTypeRouteAdvertisement, typ)
+
+	res, err := ParseRouteAdvertisement(bytes.NewReader(value))
+	assert.Nil(t, err)
+	assert.Equal(t, []IPRange{ranges[2], ranges[1], ranges[0]}, res)
+
+	assert.True(t, res[1].Contains(netip.MustParseAddr("100.100.1.1")))
+	assert.False(t, res[1].Contains(netip.MustParseAddr("100.128.0.1")))
+	assert.False(t, res[1].Contains(netip.MustParseAddr("fd00:2::1")))
+	assert.True(t, res[2].Contains(netip.MustParseAddr("fd00:2::1")))
+
+	_, err = ParseRouteAdvertisement(bytes.NewReader([]byte{4, 10, 0, 0, 2, 10, 0, 0, 1, 0}))
+	assert.NotNil(t, err)
+}
+
+func TestDatagram(t *testing.T) {
+	pkt := []byte{0x45, 1, 2, 3}
+
+	res, err := DecodeDatagram(EncodeDatagram(pkt))
+	assert.Nil(t, err)
+	assert.Equal(t, pkt, res)
+
+	_, err = DecodeDatagram(append([]byte{2}, pkt...))
+	assert.ErrorIs(t, err, ErrUnknownContextID)
+
+	_, err = DecodeDatagram(nil)
+	assert.NotNil(t, err)
+}
+
+func TestIsValidPath(t *testing.T) {
+	assert.True(t, IsValidPath(Path))
+	assert.True(t, IsValidPath("/.well-known/masque/ip/*/*"))
+	assert.False(t, IsValidPath("/.well-known/masque/ip/10.0.0.1/*/"))
+	assert.False(t, IsValidPath("/.well-known/masque/udp/*/*/"))
+	assert.False(t, IsValidPath("/"))
+}